@@ -16,6 +16,7 @@ import (
 	"fyne.io/fyne/v2/widget"
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/player/pkg/player/playlist"
 	"github.com/xaionaro-go/player/pkg/player/types"
 	xfyne "github.com/xaionaro-go/xfyne/widget"
 )
@@ -27,17 +28,17 @@ func init() {
 func runPlayerControls(
 	ctx context.Context,
 	p types.Player,
+	pl *playlist.Playlist,
 ) {
 	defer logger.Infof(ctx, "player controls ended")
 	app := fyne.CurrentApp()
 
 	observability.Go(ctx, func(ctx context.Context) {
-		ch, err := p.EndChan(ctx)
-		if err != nil {
-			panic(err)
+		if err := pl.Serve(ctx); err != nil {
+			logger.Errorf(ctx, "unable to serve the playlist: %v", err)
+			return
 		}
-		<-ch
-		w := app.NewWindow("file ended")
+		w := app.NewWindow("playlist ended")
 		b := widget.NewButton("Close", func() {
 			w.Close()
 		})
@@ -167,22 +168,66 @@ func runPlayerControls(
 		p.Seek(ctx, -time.Second, true, true)
 	})
 
+	frameStep := func(forward bool) {
+		err := p.FrameStep(ctx, forward)
+		if err != nil {
+			errorMessage.SetText(fmt.Sprintf("unable to step a frame (forward: %v): %s", forward, err))
+			return
+		}
+		errorMessage.SetText("")
+		isPaused = true
+		pauseUnpause.SetText("Unpause")
+		pauseUnpause.SetIcon(theme.MediaPlayIcon())
+	}
+
+	frameBackStepButton := widget.NewButtonWithIcon("Frame", theme.MediaSkipPreviousIcon(), func() {
+		frameStep(false)
+	})
+
+	frameStepButton := widget.NewButtonWithIcon("Frame", theme.MediaSkipNextIcon(), func() {
+		frameStep(true)
+	})
+
+	switchEntry := func(next bool) {
+		var err error
+		if next {
+			err = pl.Next(ctx)
+		} else {
+			err = pl.Previous(ctx)
+		}
+		if err != nil {
+			errorMessage.SetText(fmt.Sprintf("unable to switch the playlist entry (next: %v): %s", next, err))
+			return
+		}
+		errorMessage.SetText("")
+	}
+
+	previousButton := widget.NewButtonWithIcon("", theme.NavigateBackIcon(), func() {
+		switchEntry(false)
+	})
+
+	nextButton := widget.NewButtonWithIcon("", theme.NavigateNextIcon(), func() {
+		switchEntry(true)
+	})
+
 	posLabel := widget.NewLabel("")
 	observability.Go(ctx, func(ctx context.Context) {
-		t := time.NewTicker(time.Millisecond * 100)
-		for {
-			<-t.C
-			l, err := p.GetLength(ctx)
-			if err != nil {
-				l = -1
-			}
-
-			pos, err := p.GetPosition(ctx)
-			if err != nil {
-				posLabel.SetText(fmt.Sprintf("unable to get the position: %v", err))
+		events, err := p.Events(ctx)
+		if err != nil {
+			posLabel.SetText(fmt.Sprintf("unable to subscribe to the events: %v", err))
+			return
+		}
+		for ev := range events {
+			switch ev := ev.(type) {
+			case types.EventPosition:
+				lengthString := "live"
+				if ev.Length > 0 {
+					lengthString = ev.Length.String()
+				}
+				posLabel.SetText(ev.Position.String() + " / " + lengthString)
+			case types.EventError:
+				errorMessage.SetText(ev.Err.Error())
 			}
-
-			posLabel.SetText(pos.String() + " / " + l.String())
 		}
 	})
 
@@ -205,7 +250,13 @@ func runPlayerControls(
 				forwardQuickButton,
 			),
 			container.NewHBox(
+				previousButton,
+				nextButton,
+			),
+			container.NewHBox(
+				frameBackStepButton,
 				pauseUnpause,
+				frameStepButton,
 				stopButton,
 				closeButton,
 			),
@@ -8,15 +8,21 @@ import (
 	"time"
 
 	"github.com/xaionaro-go/avpipeline/logger"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/player/pkg/player/playlist"
 	"github.com/xaionaro-go/player/pkg/player/types"
 )
 
 func runPlayerControls(
 	ctx context.Context,
 	p types.Player,
+	pl *playlist.Playlist,
 ) {
 	defer logger.Infof(ctx, "player controls ended")
-	endCh, _ := p.EndChan(ctx)
+	endCh := make(chan error, 1)
+	observability.Go(ctx, func(ctx context.Context) {
+		endCh <- pl.Serve(ctx)
+	})
 	t := time.NewTicker(1 * time.Second)
 	defer t.Stop()
 	for {
@@ -24,8 +30,8 @@ func runPlayerControls(
 		case <-ctx.Done():
 			logger.Debugf(ctx, "context done: %v", ctx.Err())
 			return
-		case <-endCh:
-			logger.Debugf(ctx, "player ended")
+		case err := <-endCh:
+			logger.Debugf(ctx, "playlist ended: %v", err)
 			return
 		case <-t.C:
 		}
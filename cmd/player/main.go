@@ -14,6 +14,7 @@ import (
 
 	"github.com/xaionaro-go/observability"
 	"github.com/xaionaro-go/player/pkg/player"
+	"github.com/xaionaro-go/player/pkg/player/playlist"
 	"github.com/xaionaro-go/player/pkg/player/types"
 	"github.com/xaionaro-go/xsync"
 
@@ -46,6 +47,9 @@ func main() {
 	lowLatency := pflag.Bool("low-latency", false, "")
 	cacheLength := pflag.Duration("cache-duration", 0, "")
 	cacheMaxSize := pflag.Uint("cache-max-size", 0, "")
+	subtitlesPath := pflag.String("subtitles", "", "path to an external subtitles file (SubRip, WebVTT or ASS)")
+	shuffle := pflag.Bool("shuffle", false, "play the media in a random order")
+	repeat := pflag.String("repeat", "none", "repeat mode, supported values: none, one, all")
 	pflag.Parse()
 
 	l := logrus.Default().WithLevel(loggerLevel)
@@ -55,10 +59,20 @@ func main() {
 	}
 	defer belt.Flush(ctx)
 
-	if pflag.NArg() != 1 {
-		l.Fatal("exactly one argument expected")
+	if pflag.NArg() < 1 {
+		l.Fatal("at least one argument expected")
+	}
+	var repeatMode playlist.RepeatMode
+	switch *repeat {
+	case "none":
+		repeatMode = playlist.RepeatModeNone
+	case "one":
+		repeatMode = playlist.RepeatModeOne
+	case "all":
+		repeatMode = playlist.RepeatModeAll
+	default:
+		l.Fatalf("unknown repeat mode '%s'", *repeat)
 	}
-	mediaPath := pflag.Arg(0)
 
 	if *netPprofAddr != "" {
 		observability.Go(ctx, func(
@@ -88,9 +102,34 @@ func main() {
 	p, err := m.NewPlayer(ctx, "player demonstration", player.Backend(*backend))
 	assertNoError(ctx, err)
 
-	err = p.OpenURL(ctx, mediaPath)
+	pl := playlist.New(p)
+	for _, arg := range pflag.Args() {
+		if playlist.FormatFromPath(arg) == playlist.FormatUndefined {
+			pl.Append(ctx, playlist.Entry{Link: arg})
+			continue
+		}
+		entries, err := playlist.LoadFile(arg)
+		if err != nil {
+			logger.Fatalf(ctx, "unable to load the playlist: %v", err)
+		}
+		pl.Append(ctx, entries...)
+	}
+	pl.SetShuffle(ctx, *shuffle)
+	pl.SetRepeatMode(ctx, repeatMode)
+
+	err = pl.Next(ctx)
 	if err != nil {
-		logger.Fatalf(ctx, "unable to open the url '%s': %v", mediaPath, err)
+		logger.Fatalf(ctx, "unable to start the playlist: %v", err)
+	}
+
+	if *subtitlesPath != "" {
+		loader, ok := p.(types.SubtitlesFileLoader)
+		if !ok {
+			logger.Fatalf(ctx, "backend '%s' does not support external subtitles", *backend)
+		}
+		if err := loader.LoadSubtitlesFile(ctx, *subtitlesPath); err != nil {
+			logger.Fatalf(ctx, "unable to load the subtitles '%s': %v", *subtitlesPath, err)
+		}
 	}
 
 	err = p.SetPause(ctx, false)
@@ -98,5 +137,5 @@ func main() {
 		logger.Errorf(ctx, "unable to start playback: %v", err)
 	}
 
-	runPlayerControls(ctx, p)
+	runPlayerControls(ctx, p, pl)
 }
@@ -0,0 +1,27 @@
+//go:build with_fyne
+// +build with_fyne
+
+package main
+
+import (
+	"context"
+
+	"fyne.io/fyne/v2"
+	fyneapp "fyne.io/fyne/v2/app"
+	"github.com/xaionaro-go/observability"
+)
+
+func init() {
+	fyneapp.New()
+}
+
+// runUI runs the event loop of fyne (it has to be run in the main
+// goroutine), until the context is cancelled.
+func runUI(ctx context.Context) {
+	app := fyne.CurrentApp()
+	observability.Go(ctx, func(ctx context.Context) {
+		<-ctx.Done()
+		app.Quit()
+	})
+	app.Run()
+}
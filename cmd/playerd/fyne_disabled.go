@@ -0,0 +1,12 @@
+//go:build !with_fyne
+// +build !with_fyne
+
+package main
+
+import (
+	"context"
+)
+
+func runUI(ctx context.Context) {
+	<-ctx.Done()
+}
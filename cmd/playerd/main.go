@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	child_process_manager "github.com/AgustinSRG/go-child-process-manager"
+	"github.com/facebookincubator/go-belt"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/facebookincubator/go-belt/tool/logger/implementation/logrus"
+	"github.com/spf13/pflag"
+
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/player/pkg/player"
+	"github.com/xaionaro-go/player/pkg/player/playerserver"
+	"github.com/xaionaro-go/player/pkg/player/types"
+	"github.com/xaionaro-go/xsync"
+
+	_ "github.com/xaionaro-go/audio/pkg/audio/backends/oto"
+)
+
+func main() {
+	var backends []string
+	for _, backend := range player.SupportedBackends() {
+		backends = append(backends, string(backend))
+	}
+	loggerLevel := logger.LevelInfo
+	pflag.Var(&loggerLevel, "log-level", "Log level")
+	mpvPath := pflag.String("mpv", "mpv", "path to mpv")
+	backend := pflag.String("backend", backends[0], "player backend, supported values: "+strings.Join(backends, ", "))
+	listenAddr := pflag.String("listen-addr", "127.0.0.1:0", "the address to serve the gRPC player service at")
+	pflag.Parse()
+
+	l := logrus.Default().WithLevel(loggerLevel)
+	ctx := xsync.WithNoLogging(logger.CtxWithLogger(context.Background(), l), true)
+	logger.Default = func() logger.Logger {
+		return l
+	}
+	defer belt.Flush(ctx)
+
+	err := child_process_manager.InitializeChildProcessManager()
+	if err != nil {
+		logger.Fatal(ctx, err)
+	}
+	defer child_process_manager.DisposeChildProcessManager()
+
+	m := player.NewManager(types.OptionPathToMPV(*mpvPath))
+	srv := playerserver.NewServer(func(
+		ctx context.Context,
+		title string,
+	) (types.Player, error) {
+		return m.NewPlayer(ctx, title, player.Backend(*backend))
+	})
+	srv.KeepServingOnClose = true
+
+	listener, err := net.Listen("tcp", *listenAddr)
+	if err != nil {
+		logger.Fatalf(ctx, "unable to listen at '%s': %v", *listenAddr, err)
+	}
+	logger.Infof(ctx, "serving the player ('%s') at %s", *backend, listener.Addr())
+
+	ctx, cancelFn := context.WithCancel(ctx)
+	observability.Go(ctx, func(ctx context.Context) {
+		defer cancelFn()
+		if err := srv.Serve(listener); err != nil {
+			logger.Errorf(ctx, "unable to serve: %v", err)
+		}
+	})
+
+	runUI(ctx)
+}
@@ -0,0 +1,162 @@
+package gstreamer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/facebookincubator/go-belt"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/go-gst/go-gst/gst"
+	"github.com/go-gst/go-gst/gst/app"
+	"github.com/xaionaro-go/audio/pkg/audio"
+	"github.com/xaionaro-go/xsync"
+)
+
+const (
+	BufferSizeAudio = 100 * time.Millisecond
+)
+
+// audioCaps is the format requested from playbin; it is converted by
+// playbin itself, so the sample rate and the amount of channels are
+// the original ones.
+const audioCaps = "audio/x-raw,format=F32LE,layout=interleaved"
+
+type audioOutput struct {
+	SampleRate audio.SampleRate
+	Channels   audio.Channel
+	Writer     io.WriteCloser
+	Stream     audio.PlayStream
+}
+
+func (d *Decoder) newAudioSink() (*app.Sink, error) {
+	// see https://gstreamer.freedesktop.org/documentation/app/appsink.html
+	audioSinkElement, err := gst.NewElement("appsink")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create appsink element: %w", err)
+	}
+	audioSinkElement.Set("emit-signals", true)
+	audioSink := app.SinkFromElement(audioSinkElement)
+	audioSink.SetCaps(gst.NewCapsFromString(audioCaps))
+	audioSink.SetCallbacks(&app.SinkCallbacks{
+		NewSampleFunc: d.onNewAudioSampleFunc,
+	})
+	return audioSink, nil
+}
+
+func (d *Decoder) onNewAudioSampleFunc(sink *app.Sink) (_ret gst.FlowReturn) {
+	ctx := belt.CtxWithBelt(context.Background(), d.observability)
+	logger.Tracef(ctx, "onNewAudioSampleFunc called")
+	defer func() { logger.Tracef(ctx, "/onNewAudioSampleFunc: %s", _ret) }()
+
+	sample := sink.PullSample()
+	if sample == nil {
+		return gst.FlowEOS
+	}
+
+	buffer := sample.GetBuffer()
+	if buffer == nil {
+		d.logger().Errorf("no buffer in sample")
+		return gst.FlowError
+	}
+
+	structure := sample.GetCaps().GetStructureAt(0)
+	rate, err := structure.GetValue("rate")
+	if err != nil {
+		d.logger().Errorf("unable to get rate from structure: %v", err)
+		return gst.FlowError
+	}
+	channels, err := structure.GetValue("channels")
+	if err != nil {
+		d.logger().Errorf("unable to get channels from structure: %v", err)
+		return gst.FlowError
+	}
+	sampleRate, ok := rate.(int)
+	if !ok {
+		d.logger().Errorf("rate is not an int: %T", rate)
+		return gst.FlowError
+	}
+	channelsCount, ok := channels.(int)
+	if !ok {
+		d.logger().Errorf("channels is not an int: %T", channels)
+		return gst.FlowError
+	}
+
+	w, err := d.getAudioWriter(ctx, audio.SampleRate(sampleRate), audio.Channel(channelsCount))
+	if err != nil {
+		d.logger().Errorf("unable to initialize the audio output: %v", err)
+		return gst.FlowError
+	}
+
+	bufmap := buffer.Map(gst.MapRead)
+	data := append([]byte{}, bufmap.Bytes()...)
+	buffer.Unmap()
+	if gain := d.getGain(); gain != 1 {
+		applyGain(data, gain)
+	}
+
+	if _, err := w.Write(data); err != nil {
+		if errors.Is(err, io.ErrClosedPipe) {
+			// the audio output was reset (for example, by a seek)
+			logger.Debugf(ctx, "unable to write the audio: %v", err)
+			return gst.FlowOK
+		}
+		d.logger().Errorf("unable to write the audio: %v", err)
+		return gst.FlowError
+	}
+	return gst.FlowOK
+}
+
+func (d *Decoder) getAudioWriter(
+	ctx context.Context,
+	sampleRate audio.SampleRate,
+	channels audio.Channel,
+) (io.Writer, error) {
+	return xsync.DoR2(ctx, &d.audioLocker, func() (io.Writer, error) {
+		if out := d.audioOutput; out != nil {
+			if out.SampleRate == sampleRate && out.Channels == channels {
+				return out.Writer, nil
+			}
+			logger.Debugf(ctx, "the audio format changed: %d/%d -> %d/%d", out.SampleRate, out.Channels, sampleRate, channels)
+			d.resetAudioLocked()
+		}
+
+		r, w := io.Pipe()
+		audioStream, err := d.AudioRenderer.PlayPCM(
+			ctx,
+			sampleRate,
+			channels,
+			audio.PCMFormatFloat32LE,
+			BufferSizeAudio,
+			r,
+		)
+		if err != nil {
+			w.Close()
+			return nil, fmt.Errorf("unable to initialize an audio playback: %w", err)
+		}
+		d.audioOutput = &audioOutput{
+			SampleRate: sampleRate,
+			Channels:   channels,
+			Writer:     w,
+			Stream:     audioStream,
+		}
+		return w, nil
+	})
+}
+
+// resetAudio drops the audio output; it is re-initialized on the next sample.
+func (d *Decoder) resetAudio(ctx context.Context) {
+	d.audioLocker.Do(ctx, d.resetAudioLocked)
+}
+
+func (d *Decoder) resetAudioLocked() {
+	out := d.audioOutput
+	if out == nil {
+		return
+	}
+	d.audioOutput = nil
+	out.Writer.Close()
+	out.Stream.Close()
+}
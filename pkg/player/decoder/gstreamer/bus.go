@@ -0,0 +1,112 @@
+package gstreamer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/go-gst/go-gst/gst"
+	"github.com/xaionaro-go/player/pkg/player/types"
+	"github.com/xaionaro-go/xsync"
+)
+
+const (
+	busPollInterval = 100 * time.Millisecond
+)
+
+// busLoop handles the messages of the pipeline until the Decoder is closed;
+// a bus watch is not used, because it requires a running GLib main loop.
+func (d *Decoder) busLoop(
+	ctx context.Context,
+	bus *gst.Bus,
+	closeChan <-chan struct{},
+) {
+	logger.Debugf(ctx, "busLoop")
+	defer logger.Debugf(ctx, "/busLoop")
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-closeChan:
+			return
+		default:
+		}
+		msg := bus.TimedPopFiltered(
+			gst.ClockTime(busPollInterval.Nanoseconds()),
+			gst.MessageEOS|gst.MessageError|gst.MessageTag|gst.MessageStateChanged|gst.MessageBuffering|gst.MessageTOC|gst.MessageStreamStart,
+		)
+		if msg != nil {
+			d.onBusMessage(ctx, msg)
+		}
+		d.emitPosition(ctx)
+	}
+}
+
+func (d *Decoder) onBusMessage(
+	ctx context.Context,
+	msg *gst.Message,
+) {
+	logger.Tracef(ctx, "onBusMessage: %s from %s", msg.TypeName(), msg.Source())
+	switch msg.Type() {
+	case gst.MessageEOS:
+		d.events.Emit(ctx, types.EventEndOfFile{})
+		d.onEnd(ctx, nil)
+	case gst.MessageError:
+		gErr := msg.ParseError()
+		logger.Errorf(ctx, "received an error from %s: %v (%s)", msg.Source(), gErr, gErr.DebugString())
+		err := fmt.Errorf("%s: %w", msg.Source(), gErr)
+		d.events.Emit(ctx, types.EventError{Err: err})
+		d.onEnd(ctx, err)
+	case gst.MessageStateChanged:
+		if msg.Source() != d.Pipeline.GetName() {
+			return
+		}
+		_, newState := msg.ParseStateChanged()
+		d.onStateChanged(ctx, newState)
+	case gst.MessageBuffering:
+		d.events.Emit(ctx, types.EventBuffering{Percent: float64(msg.ParseBuffering())})
+	case gst.MessageTag:
+		tags := msg.ParseTags()
+		if tags == nil {
+			return
+		}
+		d.onTags(ctx, tags)
+	case gst.MessageTOC:
+		toc, _ := msg.ParseTOC()
+		if toc == nil {
+			return
+		}
+		d.onTOC(ctx, toc)
+	case gst.MessageStreamStart:
+		d.onStreamStart(ctx)
+	}
+}
+
+// onEnd marks the playback as ended and closes the current EndChan.
+func (d *Decoder) onEnd(
+	ctx context.Context,
+	err error,
+) {
+	logger.Debugf(ctx, "onEnd(ctx, %v)", err)
+	defer logger.Debugf(ctx, "/onEnd(ctx, %v)", err)
+	d.locker.Do(ctx, func() {
+		if d.isEnded {
+			return
+		}
+		d.isEnded = true
+		d.lastError = err
+		var oldEndChan chan struct{}
+		d.endChan, oldEndChan = make(chan struct{}), d.endChan
+		close(oldEndChan)
+	})
+	d.onStateChanged(ctx, gst.StateNull)
+	d.resetAudio(ctx)
+}
+
+// Err returns the error the last playback was ended with (if any).
+func (d *Decoder) Err(ctx context.Context) error {
+	return xsync.DoR1(ctx, &d.locker, func() error {
+		return d.lastError
+	})
+}
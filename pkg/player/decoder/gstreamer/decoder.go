@@ -5,16 +5,20 @@ import (
 	"errors"
 	"fmt"
 	"image"
+	"math"
 	"net/url"
 	"path/filepath"
+	"sync/atomic"
 	"time"
 
 	"github.com/facebookincubator/go-belt"
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/go-gst/go-gst/gst"
 	"github.com/go-gst/go-gst/gst/app"
+	"github.com/xaionaro-go/observability"
 	"github.com/xaionaro-go/player/pkg/player/imagerenderer"
 	"github.com/xaionaro-go/player/pkg/player/types"
+	"github.com/xaionaro-go/xsync"
 )
 
 func init() {
@@ -25,10 +29,29 @@ type Decoder struct {
 	Pipeline      *gst.Pipeline
 	Playbin       *gst.Element
 	AppSink       *app.Sink
+	AudioSink     *app.Sink
 	CurrentFrame  *image.RGBA
 	AudioRenderer AudioRenderer
 	ImageRenderer ImageRenderer
 	observability *belt.Belt
+	audioLocker   xsync.Mutex
+	frameLocker   xsync.Mutex // guards the updates of CurrentFrame
+	audioOutput   *audioOutput
+	locker        xsync.Mutex
+	isEnded       bool
+	lastError     error
+	title         string
+	mediaInfo     types.MediaInfo
+	nextLink      string // preloaded by PreloadNextURL
+	switchingTo   string // the link playbin is switching to (gaplessly)
+	rate          float64
+	state         types.PlaybackState
+	events        types.EventBroadcaster
+	volume        atomic.Uint64 // math.Float64bits
+	isMuted       atomic.Bool
+	endChan       chan struct{}
+	closeChan     chan struct{}
+	busLoopDone   chan struct{} // closed when busLoop returns
 }
 
 var _ types.Player = (*Decoder)(nil)
@@ -45,9 +68,12 @@ func New(
 		ImageRenderer: imageRenderer,
 		CurrentFrame:  image.NewRGBA(image.Rectangle{}),
 		observability: belt.CtxBelt(ctx),
+		isEnded:       true,
+		rate:          1,
+		endChan:       make(chan struct{}),
+		closeChan:     make(chan struct{}),
 	}
-	logger.Errorf(ctx, "the support for audio renderer in gstreamer decoder is not implemented yet")
-
+	d.volume.Store(math.Float64bits(1))
 	if err := d.ImageRenderer.SetImage(ctx, FrameVideo{d.CurrentFrame}); err != nil {
 		return nil, fmt.Errorf("unable to set initial image to the image renderer: %w", err)
 	}
@@ -79,6 +105,28 @@ func New(
 		return nil, fmt.Errorf("unable to create playbin element: %w", err)
 	}
 	playbin.Set("video-sink", appSinkElement)
+	if scaleTempo, err := gst.NewElement("scaletempo"); err == nil {
+		// keeps the pitch of the audio on the playback rates other than 1
+		playbin.Set("audio-filter", scaleTempo)
+	} else {
+		logger.Warnf(ctx, "unable to create scaletempo element, the pitch will not be preserved on speed changes: %v", err)
+	}
+	if d.AudioRenderer != nil {
+		audioSink, err := d.newAudioSink()
+		if err != nil {
+			return nil, fmt.Errorf("unable to create the audio sink: %w", err)
+		}
+		d.AudioSink = audioSink
+		playbin.Set("audio-sink", audioSink.Element)
+	}
+	if _, err := playbin.Connect("about-to-finish", d.onAboutToFinish); err != nil {
+		return nil, fmt.Errorf("unable to connect to the signal 'about-to-finish': %w", err)
+	}
+	for _, signal := range []string{"video-changed", "audio-changed", "text-changed"} {
+		if _, err := playbin.Connect(signal, d.onTracksChanged); err != nil {
+			return nil, fmt.Errorf("unable to connect to the signal '%s': %w", signal, err)
+		}
+	}
 	d.Playbin = playbin
 
 	pipeline, err := gst.NewPipeline("")
@@ -88,6 +136,15 @@ func New(
 	pipeline.Add(playbin)
 	d.Pipeline = pipeline
 
+	bus := pipeline.GetPipelineBus()
+	closeChan := d.closeChan
+	busLoopDone := make(chan struct{})
+	d.busLoopDone = busLoopDone
+	observability.Go(ctx, func(ctx context.Context) {
+		defer close(busLoopDone)
+		d.busLoop(ctx, bus, closeChan)
+	})
+
 	return d, nil
 }
 
@@ -142,10 +199,12 @@ func (d *Decoder) onNewSampleFunc(sink *app.Sink) (_ret gst.FlowReturn) {
 	defer buffer.Unmap()
 
 	data := bufmap.Bytes()
-	if d.CurrentFrame.Rect.Dx() != w || d.CurrentFrame.Rect.Dy() != h {
-		*d.CurrentFrame = *image.NewRGBA(image.Rect(0, 0, w, h))
-	}
-	d.CurrentFrame.Pix = data
+	d.frameLocker.Do(ctx, func() {
+		if d.CurrentFrame.Rect.Dx() != w || d.CurrentFrame.Rect.Dy() != h {
+			*d.CurrentFrame = *image.NewRGBA(image.Rect(0, 0, w, h))
+		}
+		d.CurrentFrame.Pix = data
+	})
 
 	if renderImageNower, ok := d.ImageRenderer.(imagerenderer.RenderImageNower); ok {
 		if err := renderImageNower.RenderImageNow(ctx); err != nil {
@@ -162,8 +221,11 @@ func (d *Decoder) onNewSampleFunc(sink *app.Sink) (_ret gst.FlowReturn) {
 	return gst.FlowOK
 }
 
+// ProcessTitle returns the title of the media (from its tags).
 func (d *Decoder) ProcessTitle(ctx context.Context) (string, error) {
-	return "", fmt.Errorf("not implemented")
+	return xsync.DoR1(ctx, &d.locker, func() string {
+		return d.title
+	}), nil
 }
 
 func toURI(link string) (string, error) {
@@ -192,15 +254,27 @@ func (d *Decoder) OpenURL(ctx context.Context, link string) (_err error) {
 	if err := d.Playbin.SetState(gst.StateNull); err != nil {
 		return fmt.Errorf("unable to set playbin to NULL state: %w", err)
 	}
+	d.resetAudio(ctx)
 
 	if err := d.Playbin.Set("uri", uri); err != nil {
 		return fmt.Errorf("unable to set URI to playbin: %w", err)
 	}
 
+	d.locker.Do(ctx, func() {
+		d.isEnded = false
+		d.lastError = nil
+		d.title = ""
+		d.mediaInfo = types.MediaInfo{}
+		d.nextLink = ""
+		d.switchingTo = ""
+		d.rate = 1
+	})
+
 	if err := d.Playbin.SetState(gst.StatePlaying); err != nil {
 		return fmt.Errorf("unable to set playbin to PLAYING state: %w", err)
 	}
 
+	d.events.Emit(ctx, types.EventMediaChange{Link: link})
 	return nil
 }
 
@@ -217,10 +291,15 @@ func (d *Decoder) GetLink(ctx context.Context) (_ret string, _err error) {
 }
 
 func (d *Decoder) EndChan(ctx context.Context) (<-chan struct{}, error) {
-	return nil, fmt.Errorf("not implemented")
+	return xsync.DoR1(ctx, &d.locker, func() <-chan struct{} {
+		return d.endChan
+	}), nil
 }
 
 func (d *Decoder) IsEnded(ctx context.Context) (bool, error) {
+	if xsync.DoR1(ctx, &d.locker, func() bool { return d.isEnded }) {
+		return true, nil
+	}
 	switch d.Pipeline.GetCurrentState() {
 	case gst.StateReady, gst.StatePaused, gst.StatePlaying:
 		return false, nil
@@ -252,11 +331,59 @@ func (d *Decoder) GetLength(ctx context.Context) (time.Duration, error) {
 }
 
 func (d *Decoder) GetSpeed(ctx context.Context) (float64, error) {
-	return 1.0, nil
+	return xsync.DoR1(ctx, &d.locker, func() float64 {
+		return d.rate
+	}), nil
+}
+
+// SetSpeed sets the playback rate; a negative rate means the reverse
+// playback (if the demuxer supports it).
+func (d *Decoder) SetSpeed(ctx context.Context, speed float64) (_err error) {
+	logger.Debugf(ctx, "SetSpeed(ctx, %v)", speed)
+	defer func() { logger.Debugf(ctx, "/SetSpeed(ctx, %v): %v", speed, _err) }()
+	if speed == 0 {
+		return fmt.Errorf("the speed cannot be zero, use SetPause instead")
+	}
+	curPos, err := d.GetPosition(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get the current position: %w", err)
+	}
+	if err := d.seek(ctx, curPos, speed, gst.SeekFlagFlush|gst.SeekFlagAccurate); err != nil {
+		return fmt.Errorf("unable to change the rate: %w", err)
+	}
+	d.locker.Do(ctx, func() {
+		d.rate = speed
+	})
+	return nil
 }
 
-func (d *Decoder) SetSpeed(ctx context.Context, speed float64) error {
-	return fmt.Errorf("not implemented")
+// seek sends a seek event with the given rate; for negative rates
+// the position is the stop position (the playback goes backwards from it).
+func (d *Decoder) seek(
+	ctx context.Context,
+	pos time.Duration,
+	rate float64,
+	flags gst.SeekFlags,
+) error {
+	var ev *gst.Event
+	if rate > 0 {
+		ev = gst.NewSeekEvent(
+			rate, gst.FormatTime, flags,
+			gst.SeekTypeSet, pos.Nanoseconds(),
+			gst.SeekTypeNone, -1,
+		)
+	} else {
+		ev = gst.NewSeekEvent(
+			rate, gst.FormatTime, flags,
+			gst.SeekTypeSet, 0,
+			gst.SeekTypeSet, pos.Nanoseconds(),
+		)
+	}
+	if !d.Pipeline.SendEvent(ev) {
+		return fmt.Errorf("the seek event (pos: %v, rate: %v) was not handled", pos, rate)
+	}
+	d.resetAudio(ctx)
+	return nil
 }
 
 func (d *Decoder) GetPause(ctx context.Context) (bool, error) {
@@ -306,48 +433,33 @@ func (d *Decoder) Seek(ctx context.Context, pos time.Duration, isRelative bool,
 		seekPos = pos
 	}
 
-	ok := d.Pipeline.SeekSimple(
-		seekPos.Nanoseconds(),
-		gst.FormatTime,
-		seekFlags,
-	)
-	if !ok {
-		return fmt.Errorf("unable to seek to position %v (isRelative=%v, quick=%v)", pos, isRelative, quick)
+	rate := xsync.DoR1(ctx, &d.locker, func() float64 {
+		return d.rate
+	})
+	if err := d.seek(ctx, seekPos, rate, seekFlags); err != nil {
+		return fmt.Errorf("unable to seek to position %v (isRelative=%v, quick=%v): %w", pos, isRelative, quick, err)
 	}
 	return nil
 }
 
-func (d *Decoder) GetVideoTracks(ctx context.Context) (types.VideoTracks, error) {
-	return nil, fmt.Errorf("not implemented")
-}
-
-func (d *Decoder) GetAudioTracks(ctx context.Context) (types.AudioTracks, error) {
-	return nil, fmt.Errorf("not implemented")
-}
-
-func (d *Decoder) GetSubtitlesTracks(ctx context.Context) (types.SubtitlesTracks, error) {
-	return nil, fmt.Errorf("not implemented")
-}
-
-func (d *Decoder) SetVideoTrack(ctx context.Context, vid int64) error {
-	return fmt.Errorf("not implemented")
-}
-
-func (d *Decoder) SetAudioTrack(ctx context.Context, aid int64) error {
-	return fmt.Errorf("not implemented")
-}
-
-func (d *Decoder) SetSubtitlesTrack(ctx context.Context, sid int64) error {
-	return fmt.Errorf("not implemented")
-}
-
 func (d *Decoder) Stop(ctx context.Context) error {
 	d.Pipeline.SetState(gst.StateNull)
+	d.onEnd(ctx, nil)
 	return nil
 }
 
 func (d *Decoder) Close(ctx context.Context) error {
 	var errs []error
+	d.locker.Do(ctx, func() {
+		if d.closeChan != nil {
+			close(d.closeChan)
+			d.closeChan = nil
+		}
+	})
+	if d.busLoopDone != nil {
+		// busLoop uses the pipeline, so it should finish before the teardown
+		<-d.busLoopDone
+	}
 	if d.Playbin != nil {
 		d.Playbin.SetState(gst.StateNull)
 		d.Playbin.SetProperty("video-sink", nil)
@@ -364,9 +476,14 @@ func (d *Decoder) Close(ctx context.Context) error {
 	if d.AppSink != nil {
 		d.AppSink.Clear()
 	}
+	if d.AudioSink != nil {
+		d.AudioSink.Clear()
+	}
 	d.Playbin = nil
 	d.AppSink = nil
+	d.AudioSink = nil
 	d.Pipeline = nil
+	d.resetAudio(ctx)
 
 	if d.ImageRenderer != nil {
 		if err := d.ImageRenderer.Close(); err != nil {
@@ -0,0 +1,66 @@
+package gstreamer
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-gst/go-gst/gst"
+	"github.com/xaionaro-go/player/pkg/player/types"
+	"github.com/xaionaro-go/xsync"
+)
+
+func (d *Decoder) Events(
+	ctx context.Context,
+) (<-chan types.Event, error) {
+	return d.events.Subscribe(ctx), nil
+}
+
+func playbackStateFromGST(state gst.State) types.PlaybackState {
+	switch state {
+	case gst.StatePlaying:
+		return types.PlaybackStatePlaying
+	case gst.StatePaused:
+		return types.PlaybackStatePaused
+	default:
+		return types.PlaybackStateStopped
+	}
+}
+
+// onStateChanged emits an EventStateChange if the state of the pipeline
+// has actually changed.
+func (d *Decoder) onStateChanged(
+	ctx context.Context,
+	newState gst.State,
+) {
+	state := playbackStateFromGST(newState)
+	var isChanged bool
+	d.locker.Do(ctx, func() {
+		isChanged = d.state != state
+		d.state = state
+	})
+	if isChanged {
+		d.events.Emit(ctx, types.EventStateChange{State: state})
+	}
+}
+
+func (d *Decoder) onTracksChanged(_ *gst.Element) {
+	d.events.Emit(context.Background(), types.EventTracksChange{})
+}
+
+func (d *Decoder) emitPosition(ctx context.Context) {
+	isPlaying := xsync.DoR1(ctx, &d.locker, func() bool {
+		return d.state == types.PlaybackStatePlaying
+	})
+	if !isPlaying {
+		return
+	}
+	ok, pos := d.Pipeline.QueryPosition(gst.FormatTime)
+	if !ok {
+		return
+	}
+	var length time.Duration
+	if ok, dur := d.Pipeline.QueryDuration(gst.FormatTime); ok && dur > 0 {
+		length = time.Duration(dur)
+	}
+	d.events.EmitPosition(ctx, time.Duration(pos), length)
+}
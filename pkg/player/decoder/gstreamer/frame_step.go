@@ -0,0 +1,55 @@
+package gstreamer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/go-gst/go-gst/gst"
+)
+
+// FrameStep pauses the playback (if it is not paused), and shows the next
+// (or the previous) video frame.
+//
+// GStreamer could step only in the direction of the playback, so the step
+// back is an accurate seek to the previous frame.
+func (d *Decoder) FrameStep(
+	ctx context.Context,
+	forward bool,
+) (_err error) {
+	logger.Debugf(ctx, "FrameStep(ctx, %t)", forward)
+	defer func() { logger.Debugf(ctx, "/FrameStep(ctx, %t): %v", forward, _err) }()
+
+	if err := d.SetPause(ctx, true); err != nil {
+		return fmt.Errorf("unable to pause: %w", err)
+	}
+
+	if forward {
+		// the default format of a raw video sink is frames, see
+		// https://gstreamer.freedesktop.org/documentation/additional/design/framestep.html
+		if !d.AppSink.SendEvent(gst.NewStepEvent(gst.FormatDefault, 1, 1, true, false)) {
+			return fmt.Errorf("the step event was not handled")
+		}
+		return nil
+	}
+
+	_, current, err := d.getStreams(playbinStreamTypeVideo)
+	if err != nil {
+		return err
+	}
+	if current < 0 {
+		return fmt.Errorf("there is no video to step through")
+	}
+	_, caps := d.getStreamInfo(ctx, playbinStreamTypeVideo, current)
+	fps := getStructureFPS(caps)
+	if fps <= 0 {
+		return fmt.Errorf("the frame rate of the video is unknown")
+	}
+	pos, err := d.GetPosition(ctx)
+	if err != nil {
+		return err
+	}
+	pos = max(pos-time.Duration(float64(time.Second)/fps), 0)
+	return d.Seek(ctx, pos, false, false)
+}
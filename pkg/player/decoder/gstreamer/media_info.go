@@ -0,0 +1,144 @@
+package gstreamer
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/go-gst/go-gst/gst"
+	"github.com/xaionaro-go/player/pkg/player/types"
+	"github.com/xaionaro-go/xsync"
+)
+
+// onTags collects the media information from a tag message; only the global
+// tags are treated as the container tags.
+func (d *Decoder) onTags(
+	ctx context.Context,
+	tags *gst.TagList,
+) {
+	d.locker.Do(ctx, func() {
+		if title, ok := tags.GetString(gst.TagTitle); ok && title != "" {
+			d.title = title
+		}
+		if format, ok := tags.GetString(gst.TagContainerFormat); ok {
+			d.mediaInfo.FormatName = format
+		}
+		if tags.GetScope() != gst.TagScopeGlobal {
+			return
+		}
+		if bitrate, ok := tags.GetUint32(gst.TagBitrate); ok {
+			d.mediaInfo.Bitrate = int64(bitrate)
+		} else if bitrate, ok := tags.GetUint32(gst.TagNominalBitrate); ok {
+			d.mediaInfo.Bitrate = int64(bitrate)
+		}
+		if d.mediaInfo.Tags == nil {
+			d.mediaInfo.Tags = map[string]string{}
+		}
+		tags.ForEach(func(_ *gst.TagList, tag gst.Tag) {
+			if value, ok := tagString(tags, tag); ok {
+				d.mediaInfo.Tags[strings.ToLower(string(tag))] = value
+			}
+		})
+	})
+}
+
+func tagString(
+	tags *gst.TagList,
+	tag gst.Tag,
+) (string, bool) {
+	if s, ok := tags.GetString(tag); ok {
+		return s, true
+	}
+	switch v := tags.GetValueIndex(tag, 0).(type) {
+	case nil, *gst.Sample:
+		// unknown types and binary data (like the cover images)
+		return "", false
+	default:
+		return fmt.Sprint(v), true
+	}
+}
+
+func (d *Decoder) onTOC(
+	ctx context.Context,
+	toc *gst.TOC,
+) {
+	chapters := tocChapters(toc)
+	logger.Debugf(ctx, "received a TOC with %d chapters", len(chapters))
+	d.locker.Do(ctx, func() {
+		d.mediaInfo.Chapters = chapters
+	})
+}
+
+func (d *Decoder) GetMediaInfo(
+	ctx context.Context,
+) (_ret *types.MediaInfo, _err error) {
+	logger.Tracef(ctx, "GetMediaInfo")
+	defer func() { logger.Tracef(ctx, "/GetMediaInfo: %v %v", _ret, _err) }()
+
+	var streamCount int
+	for _, streamType := range []playbinStreamType{
+		playbinStreamTypeVideo,
+		playbinStreamTypeAudio,
+		playbinStreamTypeText,
+	} {
+		count, _, err := d.getStreams(streamType)
+		if err != nil {
+			return nil, err
+		}
+		streamCount += count
+	}
+
+	return xsync.DoR1(ctx, &d.locker, func() *types.MediaInfo {
+		result := d.mediaInfo
+		result.StreamCount = streamCount
+		result.Tags = maps.Clone(d.mediaInfo.Tags)
+		result.Chapters = slices.Clone(d.mediaInfo.Chapters)
+		return &result
+	}), nil
+}
+
+// GetChapters returns the chapters from the TOC of the media.
+func (d *Decoder) GetChapters(
+	ctx context.Context,
+) (types.Chapters, error) {
+	return xsync.DoR1(ctx, &d.locker, func() types.Chapters {
+		return slices.Clone(d.mediaInfo.Chapters)
+	}), nil
+}
+
+func (d *Decoder) GetChapter(
+	ctx context.Context,
+) (int, error) {
+	chapters, err := d.GetChapters(ctx)
+	if err != nil {
+		return -1, err
+	}
+	if len(chapters) == 0 {
+		return -1, nil
+	}
+	pos, err := d.GetPosition(ctx)
+	if err != nil {
+		return -1, fmt.Errorf("unable to get the position: %w", err)
+	}
+	return chapters.IndexAt(pos), nil
+}
+
+// SetChapter seeks to the start of the chapter.
+func (d *Decoder) SetChapter(
+	ctx context.Context,
+	idx int,
+) (_err error) {
+	logger.Debugf(ctx, "SetChapter(ctx, %d)", idx)
+	defer func() { logger.Debugf(ctx, "/SetChapter(ctx, %d): %v", idx, _err) }()
+	chapters, err := d.GetChapters(ctx)
+	if err != nil {
+		return err
+	}
+	if idx < 0 || idx >= len(chapters) {
+		return fmt.Errorf("%w: #%d (total: %d)", types.ErrNoChapter, idx, len(chapters))
+	}
+	return d.Seek(ctx, chapters[idx].Start, false, false)
+}
@@ -0,0 +1,75 @@
+package gstreamer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/go-belt"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/go-gst/go-gst/gst"
+	"github.com/xaionaro-go/player/pkg/player/types"
+	"github.com/xaionaro-go/xsync"
+)
+
+var _ types.NextURLPreloader = (*Decoder)(nil)
+
+// PreloadNextURL sets the media to be played right after the current one
+// (without a gap); an empty link cancels that.
+func (d *Decoder) PreloadNextURL(
+	ctx context.Context,
+	link string,
+) (_err error) {
+	logger.Debugf(ctx, "PreloadNextURL(ctx, '%s')", link)
+	defer func() { logger.Debugf(ctx, "/PreloadNextURL(ctx, '%s'): %v", link, _err) }()
+	if link != "" {
+		if _, err := toURI(link); err != nil {
+			return fmt.Errorf("unable to convert link to URI: %w", err)
+		}
+	}
+	d.locker.Do(ctx, func() {
+		d.nextLink = link
+	})
+	return nil
+}
+
+// onAboutToFinish switches playbin to the preloaded media; setting the URI
+// in this signal is what makes the transition gapless, see
+// https://gstreamer.freedesktop.org/documentation/playback/playbin.html#playbin::about-to-finish
+func (d *Decoder) onAboutToFinish(_ *gst.Element) {
+	ctx := belt.CtxWithBelt(context.Background(), d.observability)
+	link := xsync.DoR1(ctx, &d.locker, func() string {
+		link := d.nextLink
+		d.nextLink, d.switchingTo = "", link
+		return link
+	})
+	logger.Debugf(ctx, "onAboutToFinish: next link: '%s'", link)
+	if link == "" {
+		return
+	}
+	uri, err := toURI(link)
+	if err != nil {
+		logger.Errorf(ctx, "unable to convert link '%s' to URI: %v", link, err)
+		return
+	}
+	if err := d.Playbin.Set("uri", uri); err != nil {
+		logger.Errorf(ctx, "unable to set URI '%s' to playbin: %v", uri, err)
+	}
+}
+
+// onStreamStart finishes the gapless switch started by onAboutToFinish.
+func (d *Decoder) onStreamStart(ctx context.Context) {
+	link := xsync.DoR1(ctx, &d.locker, func() string {
+		link := d.switchingTo
+		if link == "" {
+			return ""
+		}
+		d.switchingTo = ""
+		d.title = ""
+		d.mediaInfo = types.MediaInfo{}
+		return link
+	})
+	if link == "" {
+		return
+	}
+	d.events.Emit(ctx, types.EventMediaChange{Link: link})
+}
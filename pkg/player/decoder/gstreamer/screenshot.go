@@ -0,0 +1,26 @@
+package gstreamer
+
+import (
+	"context"
+	"fmt"
+	"image"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/xsync"
+)
+
+// Screenshot returns a copy of the current video frame (without the subtitles).
+func (d *Decoder) Screenshot(
+	ctx context.Context,
+) (_ret image.Image, _err error) {
+	logger.Tracef(ctx, "Screenshot")
+	defer func() { logger.Tracef(ctx, "/Screenshot: %v", _err) }()
+	return xsync.DoR2(ctx, &d.frameLocker, func() (image.Image, error) {
+		if d.CurrentFrame.Rect.Empty() {
+			return nil, fmt.Errorf("there is no decoded video frame")
+		}
+		result := image.NewRGBA(d.CurrentFrame.Rect)
+		copy(result.Pix, d.CurrentFrame.Pix)
+		return result, nil
+	})
+}
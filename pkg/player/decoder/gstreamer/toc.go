@@ -0,0 +1,54 @@
+package gstreamer
+
+// #cgo pkg-config: gstreamer-1.0
+// #include <gst/gst.h>
+import "C"
+
+import (
+	"sort"
+	"time"
+	"unsafe"
+
+	"github.com/go-gst/go-gst/gst"
+	"github.com/xaionaro-go/player/pkg/player/types"
+)
+
+// tocChapters returns the chapters described by the TOC; the entry lists are
+// walked here, because (*gst.TOC).GetEntries does not advance over the list.
+func tocChapters(toc *gst.TOC) types.Chapters {
+	var result types.Chapters
+	appendTOCChapters(&result, C.gst_toc_get_entries((*C.GstToc)(unsafe.Pointer(toc.Instance()))))
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Start < result[j].Start
+	})
+	return result
+}
+
+func appendTOCChapters(
+	result *types.Chapters,
+	entries *C.GList,
+) {
+	for item := entries; item != nil; item = item.next {
+		entry := gst.FromGstTocEntryUnsafeNone(unsafe.Pointer(item.data))
+		if entry.GetEntryType() == gst.TOCEntryTypeChapter {
+			*result = append(*result, tocEntryChapter(entry))
+		}
+		appendTOCChapters(result, C.gst_toc_entry_get_sub_entries((*C.GstTocEntry)(unsafe.Pointer(entry.Instance()))))
+	}
+}
+
+func tocEntryChapter(entry *gst.TOCEntry) types.Chapter {
+	var chapter types.Chapter
+	if ok, start, stop := entry.GetStartStopTimes(); ok {
+		if start >= 0 {
+			chapter.Start = time.Duration(start)
+		}
+		if stop > start {
+			chapter.End = time.Duration(stop)
+		}
+	}
+	if tags := entry.GetTags(); tags != nil {
+		chapter.Title, _ = tags.GetString(gst.TagTitle)
+	}
+	return chapter
+}
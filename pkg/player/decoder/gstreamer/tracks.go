@@ -0,0 +1,320 @@
+package gstreamer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/go-gst/go-gst/gst"
+	"github.com/xaionaro-go/player/pkg/player/types"
+)
+
+// see GstPlayFlags in https://gstreamer.freedesktop.org/documentation/playback/playsink.html
+const (
+	playFlagText = 0x04
+)
+
+type playbinStreamType string
+
+const (
+	playbinStreamTypeVideo = playbinStreamType("video")
+	playbinStreamTypeAudio = playbinStreamType("audio")
+	playbinStreamTypeText  = playbinStreamType("text")
+)
+
+func (d *Decoder) getIntProperty(name string) (int, error) {
+	v, err := d.Playbin.GetProperty(name)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get property '%s': %w", name, err)
+	}
+	i, ok := v.(int)
+	if !ok {
+		return 0, fmt.Errorf("property '%s' is not an int: %T", name, v)
+	}
+	return i, nil
+}
+
+func (d *Decoder) getPlayFlags() (uint, error) {
+	v, err := d.Playbin.GetProperty("flags")
+	if err != nil {
+		return 0, fmt.Errorf("unable to get property 'flags': %w", err)
+	}
+	flags, ok := v.(uint)
+	if !ok {
+		return 0, fmt.Errorf("property 'flags' is not an uint: %T", v)
+	}
+	return flags, nil
+}
+
+func (d *Decoder) setPlayFlags(flags uint) {
+	// a flags-typed value could not be constructed directly, so
+	// passing it through the deserialization
+	d.Playbin.SetArg("flags", strconv.FormatUint(uint64(flags), 10))
+}
+
+// getStreams returns the amount of streams of the given type and
+// the index of the current one (-1 if none).
+func (d *Decoder) getStreams(
+	streamType playbinStreamType,
+) (int, int, error) {
+	count, err := d.getIntProperty("n-" + string(streamType))
+	if err != nil {
+		return 0, 0, err
+	}
+	current, err := d.getIntProperty("current-" + string(streamType))
+	if err != nil {
+		return 0, 0, err
+	}
+	if streamType == playbinStreamTypeText {
+		flags, err := d.getPlayFlags()
+		if err != nil {
+			return 0, 0, err
+		}
+		if flags&playFlagText == 0 {
+			current = -1
+		}
+	}
+	return count, current, nil
+}
+
+func (d *Decoder) setStream(
+	streamType playbinStreamType,
+	id int64,
+) error {
+	count, _, err := d.getStreams(streamType)
+	if err != nil {
+		return err
+	}
+	if id < 0 || id >= int64(count) {
+		return fmt.Errorf("there is no %s stream with ID %d (total: %d)", streamType, id, count)
+	}
+	propName := "current-" + string(streamType)
+	if err := d.Playbin.Set(propName, int(id)); err != nil {
+		return fmt.Errorf("unable to set property '%s' to %d: %w", propName, id, err)
+	}
+	return nil
+}
+
+func (t playbinStreamType) codecTag() gst.Tag {
+	switch t {
+	case playbinStreamTypeVideo:
+		return gst.TagVideoCodec
+	case playbinStreamTypeAudio:
+		return gst.TagAudioCodec
+	case playbinStreamTypeText:
+		return gst.TagSubtitleCodec
+	}
+	return gst.TagCodec
+}
+
+// getStreamPad returns the pad of playbin, which the given stream is
+// flowing through (nil if it is not known yet).
+func (d *Decoder) getStreamPad(
+	streamType playbinStreamType,
+	idx int,
+) (*gst.Pad, error) {
+	signal := "get-" + string(streamType) + "-pad"
+	v, err := d.Playbin.Emit(signal, idx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to emit '%s': %w", signal, err)
+	}
+	pad, _ := v.(*gst.Pad)
+	return pad, nil
+}
+
+// getStreamInfo collects the track metadata from the tags and the caps
+// of the stream pad; the caps are nil if they are not negotiated yet.
+func (d *Decoder) getStreamInfo(
+	ctx context.Context,
+	streamType playbinStreamType,
+	idx int,
+) (types.TrackInfo, *gst.Structure) {
+	var info types.TrackInfo
+	pad, err := d.getStreamPad(streamType, idx)
+	if err != nil {
+		logger.Debugf(ctx, "unable to get the pad of %s stream #%d: %v", streamType, idx, err)
+		return info, nil
+	}
+	if pad == nil {
+		return info, nil
+	}
+
+	for eventIdx := uint(0); ; eventIdx++ {
+		event := pad.GetStickyEvent(gst.EventTypeTag, eventIdx)
+		if event == nil {
+			break
+		}
+		tags := event.ParseTag()
+		if tags == nil {
+			continue
+		}
+		if info.Codec == "" {
+			if codec, ok := tags.GetString(streamType.codecTag()); ok {
+				info.Codec = codec
+			} else if codec, ok := tags.GetString(gst.TagCodec); ok {
+				info.Codec = codec
+			}
+		}
+		if lang, ok := tags.GetString(gst.TagLanguageCode); ok && info.Language == "" {
+			info.Language = lang
+		}
+		if title, ok := tags.GetString(gst.TagTitle); ok && info.Title == "" {
+			info.Title = title
+		}
+	}
+	if event := pad.GetStickyEvent(gst.EventTypeStreamStart, 0); event != nil {
+		info.IsDefault = event.ParseStreamFlags()&gst.StreamFlagSelect != 0
+	}
+
+	caps := pad.GetCurrentCaps()
+	if caps == nil || caps.GetSize() == 0 {
+		return info, nil
+	}
+	return info, caps.GetStructureAt(0)
+}
+
+func getStructureInt(s *gst.Structure, key string) int {
+	if s == nil {
+		return 0
+	}
+	v, err := s.GetValue(key)
+	if err != nil {
+		return 0
+	}
+	i, _ := v.(int)
+	return i
+}
+
+func getStructureFPS(s *gst.Structure) float64 {
+	if s == nil {
+		return 0
+	}
+	v, err := s.GetValue("framerate")
+	if err != nil {
+		return 0
+	}
+	fps, ok := v.(*gst.FractionValue)
+	if !ok || fps.Denom() == 0 {
+		return 0
+	}
+	return float64(fps.Num()) / float64(fps.Denom())
+}
+
+func (d *Decoder) GetVideoTracks(
+	ctx context.Context,
+) (_ret types.VideoTracks, _err error) {
+	logger.Tracef(ctx, "GetVideoTracks")
+	defer func() { logger.Tracef(ctx, "/GetVideoTracks: %v %v", _ret, _err) }()
+	count, current, err := d.getStreams(playbinStreamTypeVideo)
+	if err != nil {
+		return nil, err
+	}
+	var result types.VideoTracks
+	for idx := range count {
+		info, caps := d.getStreamInfo(ctx, playbinStreamTypeVideo, idx)
+		result = append(result, types.VideoTrack{
+			ID:        int64(idx),
+			IsActive:  idx == current,
+			TrackInfo: info,
+			Width:     getStructureInt(caps, "width"),
+			Height:    getStructureInt(caps, "height"),
+			FPS:       getStructureFPS(caps),
+		})
+	}
+	return result, nil
+}
+
+func (d *Decoder) GetAudioTracks(
+	ctx context.Context,
+) (_ret types.AudioTracks, _err error) {
+	logger.Tracef(ctx, "GetAudioTracks")
+	defer func() { logger.Tracef(ctx, "/GetAudioTracks: %v %v", _ret, _err) }()
+	count, current, err := d.getStreams(playbinStreamTypeAudio)
+	if err != nil {
+		return nil, err
+	}
+	var result types.AudioTracks
+	for idx := range count {
+		info, caps := d.getStreamInfo(ctx, playbinStreamTypeAudio, idx)
+		result = append(result, types.AudioTrack{
+			ID:         int64(idx),
+			IsActive:   idx == current,
+			TrackInfo:  info,
+			SampleRate: getStructureInt(caps, "rate"),
+			Channels:   getStructureInt(caps, "channels"),
+		})
+	}
+	return result, nil
+}
+
+func (d *Decoder) GetSubtitlesTracks(
+	ctx context.Context,
+) (_ret types.SubtitlesTracks, _err error) {
+	logger.Tracef(ctx, "GetSubtitlesTracks")
+	defer func() { logger.Tracef(ctx, "/GetSubtitlesTracks: %v %v", _ret, _err) }()
+	count, current, err := d.getStreams(playbinStreamTypeText)
+	if err != nil {
+		return nil, err
+	}
+	var result types.SubtitlesTracks
+	for idx := range count {
+		info, _ := d.getStreamInfo(ctx, playbinStreamTypeText, idx)
+		result = append(result, types.SubtitlesTrack{
+			ID:        int64(idx),
+			IsActive:  idx == current,
+			TrackInfo: info,
+		})
+	}
+	return result, nil
+}
+
+func (d *Decoder) SetVideoTrack(
+	ctx context.Context,
+	vid int64,
+) (_err error) {
+	logger.Debugf(ctx, "SetVideoTrack(ctx, %d)", vid)
+	defer func() { logger.Debugf(ctx, "/SetVideoTrack(ctx, %d): %v", vid, _err) }()
+	if err := d.setStream(playbinStreamTypeVideo, vid); err != nil {
+		return err
+	}
+	d.events.Emit(ctx, types.EventTracksChange{})
+	return nil
+}
+
+func (d *Decoder) SetAudioTrack(
+	ctx context.Context,
+	aid int64,
+) (_err error) {
+	logger.Debugf(ctx, "SetAudioTrack(ctx, %d)", aid)
+	defer func() { logger.Debugf(ctx, "/SetAudioTrack(ctx, %d): %v", aid, _err) }()
+	if err := d.setStream(playbinStreamTypeAudio, aid); err != nil {
+		return err
+	}
+	d.events.Emit(ctx, types.EventTracksChange{})
+	return nil
+}
+
+// SetSubtitlesTrack selects the subtitles track; a negative ID disables
+// the subtitles.
+func (d *Decoder) SetSubtitlesTrack(
+	ctx context.Context,
+	sid int64,
+) (_err error) {
+	logger.Debugf(ctx, "SetSubtitlesTrack(ctx, %d)", sid)
+	defer func() { logger.Debugf(ctx, "/SetSubtitlesTrack(ctx, %d): %v", sid, _err) }()
+	flags, err := d.getPlayFlags()
+	if err != nil {
+		return err
+	}
+	if sid < 0 {
+		d.setPlayFlags(flags &^ playFlagText)
+	} else {
+		if err := d.setStream(playbinStreamTypeText, sid); err != nil {
+			return err
+		}
+		d.setPlayFlags(flags | playFlagText)
+	}
+	d.events.Emit(ctx, types.EventTracksChange{})
+	return nil
+}
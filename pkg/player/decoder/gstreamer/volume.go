@@ -0,0 +1,63 @@
+package gstreamer
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+)
+
+func (d *Decoder) GetVolume(
+	ctx context.Context,
+) (float64, error) {
+	return math.Float64frombits(d.volume.Load()), nil
+}
+
+func (d *Decoder) SetVolume(
+	ctx context.Context,
+	volume float64,
+) (_err error) {
+	logger.Debugf(ctx, "SetVolume(ctx, %v)", volume)
+	defer func() { logger.Debugf(ctx, "/SetVolume(ctx, %v): %v", volume, _err) }()
+	if volume < 0 || math.IsNaN(volume) || math.IsInf(volume, 0) {
+		return fmt.Errorf("invalid volume: %v", volume)
+	}
+	d.volume.Store(math.Float64bits(volume))
+	return nil
+}
+
+func (d *Decoder) GetMute(
+	ctx context.Context,
+) (bool, error) {
+	return d.isMuted.Load(), nil
+}
+
+func (d *Decoder) SetMute(
+	ctx context.Context,
+	mute bool,
+) (_err error) {
+	logger.Debugf(ctx, "SetMute(ctx, %t)", mute)
+	defer func() { logger.Debugf(ctx, "/SetMute(ctx, %t): %v", mute, _err) }()
+	d.isMuted.Store(mute)
+	return nil
+}
+
+func (d *Decoder) getGain() float64 {
+	if d.isMuted.Load() {
+		return 0
+	}
+	return math.Float64frombits(d.volume.Load())
+}
+
+// applyGain scales the samples in place; the samples are in audioCaps.
+func applyGain(
+	b []byte,
+	gain float64,
+) {
+	for offset := 0; offset+4 <= len(b); offset += 4 {
+		v := math.Float32frombits(binary.LittleEndian.Uint32(b[offset:]))
+		binary.LittleEndian.PutUint32(b[offset:], math.Float32bits(float32(float64(v)*gain)))
+	}
+}
@@ -0,0 +1,43 @@
+package libav
+
+import (
+	"io"
+
+	"github.com/xaionaro-go/audio/pkg/audio"
+)
+
+// pausableReader feeds silence to the audio playback instead of the actual
+// data while the clock is paused (audio.PlayStream has no pause on its own).
+type pausableReader struct {
+	Reader  io.Reader
+	Clock   *playbackClock
+	Silence byte
+}
+
+var _ io.Reader = (*pausableReader)(nil)
+
+func newPausableReader(
+	r io.Reader,
+	clock *playbackClock,
+	pcmFormat audio.PCMFormat,
+) *pausableReader {
+	var silence byte
+	if pcmFormat == audio.PCMFormatU8 {
+		silence = 0x80
+	}
+	return &pausableReader{
+		Reader:  r,
+		Clock:   clock,
+		Silence: silence,
+	}
+}
+
+func (r *pausableReader) Read(b []byte) (int, error) {
+	if !r.Clock.IsPaused() {
+		return r.Reader.Read(b)
+	}
+	for idx := range b {
+		b[idx] = r.Silence
+	}
+	return len(b), nil
+}
@@ -48,6 +48,7 @@ func (p *Decoder) Close(ctx context.Context) (_err error) {
 		if p.cancelFunc == nil {
 			return false
 		}
+		p.isStopping = true
 		p.cancelFunc()
 		if p.ImageRenderer != nil {
 			if err := p.ImageRenderer.Close(); err != nil {
@@ -69,6 +70,19 @@ func (p *Decoder) Close(ctx context.Context) (_err error) {
 	return errors.Join(errs...)
 }
 
+// pipelineKernel is the kernel of the player node in the pipeline. Unlike
+// Decoder.Close it does not close the renderers on closing the pipeline, so
+// that they could be reused for the next media.
+type pipelineKernel struct {
+	*Decoder
+}
+
+var _ kernel.Abstract = (*pipelineKernel)(nil)
+
+func (k *pipelineKernel) Close(ctx context.Context) error {
+	return nil
+}
+
 func (p *Decoder) CloseChan() <-chan struct{} {
 	ctx := context.TODO()
 	ch, err := p.EndChan(ctx)
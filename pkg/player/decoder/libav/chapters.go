@@ -0,0 +1,44 @@
+package libav
+
+// #cgo pkg-config: libavformat libavutil
+// #include <libavformat/avformat.h>
+//
+// static AVChapter *player_get_chapter(AVFormatContext *fmt_ctx, unsigned int idx) {
+// 	return fmt_ctx->chapters[idx];
+// }
+//
+// static const char *player_get_chapter_title(AVChapter *chapter) {
+// 	AVDictionaryEntry *entry = av_dict_get(chapter->metadata, "title", NULL, 0);
+// 	return entry == NULL ? NULL : entry->value;
+// }
+import "C"
+
+import (
+	"github.com/asticode/go-astiav"
+	avcommon "github.com/xaionaro-go/avcommon/astiav"
+	"github.com/xaionaro-go/avpipeline/avconv"
+	"github.com/xaionaro-go/player/pkg/player/types"
+)
+
+// formatContextChapters returns the chapters of the media; they are read
+// from AVFormatContext directly, because astiav does not expose them.
+func formatContextChapters(
+	fmtCtx *astiav.FormatContext,
+) types.Chapters {
+	cFmtCtx := (*C.AVFormatContext)(avcommon.CFromAVFormatContext(fmtCtx).UnsafePointer())
+	result := make(types.Chapters, 0, int(cFmtCtx.nb_chapters))
+	for idx := C.uint(0); idx < cFmtCtx.nb_chapters; idx++ {
+		chapter := C.player_get_chapter(cFmtCtx, idx)
+		timeBase := astiav.NewRational(int(chapter.time_base.num), int(chapter.time_base.den))
+		var title string
+		if cTitle := C.player_get_chapter_title(chapter); cTitle != nil {
+			title = C.GoString(cTitle)
+		}
+		result = append(result, types.Chapter{
+			Title: title,
+			Start: avconv.Duration(int64(chapter.start), timeBase),
+			End:   avconv.Duration(int64(chapter.end), timeBase),
+		})
+	}
+	return result
+}
@@ -0,0 +1,132 @@
+package libav
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/xaionaro-go/audio/pkg/audio"
+	"github.com/xaionaro-go/player/pkg/player/imagerenderer"
+	"github.com/xaionaro-go/player/pkg/player/types"
+)
+
+type dummyImageRenderer struct{}
+
+func (dummyImageRenderer) Close() error { return nil }
+func (dummyImageRenderer) SetImage(context.Context, imagerenderer.ImageGetter) error {
+	return nil
+}
+
+type dummyAudioRenderer struct{}
+
+func (dummyAudioRenderer) Close() error { return nil }
+func (dummyAudioRenderer) PlayPCM(
+	context.Context,
+	audio.SampleRate,
+	audio.Channel,
+	audio.PCMFormat,
+	time.Duration,
+	io.Reader,
+) (audio.PlayStream, error) {
+	return nil, fmt.Errorf("the audio is not supported in the tests")
+}
+
+// newMediaWithChapters generates a 10-second video with chapters
+// [0s, 4s) "First" and [4s, 10s) "Second".
+func newMediaWithChapters(t *testing.T) string {
+	ffmpeg, err := exec.LookPath("ffmpeg")
+	if err != nil {
+		t.Skip("ffmpeg is not found")
+	}
+	dir := t.TempDir()
+	metadataPath := filepath.Join(dir, "metadata.txt")
+	err = os.WriteFile(metadataPath, []byte(";FFMETADATA1\n"+
+		"[CHAPTER]\nTIMEBASE=1/1000\nSTART=0\nEND=4000\ntitle=First\n"+
+		"[CHAPTER]\nTIMEBASE=1/1000\nSTART=4000\nEND=10000\ntitle=Second\n",
+	), 0o644)
+	if err != nil {
+		t.Fatalf("unable to write the metadata: %v", err)
+	}
+	mediaPath := filepath.Join(dir, "chapters.mkv")
+	cmd := exec.Command(ffmpeg,
+		"-loglevel", "error",
+		"-f", "lavfi", "-i", "testsrc=duration=10:size=64x64:rate=10",
+		"-i", metadataPath,
+		"-map", "0", "-map_chapters", "1",
+		"-c:v", "mpeg4", "-g", "10",
+		mediaPath,
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("unable to generate the media: %v: %s", err, out)
+	}
+	return mediaPath
+}
+
+func TestChapters(t *testing.T) {
+	mediaPath := newMediaWithChapters(t)
+	ctx, cancelFn := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelFn()
+
+	p := New(ctx, dummyImageRenderer{}, dummyAudioRenderer{})
+	defer p.Close(ctx)
+	if err := p.OpenURL(ctx, mediaPath); err != nil {
+		t.Fatalf("unable to open the media: %v", err)
+	}
+	if err := p.SetPause(ctx, true); err != nil {
+		t.Fatalf("unable to pause: %v", err)
+	}
+
+	chapters, err := p.GetChapters(ctx)
+	if err != nil {
+		t.Fatalf("unable to get the chapters: %v", err)
+	}
+	expected := types.Chapters{
+		{Title: "First", Start: 0, End: 4 * time.Second},
+		{Title: "Second", Start: 4 * time.Second, End: 10 * time.Second},
+	}
+	if fmt.Sprint(chapters) != fmt.Sprint(expected) {
+		t.Fatalf("expected chapters %v, got %v", expected, chapters)
+	}
+	mediaInfo, err := p.GetMediaInfo(ctx)
+	if err != nil {
+		t.Fatalf("unable to get the media info: %v", err)
+	}
+	if fmt.Sprint(mediaInfo.Chapters) != fmt.Sprint(expected) {
+		t.Fatalf("expected chapters %v in the media info, got %v", expected, mediaInfo.Chapters)
+	}
+
+	if err := p.SetChapter(ctx, 1); err != nil {
+		t.Fatalf("unable to set the chapter: %v", err)
+	}
+	pos, err := p.GetPosition(ctx)
+	if err != nil {
+		t.Fatalf("unable to get the position: %v", err)
+	}
+	if pos != expected[1].Start {
+		t.Fatalf("expected the position %v (the start of the chapter), got %v", expected[1].Start, pos)
+	}
+	chapterIdx, err := p.GetChapter(ctx)
+	if err != nil {
+		t.Fatalf("unable to get the chapter: %v", err)
+	}
+	if chapterIdx != 1 {
+		t.Fatalf("expected chapter #1, got #%d", chapterIdx)
+	}
+
+	if err := p.SetChapter(ctx, 0); err != nil {
+		t.Fatalf("unable to set the chapter: %v", err)
+	}
+	if pos, err := p.GetPosition(ctx); err != nil || pos != 0 {
+		t.Fatalf("expected the position 0, got %v (err: %v)", pos, err)
+	}
+
+	if err := p.SetChapter(ctx, 2); !errors.Is(err, types.ErrNoChapter) {
+		t.Fatalf("expected ErrNoChapter, got %v", err)
+	}
+}
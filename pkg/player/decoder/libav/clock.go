@@ -0,0 +1,136 @@
+package libav
+
+import (
+	"context"
+	"time"
+
+	"github.com/xaionaro-go/xsync"
+)
+
+// playbackClock is the reference clock the video frames are synchronized to.
+// It is anchored by the audio output (if any), and could be paused or sped up.
+type playbackClock struct {
+	locker     xsync.Mutex
+	isSet      bool
+	isPaused   bool
+	speed      float64
+	position   time.Duration
+	anchorTime time.Time
+	changeChan chan struct{}
+}
+
+func newPlaybackClock() *playbackClock {
+	return &playbackClock{
+		speed:      1,
+		changeChan: make(chan struct{}),
+	}
+}
+
+func (c *playbackClock) Get() time.Duration {
+	return xsync.DoR1(context.Background(), &c.locker, c.get)
+}
+
+func (c *playbackClock) get() time.Duration {
+	if !c.isSet {
+		return 0
+	}
+	if c.isPaused {
+		return c.position
+	}
+	return c.position + time.Duration(float64(time.Since(c.anchorTime))*c.speed)
+}
+
+// Until returns the real (wall-clock) time left until the clock reaches
+// the given position.
+func (c *playbackClock) Until(pos time.Duration) time.Duration {
+	return xsync.DoR1(context.Background(), &c.locker, func() time.Duration {
+		return time.Duration(float64(pos-c.get()) / c.speed)
+	})
+}
+
+func (c *playbackClock) IsSet() bool {
+	return xsync.DoR1(context.Background(), &c.locker, func() bool {
+		return c.isSet
+	})
+}
+
+func (c *playbackClock) IsPaused() bool {
+	return xsync.DoR1(context.Background(), &c.locker, func() bool {
+		return c.isPaused
+	})
+}
+
+// Set moves the clock to the given position, and wakes up everybody waiting
+// on the clock.
+func (c *playbackClock) Set(pos time.Duration) {
+	c.locker.Do(context.Background(), func() {
+		c.set(pos)
+		c.notify()
+	})
+}
+
+// Sync moves the clock to the given position silently; it is used to
+// correct the drift against the audio output.
+func (c *playbackClock) Sync(pos time.Duration) {
+	c.locker.Do(context.Background(), func() {
+		c.set(pos)
+	})
+}
+
+func (c *playbackClock) set(pos time.Duration) {
+	c.isSet = true
+	c.position = pos
+	c.anchorTime = time.Now()
+}
+
+func (c *playbackClock) Unset() {
+	c.locker.Do(context.Background(), func() {
+		c.isSet = false
+		c.position = 0
+		c.notify()
+	})
+}
+
+func (c *playbackClock) GetSpeed() float64 {
+	return xsync.DoR1(context.Background(), &c.locker, func() float64 {
+		return c.speed
+	})
+}
+
+func (c *playbackClock) SetSpeed(speed float64) {
+	c.locker.Do(context.Background(), func() {
+		if c.speed == speed {
+			return
+		}
+		c.position = c.get()
+		c.anchorTime = time.Now()
+		c.speed = speed
+		c.notify()
+	})
+}
+
+func (c *playbackClock) SetPause(pause bool) {
+	c.locker.Do(context.Background(), func() {
+		if c.isPaused == pause {
+			return
+		}
+		c.position = c.get()
+		c.anchorTime = time.Now()
+		c.isPaused = pause
+		c.notify()
+	})
+}
+
+// ChangeChan returns a channel that is closed on the next change of the clock
+// (other than Sync).
+func (c *playbackClock) ChangeChan() <-chan struct{} {
+	return xsync.DoR1(context.Background(), &c.locker, func() <-chan struct{} {
+		return c.changeChan
+	})
+}
+
+func (c *playbackClock) notify() {
+	var oldChangeChan chan struct{}
+	c.changeChan, oldChangeChan = make(chan struct{}), c.changeChan
+	close(oldChangeChan)
+}
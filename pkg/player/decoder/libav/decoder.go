@@ -2,24 +2,27 @@ package libav
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"image"
 	"io"
 	"math"
-	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/asticode/go-astiav"
 	"github.com/facebookincubator/go-belt/tool/logger"
 	"github.com/xaionaro-go/audio/pkg/audio"
 	"github.com/xaionaro-go/audio/pkg/audio/planar"
 	"github.com/xaionaro-go/avpipeline"
+	"github.com/xaionaro-go/avpipeline/avconv"
 	"github.com/xaionaro-go/avpipeline/codec"
 	"github.com/xaionaro-go/avpipeline/frame"
 	"github.com/xaionaro-go/avpipeline/kernel"
 	"github.com/xaionaro-go/avpipeline/node"
 	"github.com/xaionaro-go/avpipeline/processor"
 	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/player/pkg/player/subtitles"
 	"github.com/xaionaro-go/player/pkg/player/types"
 	"github.com/xaionaro-go/secret"
 	"github.com/xaionaro-go/xcontext"
@@ -28,6 +31,8 @@ import (
 
 const (
 	BufferSizeAudio = 100 * time.Millisecond
+	SpeedMin        = 0.25
+	SpeedMax        = 4
 )
 
 type Decoder struct {
@@ -37,16 +42,45 @@ type Decoder struct {
 	audioWriter           io.WriteCloser
 	audioStream           audio.Stream
 	locker                xsync.Gorex
+	openLocker            xsync.Mutex
 	currentURL            string
 	currentImage          image.Image
+	currentImageLocker    xsync.Mutex
 	previousVideoPosition time.Duration
-	currentAudioPosition  atomic.Uint64
+	clock                 *playbackClock
 	videoStreamIndex      atomic.Uint32
 	audioStreamIndex      atomic.Uint32
+	subtitlesStreamIndex  atomic.Uint32
+	isVideoInitialized    bool
 	closedChan            chan struct{}
 	endChan               chan struct{}
 	cancelFunc            context.CancelFunc
-	videoFramesQueue      chan frame.Input
+	isReplacing           bool
+	isStopping            bool
+	videoFramesQueue      chan videoFrame
+	frameStepChan         chan struct{}
+	input                 *kernel.Input
+	decoderNode           node.Abstract
+	currentSeek           *seekRequest
+	pendingSeek           atomic.Pointer[seekRequest]
+	flushGeneration       atomic.Uint64
+	volume                atomic.Uint64 // math.Float64bits
+	length                atomic.Int64  // time.Duration; zero if unknown
+	events                types.EventBroadcaster
+	isMuted               atomic.Bool
+
+	subtitlesLocker              xsync.Mutex
+	embeddedSubtitles            *subtitles.Track
+	embeddedSubtitlesDecoder     subtitles.StreamDecoder
+	embeddedSubtitlesStreamIndex int
+	externalSubtitles            *subtitles.Track
+	subtitlesRenderer            *subtitles.Renderer
+	isSubtitlesImageSet          bool
+}
+
+type videoFrame struct {
+	frame.Input
+	FlushGeneration uint64
 }
 
 var _ types.Player = (*Decoder)(nil)
@@ -61,8 +95,11 @@ func New(
 		AudioRenderer:    audioRenderer,
 		closedChan:       make(chan struct{}),
 		endChan:          make(chan struct{}),
-		videoFramesQueue: make(chan frame.Input, 100),
+		videoFramesQueue: make(chan videoFrame, 100),
+		frameStepChan:    make(chan struct{}),
+		clock:            newPlaybackClock(),
 	}
+	p.volume.Store(math.Float64bits(1))
 	p.init(ctx)
 	p.onEnd()
 	return p
@@ -86,59 +123,112 @@ func (p *Decoder) videoRenderLoop(
 	logger.Debugf(ctx, "videoRenderLoop")
 	defer logger.Debugf(ctx, "/videoRenderLoop")
 	for {
-		var f frame.Input
+		var f videoFrame
 		select {
 		case <-ctx.Done():
 			logger.Debugf(ctx, "videoRenderLoop: context done")
 			return
 		case f = <-p.videoFramesQueue:
 		}
+		if f.FlushGeneration != p.flushGeneration.Load() {
+			logger.Tracef(ctx, "skipping a frame queued before a flush")
+			continue
+		}
 
-		currentExpectedPosition := p.getCurrentAudioPosition() - BufferSizeAudio
 		curPosition := f.GetPTSAsDuration()
-		waitIntervalForNextFrame := curPosition - currentExpectedPosition
-		p.previousVideoPosition = curPosition
-
-		logger.Tracef(ctx, "sleeping for %v (%v - %v)", waitIntervalForNextFrame, curPosition, currentExpectedPosition)
-		if waitIntervalForNextFrame > 0 {
-			time.Sleep(waitIntervalForNextFrame)
+		if !p.clock.IsSet() {
+			p.clock.Set(curPosition)
 		}
+		if !p.waitForFrame(ctx, curPosition, f.FlushGeneration) {
+			logger.Tracef(ctx, "the wait was interrupted, skipping the frame")
+			continue
+		}
+		p.previousVideoPosition = curPosition
 
 		switch r := p.ImageRenderer.(type) {
 		case AVFrameRenderer:
 			if err := r.SetAVFrame(ctx, ImageUnparsed{
 				Decoder: p,
-				Input:   f,
+				Input:   f.Input,
 			}); err != nil {
 				logger.Errorf(ctx, "unable to set the AV frame: %v", err)
 				continue
 			}
 		case ImageRenderer:
-			err := f.Data().ToImage(p.currentImage)
+			err := xsync.DoR1(ctx, &p.currentImageLocker, func() error {
+				return f.Data().ToImage(p.currentImage)
+			})
 			if err != nil {
 				logger.Errorf(ctx, "unable to convert the frame into an image: %v", err)
 				continue
 			}
-			if _, ok := p.ImageRenderer.(RenderImageNower); !ok {
+			img := p.renderSubtitles(ctx, p.currentImage, curPosition)
+			isSubtitlesImage := img != p.currentImage
+			if _, ok := p.ImageRenderer.(RenderImageNower); !ok || isSubtitlesImage || p.isSubtitlesImageSet {
 				err := r.SetImage(ctx, ImageGeneric{
 					Decoder: p,
-					Input:   f,
-					Image:   p.currentImage,
+					Input:   f.Input,
+					Image:   img,
 				})
 				if err != nil {
 					logger.Errorf(ctx, "unable to set the image: %v", err)
 					continue
 				}
+				p.isSubtitlesImageSet = isSubtitlesImage
 			}
 		default:
 			logger.Errorf(ctx, "an image renderer of an unexpected type %T", r)
 			continue
 		}
 
-		if err := p.renderCurrentPicture(ctx, f); err != nil {
+		if err := p.renderCurrentPicture(ctx, f.Input); err != nil {
 			logger.Errorf(ctx, "unable to render the picture: %v", err)
 			continue
 		}
+		p.emitPosition(ctx)
+	}
+}
+
+func (p *Decoder) waitForFrame(
+	ctx context.Context,
+	pts time.Duration,
+	flushGeneration uint64,
+) bool {
+	for {
+		clockChangeChan := p.clock.ChangeChan()
+		if p.flushGeneration.Load() != flushGeneration {
+			return false
+		}
+
+		waitIntervalForNextFrame := p.clock.Until(pts)
+		if waitIntervalForNextFrame <= 0 {
+			return true
+		}
+
+		if p.clock.IsPaused() {
+			select {
+			case <-ctx.Done():
+				return false
+			case <-clockChangeChan:
+			case <-p.frameStepChan:
+				// see FrameStep
+				p.clock.Set(pts)
+				return true
+			}
+			continue
+		}
+
+		logger.Tracef(ctx, "sleeping for %v (pts: %v)", waitIntervalForNextFrame, pts)
+		timer := time.NewTimer(waitIntervalForNextFrame)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return false
+		case <-timer.C:
+			return true
+		case <-clockChangeChan:
+			timer.Stop()
+		}
 	}
 }
 
@@ -148,35 +238,70 @@ func (p *Decoder) OpenURL(
 ) (_err error) {
 	logger.Debugf(ctx, "OpenURL(ctx, '%s')", link)
 	defer func() { logger.Debugf(ctx, "/OpenURL(ctx, '%s'): %v", link, _err) }()
-	return xsync.DoA2R1(ctx, &p.locker, p.openURL, ctx, link)
+	return xsync.DoR1(ctx, &p.openLocker, func() error {
+		if err := p.stopPipeline(ctx, true); err != nil {
+			return fmt.Errorf("unable to stop the current playback: %w", err)
+		}
+		return xsync.DoA2R1(ctx, &p.locker, p.openURL, ctx, link)
+	})
+}
+
+// stopPipeline cancels the running pipeline (if any) and waits until
+// it is finished.
+func (p *Decoder) stopPipeline(
+	ctx context.Context,
+	isReplacing bool,
+) error {
+	ch := xsync.DoR1(ctx, &p.locker, func() <-chan struct{} {
+		if p.cancelFunc == nil {
+			return nil
+		}
+		p.isReplacing = isReplacing
+		p.isStopping = true
+		p.cancelFunc()
+		return p.closedChan
+	})
+	if ch == nil {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ch:
+		return nil
+	}
 }
 
 func (p *Decoder) openURL(
 	ctx context.Context,
 	link string,
-) error {
+) (_err error) {
 	if p.cancelFunc != nil {
-		return fmt.Errorf("player is already running; changing URLs is not implemented, yet")
+		return fmt.Errorf("internal error: the previous pipeline is still running")
 	}
 	ctx = xcontext.DetachDone(ctx)
 	ctx, cancelFn := context.WithCancel(ctx)
 	p.cancelFunc = cancelFn
-	var once sync.Once
-	stopFn := func() {
-		once.Do(func() {
-			p.locker.Do(ctx, func() {
-				p.cancelFunc()
-				p.cancelFunc = nil
-			})
-		})
-	}
+	isStarted := false
+	defer func() {
+		if _err != nil && !isStarted {
+			cancelFn()
+			p.cancelFunc = nil
+			p.input = nil
+			p.decoderNode = nil
+		}
+	}()
 
-	inputCfg := kernel.InputConfig{}
+	inputCfg := kernel.InputConfig{
+		// the input is paced by inputFilter instead
+		ForceRealTime: ptr(false),
+	}
 	input, err := kernel.NewInputFromURL(ctx, link, secret.New(""), inputCfg)
 	logger.Tracef(ctx, "NewInputFromURL(ctx, '%s', '', %#+v): %v", link, inputCfg, err)
 	if err != nil {
 		return fmt.Errorf("unable to open '%s': %w", link, err)
 	}
+	input.OutputFilters = append(input.OutputFilters, newInputFilter(p, input))
 
 	inputNode := node.NewFromKernel(
 		ctx,
@@ -190,25 +315,34 @@ func (p *Decoder) openURL(
 	)
 	playerNode := node.NewFromKernel(
 		ctx,
-		p,
+		&pipelineKernel{Decoder: p},
 		processor.DefaultOptionsTranscoder()...,
 	)
 	inputNode.AddPushTo(ctx, decoderNode)
 	decoderNode.AddPushTo(ctx, playerNode)
+	p.input = input
+	p.decoderNode = decoderNode
+	if p.clock.IsPaused() {
+		if err := p.setBlockInput(ctx, true); err != nil {
+			return err
+		}
+	}
 
 	p.onSeek(ctx)
 
 	errCh := make(chan node.Error, 1)
 	observability.Go(ctx, func(ctx context.Context) {
-		defer stopFn()
+		defer cancelFn()
 		select {
 		case <-ctx.Done():
 			return
 		case err := <-errCh:
-			if err.Err != nil {
-				logger.Errorf(ctx, "received error: %v", err)
-			} else {
+			switch {
+			case err.Err == nil, errors.Is(err.Err, io.EOF), errors.Is(err.Err, context.Canceled):
 				logger.Debugf(ctx, "received error: %v", err)
+			default:
+				logger.Errorf(ctx, "received error: %v", err)
+				p.events.Emit(ctx, types.EventError{Err: err.Err})
 			}
 		}
 	})
@@ -217,8 +351,17 @@ func (p *Decoder) openURL(
 		defer p.onEnd()
 		avpipeline.Serve(ctx, avpipeline.ServeConfig{}, errCh, inputNode)
 	})
+	isStarted = true
 
 	p.currentURL = link
+	if length, err := p.getLength(); err == nil {
+		p.length.Store(int64(length))
+	} else {
+		p.length.Store(0)
+	}
+	p.events.Emit(ctx, types.EventMediaChange{Link: link})
+	p.events.Emit(ctx, types.EventTracksChange{})
+	p.emitState(ctx)
 	if p.ImageRenderer != nil {
 		if v, ok := p.ImageRenderer.(SetVisibler); ok {
 			if err := v.SetVisible(true); err != nil {
@@ -236,29 +379,33 @@ func (p *Decoder) openURL(
 	return nil
 }
 
-func (p *Decoder) getCurrentAudioPosition() time.Duration {
-	return time.Duration(p.currentAudioPosition.Load())
-}
-
 func (p *Decoder) processFrame(
 	ctx context.Context,
 	frame frame.Input,
 ) error {
 	logger.Tracef(ctx, "processFrame: pos: %v; pts: %v; time_base: %v", frame.GetPTSAsDuration(), frame.Pts(), frame.GetTimeBase())
 	defer func() {
-		logger.Tracef(ctx, "/processFrame; av-desync: %v", p.getCurrentAudioPosition()-BufferSizeAudio-p.previousVideoPosition)
+		logger.Tracef(ctx, "/processFrame; av-desync: %v", p.clock.Get()-p.previousVideoPosition)
 	}()
-	return xsync.DoR1(ctx, &p.locker, func() error {
-		switch frame.GetMediaType() {
-		case MediaTypeVideo:
-			return p.processVideoFrame(ctx, frame)
-		case MediaTypeAudio:
-			return p.processAudioFrame(ctx, frame)
-		default:
-			// we don't care about everything else
-			return nil
-		}
-	})
+	switch frame.GetMediaType() {
+	case MediaTypeVideo:
+		return p.processVideoFrame(ctx, frame)
+	case MediaTypeAudio:
+		return p.processAudioFrame(ctx, frame)
+	default:
+		// we don't care about everything else
+		return nil
+	}
+}
+
+func (p *Decoder) isFrameAccepted(
+	ctx context.Context,
+	f frame.Input,
+) bool {
+	if p.currentSeek == nil {
+		return true
+	}
+	return p.currentSeek.isFrameAccepted(ctx, f)
 }
 
 func (p *Decoder) onSeek(
@@ -280,20 +427,42 @@ func (p *Decoder) processVideoFrame(
 		return nil
 	}
 
-	streamIdx := f.GetStreamIndex()
+	item, err := xsync.DoR2(ctx, &p.locker, func() (*videoFrame, error) {
+		if !p.isFrameAccepted(ctx, f) {
+			return nil, nil
+		}
+
+		streamIdx := f.GetStreamIndex()
 
-	if p.videoStreamIndex.CompareAndSwap(math.MaxUint32, uint32(streamIdx)) { // atomics are not really needed because all of this happens while holding p.locker
-		if err := p.initImageFor(ctx, f); err != nil {
-			return fmt.Errorf("unable to initialize an image variable for the frame: %w", err)
+		p.videoStreamIndex.CompareAndSwap(math.MaxUint32, uint32(streamIdx)) // atomics are not really needed because all of this happens while holding p.locker
+		if selectedStreamIdx := int(p.videoStreamIndex.Load()); selectedStreamIdx != streamIdx {
+			logger.Tracef(ctx, "ignoring a frame of a non-selected video stream, index: %d (which is not %d)", streamIdx, selectedStreamIdx)
+			return nil, nil
 		}
-	} else {
-		oldStreamIdx := int(p.videoStreamIndex.Load())
-		if oldStreamIdx != streamIdx {
-			return fmt.Errorf("the index of the video stream have changed from %d to %d; the support of dynamic/multiple video tracks is not implemented, yet", oldStreamIdx, streamIdx)
+		if !p.isVideoInitialized {
+			if err := p.initImageFor(ctx, f); err != nil {
+				return nil, fmt.Errorf("unable to initialize an image variable for the frame: %w", err)
+			}
+			p.isVideoInitialized = true
 		}
+
+		return &videoFrame{
+			Input:           f,
+			FlushGeneration: p.flushGeneration.Load(),
+		}, nil
+	})
+	if err != nil {
+		return err
+	}
+	if item == nil {
+		return nil
 	}
 
-	p.videoFramesQueue <- f
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case p.videoFramesQueue <- *item:
+	}
 	return nil
 }
 
@@ -319,10 +488,57 @@ func (p *Decoder) processAudioFrame(
 		return nil
 	}
 
-	p.currentAudioPosition.Store(uint64(frame.GetPTSAsDuration()))
+	audioWriter, err := xsync.DoR2(ctx, &p.locker, func() (io.Writer, error) {
+		return p.initAudioFor(ctx, frame)
+	})
+	if err != nil {
+		return err
+	}
+	if audioWriter == nil {
+		return nil
+	}
+
+	align := 1
+	frameBytes, err := frame.Data().Bytes(int(align))
+	if err != nil {
+		return fmt.Errorf("unable to get the audio frame data: %w", err)
+	}
+	if gain := p.getGain(); gain != 1 {
+		applyGain(frameBytes, pcmFormatToAudio(frame.CodecParameters.SampleFormat()), gain)
+	}
+
+	n, err := audioWriter.Write(frameBytes)
+	if errors.Is(err, io.ErrClosedPipe) {
+		logger.Debugf(ctx, "the audio output was reset while writing the frame")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to write the audio frame into the playback subsystem: %w", err)
+	}
+	if n != len(frameBytes) {
+		return fmt.Errorf("unable to write the full audio frame: %d != %d", n, len(frameBytes))
+	}
+
+	return nil
+}
+
+func (p *Decoder) initAudioFor(
+	ctx context.Context,
+	frame frame.Input,
+) (io.Writer, error) {
+	if !p.isFrameAccepted(ctx, frame) {
+		return nil, nil
+	}
+
 	streamIdx := frame.GetStreamIndex()
 
-	if p.audioStreamIndex.CompareAndSwap(math.MaxUint32, uint32(streamIdx)) { // atomics are not really needed because all of this happens while holding p.locker
+	p.audioStreamIndex.CompareAndSwap(math.MaxUint32, uint32(streamIdx)) // atomics are not really needed because all of this happens while holding p.locker
+	if selectedStreamIdx := int(p.audioStreamIndex.Load()); selectedStreamIdx != streamIdx {
+		logger.Tracef(ctx, "ignoring a frame of a non-selected audio stream, index: %d (which is not %d)", streamIdx, selectedStreamIdx)
+		return nil, nil
+	}
+
+	if p.audioWriter == nil {
 		var r io.Reader
 		{
 			pr, pw := io.Pipe()
@@ -331,7 +547,7 @@ func (p *Decoder) processAudioFrame(
 		}
 		bufSize, err := frame.SamplesBufferSize(1)
 		if err != nil {
-			return fmt.Errorf("unable to get the buffer size: %w", err)
+			return nil, fmt.Errorf("unable to get the buffer size: %w", err)
 		}
 
 		codecParams := frame.CodecParameters
@@ -345,6 +561,12 @@ func (p *Decoder) processAudioFrame(
 		if isPlanar(pcmFormatAV) {
 			r = planar.NewUnplanarizeReader(r, audio.Channel(channels), uint(pcmFormat.Size()), uint(bufSize))
 		}
+		if stretcher, err := newTimeStretchReader(r, p.clock.GetSpeed, audio.SampleRate(sampleRate), audio.Channel(channels), pcmFormat); err != nil {
+			logger.Warnf(ctx, "unable to initialize the time-stretching of the audio (the audio will not follow the playback speed): %v", err)
+		} else {
+			r = stretcher
+		}
+		r = newPausableReader(r, p.clock, pcmFormat)
 		audioStream, err := p.AudioRenderer.PlayPCM(
 			ctx,
 			audio.SampleRate(sampleRate),
@@ -354,32 +576,16 @@ func (p *Decoder) processAudioFrame(
 			r,
 		)
 		if err != nil {
-			return fmt.Errorf("unable to initialize an audio playback: %w", err)
+			return nil, fmt.Errorf("unable to initialize an audio playback: %w", err)
 		}
 		p.audioStream = audioStream
-	} else {
-		oldStreamIdx := int(p.audioStreamIndex.Load())
-		if oldStreamIdx != streamIdx {
-			logger.Tracef(ctx, "we do not support multiple audio streams, yet; so we ignore this new stream, index: %d (which is not %d)", streamIdx, oldStreamIdx)
-			return nil
-		}
-	}
-
-	align := 1
-	frameBytes, err := frame.Data().Bytes(int(align))
-	if err != nil {
-		return fmt.Errorf("unable to get the audio frame data: %w", err)
 	}
 
-	n, err := p.audioWriter.Write(frameBytes)
-	if err != nil {
-		return fmt.Errorf("unable to write the audio frame into the playback subsystem: %w", err)
-	}
-	if n != len(frameBytes) {
-		return fmt.Errorf("unable to write the full audio frame: %d != %d", n, len(frameBytes))
-	}
-
-	return nil
+	// the audio buffered by the playback is consumed at the playback speed
+	bufferedDuration := time.Duration(float64(BufferSizeAudio) * p.clock.GetSpeed())
+	p.clock.Sync(frame.GetPTSAsDuration() - bufferedDuration)
+	p.emitPosition(ctx)
+	return p.audioWriter, nil
 }
 
 func (p *Decoder) onEnd() {
@@ -389,15 +595,21 @@ func (p *Decoder) onEnd() {
 	p.locker.Do(ctx, func() {
 		p.videoStreamIndex.Store(math.MaxUint32)
 		p.audioStreamIndex.Store(math.MaxUint32)
+		p.subtitlesStreamIndex.Store(math.MaxUint32)
+		p.isVideoInitialized = false
 		p.currentURL = ""
-		if p.audioWriter != nil {
-			p.audioWriter.Close()
-			p.audioWriter = nil
-		}
-		if p.audioStream != nil {
-			p.audioStream.Close()
-			p.audioStream = nil
+		p.input = nil
+		p.decoderNode = nil
+		p.currentSeek = nil
+		if req := p.pendingSeek.Swap(nil); req != nil {
+			req.setFailed(fmt.Errorf("the playback ended before the seek was performed"))
 		}
+		p.cancelFunc = nil
+		p.resetAudio()
+		p.resetEmbeddedSubtitles(ctx)
+		p.unloadExternalSubtitles(ctx)
+		p.clock.Unset()
+		p.flushVideoQueue()
 
 		var oldEndChan chan struct{}
 		p.endChan, oldEndChan = make(chan struct{}), p.endChan
@@ -407,6 +619,18 @@ func (p *Decoder) onEnd() {
 		default:
 			close(p.closedChan)
 		}
+		isStopping := p.isStopping
+		p.isStopping = false
+		if p.isReplacing {
+			// the renderer will be reused by the next media right away, so
+			// keeping it visible to avoid flickering
+			p.isReplacing = false
+			return
+		}
+		if !isStopping {
+			p.events.Emit(ctx, types.EventEndOfFile{})
+		}
+		p.emitState(ctx)
 		if p.ImageRenderer != nil {
 			if v, ok := p.ImageRenderer.(SetVisibler); ok {
 				if err := v.SetVisible(false); err != nil {
@@ -417,6 +641,17 @@ func (p *Decoder) onEnd() {
 	})
 }
 
+func (p *Decoder) resetAudio() {
+	if p.audioWriter != nil {
+		p.audioWriter.Close()
+		p.audioWriter = nil
+	}
+	if p.audioStream != nil {
+		p.audioStream.Close()
+		p.audioStream = nil
+	}
+}
+
 func (p *Decoder) EndChan(
 	ctx context.Context,
 ) (<-chan struct{}, error) {
@@ -442,16 +677,7 @@ func (p *Decoder) GetPosition(
 		if p.isEnded() {
 			return 0, fmt.Errorf("the player is not started or already ended")
 		}
-		currentAudioPosition := p.getCurrentAudioPosition()
-		switch {
-		case p.previousVideoPosition != 0 && currentAudioPosition != 0:
-			return (p.previousVideoPosition + currentAudioPosition) / 2, nil
-		case currentAudioPosition != 0:
-			return currentAudioPosition, nil
-		case p.previousVideoPosition != 0:
-			return p.previousVideoPosition, nil
-		}
-		return 0, nil
+		return p.clock.Get(), nil
 	})
 }
 
@@ -473,10 +699,35 @@ func (p *Decoder) GetLength(
 			return 0, fmt.Errorf("the player is not started or already ended")
 		}
 
-		return 0, fmt.Errorf("not implemented, yet")
+		return p.getLength()
 	})
 }
 
+func (p *Decoder) getLength() (time.Duration, error) {
+	if p.input == nil {
+		return 0, fmt.Errorf("the input is not initialized")
+	}
+	fmtCtx := p.input.FormatContext
+
+	if duration := fmtCtx.Duration(); duration != astiav.NoPtsValue && duration > 0 {
+		return avconv.Duration(duration, astiav.TimeBaseQ), nil
+	}
+
+	var result time.Duration
+	for _, stream := range fmtCtx.Streams() {
+		duration := stream.Duration()
+		if duration == astiav.NoPtsValue || duration <= 0 {
+			continue
+		}
+		result = max(result, avconv.Duration(duration, stream.TimeBase()))
+	}
+	if result > 0 {
+		return result, nil
+	}
+
+	return 0, types.ErrUnknownLength
+}
+
 func (p *Decoder) ProcessTitle(
 	ctx context.Context,
 ) (string, error) {
@@ -498,84 +749,184 @@ func (p *Decoder) GetLink(
 	})
 }
 
-func (*Decoder) GetSpeed(
+func (p *Decoder) GetSpeed(
 	ctx context.Context,
 ) (float64, error) {
-	return 1, nil
+	return p.clock.GetSpeed(), nil
 }
 
-func (*Decoder) SetSpeed(
+func (p *Decoder) SetSpeed(
 	ctx context.Context,
 	speed float64,
-) error {
-	logger.Errorf(ctx, "SetSpeed is not implemented, yet")
+) (_err error) {
+	logger.Debugf(ctx, "SetSpeed(ctx, %v)", speed)
+	defer func() { logger.Debugf(ctx, "/SetSpeed(ctx, %v): %v", speed, _err) }()
+	if speed < SpeedMin || speed > SpeedMax {
+		return fmt.Errorf("the speed %v is out of the supported range [%v, %v]", speed, SpeedMin, SpeedMax)
+	}
+	p.clock.SetSpeed(speed)
 	return nil
 }
 
-func (*Decoder) GetPause(
+func (p *Decoder) GetPause(
 	ctx context.Context,
 ) (bool, error) {
-	return false, nil
+	return p.clock.IsPaused(), nil
 }
 
-func (*Decoder) SetPause(
+func (p *Decoder) SetPause(
 	ctx context.Context,
 	pause bool,
-) error {
-	logger.Errorf(ctx, "SetPause is not implemented, yet")
-	return nil
+) (_err error) {
+	logger.Debugf(ctx, "SetPause(ctx, %t)", pause)
+	defer func() { logger.Debugf(ctx, "/SetPause(ctx, %t): %v", pause, _err) }()
+	return xsync.DoA2R1(ctx, &p.locker, p.setPause, ctx, pause)
 }
 
-func (*Decoder) Seek(
+func (p *Decoder) setPause(
 	ctx context.Context,
-	pos time.Duration,
-	isRelative bool,
-	quick bool,
+	pause bool,
 ) error {
-	return fmt.Errorf("not implemented, yet")
-}
+	if p.clock.IsPaused() == pause {
+		return nil
+	}
 
-func (*Decoder) GetVideoTracks(
-	ctx context.Context,
-) (types.VideoTracks, error) {
-	return nil, fmt.Errorf("not implemented, yet")
+	if err := p.setBlockInput(ctx, pause); err != nil {
+		return err
+	}
+	p.clock.SetPause(pause)
+	if !p.isEnded() {
+		p.emitState(ctx)
+	}
+	return nil
 }
 
-func (*Decoder) GetAudioTracks(
+func (p *Decoder) setBlockInput(
 	ctx context.Context,
-) (types.AudioTracks, error) {
-	return nil, fmt.Errorf("not implemented, yet")
+	blocked bool,
+) error {
+	if p.decoderNode == nil {
+		return nil
+	}
+	// the pause is released on cancellation of the context, so it should not
+	// depend on the context of the request
+	if err := node.SetBlockInput(xcontext.DetachDone(ctx), blocked, p.decoderNode); err != nil {
+		return fmt.Errorf("unable to set the input blocked=%t: %w", blocked, err)
+	}
+	return nil
 }
 
-func (*Decoder) GetSubtitlesTracks(
+func (p *Decoder) Seek(
 	ctx context.Context,
-) (types.SubtitlesTracks, error) {
-	return nil, fmt.Errorf("not implemented, yet")
+	pos time.Duration,
+	isRelative bool,
+	quick bool,
+) (_err error) {
+	logger.Debugf(ctx, "Seek(ctx, %v, %t, %t)", pos, isRelative, quick)
+	defer func() { logger.Debugf(ctx, "/Seek(ctx, %v, %t, %t): %v", pos, isRelative, quick, _err) }()
+	return p.seek(ctx, func(curPos time.Duration) *seekRequest {
+		pos := pos
+		if isRelative {
+			pos += curPos
+		}
+		if pos < 0 {
+			pos = 0
+		}
+		return newSeekRequest(pos, !quick, pos < curPos)
+	})
 }
 
-func (*Decoder) SetVideoTrack(
+// seek performs the seek request built by newRequest from the current
+// position, and waits until the input performs it.
+func (p *Decoder) seek(
 	ctx context.Context,
-	vid int64,
+	newRequest func(curPos time.Duration) *seekRequest,
 ) error {
-	return fmt.Errorf("not implemented, yet")
+	var closedChan <-chan struct{}
+	req, err := xsync.DoR2(ctx, &p.locker, func() (*seekRequest, error) {
+		if p.isEnded() {
+			return nil, fmt.Errorf("the player is not started or already ended")
+		}
+		closedChan = p.closedChan
+
+		req := newRequest(p.clock.Get())
+		// the request should be visible to the input before the clock change
+		// wakes it up
+		if prevReq := p.pendingSeek.Swap(req); prevReq != nil {
+			// the input has not reached the previous seek yet (for example,
+			// on a fast scrubbing), so it is just superseded by this one
+			logger.Debugf(ctx, "the seek to %v is superseded by the seek to %v", prevReq.Position, req.Position)
+			prevReq.ResultChan <- nil
+		}
+		p.flushForSeek(ctx, req)
+		p.onSeek(ctx)
+		if p.clock.IsPaused() {
+			// the input should be able to proceed to reach the seek
+			if err := p.setBlockInput(ctx, false); err != nil {
+				return nil, err
+			}
+		}
+		return req, nil
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		p.locker.Do(ctx, func() {
+			if !p.clock.IsPaused() {
+				return
+			}
+			if err := p.setBlockInput(ctx, true); err != nil {
+				logger.Errorf(ctx, "unable to re-pause the input: %v", err)
+			}
+		})
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-closedChan:
+		return fmt.Errorf("the player was closed before the seek was performed")
+	case err := <-req.ResultChan:
+		return err
+	}
 }
 
-func (*Decoder) SetAudioTrack(
-	ctx context.Context,
-	aid int64,
-) error {
-	return fmt.Errorf("not implemented, yet")
+func (p *Decoder) flushVideoQueue() {
+	p.flushGeneration.Add(1)
+	for {
+		select {
+		case <-p.videoFramesQueue:
+			continue
+		default:
+		}
+		break
+	}
 }
 
-func (*Decoder) SetSubtitlesTrack(
+func (p *Decoder) flushForSeek(
 	ctx context.Context,
-	sid int64,
-) error {
-	return fmt.Errorf("not implemented, yet")
+	req *seekRequest,
+) {
+	logger.Tracef(ctx, "flushForSeek")
+	defer logger.Tracef(ctx, "/flushForSeek")
+
+	p.currentSeek = req
+	p.flushVideoQueue()
+	p.resetAudio()
+	p.resetEmbeddedSubtitles(ctx)
+	p.clock.Set(req.ClockPosition)
+	p.previousVideoPosition = req.Position
 }
 
-func (*Decoder) Stop(
+// Stop stops the playback of the current media (if any). Unlike Close it
+// keeps the renderers open, so the Decoder could be reused by OpenURL.
+func (p *Decoder) Stop(
 	ctx context.Context,
-) error {
-	panic("not implemented, yet")
+) (_err error) {
+	logger.Debugf(ctx, "Stop")
+	defer func() { logger.Debugf(ctx, "/Stop: %v", _err) }()
+	return xsync.DoR1(ctx, &p.openLocker, func() error {
+		return p.stopPipeline(ctx, false)
+	})
 }
@@ -4,6 +4,7 @@
 package builtin
 
 const (
-	MediaTypeVideo = 0x00
-	MediaTypeAudio = 0x01
+	MediaTypeVideo    = 0x00
+	MediaTypeAudio    = 0x01
+	MediaTypeSubtitle = 0x03
 )
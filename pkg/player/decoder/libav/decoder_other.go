@@ -8,6 +8,7 @@ import (
 )
 
 const (
-	MediaTypeVideo = astiav.MediaTypeVideo
-	MediaTypeAudio = astiav.MediaTypeAudio
+	MediaTypeVideo    = astiav.MediaTypeVideo
+	MediaTypeAudio    = astiav.MediaTypeAudio
+	MediaTypeSubtitle = astiav.MediaTypeSubtitle
 )
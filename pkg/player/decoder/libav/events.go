@@ -0,0 +1,29 @@
+package libav
+
+import (
+	"context"
+	"time"
+
+	"github.com/xaionaro-go/player/pkg/player/types"
+)
+
+func (p *Decoder) Events(
+	ctx context.Context,
+) (<-chan types.Event, error) {
+	return p.events.Subscribe(ctx), nil
+}
+
+func (p *Decoder) emitState(ctx context.Context) {
+	state := types.PlaybackStatePlaying
+	switch {
+	case p.isEnded():
+		state = types.PlaybackStateStopped
+	case p.clock.IsPaused():
+		state = types.PlaybackStatePaused
+	}
+	p.events.Emit(ctx, types.EventStateChange{State: state})
+}
+
+func (p *Decoder) emitPosition(ctx context.Context) {
+	p.events.EmitPosition(ctx, p.clock.Get(), time.Duration(p.length.Load()))
+}
@@ -0,0 +1,99 @@
+package libav
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/xsync"
+)
+
+const (
+	// defaultFrameDuration is used if the frame rate of the video is unknown.
+	defaultFrameDuration = time.Second / 25
+)
+
+// FrameStep pauses the playback (if it is not paused), and shows the next
+// (or the previous) video frame.
+//
+// The step forward releases the frame the renderer waits for (see
+// waitForFrame); the step back is an exact seek to before the previous frame,
+// followed by a step forward.
+func (p *Decoder) FrameStep(
+	ctx context.Context,
+	forward bool,
+) (_err error) {
+	logger.Debugf(ctx, "FrameStep(ctx, %t)", forward)
+	defer func() { logger.Debugf(ctx, "/FrameStep(ctx, %t): %v", forward, _err) }()
+
+	if !xsync.DoR1(ctx, &p.locker, func() bool { return p.isVideoInitialized }) {
+		return fmt.Errorf("there is no video to step through")
+	}
+	if err := p.SetPause(ctx, true); err != nil {
+		return fmt.Errorf("unable to pause: %w", err)
+	}
+
+	if !forward {
+		err := p.seek(ctx, func(time.Duration) *seekRequest {
+			pos := max(p.previousVideoPosition-p.videoFrameDuration()*3/2, 0)
+			req := newSeekRequest(pos, true, true)
+			req.ClockPosition = pos - 1
+			return req
+		})
+		if err != nil {
+			return fmt.Errorf("unable to seek to the previous frame: %w", err)
+		}
+	}
+
+	var closedChan <-chan struct{}
+	err := xsync.DoR1(ctx, &p.locker, func() error {
+		if p.isEnded() {
+			return fmt.Errorf("the player is not started or already ended")
+		}
+		closedChan = p.closedChan
+		// the input should be able to proceed if the next frame is not
+		// decoded yet
+		return p.setBlockInput(ctx, false)
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		p.locker.Do(ctx, func() {
+			if !p.clock.IsPaused() {
+				return
+			}
+			if err := p.setBlockInput(ctx, true); err != nil {
+				logger.Errorf(ctx, "unable to re-pause the input: %v", err)
+			}
+		})
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-closedChan:
+		return fmt.Errorf("the player was closed before the frame step was performed")
+	case p.frameStepChan <- struct{}{}:
+	}
+	return nil
+}
+
+// videoFrameDuration returns the nominal duration of a frame of the selected
+// video stream.
+func (p *Decoder) videoFrameDuration() time.Duration {
+	if p.input == nil {
+		return defaultFrameDuration
+	}
+	streamIdx := int(p.videoStreamIndex.Load())
+	for _, stream := range p.input.FormatContext.Streams() {
+		if stream.Index() != streamIdx {
+			continue
+		}
+		if fps := streamFPS(stream); fps > 0 {
+			return time.Duration(float64(time.Second) / fps)
+		}
+	}
+	return defaultFrameDuration
+}
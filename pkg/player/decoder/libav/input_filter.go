@@ -0,0 +1,121 @@
+package libav
+
+import (
+	"context"
+	"time"
+
+	"github.com/asticode/go-astiav"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/avpipeline/avconv"
+	"github.com/xaionaro-go/avpipeline/kernel"
+	"github.com/xaionaro-go/avpipeline/packet"
+	packetcondition "github.com/xaionaro-go/avpipeline/packet/condition"
+)
+
+const (
+	InputReadAhead = time.Second
+)
+
+// inputFilter is executed by the input for every packet before sending it
+// further. It performs the requested seeks, and paces the input against
+// the playback clock (which respects the pause and the speed).
+type inputFilter struct {
+	Decoder    *Decoder
+	Input      *kernel.Input
+	activeSeek *seekRequest
+}
+
+var _ packetcondition.Condition = (*inputFilter)(nil)
+
+func newInputFilter(
+	p *Decoder,
+	input *kernel.Input,
+) *inputFilter {
+	return &inputFilter{
+		Decoder: p,
+		Input:   input,
+	}
+}
+
+func (f *inputFilter) Match(
+	ctx context.Context,
+	pkt packet.Input,
+) bool {
+	pts := pkt.GetPTS()
+	var ptsDuration time.Duration
+	if pts != astiav.NoPtsValue {
+		ptsDuration = avconv.Duration(pts, pkt.GetTimeBase())
+	}
+
+	for {
+		if req := f.Decoder.pendingSeek.Swap(nil); req != nil {
+			if err := f.seek(ctx, req); err != nil {
+				req.setFailed(err)
+				return true
+			}
+			f.activeSeek = req
+			req.ResultChan <- nil
+
+			// the packet was read before the seek
+			return false
+		}
+
+		if pts == astiav.NoPtsValue || !f.waitForClock(ctx, ptsDuration-InputReadAhead) {
+			break
+		}
+	}
+
+	if pkt.GetMediaType() == MediaTypeSubtitle {
+		f.Decoder.processSubtitlePacket(ctx, &pkt, ptsDuration)
+		return false
+	}
+
+	if f.activeSeek != nil && pts != astiav.NoPtsValue {
+		f.activeSeek.setStartPTSIfUnset(pkt.GetStreamIndex(), ptsDuration)
+	}
+	return true
+}
+
+// waitForClock waits until the playback clock reaches the position, and
+// returns true if it was interrupted by a change of the clock (for example
+// by a seek), so the caller need to re-evaluate the situation.
+func (f *inputFilter) waitForClock(
+	ctx context.Context,
+	pos time.Duration,
+) bool {
+	clock := f.Decoder.clock
+	clockChangeChan := clock.ChangeChan()
+	if !clock.IsSet() {
+		return false
+	}
+
+	// the input keeps reading ahead while paused, so that FrameStep
+	// would not starve
+	waitInterval := clock.Until(pos)
+	if waitInterval <= 0 {
+		return false
+	}
+
+	var timerChan <-chan time.Time
+	if !clock.IsPaused() {
+		logger.Tracef(ctx, "slowing down the input for %v", waitInterval)
+		timer := time.NewTimer(waitInterval)
+		defer timer.Stop()
+		timerChan = timer.C
+	}
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-f.Input.CloseChan():
+		return false
+	case <-timerChan:
+		return false
+	case <-clockChangeChan:
+		return true
+	}
+}
+
+func (f *inputFilter) String() string {
+	return "Player"
+}
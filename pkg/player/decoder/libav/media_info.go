@@ -0,0 +1,95 @@
+package libav
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/asticode/go-astiav"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/player/pkg/player/types"
+	"github.com/xaionaro-go/xsync"
+)
+
+// GetMediaInfo returns the information about the current media.
+func (p *Decoder) GetMediaInfo(
+	ctx context.Context,
+) (_ret *types.MediaInfo, _err error) {
+	logger.Tracef(ctx, "GetMediaInfo")
+	defer func() { logger.Tracef(ctx, "/GetMediaInfo: %v %v", _ret, _err) }()
+	return xsync.DoR2(ctx, &p.locker, func() (*types.MediaInfo, error) {
+		if p.isEnded() || p.input == nil {
+			return nil, fmt.Errorf("the player is not started or already ended")
+		}
+		fmtCtx := p.input.FormatContext
+
+		result := &types.MediaInfo{
+			Bitrate:     fmtCtx.BitRate(),
+			StreamCount: fmtCtx.NbStreams(),
+			Tags:        map[string]string{},
+			Chapters:    formatContextChapters(fmtCtx),
+		}
+		if inputFormat := fmtCtx.InputFormat(); inputFormat != nil {
+			result.FormatName = inputFormat.Name()
+		}
+		if metadata := fmtCtx.Metadata(); metadata != nil {
+			flags := astiav.NewDictionaryFlags(astiav.DictionaryFlagIgnoreSuffix)
+			var entry *astiav.DictionaryEntry
+			for {
+				entry = metadata.Get("", entry, flags)
+				if entry == nil {
+					break
+				}
+				result.Tags[strings.ToLower(entry.Key())] = entry.Value()
+			}
+		}
+		return result, nil
+	})
+}
+
+func (p *Decoder) GetChapters(
+	ctx context.Context,
+) (_ret types.Chapters, _err error) {
+	logger.Tracef(ctx, "GetChapters")
+	defer func() { logger.Tracef(ctx, "/GetChapters: %v %v", _ret, _err) }()
+	return xsync.DoR2(ctx, &p.locker, func() (types.Chapters, error) {
+		if p.isEnded() || p.input == nil {
+			return nil, fmt.Errorf("the player is not started or already ended")
+		}
+		return formatContextChapters(p.input.FormatContext), nil
+	})
+}
+
+func (p *Decoder) GetChapter(
+	ctx context.Context,
+) (int, error) {
+	chapters, err := p.GetChapters(ctx)
+	if err != nil {
+		return -1, err
+	}
+	if len(chapters) == 0 {
+		return -1, nil
+	}
+	pos, err := p.GetPosition(ctx)
+	if err != nil {
+		return -1, fmt.Errorf("unable to get the position: %w", err)
+	}
+	return chapters.IndexAt(pos), nil
+}
+
+// SetChapter seeks to the start of the chapter.
+func (p *Decoder) SetChapter(
+	ctx context.Context,
+	idx int,
+) (_err error) {
+	logger.Debugf(ctx, "SetChapter(ctx, %d)", idx)
+	defer func() { logger.Debugf(ctx, "/SetChapter(ctx, %d): %v", idx, _err) }()
+	chapters, err := p.GetChapters(ctx)
+	if err != nil {
+		return err
+	}
+	if idx < 0 || idx >= len(chapters) {
+		return fmt.Errorf("%w: #%d (total: %d)", types.ErrNoChapter, idx, len(chapters))
+	}
+	return p.Seek(ctx, chapters[idx].Start, false, false)
+}
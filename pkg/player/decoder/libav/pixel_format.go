@@ -20,14 +20,16 @@ func (p *Decoder) initImageFor(
 		return nil
 	}
 
-	var err error
-	p.currentImage, err = frame.Data().GuessImageFormat()
+	img, err := frame.Data().GuessImageFormat()
 	if err != nil {
 		return fmt.Errorf("unable to guess the image format: %w", err)
 	}
+	p.currentImageLocker.Do(ctx, func() {
+		p.currentImage = img
+	})
 
 	err = p.ImageRenderer.SetImage(ctx, ImageGeneric{
-		Image: p.currentImage,
+		Image: img,
 		Input: frame,
 	})
 	if err != nil {
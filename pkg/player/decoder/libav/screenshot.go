@@ -0,0 +1,29 @@
+package libav
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/draw"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/xsync"
+)
+
+// Screenshot returns a copy of the current video frame (without the subtitles).
+func (p *Decoder) Screenshot(
+	ctx context.Context,
+) (_ret image.Image, _err error) {
+	logger.Tracef(ctx, "Screenshot")
+	defer func() { logger.Tracef(ctx, "/Screenshot: %v", _err) }()
+	return xsync.DoR2(ctx, &p.currentImageLocker, func() (image.Image, error) {
+		if p.currentImage == nil {
+			// the frames are not converted to images for an AVFrameRenderer
+			return nil, fmt.Errorf("there is no decoded video frame")
+		}
+		bounds := p.currentImage.Bounds()
+		result := image.NewRGBA(bounds)
+		draw.Draw(result, bounds, p.currentImage, bounds.Min, draw.Src)
+		return result, nil
+	})
+}
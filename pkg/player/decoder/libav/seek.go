@@ -0,0 +1,130 @@
+package libav
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/asticode/go-astiav"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/avpipeline/avconv"
+	"github.com/xaionaro-go/avpipeline/frame"
+	"github.com/xaionaro-go/xsync"
+)
+
+const (
+	seekResyncTolerance = 100 * time.Millisecond
+)
+
+type seekRequest struct {
+	Position time.Duration
+	// ClockPosition is the position the playback clock is set to on the
+	// seek; it is the Position, unless the first frame after the seek should
+	// wait for a FrameStep.
+	ClockPosition time.Duration
+	IsExact       bool
+	IsBackward    bool
+	ResultChan    chan error
+
+	locker   xsync.Mutex
+	isFailed bool
+	startPTS map[int]time.Duration
+	isSynced map[int]bool
+	isDone   map[int]bool
+}
+
+func newSeekRequest(
+	pos time.Duration,
+	isExact bool,
+	isBackward bool,
+) *seekRequest {
+	return &seekRequest{
+		Position:      pos,
+		ClockPosition: pos,
+		IsExact:       isExact,
+		IsBackward:    isBackward,
+		ResultChan:    make(chan error, 1),
+		startPTS:      map[int]time.Duration{},
+		isSynced:      map[int]bool{},
+		isDone:        map[int]bool{},
+	}
+}
+
+func (req *seekRequest) Flags() astiav.SeekFlags {
+	var flags astiav.SeekFlags
+	if req.IsExact || req.IsBackward {
+		// land on the keyframe before the position, and (if exact) discard
+		// everything until the position is reached
+		flags = flags.Add(astiav.SeekFlagBackward)
+	}
+	return flags
+}
+
+func (req *seekRequest) setFailed(err error) {
+	req.locker.Do(context.Background(), func() {
+		req.isFailed = true
+	})
+	req.ResultChan <- err
+}
+
+func (req *seekRequest) setStartPTSIfUnset(
+	streamIdx int,
+	pts time.Duration,
+) {
+	req.locker.Do(context.Background(), func() {
+		if _, ok := req.startPTS[streamIdx]; ok {
+			return
+		}
+		req.startPTS[streamIdx] = pts
+	})
+}
+
+// isFrameAccepted drops the frames that were read before the seek
+// (those still in flight through the pipeline), and if the seek is exact,
+// also the frames before the requested position.
+func (req *seekRequest) isFrameAccepted(
+	ctx context.Context,
+	f frame.Input,
+) bool {
+	return xsync.DoR1(ctx, &req.locker, func() bool {
+		if req.isFailed {
+			return true
+		}
+		streamIdx := f.GetStreamIndex()
+		if req.isDone[streamIdx] {
+			return true
+		}
+		startPTS, ok := req.startPTS[streamIdx]
+		if !ok {
+			return false
+		}
+		pts := f.GetPTSAsDuration()
+		if !req.isSynced[streamIdx] {
+			if pts < startPTS-seekResyncTolerance || pts > startPTS+seekResyncTolerance {
+				logger.Tracef(ctx, "dropping a stale frame: stream:%d pts:%v (expected ~%v)", streamIdx, pts, startPTS)
+				return false
+			}
+			req.isSynced[streamIdx] = true
+		}
+		if req.IsExact && pts < req.Position {
+			logger.Tracef(ctx, "dropping a frame before the seek position: stream:%d pts:%v < %v", streamIdx, pts, req.Position)
+			return false
+		}
+		req.isDone[streamIdx] = true
+		return true
+	})
+}
+
+func (f *inputFilter) seek(
+	ctx context.Context,
+	req *seekRequest,
+) (_err error) {
+	logger.Debugf(ctx, "seek(ctx, %v, exact:%t)", req.Position, req.IsExact)
+	defer func() { logger.Debugf(ctx, "/seek(ctx, %v, exact:%t): %v", req.Position, req.IsExact, _err) }()
+
+	ts := avconv.FromDuration(req.Position, astiav.TimeBaseQ)
+	if err := f.Input.FormatContext.SeekFrame(-1, ts, req.Flags()); err != nil {
+		return fmt.Errorf("unable to seek to %v: %w", req.Position, err)
+	}
+	return nil
+}
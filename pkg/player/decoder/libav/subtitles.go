@@ -0,0 +1,169 @@
+package libav
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"math"
+	"time"
+
+	"github.com/asticode/go-astiav"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/avpipeline/avconv"
+	"github.com/xaionaro-go/avpipeline/packet"
+	"github.com/xaionaro-go/player/pkg/player/subtitles"
+	"github.com/xaionaro-go/player/pkg/player/types"
+	"github.com/xaionaro-go/xsync"
+)
+
+var _ types.SubtitlesFileLoader = (*Decoder)(nil)
+
+func subtitlesCodecFromCodecID(codecID astiav.CodecID) subtitles.Codec {
+	switch codecID {
+	case astiav.CodecIDText:
+		return subtitles.CodecText
+	case astiav.CodecIDSubrip, astiav.CodecIDSrt:
+		return subtitles.CodecSubRip
+	case astiav.CodecIDAss, astiav.CodecIDSsa:
+		return subtitles.CodecASS
+	case astiav.CodecIDWebvtt:
+		return subtitles.CodecWebVTT
+	case astiav.CodecIDMovText:
+		return subtitles.CodecMovText
+	case astiav.CodecIDHdmvPgsSubtitle:
+		return subtitles.CodecPGS
+	case astiav.CodecIDDvbSubtitle:
+		return subtitles.CodecDVB
+	default:
+		return subtitles.CodecUndefined
+	}
+}
+
+// processSubtitlePacket decodes the packets of the selected subtitles
+// stream; the subtitle packets are not sent to the libav decoder.
+func (p *Decoder) processSubtitlePacket(
+	ctx context.Context,
+	pkt *packet.Input,
+	pts time.Duration,
+) {
+	streamIndex := pkt.GetStreamIndex()
+	if p.subtitlesStreamIndex.Load() != uint32(streamIndex) {
+		return
+	}
+
+	p.subtitlesLocker.Do(ctx, func() {
+		if p.embeddedSubtitlesDecoder == nil || p.embeddedSubtitlesStreamIndex != streamIndex {
+			codecID := pkt.GetCodecParameters().CodecID()
+			codec := subtitlesCodecFromCodecID(codecID)
+			decoder, err := subtitles.NewStreamDecoder(codec)
+			if err != nil {
+				logger.Errorf(ctx, "unable to initialize a decoder of subtitles %s: %v", codecID, err)
+				return
+			}
+			p.embeddedSubtitlesDecoder = decoder
+			p.embeddedSubtitlesStreamIndex = streamIndex
+			p.embeddedSubtitles = &subtitles.Track{
+				Exclusive: codec.IsBitmap(),
+				EvictPast: true,
+			}
+		}
+
+		var duration time.Duration
+		if pkt.GetDuration() > 0 {
+			duration = avconv.Duration(pkt.GetDuration(), pkt.GetTimeBase())
+		}
+		cues, err := p.embeddedSubtitlesDecoder.Decode(pkt.Packet.Data(), pts, duration)
+		if err != nil {
+			logger.Errorf(ctx, "unable to decode a subtitles packet: %v", err)
+		}
+		for _, cue := range cues {
+			p.embeddedSubtitles.Add(cue)
+		}
+	})
+}
+
+// resetEmbeddedSubtitles forgets the decoded cues of the embedded subtitles
+// (for example, after a seek).
+func (p *Decoder) resetEmbeddedSubtitles(ctx context.Context) {
+	p.subtitlesLocker.Do(ctx, func() {
+		p.embeddedSubtitlesDecoder = nil
+		p.embeddedSubtitles = nil
+	})
+}
+
+func (p *Decoder) getSubtitlesAt(
+	ctx context.Context,
+	pos time.Duration,
+) []subtitles.Cue {
+	return xsync.DoR1(ctx, &p.subtitlesLocker, func() []subtitles.Cue {
+		var result []subtitles.Cue
+		if p.embeddedSubtitles != nil {
+			result = append(result, p.embeddedSubtitles.At(pos)...)
+		}
+		if p.externalSubtitles != nil {
+			result = append(result, p.externalSubtitles.At(pos)...)
+		}
+		return result
+	})
+}
+
+// renderSubtitles composites the subtitles active at the given position
+// onto the picture; see subtitles.Renderer.Render.
+func (p *Decoder) renderSubtitles(
+	ctx context.Context,
+	img image.Image,
+	pos time.Duration,
+) image.Image {
+	cues := p.getSubtitlesAt(ctx, pos)
+	if len(cues) == 0 {
+		return img
+	}
+	if p.subtitlesRenderer == nil {
+		r, err := subtitles.NewRenderer()
+		if err != nil {
+			logger.Errorf(ctx, "unable to initialize the subtitles renderer: %v", err)
+			return img
+		}
+		p.subtitlesRenderer = r
+	}
+	result, err := p.subtitlesRenderer.Render(img, cues)
+	if err != nil {
+		logger.Errorf(ctx, "unable to render the subtitles: %v", err)
+	}
+	return result
+}
+
+// LoadSubtitlesFile loads external subtitles (SubRip, WebVTT or ASS;
+// detected by the file extension) for the currently opened media. The
+// embedded subtitles are disabled; selecting an embedded subtitles track
+// unloads the external subtitles.
+func (p *Decoder) LoadSubtitlesFile(
+	ctx context.Context,
+	path string,
+) (_err error) {
+	logger.Debugf(ctx, "LoadSubtitlesFile(ctx, '%s')", path)
+	defer func() { logger.Debugf(ctx, "/LoadSubtitlesFile(ctx, '%s'): %v", path, _err) }()
+
+	track, err := subtitles.ParseFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to load the subtitles: %w", err)
+	}
+	return xsync.DoR1(ctx, &p.locker, func() error {
+		if p.isEnded() {
+			return fmt.Errorf("the player is not started or already ended")
+		}
+		p.subtitlesStreamIndex.Store(math.MaxUint32)
+		p.resetEmbeddedSubtitles(ctx)
+		p.subtitlesLocker.Do(ctx, func() {
+			p.externalSubtitles = track
+		})
+		p.events.Emit(ctx, types.EventTracksChange{})
+		return nil
+	})
+}
+
+func (p *Decoder) unloadExternalSubtitles(ctx context.Context) {
+	p.subtitlesLocker.Do(ctx, func() {
+		p.externalSubtitles = nil
+	})
+}
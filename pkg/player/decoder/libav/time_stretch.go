@@ -0,0 +1,263 @@
+package libav
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/xaionaro-go/audio/pkg/audio"
+)
+
+const (
+	timeStretchSegmentDuration   = 40 * time.Millisecond
+	timeStretchToleranceDuration = 8 * time.Millisecond
+	timeStretchCorrelationStride = 2
+)
+
+// timeStretchReader changes the tempo of an interleaved PCM stream
+// without changing its pitch (WSOLA: waveform similarity overlap-add).
+type timeStretchReader struct {
+	Reader   io.Reader
+	Speed    func() float64
+	Format   audio.PCMFormat
+	Channels int
+
+	curSpeed   float64
+	sampleSize int
+	hopSize    int
+	tolerance  int
+	window     []float64
+
+	readBuf      []byte
+	pendingBytes []byte
+	in           []float64
+	inPos        float64
+	prevPos      int
+	tail         []float64
+	out          []byte
+}
+
+var _ io.Reader = (*timeStretchReader)(nil)
+
+func newTimeStretchReader(
+	r io.Reader,
+	speed func() float64,
+	sampleRate audio.SampleRate,
+	channels audio.Channel,
+	pcmFormat audio.PCMFormat,
+) (*timeStretchReader, error) {
+	switch pcmFormat {
+	case audio.PCMFormatU8,
+		audio.PCMFormatS16LE,
+		audio.PCMFormatS32LE,
+		audio.PCMFormatS64LE,
+		audio.PCMFormatFloat32LE,
+		audio.PCMFormatFloat64LE:
+	default:
+		return nil, fmt.Errorf("PCM format %v is not supported", pcmFormat)
+	}
+	if channels == 0 {
+		return nil, fmt.Errorf("the amount of channels is zero")
+	}
+
+	segmentSize := int(uint64(sampleRate) * uint64(timeStretchSegmentDuration) / uint64(time.Second))
+	hopSize := segmentSize / 2
+	if hopSize < 1 {
+		return nil, fmt.Errorf("the sample rate is too low: %d", sampleRate)
+	}
+	segmentSize = hopSize * 2
+
+	window := make([]float64, segmentSize)
+	for idx := range window {
+		window[idx] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(idx)/float64(segmentSize))
+	}
+
+	return &timeStretchReader{
+		Reader:     r,
+		Speed:      speed,
+		Format:     pcmFormat,
+		Channels:   int(channels),
+		curSpeed:   1,
+		sampleSize: int(pcmFormat.Size()),
+		hopSize:    hopSize,
+		tolerance:  int(uint64(sampleRate) * uint64(timeStretchToleranceDuration) / uint64(time.Second)),
+		window:     window,
+		readBuf:    make([]byte, segmentSize*int(channels)*int(pcmFormat.Size())),
+		prevPos:    -1,
+	}, nil
+}
+
+func (r *timeStretchReader) Read(b []byte) (int, error) {
+	if speed := r.Speed(); speed != r.curSpeed {
+		r.reset(speed)
+	}
+
+	if len(r.out) == 0 && len(r.pendingBytes) == 0 && r.curSpeed == 1 {
+		return r.Reader.Read(b)
+	}
+
+	for len(r.out) == 0 {
+		if r.curSpeed == 1 {
+			// flushing the leftovers after the speed was restored to 1x
+			r.out = append(r.out, r.pendingBytes...)
+			r.pendingBytes = r.pendingBytes[:0]
+			if len(r.out) == 0 {
+				return r.Reader.Read(b)
+			}
+			break
+		}
+		if r.process() {
+			continue
+		}
+		if err := r.readMore(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(b, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+func (r *timeStretchReader) reset(speed float64) {
+	r.curSpeed = speed
+	r.in = r.in[:0]
+	r.inPos = 0
+	r.prevPos = -1
+	r.tail = r.tail[:0]
+}
+
+func (r *timeStretchReader) readMore() error {
+	n, err := r.Reader.Read(r.readBuf)
+	r.pendingBytes = append(r.pendingBytes, r.readBuf[:n]...)
+	frameSize := r.sampleSize * r.Channels
+	completeSize := len(r.pendingBytes) / frameSize * frameSize
+	if completeSize > 0 {
+		for offset := 0; offset < completeSize; offset += r.sampleSize {
+			r.in = append(r.in, decodePCMSample(r.Format, r.pendingBytes[offset:]))
+		}
+		r.pendingBytes = append(r.pendingBytes[:0], r.pendingBytes[completeSize:]...)
+	}
+	if n > 0 {
+		return nil
+	}
+	return err
+}
+
+func (r *timeStretchReader) framesAvailable() int {
+	return len(r.in) / r.Channels
+}
+
+// process produces the next hopSize frames of the output if there is
+// enough input for that.
+func (r *timeStretchReader) process() bool {
+	ch := r.Channels
+	segmentSize := len(r.window)
+	nominalPos := int(r.inPos)
+
+	chosenPos := nominalPos
+	if r.prevPos >= 0 {
+		naturalPos := r.prevPos + r.hopSize
+		if r.framesAvailable() < max(nominalPos+r.tolerance+segmentSize, naturalPos+r.hopSize) {
+			return false
+		}
+		chosenPos = r.findBestMatch(nominalPos, naturalPos)
+	} else {
+		if r.framesAvailable() < nominalPos+segmentSize {
+			return false
+		}
+		r.tail = append(r.tail[:0], make([]float64, r.hopSize*ch)...)
+	}
+
+	for idx := 0; idx < r.hopSize; idx++ {
+		w := r.window[idx]
+		for c := 0; c < ch; c++ {
+			v := r.tail[idx*ch+c] + r.in[(chosenPos+idx)*ch+c]*w
+			r.out = appendPCMSample(r.out, r.Format, v)
+		}
+	}
+	for idx := 0; idx < r.hopSize; idx++ {
+		w := r.window[r.hopSize+idx]
+		for c := 0; c < ch; c++ {
+			r.tail[idx*ch+c] = r.in[(chosenPos+r.hopSize+idx)*ch+c] * w
+		}
+	}
+
+	r.prevPos = chosenPos
+	r.inPos += float64(r.hopSize) * r.curSpeed
+
+	drop := min(int(r.inPos)-r.tolerance, r.prevPos+r.hopSize)
+	if drop > 0 {
+		r.in = append(r.in[:0], r.in[drop*ch:]...)
+		r.inPos -= float64(drop)
+		r.prevPos -= drop
+	}
+	return true
+}
+
+func (r *timeStretchReader) findBestMatch(
+	nominalPos int,
+	naturalPos int,
+) int {
+	ch := r.Channels
+	bestPos := nominalPos
+	bestCorrelation := math.Inf(-1)
+	for candidatePos := max(nominalPos-r.tolerance, 0); candidatePos <= nominalPos+r.tolerance; candidatePos++ {
+		var correlation float64
+		for idx := 0; idx < r.hopSize; idx += timeStretchCorrelationStride {
+			a := r.in[(candidatePos+idx)*ch:]
+			b := r.in[(naturalPos+idx)*ch:]
+			for c := 0; c < ch; c++ {
+				correlation += a[c] * b[c]
+			}
+		}
+		if correlation > bestCorrelation {
+			bestCorrelation = correlation
+			bestPos = candidatePos
+		}
+	}
+	return bestPos
+}
+
+func decodePCMSample(format audio.PCMFormat, b []byte) float64 {
+	switch format {
+	case audio.PCMFormatU8:
+		return (float64(b[0]) - 128) / 128
+	case audio.PCMFormatS16LE:
+		return float64(int16(binary.LittleEndian.Uint16(b))) / math.MaxInt16
+	case audio.PCMFormatS32LE:
+		return float64(int32(binary.LittleEndian.Uint32(b))) / math.MaxInt32
+	case audio.PCMFormatS64LE:
+		return float64(int64(binary.LittleEndian.Uint64(b))) / math.MaxInt64
+	case audio.PCMFormatFloat32LE:
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(b)))
+	case audio.PCMFormatFloat64LE:
+		return math.Float64frombits(binary.LittleEndian.Uint64(b))
+	}
+	return 0
+}
+
+func appendPCMSample(out []byte, format audio.PCMFormat, v float64) []byte {
+	switch format {
+	case audio.PCMFormatU8:
+		return append(out, uint8(clamp(v*128+128, 0, math.MaxUint8)))
+	case audio.PCMFormatS16LE:
+		return binary.LittleEndian.AppendUint16(out, uint16(int16(clamp(v*math.MaxInt16, math.MinInt16, math.MaxInt16))))
+	case audio.PCMFormatS32LE:
+		return binary.LittleEndian.AppendUint32(out, uint32(int32(clamp(v*math.MaxInt32, math.MinInt32, math.MaxInt32))))
+	case audio.PCMFormatS64LE:
+		// math.MaxInt64 is not representable as float64, so using the closest value below it
+		return binary.LittleEndian.AppendUint64(out, uint64(int64(clamp(v, -1, 1)*(math.MaxInt64-1023))))
+	case audio.PCMFormatFloat32LE:
+		return binary.LittleEndian.AppendUint32(out, math.Float32bits(float32(v)))
+	case audio.PCMFormatFloat64LE:
+		return binary.LittleEndian.AppendUint64(out, math.Float64bits(v))
+	}
+	return out
+}
+
+func clamp(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, v))
+}
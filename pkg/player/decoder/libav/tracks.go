@@ -0,0 +1,223 @@
+package libav
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync/atomic"
+
+	"github.com/asticode/go-astiav"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/player/pkg/player/types"
+	"github.com/xaionaro-go/xsync"
+)
+
+func (p *Decoder) getStreams(
+	mediaType astiav.MediaType,
+) ([]*astiav.Stream, error) {
+	if p.isEnded() || p.input == nil {
+		return nil, fmt.Errorf("the player is not started or already ended")
+	}
+	var result []*astiav.Stream
+	for _, stream := range p.input.FormatContext.Streams() {
+		if stream.CodecParameters().MediaType() != mediaType {
+			continue
+		}
+		result = append(result, stream)
+	}
+	return result, nil
+}
+
+func isActiveStream(
+	selectedStreamIdx *atomic.Uint32,
+	stream *astiav.Stream,
+) bool {
+	return selectedStreamIdx.Load() == uint32(stream.Index())
+}
+
+func streamTrackInfo(stream *astiav.Stream) types.TrackInfo {
+	getMetadata := func(key string) string {
+		metadata := stream.Metadata()
+		if metadata == nil {
+			return ""
+		}
+		entry := metadata.Get(key, nil, 0)
+		if entry == nil {
+			return ""
+		}
+		return entry.Value()
+	}
+	disposition := stream.DispositionFlags()
+	return types.TrackInfo{
+		Codec:     stream.CodecParameters().CodecID().Name(),
+		Language:  getMetadata("language"),
+		Title:     getMetadata("title"),
+		IsDefault: disposition.Has(astiav.DispositionFlagDefault),
+		IsForced:  disposition.Has(astiav.DispositionFlagForced),
+	}
+}
+
+func streamFPS(stream *astiav.Stream) float64 {
+	if r := stream.AvgFrameRate(); r.Num() > 0 && r.Den() > 0 {
+		return r.Float64()
+	}
+	if r := stream.RFrameRate(); r.Num() > 0 && r.Den() > 0 {
+		return r.Float64()
+	}
+	return 0
+}
+
+func (p *Decoder) GetVideoTracks(
+	ctx context.Context,
+) (_ret types.VideoTracks, _err error) {
+	logger.Tracef(ctx, "GetVideoTracks")
+	defer func() { logger.Tracef(ctx, "/GetVideoTracks: %v %v", _ret, _err) }()
+	return xsync.DoR2(ctx, &p.locker, func() (types.VideoTracks, error) {
+		streams, err := p.getStreams(MediaTypeVideo)
+		if err != nil {
+			return nil, err
+		}
+		var result types.VideoTracks
+		for _, stream := range streams {
+			codecParams := stream.CodecParameters()
+			result = append(result, types.VideoTrack{
+				ID:        int64(stream.Index()),
+				IsActive:  isActiveStream(&p.videoStreamIndex, stream),
+				TrackInfo: streamTrackInfo(stream),
+				Width:     codecParams.Width(),
+				Height:    codecParams.Height(),
+				FPS:       streamFPS(stream),
+			})
+		}
+		return result, nil
+	})
+}
+
+func (p *Decoder) GetAudioTracks(
+	ctx context.Context,
+) (_ret types.AudioTracks, _err error) {
+	logger.Tracef(ctx, "GetAudioTracks")
+	defer func() { logger.Tracef(ctx, "/GetAudioTracks: %v %v", _ret, _err) }()
+	return xsync.DoR2(ctx, &p.locker, func() (types.AudioTracks, error) {
+		streams, err := p.getStreams(MediaTypeAudio)
+		if err != nil {
+			return nil, err
+		}
+		var result types.AudioTracks
+		for _, stream := range streams {
+			codecParams := stream.CodecParameters()
+			channelLayout := codecParams.ChannelLayout()
+			result = append(result, types.AudioTrack{
+				ID:            int64(stream.Index()),
+				IsActive:      isActiveStream(&p.audioStreamIndex, stream),
+				TrackInfo:     streamTrackInfo(stream),
+				SampleRate:    codecParams.SampleRate(),
+				Channels:      channelLayout.Channels(),
+				ChannelLayout: channelLayout.String(),
+			})
+		}
+		return result, nil
+	})
+}
+
+func (p *Decoder) GetSubtitlesTracks(
+	ctx context.Context,
+) (_ret types.SubtitlesTracks, _err error) {
+	logger.Tracef(ctx, "GetSubtitlesTracks")
+	defer func() { logger.Tracef(ctx, "/GetSubtitlesTracks: %v %v", _ret, _err) }()
+	return xsync.DoR2(ctx, &p.locker, func() (types.SubtitlesTracks, error) {
+		streams, err := p.getStreams(MediaTypeSubtitle)
+		if err != nil {
+			return nil, err
+		}
+		var result types.SubtitlesTracks
+		for _, stream := range streams {
+			result = append(result, types.SubtitlesTrack{
+				ID:        int64(stream.Index()),
+				IsActive:  isActiveStream(&p.subtitlesStreamIndex, stream),
+				TrackInfo: streamTrackInfo(stream),
+			})
+		}
+		return result, nil
+	})
+}
+
+func (p *Decoder) checkStreamID(
+	mediaType astiav.MediaType,
+	id int64,
+) error {
+	streams, err := p.getStreams(mediaType)
+	if err != nil {
+		return err
+	}
+	for _, stream := range streams {
+		if int64(stream.Index()) == id {
+			return nil
+		}
+	}
+	return fmt.Errorf("there is no %s stream with ID %d", mediaType, id)
+}
+
+func (p *Decoder) SetVideoTrack(
+	ctx context.Context,
+	vid int64,
+) (_err error) {
+	logger.Debugf(ctx, "SetVideoTrack(ctx, %d)", vid)
+	defer func() { logger.Debugf(ctx, "/SetVideoTrack(ctx, %d): %v", vid, _err) }()
+	return xsync.DoR1(ctx, &p.locker, func() error {
+		if err := p.checkStreamID(MediaTypeVideo, vid); err != nil {
+			return err
+		}
+		if p.videoStreamIndex.Swap(uint32(vid)) == uint32(vid) {
+			return nil
+		}
+		p.isVideoInitialized = false
+		p.flushVideoQueue()
+		p.events.Emit(ctx, types.EventTracksChange{})
+		return nil
+	})
+}
+
+func (p *Decoder) SetAudioTrack(
+	ctx context.Context,
+	aid int64,
+) (_err error) {
+	logger.Debugf(ctx, "SetAudioTrack(ctx, %d)", aid)
+	defer func() { logger.Debugf(ctx, "/SetAudioTrack(ctx, %d): %v", aid, _err) }()
+	return xsync.DoR1(ctx, &p.locker, func() error {
+		if err := p.checkStreamID(MediaTypeAudio, aid); err != nil {
+			return err
+		}
+		if p.audioStreamIndex.Swap(uint32(aid)) == uint32(aid) {
+			return nil
+		}
+		// the audio playback is re-initialized with the parameters of the new stream
+		// on the next frame
+		p.resetAudio()
+		p.events.Emit(ctx, types.EventTracksChange{})
+		return nil
+	})
+}
+
+func (p *Decoder) SetSubtitlesTrack(
+	ctx context.Context,
+	sid int64,
+) (_err error) {
+	logger.Debugf(ctx, "SetSubtitlesTrack(ctx, %d)", sid)
+	defer func() { logger.Debugf(ctx, "/SetSubtitlesTrack(ctx, %d): %v", sid, _err) }()
+	return xsync.DoR1(ctx, &p.locker, func() error {
+		newIndex := uint32(math.MaxUint32)
+		if sid >= 0 {
+			if err := p.checkStreamID(MediaTypeSubtitle, sid); err != nil {
+				return err
+			}
+			newIndex = uint32(sid)
+		}
+		p.unloadExternalSubtitles(ctx)
+		if p.subtitlesStreamIndex.Swap(newIndex) != newIndex {
+			p.resetEmbeddedSubtitles(ctx)
+			p.events.Emit(ctx, types.EventTracksChange{})
+		}
+		return nil
+	})
+}
@@ -0,0 +1,69 @@
+package libav
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/audio/pkg/audio"
+)
+
+func (p *Decoder) GetVolume(
+	ctx context.Context,
+) (float64, error) {
+	return math.Float64frombits(p.volume.Load()), nil
+}
+
+func (p *Decoder) SetVolume(
+	ctx context.Context,
+	volume float64,
+) (_err error) {
+	logger.Debugf(ctx, "SetVolume(ctx, %v)", volume)
+	defer func() { logger.Debugf(ctx, "/SetVolume(ctx, %v): %v", volume, _err) }()
+	if volume < 0 || math.IsNaN(volume) || math.IsInf(volume, 0) {
+		return fmt.Errorf("invalid volume: %v", volume)
+	}
+	p.volume.Store(math.Float64bits(volume))
+	return nil
+}
+
+func (p *Decoder) GetMute(
+	ctx context.Context,
+) (bool, error) {
+	return p.isMuted.Load(), nil
+}
+
+func (p *Decoder) SetMute(
+	ctx context.Context,
+	mute bool,
+) (_err error) {
+	logger.Debugf(ctx, "SetMute(ctx, %t)", mute)
+	defer func() { logger.Debugf(ctx, "/SetMute(ctx, %t): %v", mute, _err) }()
+	p.isMuted.Store(mute)
+	return nil
+}
+
+func (p *Decoder) getGain() float64 {
+	if p.isMuted.Load() {
+		return 0
+	}
+	return math.Float64frombits(p.volume.Load())
+}
+
+// applyGain scales the PCM samples in place; the format of a planar
+// layout is the same as of an interleaved one, so it works for both.
+func applyGain(
+	b []byte,
+	pcmFormat audio.PCMFormat,
+	gain float64,
+) {
+	sampleSize := int(pcmFormat.Size())
+	if sampleSize == 0 {
+		return
+	}
+	for offset := 0; offset+sampleSize <= len(b); offset += sampleSize {
+		v := decodePCMSample(pcmFormat, b[offset:])
+		appendPCMSample(b[offset:offset], pcmFormat, v*gain)
+	}
+}
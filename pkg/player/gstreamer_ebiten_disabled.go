@@ -6,6 +6,7 @@ package player
 import (
 	"context"
 	"fmt"
+	"image"
 	"time"
 
 	"github.com/xaionaro-go/player/pkg/player/types"
@@ -112,6 +113,32 @@ func (*GStreamerEbiten) SetPause(
 	panic("compiled without GStreamerEbiten support")
 }
 
+func (*GStreamerEbiten) GetVolume(
+	ctx context.Context,
+) (float64, error) {
+	panic("compiled without GStreamerEbiten support")
+}
+
+func (*GStreamerEbiten) SetVolume(
+	ctx context.Context,
+	volume float64,
+) error {
+	panic("compiled without GStreamerEbiten support")
+}
+
+func (*GStreamerEbiten) GetMute(
+	ctx context.Context,
+) (bool, error) {
+	panic("compiled without GStreamerEbiten support")
+}
+
+func (*GStreamerEbiten) SetMute(
+	ctx context.Context,
+	mute bool,
+) error {
+	panic("compiled without GStreamerEbiten support")
+}
+
 func (*GStreamerEbiten) Seek(
 	ctx context.Context,
 	pos time.Duration,
@@ -121,6 +148,19 @@ func (*GStreamerEbiten) Seek(
 	return fmt.Errorf("not implemented, yet")
 }
 
+func (*GStreamerEbiten) FrameStep(
+	ctx context.Context,
+	forward bool,
+) error {
+	panic("compiled without GStreamerEbiten support")
+}
+
+func (*GStreamerEbiten) Screenshot(
+	ctx context.Context,
+) (image.Image, error) {
+	panic("compiled without GStreamerEbiten support")
+}
+
 func (*GStreamerEbiten) GetVideoTracks(
 	ctx context.Context,
 ) (types.VideoTracks, error) {
@@ -160,6 +200,31 @@ func (*GStreamerEbiten) SetSubtitlesTrack(
 	panic("compiled without GStreamerEbiten support")
 }
 
+func (*GStreamerEbiten) GetMediaInfo(
+	ctx context.Context,
+) (*types.MediaInfo, error) {
+	panic("compiled without GStreamerEbiten support")
+}
+
+func (*GStreamerEbiten) GetChapters(
+	ctx context.Context,
+) (types.Chapters, error) {
+	panic("compiled without GStreamerEbiten support")
+}
+
+func (*GStreamerEbiten) GetChapter(
+	ctx context.Context,
+) (int, error) {
+	panic("compiled without GStreamerEbiten support")
+}
+
+func (*GStreamerEbiten) SetChapter(
+	ctx context.Context,
+	idx int,
+) error {
+	panic("compiled without GStreamerEbiten support")
+}
+
 func (*GStreamerEbiten) Stop(
 	ctx context.Context,
 ) error {
@@ -169,3 +234,9 @@ func (*GStreamerEbiten) Stop(
 func (*GStreamerEbiten) Close(ctx context.Context) error {
 	panic("compiled without GStreamerEbiten support")
 }
+
+func (*GStreamerEbiten) Events(
+	ctx context.Context,
+) (<-chan types.Event, error) {
+	panic("compiled without GStreamerEbiten support")
+}
@@ -0,0 +1,66 @@
+//go:build with_gstreamer && with_fyne
+// +build with_gstreamer,with_fyne
+
+package player
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/xaionaro-go/audio/pkg/audio"
+	"github.com/xaionaro-go/player/pkg/player/audiorenderer"
+	"github.com/xaionaro-go/player/pkg/player/decoder/gstreamer"
+	"github.com/xaionaro-go/player/pkg/player/imagerenderer/fyne"
+	"github.com/xaionaro-go/player/pkg/player/types"
+)
+
+const SupportedGStreamerFyne = true
+
+type GStreamerFyne struct {
+	*gstreamer.Decoder
+	*fyne.Window
+	audiorenderer.AudioRenderer
+}
+
+func NewGStreamerFyne(
+	ctx context.Context,
+	title string,
+	opts ...types.Option,
+) (*GStreamerFyne, error) {
+	videoRenderer := fyne.NewWindow(ctx, title, opts...)
+	audioRenderer := audio.NewPlayerAuto(ctx)
+	decoder, err := gstreamer.New(ctx, videoRenderer, audioRenderer)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create a gstreamer decoder: %w", err)
+	}
+	return &GStreamerFyne{
+		Decoder:       decoder,
+		Window:        videoRenderer,
+		AudioRenderer: audioRenderer,
+	}, nil
+}
+
+func (*Manager) NewGStreamerFyne(
+	ctx context.Context,
+	title string,
+	opts ...types.Option,
+) (*GStreamerFyne, error) {
+	return NewGStreamerFyne(ctx, title, opts...)
+}
+
+func (p *GStreamerFyne) Close(
+	ctx context.Context,
+) error {
+	var errs []error
+	if err := p.Decoder.Close(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("unable to close decoder: %w", err))
+	}
+	if err := p.AudioRenderer.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("unable to close audio renderer: %w", err))
+	}
+	if err := p.Window.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("unable to close window: %w", err))
+	}
+	return errors.Join(errs...)
+}
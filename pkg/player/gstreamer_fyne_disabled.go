@@ -0,0 +1,242 @@
+//go:build !with_gstreamer || !with_fyne
+// +build !with_gstreamer !with_fyne
+
+package player
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"time"
+
+	"github.com/xaionaro-go/player/pkg/player/types"
+)
+
+const SupportedGStreamerFyne = false
+
+type GStreamerFyne struct{}
+
+func NewGStreamerFyne(
+	ctx context.Context,
+	title string,
+	opts ...types.Option,
+) (*GStreamerFyne, error) {
+	return nil, fmt.Errorf("compiled without GStreamerFyne")
+}
+
+func (*Manager) NewGStreamerFyne(
+	ctx context.Context,
+	title string,
+	opts ...types.Option,
+) (*GStreamerFyne, error) {
+	return NewGStreamerFyne(ctx, title, opts...)
+}
+
+func (*GStreamerFyne) SetupForStreaming(
+	ctx context.Context,
+) error {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) OpenURL(
+	ctx context.Context,
+	link string,
+) error {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) EndChan(
+	ctx context.Context,
+) (<-chan struct{}, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) IsEnded(
+	ctx context.Context,
+) (bool, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (p *GStreamerFyne) GetPosition(
+	ctx context.Context,
+) (time.Duration, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (p *GStreamerFyne) GetAudioPosition(
+	ctx context.Context,
+) (time.Duration, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (p *GStreamerFyne) GetLength(
+	ctx context.Context,
+) (time.Duration, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (p *GStreamerFyne) ProcessTitle(
+	ctx context.Context,
+) (string, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (p *GStreamerFyne) GetLink(
+	ctx context.Context,
+) (string, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) GetSpeed(
+	ctx context.Context,
+) (float64, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) SetSpeed(
+	ctx context.Context,
+	speed float64,
+) error {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) GetPause(
+	ctx context.Context,
+) (bool, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) SetPause(
+	ctx context.Context,
+	pause bool,
+) error {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) GetVolume(
+	ctx context.Context,
+) (float64, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) SetVolume(
+	ctx context.Context,
+	volume float64,
+) error {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) GetMute(
+	ctx context.Context,
+) (bool, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) SetMute(
+	ctx context.Context,
+	mute bool,
+) error {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) Seek(
+	ctx context.Context,
+	pos time.Duration,
+	isRelative bool,
+	quick bool,
+) error {
+	return fmt.Errorf("not implemented, yet")
+}
+
+func (*GStreamerFyne) FrameStep(
+	ctx context.Context,
+	forward bool,
+) error {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) Screenshot(
+	ctx context.Context,
+) (image.Image, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) GetVideoTracks(
+	ctx context.Context,
+) (types.VideoTracks, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) GetAudioTracks(
+	ctx context.Context,
+) (types.AudioTracks, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) GetSubtitlesTracks(
+	ctx context.Context,
+) (types.SubtitlesTracks, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) SetVideoTrack(
+	ctx context.Context,
+	vid int64,
+) error {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) SetAudioTrack(
+	ctx context.Context,
+	aid int64,
+) error {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) SetSubtitlesTrack(
+	ctx context.Context,
+	sid int64,
+) error {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) GetMediaInfo(
+	ctx context.Context,
+) (*types.MediaInfo, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) GetChapters(
+	ctx context.Context,
+) (types.Chapters, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) GetChapter(
+	ctx context.Context,
+) (int, error) {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) SetChapter(
+	ctx context.Context,
+	idx int,
+) error {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) Stop(
+	ctx context.Context,
+) error {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) Close(ctx context.Context) error {
+	panic("compiled without GStreamerFyne support")
+}
+
+func (*GStreamerFyne) Events(
+	ctx context.Context,
+) (<-chan types.Event, error) {
+	panic("compiled without GStreamerFyne support")
+}
@@ -6,6 +6,7 @@ package player
 import (
 	"context"
 	"fmt"
+	"image"
 	"time"
 
 	"github.com/xaionaro-go/player/pkg/player/types"
@@ -112,6 +113,32 @@ func (*LibVLC) SetPause(
 	panic("compiled without LibVLC support")
 }
 
+func (*LibVLC) GetVolume(
+	ctx context.Context,
+) (float64, error) {
+	panic("compiled without LibVLC support")
+}
+
+func (*LibVLC) SetVolume(
+	ctx context.Context,
+	volume float64,
+) error {
+	panic("compiled without LibVLC support")
+}
+
+func (*LibVLC) GetMute(
+	ctx context.Context,
+) (bool, error) {
+	panic("compiled without LibVLC support")
+}
+
+func (*LibVLC) SetMute(
+	ctx context.Context,
+	mute bool,
+) error {
+	panic("compiled without LibVLC support")
+}
+
 func (*LibVLC) Seek(
 	ctx context.Context,
 	pos time.Duration,
@@ -121,6 +148,19 @@ func (*LibVLC) Seek(
 	return fmt.Errorf("not implemented, yet")
 }
 
+func (*LibVLC) FrameStep(
+	ctx context.Context,
+	forward bool,
+) error {
+	panic("compiled without LibVLC support")
+}
+
+func (*LibVLC) Screenshot(
+	ctx context.Context,
+) (image.Image, error) {
+	panic("compiled without LibVLC support")
+}
+
 func (*LibVLC) GetVideoTracks(
 	ctx context.Context,
 ) (types.VideoTracks, error) {
@@ -160,6 +200,31 @@ func (*LibVLC) SetSubtitlesTrack(
 	panic("compiled without LibVLC support")
 }
 
+func (*LibVLC) GetMediaInfo(
+	ctx context.Context,
+) (*types.MediaInfo, error) {
+	panic("compiled without LibVLC support")
+}
+
+func (*LibVLC) GetChapters(
+	ctx context.Context,
+) (types.Chapters, error) {
+	panic("compiled without LibVLC support")
+}
+
+func (*LibVLC) GetChapter(
+	ctx context.Context,
+) (int, error) {
+	panic("compiled without LibVLC support")
+}
+
+func (*LibVLC) SetChapter(
+	ctx context.Context,
+	idx int,
+) error {
+	panic("compiled without LibVLC support")
+}
+
 func (*LibVLC) Stop(
 	ctx context.Context,
 ) error {
@@ -169,3 +234,9 @@ func (*LibVLC) Stop(
 func (*LibVLC) Close(ctx context.Context) error {
 	panic("compiled without LibVLC support")
 }
+
+func (*LibVLC) Events(
+	ctx context.Context,
+) (<-chan types.Event, error) {
+	panic("compiled without LibVLC support")
+}
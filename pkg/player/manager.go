@@ -27,6 +27,9 @@ func SupportedBackends() []Backend {
 	if SupportedGStreamerEbiten {
 		result = append(result, BackendGStreamerEbiten)
 	}
+	if SupportedGStreamerFyne {
+		result = append(result, BackendGStreamerFyne)
+	}
 	if SupportedLibVLC {
 		result = append(result, BackendLibVLC)
 	}
@@ -62,7 +65,7 @@ func (m *Manager) NewPlayer(
 	case BackendLibVLC:
 		return m.NewLibVLC(ctx, title, opts...)
 	case BackendGStreamerFyne:
-		return nil, fmt.Errorf("not implemented")
+		return m.NewGStreamerFyne(ctx, title, opts...)
 	case BackendGStreamerEbiten:
 		return m.NewGStreamerEbiten(ctx, title, opts...)
 	case BackendMPV:
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"image"
+	"image/png"
+	"math"
 	"os"
 	"os/exec"
 	"path"
@@ -50,7 +53,11 @@ type MPV struct {
 	EndChMutex       xsync.Mutex
 	EndCh            chan struct{}
 
+	NextLinkMutex   xsync.Mutex
 	OpenLinkOnRerun string
+	NextLink        string
+
+	events types.EventBroadcaster
 }
 
 var _ Player = (*MPV)(nil)
@@ -155,7 +162,10 @@ func (p *MPV) execMPV(
 	args := []string{
 		p.PathToMPV,
 		"--idle",
-		"--keep-open=always",
+		// "yes" (instead of "always") lets a preloaded next file to start
+		// (see PreloadNextURL), otherwise the player is kept on the last frame
+		"--keep-open=yes",
+		"--prefetch-playlist=yes",
 		"--keep-open-pause=no",
 		"--no-hidpi-window-scale",
 		"--no-osc",
@@ -272,12 +282,17 @@ func (p *MPV) execMPV(
 	p.SocketPath = socketPath
 	p.Cmd = cmd
 	p.MPVConn = mpvConn
+	observability.Go(ctx, func(ctx context.Context) {
+		p.eventLoop(ctx, mpvConn)
+	})
 
 	if restartMPV {
 		observability.Go(ctx, func(ctx context.Context) {
 			err := p.Cmd.Wait()
 			logger.Debugf(ctx, "player was closed: %v", err)
-			link := p.OpenLinkOnRerun
+			link := xsync.DoR1(ctx, &p.NextLinkMutex, func() string {
+				return p.OpenLinkOnRerun
+			})
 			if link == "" {
 				logger.Debugf(ctx, "not going to open any links")
 			} else {
@@ -339,11 +354,40 @@ func (p *MPV) OpenURL(
 	link string,
 ) error {
 	logger.Debugf(ctx, "OpenURL(ctx, '%s')", link)
-	p.OpenLinkOnRerun = link
+	p.NextLinkMutex.Do(ctx, func() {
+		p.OpenLinkOnRerun = link
+		// "replace" drops the preloaded file as well
+		p.NextLink = ""
+	})
 	_, err := p.mpvCall(ctx, "loadfile", link, "replace")
 	return err
 }
 
+// PreloadNextURL appends the link to the playlist of mpv, so that it is
+// prefetched and played right after the current file.
+func (p *MPV) PreloadNextURL(
+	ctx context.Context,
+	link string,
+) (_err error) {
+	logger.Debugf(ctx, "PreloadNextURL(ctx, '%s')", link)
+	defer func() { logger.Debugf(ctx, "/PreloadNextURL(ctx, '%s'): %v", link, _err) }()
+	return xsync.DoR1(ctx, &p.NextLinkMutex, func() error {
+		// removes everything from the playlist except the current file
+		if _, err := p.mpvCall(ctx, "playlist-clear"); err != nil {
+			return fmt.Errorf("unable to clear the playlist: %w", err)
+		}
+		p.NextLink = ""
+		if link == "" {
+			return nil
+		}
+		if _, err := p.mpvCall(ctx, "loadfile", link, "append"); err != nil {
+			return fmt.Errorf("unable to append '%s' to the playlist: %w", link, err)
+		}
+		p.NextLink = link
+		return nil
+	})
+}
+
 func (p *MPV) getString(
 	ctx context.Context,
 	key string,
@@ -600,6 +644,57 @@ func (p *MPV) Seek(
 	return nil
 }
 
+// FrameStep shows the next (or the previous) video frame and pauses,
+// see https://mpv.io/manual/stable/#command-interface-frame-step
+func (p *MPV) FrameStep(
+	ctx context.Context,
+	forward bool,
+) error {
+	cmd := "frame-step"
+	if !forward {
+		cmd = "frame-back-step"
+	}
+	if _, err := p.mpvCall(ctx, cmd); err != nil {
+		return fmt.Errorf("unable to request '%s': %w", cmd, err)
+	}
+	return nil
+}
+
+// Screenshot returns the current video frame (without the subtitles).
+//
+// "screenshot-raw" returns a byte array, which could not be transferred
+// over the JSON IPC, so the frame is passed through a temporary file.
+func (p *MPV) Screenshot(
+	ctx context.Context,
+) (_ret image.Image, _err error) {
+	logger.Debugf(ctx, "Screenshot")
+	defer func() { logger.Debugf(ctx, "/Screenshot: %v", _err) }()
+
+	f, err := os.CreateTemp("", "mpv-screenshot-*.png")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create a temporary file: %w", err)
+	}
+	filePath := f.Name()
+	f.Close()
+	defer os.Remove(filePath)
+
+	// see https://mpv.io/manual/stable/#command-interface-screenshot-to-file
+	if _, err := p.mpvCall(ctx, "screenshot-to-file", filePath, "video"); err != nil {
+		return nil, fmt.Errorf("unable to request 'screenshot-to-file': %w", err)
+	}
+
+	f, err = os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open '%s': %w", filePath, err)
+	}
+	defer f.Close()
+	img, err := png.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the screenshot: %w", err)
+	}
+	return img, nil
+}
+
 func (p *MPV) SetSpeed(
 	ctx context.Context,
 	speed float64,
@@ -626,6 +721,40 @@ func (p *MPV) SetPause(
 	return p.mpvSet(ctx, "pause", pause)
 }
 
+// GetVolume returns the volume, see types.Player (MPV uses percents).
+func (p *MPV) GetVolume(
+	ctx context.Context,
+) (float64, error) {
+	volume, err := p.getFloat64(ctx, "volume")
+	if err != nil {
+		return 0, err
+	}
+	return volume / 100, nil
+}
+
+func (p *MPV) SetVolume(
+	ctx context.Context,
+	volume float64,
+) error {
+	if volume < 0 || math.IsNaN(volume) {
+		return fmt.Errorf("invalid volume: %v", volume)
+	}
+	return p.mpvSet(ctx, "volume", volume*100)
+}
+
+func (p *MPV) GetMute(
+	ctx context.Context,
+) (bool, error) {
+	return p.getBool(ctx, "mute")
+}
+
+func (p *MPV) SetMute(
+	ctx context.Context,
+	mute bool,
+) error {
+	return p.mpvSet(ctx, "mute", mute)
+}
+
 func (p *MPV) Stop(
 	ctx context.Context,
 ) error {
@@ -657,11 +786,25 @@ func (p *MPV) SetDisplayScale(ctx context.Context, scale float64) error {
 	return p.mpvSet(ctx, "window-scale", scale)
 }
 
+// mpvTrack is an item of the 'track-list' property, see
+// https://mpv.io/manual/stable/#command-interface-track-list
+type mpvTrack struct {
+	ID            int64
+	IsSelected    bool
+	Info          types.TrackInfo
+	Width         int
+	Height        int
+	FPS           float64
+	SampleRate    int
+	Channels      int
+	ChannelLayout string
+}
+
 func getTracks[E any, T []E](
 	ctx context.Context,
 	p *MPV,
 	trackType string,
-	fn func(trackID int64, isActive bool) E,
+	fn func(track mpvTrack) E,
 ) (T, error) {
 	resp, err := p.mpvGet(ctx, "track-list")
 	if err != nil {
@@ -711,19 +854,45 @@ func getTracks[E any, T []E](
 			return nil, fmt.Errorf("item #%d has field 'selected' of an unexpected type: %T", idx, selectedI)
 		}
 
-		result = append(result, fn(int64(trackID), selected))
+		// the rest of the fields are optional
+		result = append(result, fn(mpvTrack{
+			ID:         int64(trackID),
+			IsSelected: selected,
+			Info: types.TrackInfo{
+				Codec:     optValue[string](m, "codec"),
+				Language:  optValue[string](m, "lang"),
+				Title:     optValue[string](m, "title"),
+				IsDefault: optValue[bool](m, "default"),
+				IsForced:  optValue[bool](m, "forced"),
+			},
+			Width:         int(optValue[float64](m, "demux-w")),
+			Height:        int(optValue[float64](m, "demux-h")),
+			FPS:           optValue[float64](m, "demux-fps"),
+			SampleRate:    int(optValue[float64](m, "demux-samplerate")),
+			Channels:      int(optValue[float64](m, "demux-channel-count")),
+			ChannelLayout: optValue[string](m, "demux-channels"),
+		}))
 	}
 
 	return result, nil
 }
 
+func optValue[T any](m map[string]any, key string) T {
+	v, _ := m[key].(T)
+	return v
+}
+
 func (p *MPV) GetVideoTracks(
 	ctx context.Context,
 ) (types.VideoTracks, error) {
-	return getTracks(ctx, p, "video", func(trackID int64, isActive bool) types.VideoTrack {
+	return getTracks(ctx, p, "video", func(track mpvTrack) types.VideoTrack {
 		return types.VideoTrack{
-			ID:       trackID,
-			IsActive: isActive,
+			ID:        track.ID,
+			IsActive:  track.IsSelected,
+			TrackInfo: track.Info,
+			Width:     track.Width,
+			Height:    track.Height,
+			FPS:       track.FPS,
 		}
 	})
 }
@@ -731,10 +900,14 @@ func (p *MPV) GetVideoTracks(
 func (p *MPV) GetAudioTracks(
 	ctx context.Context,
 ) (types.AudioTracks, error) {
-	return getTracks(ctx, p, "audio", func(trackID int64, isActive bool) types.AudioTrack {
+	return getTracks(ctx, p, "audio", func(track mpvTrack) types.AudioTrack {
 		return types.AudioTrack{
-			ID:       trackID,
-			IsActive: isActive,
+			ID:            track.ID,
+			IsActive:      track.IsSelected,
+			TrackInfo:     track.Info,
+			SampleRate:    track.SampleRate,
+			Channels:      track.Channels,
+			ChannelLayout: track.ChannelLayout,
 		}
 	})
 }
@@ -742,10 +915,11 @@ func (p *MPV) GetAudioTracks(
 func (p *MPV) GetSubtitlesTracks(
 	ctx context.Context,
 ) (types.SubtitlesTracks, error) {
-	return getTracks[types.SubtitlesTrack](ctx, p, "sub", func(trackID int64, isActive bool) types.SubtitlesTrack {
+	return getTracks[types.SubtitlesTrack](ctx, p, "sub", func(track mpvTrack) types.SubtitlesTrack {
 		return types.SubtitlesTrack{
-			ID:       trackID,
-			IsActive: isActive,
+			ID:        track.ID,
+			IsActive:  track.IsSelected,
+			TrackInfo: track.Info,
 		}
 	})
 }
@@ -762,6 +936,115 @@ func (p *MPV) SetSubtitlesTrack(ctx context.Context, sid int64) error {
 	return p.mpvSet(ctx, "sid", sid)
 }
 
+// GetMediaInfo returns the information about the current media; mpv does not
+// report the container bitrate, so the sum of the video and audio bitrates
+// is returned instead.
+func (p *MPV) GetMediaInfo(
+	ctx context.Context,
+) (*types.MediaInfo, error) {
+	formatName, err := p.getString(ctx, "file-format")
+	if err != nil {
+		return nil, err
+	}
+	result := &types.MediaInfo{
+		FormatName: formatName,
+		Tags:       map[string]string{},
+	}
+
+	for _, key := range []string{"video-bitrate", "audio-bitrate"} {
+		// the property is unavailable if there is no such stream
+		if bitrate, err := p.getFloat64(ctx, key); err == nil {
+			result.Bitrate += int64(bitrate)
+		}
+	}
+
+	if trackList, err := p.mpvGet(ctx, "track-list"); err == nil {
+		list, _ := trackList.([]any)
+		result.StreamCount = len(list)
+	}
+
+	if metadata, err := p.mpvGet(ctx, "metadata"); err == nil {
+		m, _ := metadata.(map[string]any)
+		for k, v := range m {
+			result.Tags[strings.ToLower(k)] = fmt.Sprint(v)
+		}
+	}
+
+	chapters, err := p.getChapters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.Chapters = chapters
+	return result, nil
+}
+
+// see https://mpv.io/manual/stable/#command-interface-chapter-list
+func (p *MPV) getChapters(
+	ctx context.Context,
+) (types.Chapters, error) {
+	resp, err := p.mpvGet(ctx, "chapter-list")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the chapter list: %w", err)
+	}
+	list, ok := resp.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a slice of values, but received %T", resp)
+	}
+
+	result := make(types.Chapters, 0, len(list))
+	for idx, item := range list {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("item #%d is expected to be a map[string]any, but received %T", idx, item)
+		}
+		result = append(result, types.Chapter{
+			Title: optValue[string](m, "title"),
+			Start: time.Duration(optValue[float64](m, "time") * float64(time.Second)),
+		})
+	}
+
+	for idx := 0; idx+1 < len(result); idx++ {
+		result[idx].End = result[idx+1].Start
+	}
+	if len(result) > 0 {
+		if length, err := p.GetLength(ctx); err == nil {
+			result[len(result)-1].End = length
+		}
+	}
+	return result, nil
+}
+
+func (p *MPV) GetChapters(
+	ctx context.Context,
+) (types.Chapters, error) {
+	return p.getChapters(ctx)
+}
+
+func (p *MPV) GetChapter(
+	ctx context.Context,
+) (int, error) {
+	count, err := p.getFloat64(ctx, "chapters")
+	if err != nil {
+		return -1, err
+	}
+	if count == 0 {
+		// the property 'chapter' is unavailable if there are no chapters
+		return -1, nil
+	}
+	chapter, err := p.getFloat64(ctx, "chapter")
+	if err != nil {
+		return -1, err
+	}
+	return int(chapter), nil
+}
+
+func (p *MPV) SetChapter(
+	ctx context.Context,
+	idx int,
+) error {
+	return p.mpvSet(ctx, "chapter", idx)
+}
+
 const mpvQuitTimeout = time.Second
 
 func (p *MPV) Close(ctx context.Context) (_err error) {
@@ -773,7 +1056,9 @@ func (p *MPV) Close(ctx context.Context) (_err error) {
 	}
 	p.isClosed = true
 	p.CancelFunc()
-	p.OpenLinkOnRerun = ""
+	p.NextLinkMutex.Do(ctx, func() {
+		p.OpenLinkOnRerun = ""
+	})
 	return p.cleanup(ctx)
 }
 
@@ -0,0 +1,149 @@
+package player
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dexterlb/mpvipc"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/player/pkg/player/types"
+	"github.com/xaionaro-go/xsync"
+)
+
+const (
+	mpvObserveIDPause = int64(iota + 1)
+	mpvObserveIDIdleActive
+	mpvObserveIDEOFReached
+	mpvObserveIDTimePos
+	mpvObserveIDDuration
+	mpvObserveIDTrackList
+	mpvObserveIDPausedForCache
+	mpvObserveIDCacheBufferingState
+)
+
+// see https://mpv.io/manual/stable/#property-list
+var mpvObservedProperties = map[int64]string{
+	mpvObserveIDPause:               "pause",
+	mpvObserveIDIdleActive:          "idle-active",
+	mpvObserveIDEOFReached:          "eof-reached",
+	mpvObserveIDTimePos:             "time-pos",
+	mpvObserveIDDuration:            "duration",
+	mpvObserveIDTrackList:           "track-list",
+	mpvObserveIDPausedForCache:      "paused-for-cache",
+	mpvObserveIDCacheBufferingState: "cache-buffering-state",
+}
+
+func (p *MPV) Events(
+	ctx context.Context,
+) (<-chan types.Event, error) {
+	return p.events.Subscribe(ctx), nil
+}
+
+// eventLoop translates the events of the MPV instance into types.Event-s
+// until the connection is closed.
+func (p *MPV) eventLoop(
+	ctx context.Context,
+	conn *mpvipc.Connection,
+) {
+	logger.Debugf(ctx, "eventLoop")
+	defer logger.Debugf(ctx, "/eventLoop")
+
+	events, stop := conn.NewEventListener()
+	for id, name := range mpvObservedProperties {
+		if _, err := conn.Call("observe_property", id, name); err != nil {
+			logger.Errorf(ctx, "unable to observe property '%s': %v", name, err)
+		}
+	}
+
+	var (
+		state          types.PlaybackState
+		isPaused       bool
+		isIdle         bool
+		isPausedForBuf bool
+		duration       time.Duration
+	)
+	updateState := func() {
+		newState := types.PlaybackStatePlaying
+		switch {
+		case isIdle:
+			newState = types.PlaybackStateStopped
+		case isPaused:
+			newState = types.PlaybackStatePaused
+		}
+		if newState == state {
+			return
+		}
+		state = newState
+		p.events.Emit(ctx, types.EventStateChange{State: state})
+	}
+
+	for {
+		var ev *mpvipc.Event
+		select {
+		case <-ctx.Done():
+			close(stop)
+			return
+		case ev = <-events:
+		}
+		if ev == nil {
+			// the connection is closed
+			return
+		}
+		logger.Tracef(ctx, "received an MPV event: %#+v", ev)
+
+		switch ev.Name {
+		case "start-file":
+			link := xsync.DoR1(ctx, &p.NextLinkMutex, func() string {
+				return p.OpenLinkOnRerun
+			})
+			p.events.Emit(ctx, types.EventMediaChange{Link: link})
+		case "end-file":
+			switch ev.Reason {
+			case "error":
+				p.events.Emit(ctx, types.EventError{Err: fmt.Errorf("%v", ev.ExtraData["file_error"])})
+			case "eof":
+				// mpv proceeds to the preloaded file (if any)
+				p.NextLinkMutex.Do(ctx, func() {
+					if p.NextLink == "" {
+						return
+					}
+					p.OpenLinkOnRerun, p.NextLink = p.NextLink, ""
+				})
+			}
+		case "property-change":
+			switch ev.ID {
+			case mpvObserveIDPause:
+				isPaused, _ = ev.Data.(bool)
+				updateState()
+			case mpvObserveIDIdleActive:
+				isIdle, _ = ev.Data.(bool)
+				updateState()
+			case mpvObserveIDEOFReached:
+				// EOF does not end the file, because of "--keep-open=yes"
+				if eofReached, _ := ev.Data.(bool); eofReached {
+					p.events.Emit(ctx, types.EventEndOfFile{})
+				}
+			case mpvObserveIDTimePos:
+				if pos, ok := ev.Data.(float64); ok {
+					p.events.EmitPosition(ctx, time.Duration(pos*float64(time.Second)), duration)
+				}
+			case mpvObserveIDDuration:
+				d, _ := ev.Data.(float64)
+				duration = time.Duration(d * float64(time.Second))
+			case mpvObserveIDTrackList:
+				p.events.Emit(ctx, types.EventTracksChange{})
+			case mpvObserveIDPausedForCache:
+				wasPausedForBuf := isPausedForBuf
+				isPausedForBuf, _ = ev.Data.(bool)
+				if wasPausedForBuf && !isPausedForBuf {
+					p.events.Emit(ctx, types.EventBuffering{Percent: 100})
+				}
+			case mpvObserveIDCacheBufferingState:
+				if percent, ok := ev.Data.(float64); ok && isPausedForBuf {
+					p.events.Emit(ctx, types.EventBuffering{Percent: percent})
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,868 @@
+package playerserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image/png"
+	"net"
+	"time"
+
+	"github.com/facebookincubator/go-belt"
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/facebookincubator/go-belt/tool/logger/implementation/logrus"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/player/pkg/player/protobuf/go/player_grpc"
+	"github.com/xaionaro-go/player/pkg/player/types"
+	"github.com/xaionaro-go/xsync"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+const (
+	timeoutClosePlayer   = 10 * time.Second
+	keepaliveMinInterval = 5 * time.Second
+)
+
+// PlayerFactory creates the player to serve, for example:
+//
+//	func(ctx context.Context, title string) (types.Player, error) {
+//		return manager.NewPlayer(ctx, title, player.BackendMPV)
+//	}
+type PlayerFactory func(ctx context.Context, title string) (types.Player, error)
+
+// GRPCServer exposes a player (created by NewPlayer on Open) as
+// the player_grpc.Player service, which could be used through
+// vlcserver/client.Client.
+type GRPCServer struct {
+	player_grpc.UnimplementedPlayerServer
+	GRPCServer *grpc.Server
+	NewPlayer  PlayerFactory
+
+	// KeepServingOnClose makes Close to close only the player; otherwise
+	// the gRPC server is stopped as well.
+	KeepServingOnClose bool
+
+	PlayerLocker xsync.Mutex
+	Player       types.Player
+	Belt         *belt.Belt
+
+	// playerCancelFn cancels playerCtx when the Player is closed
+	// (or replaced), to finish the streams of the Player.
+	playerCtx      context.Context
+	playerCancelFn context.CancelFunc
+}
+
+func NewServer(newPlayer PlayerFactory) *GRPCServer {
+	srv := &GRPCServer{
+		GRPCServer: grpc.NewServer(
+			// the clients ping the idle connections to detect if they are broken
+			grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+				MinTime:             keepaliveMinInterval,
+				PermitWithoutStream: true,
+			}),
+		),
+		NewPlayer: newPlayer,
+	}
+	player_grpc.RegisterPlayerServer(srv.GRPCServer, srv)
+	return srv
+}
+
+func (srv *GRPCServer) Serve(
+	listener net.Listener,
+) error {
+	return srv.GRPCServer.Serve(listener)
+}
+
+func logLevelProtobuf2Go(logLevel player_grpc.LoggingLevel) logger.Level {
+	switch logLevel {
+	case player_grpc.LoggingLevel_LoggingLevelNone:
+		return logger.LevelFatal
+	case player_grpc.LoggingLevel_LoggingLevelFatal:
+		return logger.LevelFatal
+	case player_grpc.LoggingLevel_LoggingLevelPanic:
+		return logger.LevelPanic
+	case player_grpc.LoggingLevel_LoggingLevelError:
+		return logger.LevelError
+	case player_grpc.LoggingLevel_LoggingLevelWarn:
+		return logger.LevelWarning
+	case player_grpc.LoggingLevel_LoggingLevelInfo:
+		return logger.LevelInfo
+	case player_grpc.LoggingLevel_LoggingLevelDebug:
+		return logger.LevelDebug
+	case player_grpc.LoggingLevel_LoggingLevelTrace:
+		return logger.LevelTrace
+	default:
+		return logger.LevelUndefined
+	}
+}
+
+func (srv *GRPCServer) Open(
+	ctx context.Context,
+	req *player_grpc.OpenRequest,
+) (*player_grpc.OpenReply, error) {
+	return xsync.DoR2(ctx, &srv.PlayerLocker, func() (*player_grpc.OpenReply, error) {
+		l := logrus.Default().WithLevel(logLevelProtobuf2Go(req.LoggingLevel))
+		srv.Belt = logger.BeltWithLogger(belt.New(), l)
+
+		if srv.reopen(ctx, req) {
+			return &player_grpc.OpenReply{}, nil
+		}
+
+		if err := srv.closePlayer(ctx); err != nil {
+			logger.Errorf(ctx, "unable to close the player: %v", err)
+		}
+
+		var err error
+		srv.Player, err = srv.NewPlayer(ctx, req.GetTitle())
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the player: %w", err)
+		}
+		srv.playerCtx, srv.playerCancelFn = context.WithCancel(context.Background())
+
+		if err := srv.Player.OpenURL(ctx, req.Link); err != nil {
+			return nil, fmt.Errorf("unable to open link '%s': %w", req.Link, err)
+		}
+
+		return &player_grpc.OpenReply{}, nil
+	})
+}
+
+// reopen opens the link in the current player (instead of recreating it)
+// if it has the requested title; it returns false if the player should
+// be recreated.
+func (srv *GRPCServer) reopen(
+	ctx context.Context,
+	req *player_grpc.OpenRequest,
+) bool {
+	if srv.Player == nil {
+		return false
+	}
+	title, err := srv.Player.ProcessTitle(ctx)
+	if err != nil || title != req.GetTitle() {
+		return false
+	}
+	if err := srv.Player.OpenURL(ctx, req.Link); err != nil {
+		logger.Debugf(ctx, "unable to open link '%s' in the current player, recreating it: %v", req.Link, err)
+		return false
+	}
+	return true
+}
+
+func (srv *GRPCServer) SetupForStreaming(
+	ctx context.Context,
+	req *player_grpc.SetupForStreamingRequest,
+) (*player_grpc.SetupForStreamingReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	if err := srv.Player.SetupForStreaming(ctx); err != nil {
+		return nil, fmt.Errorf("unable to setup the player for streaming: %w", err)
+	}
+	return &player_grpc.SetupForStreamingReply{}, nil
+}
+
+func (srv *GRPCServer) ctx(ctx context.Context) context.Context {
+	return belt.CtxWithBelt(ctx, srv.Belt)
+}
+
+func (srv *GRPCServer) isInited() error {
+	ctx := context.TODO()
+	return xsync.DoR1(ctx, &srv.PlayerLocker, func() error {
+		if srv.Player == nil {
+			return fmt.Errorf("call Open first")
+		}
+		return nil
+	})
+}
+
+// streamCtx returns the current player and a context derived from ctx,
+// which is also cancelled when the player is closed (or replaced).
+func (srv *GRPCServer) streamCtx(
+	ctx context.Context,
+) (types.Player, context.Context, context.CancelFunc, error) {
+	return xsync.DoR4(ctx, &srv.PlayerLocker, func() (types.Player, context.Context, context.CancelFunc, error) {
+		if srv.Player == nil {
+			return nil, nil, nil, fmt.Errorf("call Open first")
+		}
+		ctx, cancelFn := context.WithCancel(ctx)
+		stop := context.AfterFunc(srv.playerCtx, cancelFn)
+		return srv.Player, ctx, func() {
+			stop()
+			cancelFn()
+		}, nil
+	})
+}
+
+func (srv *GRPCServer) ProcessTitle(
+	ctx context.Context,
+	req *player_grpc.ProcessTitleRequest,
+) (*player_grpc.ProcessTitleReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	title, err := srv.Player.ProcessTitle(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the title: %w", err)
+	}
+	return &player_grpc.ProcessTitleReply{
+		Title: title,
+	}, nil
+}
+
+func (srv *GRPCServer) GetLink(
+	ctx context.Context,
+	req *player_grpc.GetLinkRequest,
+) (*player_grpc.GetLinkReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	link, err := srv.Player.GetLink(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the title: %w", err)
+	}
+	return &player_grpc.GetLinkReply{
+		Link: link,
+	}, nil
+}
+
+func (srv *GRPCServer) EndChan(
+	req *player_grpc.EndChanRequest,
+	server player_grpc.Player_EndChanServer,
+) (_ret error) {
+	ctx := srv.ctx(server.Context())
+	logger.Tracef(ctx, "EndChan()")
+	defer func() {
+		logger.Tracef(ctx, "/EndChan(): %v", _ret)
+	}()
+
+	player, ctx, cancelFn, err := srv.streamCtx(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancelFn()
+
+	ch, err := player.EndChan(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get the EndChan: %w", err)
+	}
+	select {
+	case <-ctx.Done():
+		if server.Context().Err() == nil {
+			return fmt.Errorf("the player is closed")
+		}
+		return ctx.Err()
+	case <-ch:
+	}
+
+	return server.Send(&player_grpc.EndChanReply{})
+}
+
+func (srv *GRPCServer) IsEnded(
+	ctx context.Context,
+	req *player_grpc.IsEndedRequest,
+) (*player_grpc.IsEndedReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	isEnded, err := srv.Player.IsEnded(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get if it is already ended: %w", err)
+	}
+	return &player_grpc.IsEndedReply{
+		IsEnded: isEnded,
+	}, nil
+}
+
+func (srv *GRPCServer) GetPosition(
+	ctx context.Context,
+	req *player_grpc.GetPositionRequest,
+) (*player_grpc.GetPositionReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	pos, err := srv.Player.GetPosition(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the position: %w", err)
+	}
+	return &player_grpc.GetPositionReply{
+		PositionSecs: pos.Seconds(),
+	}, nil
+}
+
+func (srv *GRPCServer) GetAudioPosition(
+	ctx context.Context,
+	req *player_grpc.GetAudioPositionRequest,
+) (*player_grpc.GetAudioPositionReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	pos, err := srv.Player.GetAudioPosition(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the audio position: %w", err)
+	}
+	return &player_grpc.GetAudioPositionReply{
+		PositionSecs: pos.Seconds(),
+	}, nil
+}
+
+func (srv *GRPCServer) GetLength(
+	ctx context.Context,
+	req *player_grpc.GetLengthRequest,
+) (*player_grpc.GetLengthReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	length, err := srv.Player.GetLength(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the length: %w", err)
+	}
+	return &player_grpc.GetLengthReply{
+		LengthSecs: length.Seconds(),
+	}, nil
+}
+
+func (srv *GRPCServer) GetSpeed(
+	ctx context.Context,
+	req *player_grpc.GetSpeedRequest,
+) (*player_grpc.GetSpeedReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	speed, err := srv.Player.GetSpeed(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to set speed to '%v': %w", speed, err)
+	}
+	return &player_grpc.GetSpeedReply{
+		Speed: speed,
+	}, nil
+}
+
+func (srv *GRPCServer) SetSpeed(
+	ctx context.Context,
+	req *player_grpc.SetSpeedRequest,
+) (*player_grpc.SetSpeedReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	if err := srv.Player.SetSpeed(ctx, req.GetSpeed()); err != nil {
+		return nil, fmt.Errorf("unable to set speed to '%v': %w", req.GetSpeed(), err)
+	}
+	return &player_grpc.SetSpeedReply{}, nil
+}
+
+func (srv *GRPCServer) GetPause(
+	ctx context.Context,
+	req *player_grpc.GetPauseRequest,
+) (*player_grpc.GetPauseReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	isPaused, err := srv.Player.GetPause(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the info if it is paused: %w", err)
+	}
+	return &player_grpc.GetPauseReply{
+		IsPaused: isPaused,
+	}, nil
+}
+
+func (srv *GRPCServer) SetPause(
+	ctx context.Context,
+	req *player_grpc.SetPauseRequest,
+) (*player_grpc.SetPauseReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	if err := srv.Player.SetPause(ctx, req.GetIsPaused()); err != nil {
+		return nil, fmt.Errorf("unable to set paused state to '%v': %w", req.GetIsPaused(), err)
+	}
+	return &player_grpc.SetPauseReply{}, nil
+}
+
+func (srv *GRPCServer) GetVolume(
+	ctx context.Context,
+	req *player_grpc.GetVolumeRequest,
+) (*player_grpc.GetVolumeReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	volume, err := srv.Player.GetVolume(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the volume: %w", err)
+	}
+	return &player_grpc.GetVolumeReply{
+		Volume: volume,
+	}, nil
+}
+
+func (srv *GRPCServer) SetVolume(
+	ctx context.Context,
+	req *player_grpc.SetVolumeRequest,
+) (*player_grpc.SetVolumeReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	if err := srv.Player.SetVolume(ctx, req.GetVolume()); err != nil {
+		return nil, fmt.Errorf("unable to set the volume to '%v': %w", req.GetVolume(), err)
+	}
+	return &player_grpc.SetVolumeReply{}, nil
+}
+
+func (srv *GRPCServer) GetMute(
+	ctx context.Context,
+	req *player_grpc.GetMuteRequest,
+) (*player_grpc.GetMuteReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	isMuted, err := srv.Player.GetMute(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the info if it is muted: %w", err)
+	}
+	return &player_grpc.GetMuteReply{
+		IsMuted: isMuted,
+	}, nil
+}
+
+func (srv *GRPCServer) SetMute(
+	ctx context.Context,
+	req *player_grpc.SetMuteRequest,
+) (*player_grpc.SetMuteReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	if err := srv.Player.SetMute(ctx, req.GetIsMuted()); err != nil {
+		return nil, fmt.Errorf("unable to set muted state to '%v': %w", req.GetIsMuted(), err)
+	}
+	return &player_grpc.SetMuteReply{}, nil
+}
+
+func (srv *GRPCServer) Seek(
+	ctx context.Context,
+	req *player_grpc.SeekRequest,
+) (*player_grpc.SeekReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	pos := time.Duration(req.GetPosNanoseconds()) * time.Nanosecond
+	isRel := req.GetIsRelative()
+	isQuick := req.GetIsQuick()
+	if err := srv.Player.Seek(ctx, pos, isRel, isQuick); err != nil {
+		return nil, fmt.Errorf("unable to seek to %v (rel:%t, quick:%t): %w", pos, isRel, isQuick, err)
+	}
+	return &player_grpc.SeekReply{}, nil
+}
+
+func (srv *GRPCServer) FrameStep(
+	ctx context.Context,
+	req *player_grpc.FrameStepRequest,
+) (*player_grpc.FrameStepReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	if err := srv.Player.FrameStep(ctx, req.GetForward()); err != nil {
+		return nil, fmt.Errorf("unable to step a frame (forward:%t): %w", req.GetForward(), err)
+	}
+	return &player_grpc.FrameStepReply{}, nil
+}
+
+func (srv *GRPCServer) Screenshot(
+	ctx context.Context,
+	req *player_grpc.ScreenshotRequest,
+) (*player_grpc.ScreenshotReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	img, err := srv.Player.Screenshot(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to take a screenshot: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("unable to encode the screenshot: %w", err)
+	}
+	return &player_grpc.ScreenshotReply{
+		Png: buf.Bytes(),
+	}, nil
+}
+
+func (srv *GRPCServer) GetVideoTracks(
+	ctx context.Context,
+	req *player_grpc.GetVideoTracksRequest,
+) (*player_grpc.GetVideoTracksReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	result, err := srv.Player.GetVideoTracks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get video tracks: %w", err)
+	}
+	resp := &player_grpc.GetVideoTracksReply{}
+	for _, track := range result {
+		resp.VideoTrack = append(resp.VideoTrack, &player_grpc.VideoTrack{
+			Id:       track.ID,
+			IsActive: track.IsActive,
+			Info:     trackInfoToGRPC(track.TrackInfo),
+			Width:    int32(track.Width),
+			Height:   int32(track.Height),
+			Fps:      track.FPS,
+		})
+	}
+	return resp, nil
+}
+
+func (srv *GRPCServer) GetAudioTracks(
+	ctx context.Context,
+	req *player_grpc.GetAudioTracksRequest,
+) (*player_grpc.GetAudioTracksReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	result, err := srv.Player.GetAudioTracks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get audio tracks: %w", err)
+	}
+	resp := &player_grpc.GetAudioTracksReply{}
+	for _, track := range result {
+		resp.AudioTrack = append(resp.AudioTrack, &player_grpc.AudioTrack{
+			Id:            track.ID,
+			IsActive:      track.IsActive,
+			Info:          trackInfoToGRPC(track.TrackInfo),
+			SampleRate:    int32(track.SampleRate),
+			Channels:      int32(track.Channels),
+			ChannelLayout: track.ChannelLayout,
+		})
+	}
+	return resp, nil
+}
+
+func (srv *GRPCServer) GetSubtitlesTracks(
+	ctx context.Context,
+	req *player_grpc.GetSubtitlesTracksRequest,
+) (*player_grpc.GetSubtitlesTracksReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	result, err := srv.Player.GetSubtitlesTracks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get subtitles tracks: %w", err)
+	}
+	resp := &player_grpc.GetSubtitlesTracksReply{}
+	for _, track := range result {
+		resp.SubtitlesTrack = append(resp.SubtitlesTrack, &player_grpc.SubtitlesTrack{
+			Id:       track.ID,
+			IsActive: track.IsActive,
+			Info:     trackInfoToGRPC(track.TrackInfo),
+		})
+	}
+	return resp, nil
+}
+
+func trackInfoToGRPC(info types.TrackInfo) *player_grpc.TrackInfo {
+	return &player_grpc.TrackInfo{
+		Codec:     info.Codec,
+		Language:  info.Language,
+		Title:     info.Title,
+		IsDefault: info.IsDefault,
+		IsForced:  info.IsForced,
+	}
+}
+
+func (srv *GRPCServer) SetVideoTrack(
+	ctx context.Context,
+	req *player_grpc.SetVideoTrackRequest,
+) (*player_grpc.SetVideoTrackReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	if err := srv.Player.SetVideoTrack(ctx, req.GetVideoTrackID()); err != nil {
+		return nil, fmt.Errorf("unable to set video track ID to '%v': %w", req.GetVideoTrackID(), err)
+	}
+	return &player_grpc.SetVideoTrackReply{}, nil
+}
+
+func (srv *GRPCServer) SetAudioTrack(
+	ctx context.Context,
+	req *player_grpc.SetAudioTrackRequest,
+) (*player_grpc.SetAudioTrackReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	if err := srv.Player.SetAudioTrack(ctx, req.GetAudioTrackID()); err != nil {
+		return nil, fmt.Errorf("unable to set audio track ID to '%v': %w", req.GetAudioTrackID(), err)
+	}
+	return &player_grpc.SetAudioTrackReply{}, nil
+}
+
+func (srv *GRPCServer) SetSubtitlesTrack(
+	ctx context.Context,
+	req *player_grpc.SetSubtitlesTrackRequest,
+) (*player_grpc.SetSubtitlesTrackReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	if err := srv.Player.SetSubtitlesTrack(ctx, req.GetSubtitlesTrackID()); err != nil {
+		return nil, fmt.Errorf("unable to set subtitles track ID to '%v': %w", req.GetSubtitlesTrackID(), err)
+	}
+	return &player_grpc.SetSubtitlesTrackReply{}, nil
+}
+
+func (srv *GRPCServer) GetMediaInfo(
+	ctx context.Context,
+	req *player_grpc.GetMediaInfoRequest,
+) (*player_grpc.GetMediaInfoReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	info, err := srv.Player.GetMediaInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the media info: %w", err)
+	}
+	return &player_grpc.GetMediaInfoReply{
+		MediaInfo: mediaInfoToGRPC(info),
+	}, nil
+}
+
+func mediaInfoToGRPC(info *types.MediaInfo) *player_grpc.MediaInfo {
+	return &player_grpc.MediaInfo{
+		FormatName:  info.FormatName,
+		Bitrate:     info.Bitrate,
+		StreamCount: int32(info.StreamCount),
+		Tags:        info.Tags,
+		Chapters:    chaptersToGRPC(info.Chapters),
+	}
+}
+
+func chaptersToGRPC(chapters types.Chapters) []*player_grpc.Chapter {
+	result := make([]*player_grpc.Chapter, 0, len(chapters))
+	for _, chapter := range chapters {
+		result = append(result, &player_grpc.Chapter{
+			Title:     chapter.Title,
+			StartSecs: chapter.Start.Seconds(),
+			EndSecs:   chapter.End.Seconds(),
+		})
+	}
+	return result
+}
+
+func (srv *GRPCServer) GetChapters(
+	ctx context.Context,
+	req *player_grpc.GetChaptersRequest,
+) (*player_grpc.GetChaptersReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	chapters, err := srv.Player.GetChapters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the chapters: %w", err)
+	}
+	return &player_grpc.GetChaptersReply{
+		Chapters: chaptersToGRPC(chapters),
+	}, nil
+}
+
+func (srv *GRPCServer) GetChapter(
+	ctx context.Context,
+	req *player_grpc.GetChapterRequest,
+) (*player_grpc.GetChapterReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	idx, err := srv.Player.GetChapter(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the current chapter: %w", err)
+	}
+	return &player_grpc.GetChapterReply{
+		ChapterIdx: int32(idx),
+	}, nil
+}
+
+func (srv *GRPCServer) SetChapter(
+	ctx context.Context,
+	req *player_grpc.SetChapterRequest,
+) (*player_grpc.SetChapterReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	if err := srv.Player.SetChapter(ctx, int(req.GetChapterIdx())); err != nil {
+		return nil, fmt.Errorf("unable to set the chapter to #%d: %w", req.GetChapterIdx(), err)
+	}
+	return &player_grpc.SetChapterReply{}, nil
+}
+
+func (srv *GRPCServer) Stop(
+	ctx context.Context,
+	req *player_grpc.StopRequest,
+) (*player_grpc.StopReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, err
+	}
+	if err := srv.Player.Stop(ctx); err != nil {
+		return nil, fmt.Errorf("unable to stop the playback: %w", err)
+	}
+	return &player_grpc.StopReply{}, nil
+}
+
+func (srv *GRPCServer) Events(
+	req *player_grpc.EventsRequest,
+	server player_grpc.Player_EventsServer,
+) (_ret error) {
+	ctx := srv.ctx(server.Context())
+	logger.Tracef(ctx, "Events()")
+	defer func() {
+		logger.Tracef(ctx, "/Events(): %v", _ret)
+	}()
+
+	player, ctx, cancelFn, err := srv.streamCtx(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancelFn()
+
+	ch, err := player.Events(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to the events: %w", err)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			if server.Context().Err() == nil {
+				// the player is closed
+				return nil
+			}
+			return ctx.Err()
+		case ev, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := server.Send(eventToGRPC(ev)); err != nil {
+				return fmt.Errorf("unable to send the event: %w", err)
+			}
+		}
+	}
+}
+
+func eventToGRPC(ev types.Event) *player_grpc.Event {
+	switch ev := ev.(type) {
+	case types.EventStateChange:
+		return &player_grpc.Event{Event: &player_grpc.Event_StateChange{
+			StateChange: &player_grpc.EventStateChange{
+				State: player_grpc.PlaybackState(ev.State),
+			},
+		}}
+	case types.EventPosition:
+		return &player_grpc.Event{Event: &player_grpc.Event_Position{
+			Position: &player_grpc.EventPosition{
+				PositionSecs: ev.Position.Seconds(),
+				LengthSecs:   ev.Length.Seconds(),
+			},
+		}}
+	case types.EventTracksChange:
+		return &player_grpc.Event{Event: &player_grpc.Event_TracksChange{
+			TracksChange: &player_grpc.EventTracksChange{},
+		}}
+	case types.EventBuffering:
+		return &player_grpc.Event{Event: &player_grpc.Event_Buffering{
+			Buffering: &player_grpc.EventBuffering{
+				Percent: ev.Percent,
+			},
+		}}
+	case types.EventError:
+		return &player_grpc.Event{Event: &player_grpc.Event_Error{
+			Error: &player_grpc.EventError{
+				Error: fmt.Sprint(ev.Err),
+			},
+		}}
+	case types.EventEndOfFile:
+		return &player_grpc.Event{Event: &player_grpc.Event_EndOfFile{
+			EndOfFile: &player_grpc.EventEndOfFile{},
+		}}
+	case types.EventMediaChange:
+		return &player_grpc.Event{Event: &player_grpc.Event_MediaChange{
+			MediaChange: &player_grpc.EventMediaChange{
+				Link: ev.Link,
+			},
+		}}
+	}
+	return &player_grpc.Event{}
+}
+
+func (srv *GRPCServer) Close(
+	ctx context.Context,
+	req *player_grpc.CloseRequest,
+) (*player_grpc.CloseReply, error) {
+	if err := srv.isInited(); err != nil {
+		return nil, nil
+	}
+	return xsync.DoR2(ctx, &srv.PlayerLocker, func() (*player_grpc.CloseReply, error) {
+		if srv.KeepServingOnClose {
+			if err := srv.closePlayer(ctx); err != nil {
+				return nil, fmt.Errorf("unable to close the player: %w", err)
+			}
+			return &player_grpc.CloseReply{}, nil
+		}
+		if err := srv.close(ctx); err != nil {
+			return nil, err
+		}
+		return &player_grpc.CloseReply{}, nil
+	})
+}
+
+func (srv *GRPCServer) closePlayer(
+	ctx context.Context,
+) (_err error) {
+	logger.Debugf(ctx, "closePlayer")
+	defer func() { logger.Debugf(ctx, "/closePlayer: %v", _err) }()
+	defer func() {
+		srv.Player = nil
+		if srv.playerCancelFn != nil {
+			srv.playerCancelFn()
+			srv.playerCtx, srv.playerCancelFn = nil, nil
+		}
+	}()
+
+	if srv.Player == nil {
+		return nil
+	}
+
+	ctx, cancelFn := context.WithTimeout(ctx, timeoutClosePlayer)
+	defer cancelFn()
+	errCh := make(chan error, 1)
+	observability.Go(ctx, func(ctx context.Context) {
+		defer close(errCh)
+		if err := srv.Player.Close(ctx); err != nil {
+			errCh <- fmt.Errorf("unable to stop the playback: %w", err)
+			return
+		}
+		errCh <- nil
+	})
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("closing takes too long: %w", ctx.Err())
+	case err := <-errCh:
+		return err
+	}
+}
+
+func (srv *GRPCServer) close(
+	ctx context.Context,
+) (_err error) {
+	logger.Debugf(ctx, "close")
+	defer func() { logger.Debugf(ctx, "/close: %v", _err) }()
+
+	defer func() {
+		if srv.GRPCServer != nil {
+			logger.Debugf(ctx, "closing the GRPCServer")
+			srv.GRPCServer.Stop()
+		}
+		srv.Belt = nil
+	}()
+
+	err := srv.closePlayer(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to close the player: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package playlist
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type Format string
+
+const (
+	FormatUndefined = Format("")
+	FormatM3U       = Format("m3u")
+	FormatM3U8      = Format("m3u8")
+	FormatPLS       = Format("pls")
+)
+
+func FormatFromPath(path string) Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".m3u":
+		return FormatM3U
+	case ".m3u8":
+		return FormatM3U8
+	case ".pls":
+		return FormatPLS
+	}
+	return FormatUndefined
+}
+
+// LoadFile parses a playlist file; the format is detected by the extension
+// of the file. The relative paths are resolved against the directory of
+// the playlist.
+func LoadFile(path string) ([]Entry, error) {
+	format := FormatFromPath(path)
+	if format == FormatUndefined {
+		return nil, fmt.Errorf("unable to detect the playlist format of '%s'", path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open '%s': %w", path, err)
+	}
+	defer f.Close()
+	entries, err := Parse(f, format)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse '%s': %w", path, err)
+	}
+	dir := filepath.Dir(path)
+	for idx := range entries {
+		entries[idx].Link = resolveLink(dir, entries[idx].Link)
+	}
+	return entries, nil
+}
+
+func resolveLink(dir string, link string) string {
+	if u, err := url.Parse(link); err == nil && len(u.Scheme) > 1 {
+		// a one-letter scheme is a drive letter on Windows
+		return link
+	}
+	if filepath.IsAbs(link) {
+		return link
+	}
+	return filepath.Join(dir, filepath.FromSlash(link))
+}
+
+func Parse(r io.Reader, format Format) ([]Entry, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read: %w", err)
+	}
+	b = bytes.TrimPrefix(b, []byte("\xef\xbb\xbf"))
+	b = bytes.ReplaceAll(b, []byte("\r\n"), []byte("\n"))
+	switch format {
+	case FormatM3U, FormatM3U8:
+		return parseM3U(string(b), format == FormatM3U8)
+	case FormatPLS:
+		return parsePLS(string(b))
+	default:
+		return nil, fmt.Errorf("unknown playlist format '%s'", format)
+	}
+}
+
+// SaveFile writes the entries to a playlist file; the format is detected
+// by the extension of the file.
+func SaveFile(path string, entries []Entry) (_err error) {
+	format := FormatFromPath(path)
+	if format == FormatUndefined {
+		return fmt.Errorf("unable to detect the playlist format of '%s'", path)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create '%s': %w", path, err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil && _err == nil {
+			_err = fmt.Errorf("unable to close '%s': %w", path, err)
+		}
+	}()
+	if err := Write(f, format, entries); err != nil {
+		return fmt.Errorf("unable to write '%s': %w", path, err)
+	}
+	return nil
+}
+
+func Write(w io.Writer, format Format, entries []Entry) error {
+	var s string
+	switch format {
+	case FormatM3U, FormatM3U8:
+		s = formatM3U(entries)
+	case FormatPLS:
+		s = formatPLS(entries)
+	default:
+		return fmt.Errorf("unknown playlist format '%s'", format)
+	}
+	if _, err := io.WriteString(w, s); err != nil {
+		return fmt.Errorf("unable to write: %w", err)
+	}
+	return nil
+}
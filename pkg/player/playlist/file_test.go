@@ -0,0 +1,145 @@
+package playlist
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testEntries = []Entry{
+	{Link: "https://example.com/stream", Title: "Stream"},
+	{Link: "/music/song.mp3", Title: "Song", Duration: 3 * time.Minute},
+	{Link: "video.mkv", Duration: 90 * time.Second},
+}
+
+func TestWriteParseRoundTrip(t *testing.T) {
+	for _, format := range []Format{FormatM3U, FormatM3U8, FormatPLS} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Write(&buf, format, testEntries); err != nil {
+				t.Fatalf("unable to write: %v", err)
+			}
+			entries, err := Parse(&buf, format)
+			if err != nil {
+				t.Fatalf("unable to parse: %v", err)
+			}
+			if !reflect.DeepEqual(entries, testEntries) {
+				t.Fatalf("expected %#+v, got %#+v", testEntries, entries)
+			}
+		})
+	}
+}
+
+func TestSaveLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"list.m3u", "list.m3u8", "list.pls"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			if err := SaveFile(path, testEntries); err != nil {
+				t.Fatalf("unable to save: %v", err)
+			}
+			entries, err := LoadFile(path)
+			if err != nil {
+				t.Fatalf("unable to load: %v", err)
+			}
+			// the relative links are resolved against the directory of the playlist
+			expected := append([]Entry{}, testEntries...)
+			expected[2].Link = filepath.Join(dir, "video.mkv")
+			if !reflect.DeepEqual(entries, expected) {
+				t.Fatalf("expected %#+v, got %#+v", expected, entries)
+			}
+		})
+	}
+	if err := SaveFile(filepath.Join(dir, "list.txt"), testEntries); err == nil {
+		t.Fatalf("expected an error for an unknown format")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "list.txt")); err == nil {
+		t.Fatalf("expected no file to be created for an unknown format")
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Format   Format
+		Input    string
+		Expected []Entry
+		IsError  bool
+	}{
+		{
+			Name:     "m3u_plain",
+			Format:   FormatM3U,
+			Input:    "\xef\xbb\xbfa.mp3\r\n# comment\r\n\r\nb.mp3\r\n",
+			Expected: []Entry{{Link: "a.mp3"}, {Link: "b.mp3"}},
+		},
+		{
+			Name:     "m3u_latin1",
+			Format:   FormatM3U,
+			Input:    "#EXTINF:-1,Caf\xe9\nhttp://example.com/\n",
+			Expected: []Entry{{Link: "http://example.com/", Title: "Café"}},
+		},
+		{
+			Name:     "m3u_attributes",
+			Format:   FormatM3U8,
+			Input:    "#EXTM3U\n#EXTINF:10.5 tvg-id=\"x\",Title, with a comma\nlink\n",
+			Expected: []Entry{{Link: "link", Title: "Title, with a comma", Duration: 10500 * time.Millisecond}},
+		},
+		{
+			Name:    "m3u_bad_duration",
+			Format:  FormatM3U,
+			Input:   "#EXTINF:abc,Title\nlink\n",
+			IsError: true,
+		},
+		{
+			Name:   "pls_out_of_order",
+			Format: FormatPLS,
+			Input: "; comment\n[other]\nFile1=ignored\n[Playlist]\nNumberOfEntries=2\n" +
+				"File2=b\nTitle2=B\nFile1=a\nLength1=5\nVersion=2\n",
+			Expected: []Entry{{Link: "a", Duration: 5 * time.Second}, {Link: "b", Title: "B"}},
+		},
+		{
+			Name:    "pls_no_file",
+			Format:  FormatPLS,
+			Input:   "[playlist]\nTitle1=a\n",
+			IsError: true,
+		},
+		{
+			Name:    "pls_no_equals",
+			Format:  FormatPLS,
+			Input:   "[playlist]\nFile1\n",
+			IsError: true,
+		},
+		{
+			Name:    "pls_bad_index",
+			Format:  FormatPLS,
+			Input:   "[playlist]\nFileX=a\n",
+			IsError: true,
+		},
+		{
+			Name:    "unknown_format",
+			Format:  FormatUndefined,
+			Input:   "a\n",
+			IsError: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			entries, err := Parse(strings.NewReader(tc.Input), tc.Format)
+			if tc.IsError {
+				if err == nil {
+					t.Fatalf("expected an error, got %#+v", entries)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(entries, tc.Expected) {
+				t.Fatalf("expected %#+v, got %#+v", tc.Expected, entries)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package playlist
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// parseM3U parses the (extended) M3U playlists, see
+// https://en.wikipedia.org/wiki/M3U; a plain M3U file is expected in
+// Latin-1 unless it is a valid UTF-8.
+func parseM3U(s string, isM3U8 bool) ([]Entry, error) {
+	if !isM3U8 && !utf8.ValidString(s) {
+		s = latin1ToUTF8(s)
+	}
+	var (
+		result  []Entry
+		pending Entry
+	)
+	for lineIdx, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "#EXTINF:"):
+			// #EXTINF:<duration> [<attributes>],<title>
+			info, title, _ := strings.Cut(strings.TrimPrefix(line, "#EXTINF:"), ",")
+			durationString, _, _ := strings.Cut(strings.TrimSpace(info), " ")
+			secs, err := strconv.ParseFloat(durationString, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse the duration '%s' at line %d: %w", durationString, lineIdx+1, err)
+			}
+			pending.Title = strings.TrimSpace(title)
+			pending.Duration = 0
+			if secs > 0 {
+				pending.Duration = time.Duration(secs * float64(time.Second))
+			}
+		case strings.HasPrefix(line, "#"):
+			// a comment or an unsupported directive
+		default:
+			pending.Link = line
+			result = append(result, pending)
+			pending = Entry{}
+		}
+	}
+	return result, nil
+}
+
+func latin1ToUTF8(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		b.WriteRune(rune(s[i]))
+	}
+	return b.String()
+}
+
+func formatM3U(entries []Entry) string {
+	var b strings.Builder
+	b.WriteString("#EXTM3U\n")
+	for _, e := range entries {
+		secs := int64(-1)
+		if e.Duration > 0 {
+			secs = int64(e.Duration.Round(time.Second) / time.Second)
+		}
+		fmt.Fprintf(&b, "#EXTINF:%d,%s\n%s\n", secs, e.Title, e.Link)
+	}
+	return b.String()
+}
@@ -0,0 +1,425 @@
+package playlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/player/pkg/player/types"
+	"github.com/xaionaro-go/xsync"
+)
+
+// ErrEndOfPlaylist is returned if there is no entry to switch to.
+var ErrEndOfPlaylist = errors.New("the end of the playlist")
+
+type Entry struct {
+	Link  string
+	Title string
+
+	// Duration is zero if it is unknown.
+	Duration time.Duration
+}
+
+type RepeatMode int
+
+const (
+	RepeatModeNone = RepeatMode(iota)
+	RepeatModeOne
+	RepeatModeAll
+)
+
+func (m RepeatMode) String() string {
+	switch m {
+	case RepeatModeNone:
+		return "none"
+	case RepeatModeOne:
+		return "one"
+	case RepeatModeAll:
+		return "all"
+	default:
+		return fmt.Sprintf("unknown_repeat_mode_%d", int(m))
+	}
+}
+
+// Playlist is a queue of media played one after another by the Player
+// (see Serve). The entries are addressed by their indexes in Entries,
+// which are not affected by the shuffling.
+//
+// If the Player implements types.NextURLPreloader, the next entry is
+// preloaded to switch to it without a gap.
+type Playlist struct {
+	Player types.Player
+
+	locker     xsync.Mutex
+	entries    []*Entry
+	order      []*Entry // the playback order
+	current    *Entry
+	resumeFrom int  // the position in order to continue from, if current is nil
+	isOrphaned bool // the Player is playing an entry, which was removed
+	preloaded  *Entry
+	isShuffled bool
+	repeatMode RepeatMode
+}
+
+func New(player types.Player) *Playlist {
+	return &Playlist{
+		Player: player,
+	}
+}
+
+func (p *Playlist) Entries(ctx context.Context) []Entry {
+	return xsync.DoR1(ctx, &p.locker, func() []Entry {
+		result := make([]Entry, 0, len(p.entries))
+		for _, e := range p.entries {
+			result = append(result, *e)
+		}
+		return result
+	})
+}
+
+// Current returns the index of the entry being played (-1 if none).
+func (p *Playlist) Current(ctx context.Context) int {
+	return xsync.DoR1(ctx, &p.locker, func() int {
+		return slices.Index(p.entries, p.current)
+	})
+}
+
+func (p *Playlist) Append(
+	ctx context.Context,
+	entries ...Entry,
+) {
+	p.locker.Do(ctx, func() {
+		p.insert(ctx, len(p.entries), entries)
+	})
+}
+
+func (p *Playlist) Insert(
+	ctx context.Context,
+	idx int,
+	entries ...Entry,
+) error {
+	return xsync.DoR1(ctx, &p.locker, func() error {
+		if idx < 0 || idx > len(p.entries) {
+			return fmt.Errorf("index %d is out of range [0, %d]", idx, len(p.entries))
+		}
+		p.insert(ctx, idx, entries)
+		return nil
+	})
+}
+
+func (p *Playlist) insert(
+	ctx context.Context,
+	idx int,
+	entries []Entry,
+) {
+	newEntries := make([]*Entry, 0, len(entries))
+	for _, e := range entries {
+		newEntries = append(newEntries, &e)
+	}
+	p.entries = slices.Insert(p.entries, idx, newEntries...)
+	if !p.isShuffled {
+		p.order = slices.Clone(p.entries)
+	} else {
+		// the new entries are shuffled into the part, which is not played yet
+		minPos := p.resumeFrom
+		if p.current != nil {
+			minPos = slices.Index(p.order, p.current) + 1
+		}
+		minPos = min(minPos, len(p.order))
+		for _, e := range newEntries {
+			pos := minPos + rand.IntN(len(p.order)-minPos+1)
+			p.order = slices.Insert(p.order, pos, e)
+		}
+	}
+	p.updatePreload(ctx)
+}
+
+// Remove removes the entry; if it is being played, the playback is not
+// stopped, and Next switches to the entry following the removed one.
+func (p *Playlist) Remove(
+	ctx context.Context,
+	idx int,
+) error {
+	return xsync.DoR1(ctx, &p.locker, func() error {
+		if idx < 0 || idx >= len(p.entries) {
+			return fmt.Errorf("index %d is out of range [0, %d)", idx, len(p.entries))
+		}
+		e := p.entries[idx]
+		p.entries = slices.Delete(p.entries, idx, idx+1)
+		pos := slices.Index(p.order, e)
+		p.order = slices.Delete(p.order, pos, pos+1)
+		switch {
+		case e == p.current:
+			p.current = nil
+			p.resumeFrom = pos
+			p.isOrphaned = true
+		case p.current == nil && pos < p.resumeFrom:
+			p.resumeFrom--
+		}
+		p.updatePreload(ctx)
+		return nil
+	})
+}
+
+// Move moves the entry from index "from" to index "to".
+func (p *Playlist) Move(
+	ctx context.Context,
+	from int,
+	to int,
+) error {
+	return xsync.DoR1(ctx, &p.locker, func() error {
+		if from < 0 || from >= len(p.entries) {
+			return fmt.Errorf("index %d is out of range [0, %d)", from, len(p.entries))
+		}
+		if to < 0 || to >= len(p.entries) {
+			return fmt.Errorf("index %d is out of range [0, %d)", to, len(p.entries))
+		}
+		e := p.entries[from]
+		p.entries = slices.Delete(p.entries, from, from+1)
+		p.entries = slices.Insert(p.entries, to, e)
+		if !p.isShuffled {
+			p.order = slices.Clone(p.entries)
+		}
+		p.updatePreload(ctx)
+		return nil
+	})
+}
+
+// Clear removes all the entries (the playback is not stopped).
+func (p *Playlist) Clear(ctx context.Context) {
+	p.locker.Do(ctx, func() {
+		p.entries = nil
+		p.order = nil
+		if p.current != nil {
+			p.current = nil
+			p.isOrphaned = true
+		}
+		p.resumeFrom = 0
+		p.updatePreload(ctx)
+	})
+}
+
+func (p *Playlist) IsShuffled(ctx context.Context) bool {
+	return xsync.DoR1(ctx, &p.locker, func() bool {
+		return p.isShuffled
+	})
+}
+
+// SetShuffle enables or disables the random playback order; the entry
+// being played stays the current one in either case.
+func (p *Playlist) SetShuffle(
+	ctx context.Context,
+	shuffle bool,
+) {
+	p.locker.Do(ctx, func() {
+		p.isShuffled = shuffle
+		p.order = slices.Clone(p.entries)
+		p.resumeFrom = 0
+		if shuffle {
+			rand.Shuffle(len(p.order), func(i, j int) {
+				p.order[i], p.order[j] = p.order[j], p.order[i]
+			})
+			if pos := slices.Index(p.order, p.current); pos > 0 {
+				p.order[0], p.order[pos] = p.order[pos], p.order[0]
+			}
+		}
+		p.updatePreload(ctx)
+	})
+}
+
+func (p *Playlist) GetRepeatMode(ctx context.Context) RepeatMode {
+	return xsync.DoR1(ctx, &p.locker, func() RepeatMode {
+		return p.repeatMode
+	})
+}
+
+func (p *Playlist) SetRepeatMode(
+	ctx context.Context,
+	mode RepeatMode,
+) {
+	p.locker.Do(ctx, func() {
+		p.repeatMode = mode
+		p.updatePreload(ctx)
+	})
+}
+
+// Play opens the entry with the given index in the Player.
+func (p *Playlist) Play(
+	ctx context.Context,
+	idx int,
+) error {
+	return xsync.DoR1(ctx, &p.locker, func() error {
+		if idx < 0 || idx >= len(p.entries) {
+			return fmt.Errorf("index %d is out of range [0, %d)", idx, len(p.entries))
+		}
+		return p.play(ctx, p.entries[idx])
+	})
+}
+
+// Next switches to the next entry; it wraps around only in RepeatModeAll.
+func (p *Playlist) Next(ctx context.Context) error {
+	return xsync.DoR1(ctx, &p.locker, func() error {
+		e := p.following(1, p.repeatMode == RepeatModeAll)
+		if e == nil {
+			return ErrEndOfPlaylist
+		}
+		return p.play(ctx, e)
+	})
+}
+
+// Previous switches to the previous entry; it wraps around only in
+// RepeatModeAll.
+func (p *Playlist) Previous(ctx context.Context) error {
+	return xsync.DoR1(ctx, &p.locker, func() error {
+		e := p.following(-1, p.repeatMode == RepeatModeAll)
+		if e == nil {
+			return ErrEndOfPlaylist
+		}
+		return p.play(ctx, e)
+	})
+}
+
+func (p *Playlist) play(
+	ctx context.Context,
+	e *Entry,
+) (_err error) {
+	logger.Debugf(ctx, "play(ctx, '%s')", e.Link)
+	defer func() { logger.Debugf(ctx, "/play(ctx, '%s'): %v", e.Link, _err) }()
+	p.current = e
+	p.isOrphaned = false
+	p.preloaded = nil
+	if err := p.Player.OpenURL(ctx, e.Link); err != nil {
+		return fmt.Errorf("unable to open '%s': %w", e.Link, err)
+	}
+	p.updatePreload(ctx)
+	return nil
+}
+
+// following returns the entry "step" positions away from the current one
+// in the playback order (nil if there is no such entry).
+func (p *Playlist) following(
+	step int,
+	wrap bool,
+) *Entry {
+	if len(p.order) == 0 {
+		return nil
+	}
+	var pos int
+	switch {
+	case p.current != nil:
+		pos = slices.Index(p.order, p.current) + step
+	case step > 0:
+		pos = p.resumeFrom + step - 1
+	default:
+		pos = p.resumeFrom + step
+	}
+	if pos < 0 || pos >= len(p.order) {
+		if !wrap {
+			return nil
+		}
+		pos = (pos%len(p.order) + len(p.order)) % len(p.order)
+	}
+	return p.order[pos]
+}
+
+// autoNext returns the entry to be played when the current one ends.
+func (p *Playlist) autoNext() *Entry {
+	if p.current == nil && !p.isOrphaned {
+		// nothing is being played
+		return nil
+	}
+	if p.repeatMode == RepeatModeOne && p.current != nil {
+		return p.current
+	}
+	return p.following(1, p.repeatMode == RepeatModeAll)
+}
+
+func (p *Playlist) updatePreload(ctx context.Context) {
+	preloader, ok := p.Player.(types.NextURLPreloader)
+	if !ok {
+		return
+	}
+	next := p.autoNext()
+	if next == p.preloaded {
+		return
+	}
+	var link string
+	if next != nil {
+		link = next.Link
+	}
+	if err := preloader.PreloadNextURL(ctx, link); err != nil {
+		logger.Warnf(ctx, "unable to preload '%s': %v", link, err)
+		p.preloaded = nil
+		return
+	}
+	p.preloaded = next
+}
+
+// Serve switches the Player to the next entry, when the current one ends;
+// it returns nil when the last entry ends, or an error if the context is
+// cancelled (or the events of the Player are not available anymore).
+func (p *Playlist) Serve(ctx context.Context) (_err error) {
+	logger.Debugf(ctx, "Serve")
+	defer func() { logger.Debugf(ctx, "/Serve: %v", _err) }()
+
+	events, err := p.Player.Events(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to the events of the player: %w", err)
+	}
+	for {
+		var (
+			ev types.Event
+			ok bool
+		)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev, ok = <-events:
+		}
+		if !ok {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("the events channel is closed")
+		}
+		if isEnded := p.onEvent(ctx, ev); isEnded {
+			return nil
+		}
+	}
+}
+
+// onEvent handles an event of the Player; it returns true if there is
+// no entry to play after the current one ended.
+func (p *Playlist) onEvent(
+	ctx context.Context,
+	ev types.Event,
+) bool {
+	switch ev := ev.(type) {
+	case types.EventMediaChange:
+		p.locker.Do(ctx, func() {
+			if p.preloaded == nil || p.preloaded.Link != ev.Link {
+				return
+			}
+			// the Player has switched to the preloaded entry by itself
+			p.current, p.preloaded = p.preloaded, nil
+			p.isOrphaned = false
+			p.updatePreload(ctx)
+		})
+	case types.EventEndOfFile:
+		return xsync.DoR1(ctx, &p.locker, func() bool {
+			next := p.autoNext()
+			if next == nil {
+				return true
+			}
+			if err := p.play(ctx, next); err != nil {
+				logger.Errorf(ctx, "unable to switch to the next entry: %v", err)
+			}
+			return false
+		})
+	}
+	return false
+}
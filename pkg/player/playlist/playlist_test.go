@@ -0,0 +1,313 @@
+package playlist
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/xaionaro-go/player/pkg/player/types"
+)
+
+// fakePlayer records the opened links; the methods not needed by
+// the Playlist are not implemented.
+type fakePlayer struct {
+	types.Player
+
+	events         types.EventBroadcaster
+	subscribedChan chan struct{}
+	locker         sync.Mutex
+	openChan       chan string
+	preloaded      string
+}
+
+func newFakePlayer() *fakePlayer {
+	return &fakePlayer{
+		subscribedChan: make(chan struct{}, 1),
+		openChan:       make(chan string, 100),
+	}
+}
+
+func (p *fakePlayer) OpenURL(ctx context.Context, link string) error {
+	p.locker.Lock()
+	defer p.locker.Unlock()
+	p.openChan <- link
+	return nil
+}
+
+func (p *fakePlayer) Events(ctx context.Context) (<-chan types.Event, error) {
+	ch := p.events.Subscribe(ctx)
+	p.subscribedChan <- struct{}{}
+	return ch, nil
+}
+
+// fakePreloadingPlayer is a fakePlayer implementing types.NextURLPreloader.
+type fakePreloadingPlayer struct {
+	*fakePlayer
+}
+
+func (p fakePreloadingPlayer) PreloadNextURL(ctx context.Context, link string) error {
+	p.locker.Lock()
+	defer p.locker.Unlock()
+	p.preloaded = link
+	return nil
+}
+
+func newTestPlaylist(player types.Player, links ...string) *Playlist {
+	p := New(player)
+	for _, link := range links {
+		p.Append(context.Background(), Entry{Link: link})
+	}
+	return p
+}
+
+func expectOpened(t *testing.T, player *fakePlayer, expected string) {
+	t.Helper()
+	select {
+	case link := <-player.openChan:
+		if link != expected {
+			t.Fatalf("expected '%s' to be opened, got '%s'", expected, link)
+		}
+	case <-time.After(time.Minute):
+		t.Fatalf("expected '%s' to be opened, got nothing", expected)
+	}
+}
+
+func TestPlaylistNextPrevious(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		Mode          RepeatMode
+		ExpectWrapped bool
+	}{
+		{Mode: RepeatModeNone},
+		{Mode: RepeatModeOne},
+		{Mode: RepeatModeAll, ExpectWrapped: true},
+	} {
+		t.Run(tc.Mode.String(), func(t *testing.T) {
+			player := newFakePlayer()
+			p := newTestPlaylist(player, "a", "b")
+			p.SetRepeatMode(ctx, tc.Mode)
+
+			if err := p.Next(ctx); err != nil {
+				t.Fatalf("unable to switch to the first entry: %v", err)
+			}
+			expectOpened(t, player, "a")
+			if err := p.Next(ctx); err != nil {
+				t.Fatalf("unable to switch to the second entry: %v", err)
+			}
+			expectOpened(t, player, "b")
+
+			err := p.Next(ctx)
+			if !tc.ExpectWrapped {
+				if !errors.Is(err, ErrEndOfPlaylist) {
+					t.Fatalf("expected ErrEndOfPlaylist, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unable to wrap around: %v", err)
+			}
+			expectOpened(t, player, "a")
+			if err := p.Previous(ctx); err != nil {
+				t.Fatalf("unable to wrap around backwards: %v", err)
+			}
+			expectOpened(t, player, "b")
+		})
+	}
+}
+
+func TestPlaylistServe(t *testing.T) {
+	for _, tc := range []struct {
+		Mode     RepeatMode
+		Expected []string
+	}{
+		{Mode: RepeatModeNone, Expected: []string{"b"}},
+		{Mode: RepeatModeOne, Expected: []string{"b", "b", "b"}},
+		{Mode: RepeatModeAll, Expected: []string{"b", "a", "b"}},
+	} {
+		t.Run(tc.Mode.String(), func(t *testing.T) {
+			ctx, cancelFn := context.WithCancel(context.Background())
+			defer cancelFn()
+			player := newFakePlayer()
+			p := newTestPlaylist(player, "a", "b")
+			p.SetRepeatMode(ctx, tc.Mode)
+
+			errCh := make(chan error, 1)
+			go func() { errCh <- p.Serve(ctx) }()
+			<-player.subscribedChan
+
+			if err := p.Play(ctx, 1); err != nil {
+				t.Fatalf("unable to play: %v", err)
+			}
+			for idx, link := range tc.Expected {
+				expectOpened(t, player, link)
+				if idx < len(tc.Expected)-1 {
+					player.events.Emit(ctx, types.EventEndOfFile{})
+				}
+			}
+			if tc.Mode != RepeatModeNone {
+				cancelFn()
+				if err := <-errCh; !errors.Is(err, context.Canceled) {
+					t.Fatalf("expected context.Canceled, got %v", err)
+				}
+				return
+			}
+
+			player.events.Emit(ctx, types.EventEndOfFile{})
+			select {
+			case err := <-errCh:
+				if err != nil {
+					t.Fatalf("expected Serve to return nil at the end of the playlist, got %v", err)
+				}
+			case <-time.After(time.Minute):
+				t.Fatalf("Serve has not returned at the end of the playlist")
+			}
+		})
+	}
+}
+
+func TestPlaylistRemoveCurrent(t *testing.T) {
+	ctx := context.Background()
+	player := newFakePlayer()
+	p := newTestPlaylist(player, "a", "b", "c")
+	if err := p.Play(ctx, 1); err != nil {
+		t.Fatalf("unable to play: %v", err)
+	}
+	expectOpened(t, player, "b")
+
+	if err := p.Remove(ctx, 1); err != nil {
+		t.Fatalf("unable to remove: %v", err)
+	}
+	if idx := p.Current(ctx); idx != -1 {
+		t.Fatalf("expected no current entry, got #%d", idx)
+	}
+	// the playback continues from the entry following the removed one
+	if isEnded := p.onEvent(ctx, types.EventEndOfFile{}); isEnded {
+		t.Fatalf("expected the playback to continue")
+	}
+	expectOpened(t, player, "c")
+	if idx := p.Current(ctx); idx != 1 {
+		t.Fatalf("expected the current entry #1, got #%d", idx)
+	}
+
+	if err := p.Remove(ctx, 1); err != nil {
+		t.Fatalf("unable to remove: %v", err)
+	}
+	if err := p.Previous(ctx); err != nil {
+		t.Fatalf("unable to switch to the previous entry: %v", err)
+	}
+	expectOpened(t, player, "a")
+}
+
+func TestPlaylistRemoveBeforeCurrent(t *testing.T) {
+	ctx := context.Background()
+	player := newFakePlayer()
+	p := newTestPlaylist(player, "a", "b", "c")
+	if err := p.Play(ctx, 1); err != nil {
+		t.Fatalf("unable to play: %v", err)
+	}
+	expectOpened(t, player, "b")
+
+	if err := p.Remove(ctx, 0); err != nil {
+		t.Fatalf("unable to remove: %v", err)
+	}
+	if idx := p.Current(ctx); idx != 0 {
+		t.Fatalf("expected the current entry #0, got #%d", idx)
+	}
+	if err := p.Next(ctx); err != nil {
+		t.Fatalf("unable to switch to the next entry: %v", err)
+	}
+	expectOpened(t, player, "c")
+}
+
+func TestPlaylistMoveCurrent(t *testing.T) {
+	ctx := context.Background()
+	player := newFakePlayer()
+	p := newTestPlaylist(player, "a", "b", "c")
+	if err := p.Play(ctx, 0); err != nil {
+		t.Fatalf("unable to play: %v", err)
+	}
+	expectOpened(t, player, "a")
+
+	if err := p.Move(ctx, 0, 2); err != nil {
+		t.Fatalf("unable to move: %v", err)
+	}
+	if idx := p.Current(ctx); idx != 2 {
+		t.Fatalf("expected the current entry #2, got #%d", idx)
+	}
+	if err := p.Next(ctx); !errors.Is(err, ErrEndOfPlaylist) {
+		t.Fatalf("expected ErrEndOfPlaylist, got %v", err)
+	}
+	if err := p.Previous(ctx); err != nil {
+		t.Fatalf("unable to switch to the previous entry: %v", err)
+	}
+	expectOpened(t, player, "c")
+
+	if err := p.Move(ctx, 1, 0); err != nil {
+		t.Fatalf("unable to move: %v", err)
+	}
+	if idx := p.Current(ctx); idx != 0 {
+		t.Fatalf("expected the current entry #0, got #%d", idx)
+	}
+	if err := p.Move(ctx, 0, 3); err == nil {
+		t.Fatalf("expected an out-of-range error")
+	}
+}
+
+func TestPlaylistShuffleKeepsCurrent(t *testing.T) {
+	ctx := context.Background()
+	player := newFakePlayer()
+	links := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	p := newTestPlaylist(player, links...)
+	if err := p.Play(ctx, 5); err != nil {
+		t.Fatalf("unable to play: %v", err)
+	}
+	expectOpened(t, player, "f")
+
+	p.SetShuffle(ctx, true)
+	if idx := p.Current(ctx); idx != 5 {
+		t.Fatalf("expected the current entry #5, got #%d", idx)
+	}
+	// all the other entries are played exactly once
+	seen := map[string]bool{"f": true}
+	for range len(links) - 1 {
+		if err := p.Next(ctx); err != nil {
+			t.Fatalf("unable to switch to the next entry: %v", err)
+		}
+		link := <-player.openChan
+		if seen[link] {
+			t.Fatalf("'%s' is played twice", link)
+		}
+		seen[link] = true
+	}
+	if err := p.Next(ctx); !errors.Is(err, ErrEndOfPlaylist) {
+		t.Fatalf("expected ErrEndOfPlaylist, got %v", err)
+	}
+}
+
+func TestPlaylistPreload(t *testing.T) {
+	ctx := context.Background()
+	player := fakePreloadingPlayer{fakePlayer: newFakePlayer()}
+	p := newTestPlaylist(player, "a", "b")
+	if err := p.Play(ctx, 0); err != nil {
+		t.Fatalf("unable to play: %v", err)
+	}
+	expectOpened(t, player.fakePlayer, "a")
+	if player.preloaded != "b" {
+		t.Fatalf("expected 'b' to be preloaded, got '%s'", player.preloaded)
+	}
+
+	// the player switches to the preloaded entry without EventEndOfFile
+	p.onEvent(ctx, types.EventMediaChange{Link: "b"})
+	if idx := p.Current(ctx); idx != 1 {
+		t.Fatalf("expected the current entry #1, got #%d", idx)
+	}
+
+	// the preloaded media is consumed by the switch, so the current entry
+	// is preloaded again to be repeated
+	p.SetRepeatMode(ctx, RepeatModeOne)
+	if player.preloaded != "b" {
+		t.Fatalf("expected 'b' to be preloaded, got '%s'", player.preloaded)
+	}
+}
@@ -0,0 +1,105 @@
+package playlist
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parsePLS parses the PLS playlists, see https://en.wikipedia.org/wiki/PLS_(file_format)
+func parsePLS(s string) ([]Entry, error) {
+	entries := map[int]*Entry{}
+	getEntry := func(idx int) *Entry {
+		e := entries[idx]
+		if e == nil {
+			e = &Entry{}
+			entries[idx] = e
+		}
+		return e
+	}
+	isPlaylistSection := false
+	for lineIdx, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			isPlaylistSection = strings.EqualFold(line, "[playlist]")
+			continue
+		}
+		if !isPlaylistSection {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("expected 'key=value' at line %d, received '%s'", lineIdx+1, line)
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		var field string
+		for _, prefix := range []string{"file", "title", "length"} {
+			if strings.HasPrefix(key, prefix) {
+				field = prefix
+				break
+			}
+		}
+		if field == "" {
+			// NumberOfEntries, Version, etc.
+			continue
+		}
+		idx, err := strconv.Atoi(strings.TrimPrefix(key, field))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the index of '%s' at line %d: %w", key, lineIdx+1, err)
+		}
+		e := getEntry(idx)
+		switch field {
+		case "file":
+			e.Link = value
+		case "title":
+			e.Title = value
+		case "length":
+			secs, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse the length '%s' at line %d: %w", value, lineIdx+1, err)
+			}
+			if secs > 0 {
+				e.Duration = time.Duration(secs * float64(time.Second))
+			}
+		}
+	}
+
+	indexes := make([]int, 0, len(entries))
+	for idx := range entries {
+		indexes = append(indexes, idx)
+	}
+	slices.Sort(indexes)
+	result := make([]Entry, 0, len(entries))
+	for _, idx := range indexes {
+		e := entries[idx]
+		if e.Link == "" {
+			return nil, fmt.Errorf("entry #%d has no 'File%d'", idx, idx)
+		}
+		result = append(result, *e)
+	}
+	return result, nil
+}
+
+func formatPLS(entries []Entry) string {
+	var b strings.Builder
+	b.WriteString("[playlist]\n")
+	for idx, e := range entries {
+		secs := int64(-1)
+		if e.Duration > 0 {
+			secs = int64(e.Duration.Round(time.Second) / time.Second)
+		}
+		fmt.Fprintf(&b, "File%d=%s\n", idx+1, e.Link)
+		if e.Title != "" {
+			fmt.Fprintf(&b, "Title%d=%s\n", idx+1, e.Title)
+		}
+		fmt.Fprintf(&b, "Length%d=%d\n", idx+1, secs)
+	}
+	fmt.Fprintf(&b, "NumberOfEntries=%d\nVersion=2\n", len(entries))
+	return b.String()
+}
@@ -29,20 +29,32 @@ static const char* Player_method_names[] = {
   "/player.Player/EndChan",
   "/player.Player/IsEnded",
   "/player.Player/GetPosition",
+  "/player.Player/GetAudioPosition",
   "/player.Player/GetLength",
   "/player.Player/GetSpeed",
   "/player.Player/SetSpeed",
   "/player.Player/GetPause",
   "/player.Player/SetPause",
+  "/player.Player/GetVolume",
+  "/player.Player/SetVolume",
+  "/player.Player/GetMute",
+  "/player.Player/SetMute",
   "/player.Player/Seek",
+  "/player.Player/FrameStep",
+  "/player.Player/Screenshot",
   "/player.Player/GetVideoTracks",
   "/player.Player/GetAudioTracks",
   "/player.Player/GetSubtitlesTracks",
   "/player.Player/SetVideoTrack",
   "/player.Player/SetAudioTrack",
   "/player.Player/SetSubtitlesTrack",
+  "/player.Player/GetMediaInfo",
+  "/player.Player/GetChapters",
+  "/player.Player/GetChapter",
+  "/player.Player/SetChapter",
   "/player.Player/Stop",
   "/player.Player/Close",
+  "/player.Player/Events",
 };
 
 std::unique_ptr< Player::Stub> Player::NewStub(const std::shared_ptr< ::grpc::ChannelInterface>& channel, const ::grpc::StubOptions& options) {
@@ -59,20 +71,32 @@ Player::Stub::Stub(const std::shared_ptr< ::grpc::ChannelInterface>& channel, co
   , rpcmethod_EndChan_(Player_method_names[4], options.suffix_for_stats(),::grpc::internal::RpcMethod::SERVER_STREAMING, channel)
   , rpcmethod_IsEnded_(Player_method_names[5], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
   , rpcmethod_GetPosition_(Player_method_names[6], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
-  , rpcmethod_GetLength_(Player_method_names[7], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
-  , rpcmethod_GetSpeed_(Player_method_names[8], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
-  , rpcmethod_SetSpeed_(Player_method_names[9], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
-  , rpcmethod_GetPause_(Player_method_names[10], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
-  , rpcmethod_SetPause_(Player_method_names[11], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
-  , rpcmethod_Seek_(Player_method_names[12], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
-  , rpcmethod_GetVideoTracks_(Player_method_names[13], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
-  , rpcmethod_GetAudioTracks_(Player_method_names[14], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
-  , rpcmethod_GetSubtitlesTracks_(Player_method_names[15], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
-  , rpcmethod_SetVideoTrack_(Player_method_names[16], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
-  , rpcmethod_SetAudioTrack_(Player_method_names[17], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
-  , rpcmethod_SetSubtitlesTrack_(Player_method_names[18], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
-  , rpcmethod_Stop_(Player_method_names[19], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
-  , rpcmethod_Close_(Player_method_names[20], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_GetAudioPosition_(Player_method_names[7], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_GetLength_(Player_method_names[8], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_GetSpeed_(Player_method_names[9], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_SetSpeed_(Player_method_names[10], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_GetPause_(Player_method_names[11], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_SetPause_(Player_method_names[12], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_GetVolume_(Player_method_names[13], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_SetVolume_(Player_method_names[14], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_GetMute_(Player_method_names[15], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_SetMute_(Player_method_names[16], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_Seek_(Player_method_names[17], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_FrameStep_(Player_method_names[18], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_Screenshot_(Player_method_names[19], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_GetVideoTracks_(Player_method_names[20], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_GetAudioTracks_(Player_method_names[21], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_GetSubtitlesTracks_(Player_method_names[22], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_SetVideoTrack_(Player_method_names[23], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_SetAudioTrack_(Player_method_names[24], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_SetSubtitlesTrack_(Player_method_names[25], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_GetMediaInfo_(Player_method_names[26], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_GetChapters_(Player_method_names[27], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_GetChapter_(Player_method_names[28], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_SetChapter_(Player_method_names[29], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_Stop_(Player_method_names[30], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_Close_(Player_method_names[31], options.suffix_for_stats(),::grpc::internal::RpcMethod::NORMAL_RPC, channel)
+  , rpcmethod_Events_(Player_method_names[32], options.suffix_for_stats(),::grpc::internal::RpcMethod::SERVER_STREAMING, channel)
   {}
 
 ::grpc::Status Player::Stub::Open(::grpc::ClientContext* context, const ::player::OpenRequest& request, ::player::OpenReply* response) {
@@ -229,6 +253,29 @@ void Player::Stub::async::GetPosition(::grpc::ClientContext* context, const ::pl
   return result;
 }
 
+::grpc::Status Player::Stub::GetAudioPosition(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest& request, ::player::GetAudioPositionReply* response) {
+  return ::grpc::internal::BlockingUnaryCall< ::player::GetAudioPositionRequest, ::player::GetAudioPositionReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), rpcmethod_GetAudioPosition_, context, request, response);
+}
+
+void Player::Stub::async::GetAudioPosition(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest* request, ::player::GetAudioPositionReply* response, std::function<void(::grpc::Status)> f) {
+  ::grpc::internal::CallbackUnaryCall< ::player::GetAudioPositionRequest, ::player::GetAudioPositionReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_GetAudioPosition_, context, request, response, std::move(f));
+}
+
+void Player::Stub::async::GetAudioPosition(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest* request, ::player::GetAudioPositionReply* response, ::grpc::ClientUnaryReactor* reactor) {
+  ::grpc::internal::ClientCallbackUnaryFactory::Create< ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_GetAudioPosition_, context, request, response, reactor);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::GetAudioPositionReply>* Player::Stub::PrepareAsyncGetAudioPositionRaw(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderHelper::Create< ::player::GetAudioPositionReply, ::player::GetAudioPositionRequest, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), cq, rpcmethod_GetAudioPosition_, context, request);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::GetAudioPositionReply>* Player::Stub::AsyncGetAudioPositionRaw(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest& request, ::grpc::CompletionQueue* cq) {
+  auto* result =
+    this->PrepareAsyncGetAudioPositionRaw(context, request, cq);
+  result->StartCall();
+  return result;
+}
+
 ::grpc::Status Player::Stub::GetLength(::grpc::ClientContext* context, const ::player::GetLengthRequest& request, ::player::GetLengthReply* response) {
   return ::grpc::internal::BlockingUnaryCall< ::player::GetLengthRequest, ::player::GetLengthReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), rpcmethod_GetLength_, context, request, response);
 }
@@ -344,6 +391,98 @@ void Player::Stub::async::SetPause(::grpc::ClientContext* context, const ::playe
   return result;
 }
 
+::grpc::Status Player::Stub::GetVolume(::grpc::ClientContext* context, const ::player::GetVolumeRequest& request, ::player::GetVolumeReply* response) {
+  return ::grpc::internal::BlockingUnaryCall< ::player::GetVolumeRequest, ::player::GetVolumeReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), rpcmethod_GetVolume_, context, request, response);
+}
+
+void Player::Stub::async::GetVolume(::grpc::ClientContext* context, const ::player::GetVolumeRequest* request, ::player::GetVolumeReply* response, std::function<void(::grpc::Status)> f) {
+  ::grpc::internal::CallbackUnaryCall< ::player::GetVolumeRequest, ::player::GetVolumeReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_GetVolume_, context, request, response, std::move(f));
+}
+
+void Player::Stub::async::GetVolume(::grpc::ClientContext* context, const ::player::GetVolumeRequest* request, ::player::GetVolumeReply* response, ::grpc::ClientUnaryReactor* reactor) {
+  ::grpc::internal::ClientCallbackUnaryFactory::Create< ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_GetVolume_, context, request, response, reactor);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::GetVolumeReply>* Player::Stub::PrepareAsyncGetVolumeRaw(::grpc::ClientContext* context, const ::player::GetVolumeRequest& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderHelper::Create< ::player::GetVolumeReply, ::player::GetVolumeRequest, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), cq, rpcmethod_GetVolume_, context, request);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::GetVolumeReply>* Player::Stub::AsyncGetVolumeRaw(::grpc::ClientContext* context, const ::player::GetVolumeRequest& request, ::grpc::CompletionQueue* cq) {
+  auto* result =
+    this->PrepareAsyncGetVolumeRaw(context, request, cq);
+  result->StartCall();
+  return result;
+}
+
+::grpc::Status Player::Stub::SetVolume(::grpc::ClientContext* context, const ::player::SetVolumeRequest& request, ::player::SetVolumeReply* response) {
+  return ::grpc::internal::BlockingUnaryCall< ::player::SetVolumeRequest, ::player::SetVolumeReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), rpcmethod_SetVolume_, context, request, response);
+}
+
+void Player::Stub::async::SetVolume(::grpc::ClientContext* context, const ::player::SetVolumeRequest* request, ::player::SetVolumeReply* response, std::function<void(::grpc::Status)> f) {
+  ::grpc::internal::CallbackUnaryCall< ::player::SetVolumeRequest, ::player::SetVolumeReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_SetVolume_, context, request, response, std::move(f));
+}
+
+void Player::Stub::async::SetVolume(::grpc::ClientContext* context, const ::player::SetVolumeRequest* request, ::player::SetVolumeReply* response, ::grpc::ClientUnaryReactor* reactor) {
+  ::grpc::internal::ClientCallbackUnaryFactory::Create< ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_SetVolume_, context, request, response, reactor);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::SetVolumeReply>* Player::Stub::PrepareAsyncSetVolumeRaw(::grpc::ClientContext* context, const ::player::SetVolumeRequest& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderHelper::Create< ::player::SetVolumeReply, ::player::SetVolumeRequest, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), cq, rpcmethod_SetVolume_, context, request);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::SetVolumeReply>* Player::Stub::AsyncSetVolumeRaw(::grpc::ClientContext* context, const ::player::SetVolumeRequest& request, ::grpc::CompletionQueue* cq) {
+  auto* result =
+    this->PrepareAsyncSetVolumeRaw(context, request, cq);
+  result->StartCall();
+  return result;
+}
+
+::grpc::Status Player::Stub::GetMute(::grpc::ClientContext* context, const ::player::GetMuteRequest& request, ::player::GetMuteReply* response) {
+  return ::grpc::internal::BlockingUnaryCall< ::player::GetMuteRequest, ::player::GetMuteReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), rpcmethod_GetMute_, context, request, response);
+}
+
+void Player::Stub::async::GetMute(::grpc::ClientContext* context, const ::player::GetMuteRequest* request, ::player::GetMuteReply* response, std::function<void(::grpc::Status)> f) {
+  ::grpc::internal::CallbackUnaryCall< ::player::GetMuteRequest, ::player::GetMuteReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_GetMute_, context, request, response, std::move(f));
+}
+
+void Player::Stub::async::GetMute(::grpc::ClientContext* context, const ::player::GetMuteRequest* request, ::player::GetMuteReply* response, ::grpc::ClientUnaryReactor* reactor) {
+  ::grpc::internal::ClientCallbackUnaryFactory::Create< ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_GetMute_, context, request, response, reactor);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::GetMuteReply>* Player::Stub::PrepareAsyncGetMuteRaw(::grpc::ClientContext* context, const ::player::GetMuteRequest& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderHelper::Create< ::player::GetMuteReply, ::player::GetMuteRequest, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), cq, rpcmethod_GetMute_, context, request);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::GetMuteReply>* Player::Stub::AsyncGetMuteRaw(::grpc::ClientContext* context, const ::player::GetMuteRequest& request, ::grpc::CompletionQueue* cq) {
+  auto* result =
+    this->PrepareAsyncGetMuteRaw(context, request, cq);
+  result->StartCall();
+  return result;
+}
+
+::grpc::Status Player::Stub::SetMute(::grpc::ClientContext* context, const ::player::SetMuteRequest& request, ::player::SetMuteReply* response) {
+  return ::grpc::internal::BlockingUnaryCall< ::player::SetMuteRequest, ::player::SetMuteReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), rpcmethod_SetMute_, context, request, response);
+}
+
+void Player::Stub::async::SetMute(::grpc::ClientContext* context, const ::player::SetMuteRequest* request, ::player::SetMuteReply* response, std::function<void(::grpc::Status)> f) {
+  ::grpc::internal::CallbackUnaryCall< ::player::SetMuteRequest, ::player::SetMuteReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_SetMute_, context, request, response, std::move(f));
+}
+
+void Player::Stub::async::SetMute(::grpc::ClientContext* context, const ::player::SetMuteRequest* request, ::player::SetMuteReply* response, ::grpc::ClientUnaryReactor* reactor) {
+  ::grpc::internal::ClientCallbackUnaryFactory::Create< ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_SetMute_, context, request, response, reactor);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::SetMuteReply>* Player::Stub::PrepareAsyncSetMuteRaw(::grpc::ClientContext* context, const ::player::SetMuteRequest& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderHelper::Create< ::player::SetMuteReply, ::player::SetMuteRequest, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), cq, rpcmethod_SetMute_, context, request);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::SetMuteReply>* Player::Stub::AsyncSetMuteRaw(::grpc::ClientContext* context, const ::player::SetMuteRequest& request, ::grpc::CompletionQueue* cq) {
+  auto* result =
+    this->PrepareAsyncSetMuteRaw(context, request, cq);
+  result->StartCall();
+  return result;
+}
+
 ::grpc::Status Player::Stub::Seek(::grpc::ClientContext* context, const ::player::SeekRequest& request, ::player::SeekReply* response) {
   return ::grpc::internal::BlockingUnaryCall< ::player::SeekRequest, ::player::SeekReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), rpcmethod_Seek_, context, request, response);
 }
@@ -367,6 +506,52 @@ void Player::Stub::async::Seek(::grpc::ClientContext* context, const ::player::S
   return result;
 }
 
+::grpc::Status Player::Stub::FrameStep(::grpc::ClientContext* context, const ::player::FrameStepRequest& request, ::player::FrameStepReply* response) {
+  return ::grpc::internal::BlockingUnaryCall< ::player::FrameStepRequest, ::player::FrameStepReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), rpcmethod_FrameStep_, context, request, response);
+}
+
+void Player::Stub::async::FrameStep(::grpc::ClientContext* context, const ::player::FrameStepRequest* request, ::player::FrameStepReply* response, std::function<void(::grpc::Status)> f) {
+  ::grpc::internal::CallbackUnaryCall< ::player::FrameStepRequest, ::player::FrameStepReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_FrameStep_, context, request, response, std::move(f));
+}
+
+void Player::Stub::async::FrameStep(::grpc::ClientContext* context, const ::player::FrameStepRequest* request, ::player::FrameStepReply* response, ::grpc::ClientUnaryReactor* reactor) {
+  ::grpc::internal::ClientCallbackUnaryFactory::Create< ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_FrameStep_, context, request, response, reactor);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::FrameStepReply>* Player::Stub::PrepareAsyncFrameStepRaw(::grpc::ClientContext* context, const ::player::FrameStepRequest& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderHelper::Create< ::player::FrameStepReply, ::player::FrameStepRequest, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), cq, rpcmethod_FrameStep_, context, request);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::FrameStepReply>* Player::Stub::AsyncFrameStepRaw(::grpc::ClientContext* context, const ::player::FrameStepRequest& request, ::grpc::CompletionQueue* cq) {
+  auto* result =
+    this->PrepareAsyncFrameStepRaw(context, request, cq);
+  result->StartCall();
+  return result;
+}
+
+::grpc::Status Player::Stub::Screenshot(::grpc::ClientContext* context, const ::player::ScreenshotRequest& request, ::player::ScreenshotReply* response) {
+  return ::grpc::internal::BlockingUnaryCall< ::player::ScreenshotRequest, ::player::ScreenshotReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), rpcmethod_Screenshot_, context, request, response);
+}
+
+void Player::Stub::async::Screenshot(::grpc::ClientContext* context, const ::player::ScreenshotRequest* request, ::player::ScreenshotReply* response, std::function<void(::grpc::Status)> f) {
+  ::grpc::internal::CallbackUnaryCall< ::player::ScreenshotRequest, ::player::ScreenshotReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_Screenshot_, context, request, response, std::move(f));
+}
+
+void Player::Stub::async::Screenshot(::grpc::ClientContext* context, const ::player::ScreenshotRequest* request, ::player::ScreenshotReply* response, ::grpc::ClientUnaryReactor* reactor) {
+  ::grpc::internal::ClientCallbackUnaryFactory::Create< ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_Screenshot_, context, request, response, reactor);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::ScreenshotReply>* Player::Stub::PrepareAsyncScreenshotRaw(::grpc::ClientContext* context, const ::player::ScreenshotRequest& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderHelper::Create< ::player::ScreenshotReply, ::player::ScreenshotRequest, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), cq, rpcmethod_Screenshot_, context, request);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::ScreenshotReply>* Player::Stub::AsyncScreenshotRaw(::grpc::ClientContext* context, const ::player::ScreenshotRequest& request, ::grpc::CompletionQueue* cq) {
+  auto* result =
+    this->PrepareAsyncScreenshotRaw(context, request, cq);
+  result->StartCall();
+  return result;
+}
+
 ::grpc::Status Player::Stub::GetVideoTracks(::grpc::ClientContext* context, const ::player::GetVideoTracksRequest& request, ::player::GetVideoTracksReply* response) {
   return ::grpc::internal::BlockingUnaryCall< ::player::GetVideoTracksRequest, ::player::GetVideoTracksReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), rpcmethod_GetVideoTracks_, context, request, response);
 }
@@ -505,6 +690,98 @@ void Player::Stub::async::SetSubtitlesTrack(::grpc::ClientContext* context, cons
   return result;
 }
 
+::grpc::Status Player::Stub::GetMediaInfo(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest& request, ::player::GetMediaInfoReply* response) {
+  return ::grpc::internal::BlockingUnaryCall< ::player::GetMediaInfoRequest, ::player::GetMediaInfoReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), rpcmethod_GetMediaInfo_, context, request, response);
+}
+
+void Player::Stub::async::GetMediaInfo(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest* request, ::player::GetMediaInfoReply* response, std::function<void(::grpc::Status)> f) {
+  ::grpc::internal::CallbackUnaryCall< ::player::GetMediaInfoRequest, ::player::GetMediaInfoReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_GetMediaInfo_, context, request, response, std::move(f));
+}
+
+void Player::Stub::async::GetMediaInfo(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest* request, ::player::GetMediaInfoReply* response, ::grpc::ClientUnaryReactor* reactor) {
+  ::grpc::internal::ClientCallbackUnaryFactory::Create< ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_GetMediaInfo_, context, request, response, reactor);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::GetMediaInfoReply>* Player::Stub::PrepareAsyncGetMediaInfoRaw(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderHelper::Create< ::player::GetMediaInfoReply, ::player::GetMediaInfoRequest, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), cq, rpcmethod_GetMediaInfo_, context, request);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::GetMediaInfoReply>* Player::Stub::AsyncGetMediaInfoRaw(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest& request, ::grpc::CompletionQueue* cq) {
+  auto* result =
+    this->PrepareAsyncGetMediaInfoRaw(context, request, cq);
+  result->StartCall();
+  return result;
+}
+
+::grpc::Status Player::Stub::GetChapters(::grpc::ClientContext* context, const ::player::GetChaptersRequest& request, ::player::GetChaptersReply* response) {
+  return ::grpc::internal::BlockingUnaryCall< ::player::GetChaptersRequest, ::player::GetChaptersReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), rpcmethod_GetChapters_, context, request, response);
+}
+
+void Player::Stub::async::GetChapters(::grpc::ClientContext* context, const ::player::GetChaptersRequest* request, ::player::GetChaptersReply* response, std::function<void(::grpc::Status)> f) {
+  ::grpc::internal::CallbackUnaryCall< ::player::GetChaptersRequest, ::player::GetChaptersReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_GetChapters_, context, request, response, std::move(f));
+}
+
+void Player::Stub::async::GetChapters(::grpc::ClientContext* context, const ::player::GetChaptersRequest* request, ::player::GetChaptersReply* response, ::grpc::ClientUnaryReactor* reactor) {
+  ::grpc::internal::ClientCallbackUnaryFactory::Create< ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_GetChapters_, context, request, response, reactor);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::GetChaptersReply>* Player::Stub::PrepareAsyncGetChaptersRaw(::grpc::ClientContext* context, const ::player::GetChaptersRequest& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderHelper::Create< ::player::GetChaptersReply, ::player::GetChaptersRequest, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), cq, rpcmethod_GetChapters_, context, request);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::GetChaptersReply>* Player::Stub::AsyncGetChaptersRaw(::grpc::ClientContext* context, const ::player::GetChaptersRequest& request, ::grpc::CompletionQueue* cq) {
+  auto* result =
+    this->PrepareAsyncGetChaptersRaw(context, request, cq);
+  result->StartCall();
+  return result;
+}
+
+::grpc::Status Player::Stub::GetChapter(::grpc::ClientContext* context, const ::player::GetChapterRequest& request, ::player::GetChapterReply* response) {
+  return ::grpc::internal::BlockingUnaryCall< ::player::GetChapterRequest, ::player::GetChapterReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), rpcmethod_GetChapter_, context, request, response);
+}
+
+void Player::Stub::async::GetChapter(::grpc::ClientContext* context, const ::player::GetChapterRequest* request, ::player::GetChapterReply* response, std::function<void(::grpc::Status)> f) {
+  ::grpc::internal::CallbackUnaryCall< ::player::GetChapterRequest, ::player::GetChapterReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_GetChapter_, context, request, response, std::move(f));
+}
+
+void Player::Stub::async::GetChapter(::grpc::ClientContext* context, const ::player::GetChapterRequest* request, ::player::GetChapterReply* response, ::grpc::ClientUnaryReactor* reactor) {
+  ::grpc::internal::ClientCallbackUnaryFactory::Create< ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_GetChapter_, context, request, response, reactor);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::GetChapterReply>* Player::Stub::PrepareAsyncGetChapterRaw(::grpc::ClientContext* context, const ::player::GetChapterRequest& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderHelper::Create< ::player::GetChapterReply, ::player::GetChapterRequest, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), cq, rpcmethod_GetChapter_, context, request);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::GetChapterReply>* Player::Stub::AsyncGetChapterRaw(::grpc::ClientContext* context, const ::player::GetChapterRequest& request, ::grpc::CompletionQueue* cq) {
+  auto* result =
+    this->PrepareAsyncGetChapterRaw(context, request, cq);
+  result->StartCall();
+  return result;
+}
+
+::grpc::Status Player::Stub::SetChapter(::grpc::ClientContext* context, const ::player::SetChapterRequest& request, ::player::SetChapterReply* response) {
+  return ::grpc::internal::BlockingUnaryCall< ::player::SetChapterRequest, ::player::SetChapterReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), rpcmethod_SetChapter_, context, request, response);
+}
+
+void Player::Stub::async::SetChapter(::grpc::ClientContext* context, const ::player::SetChapterRequest* request, ::player::SetChapterReply* response, std::function<void(::grpc::Status)> f) {
+  ::grpc::internal::CallbackUnaryCall< ::player::SetChapterRequest, ::player::SetChapterReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_SetChapter_, context, request, response, std::move(f));
+}
+
+void Player::Stub::async::SetChapter(::grpc::ClientContext* context, const ::player::SetChapterRequest* request, ::player::SetChapterReply* response, ::grpc::ClientUnaryReactor* reactor) {
+  ::grpc::internal::ClientCallbackUnaryFactory::Create< ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(stub_->channel_.get(), stub_->rpcmethod_SetChapter_, context, request, response, reactor);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::SetChapterReply>* Player::Stub::PrepareAsyncSetChapterRaw(::grpc::ClientContext* context, const ::player::SetChapterRequest& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncResponseReaderHelper::Create< ::player::SetChapterReply, ::player::SetChapterRequest, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), cq, rpcmethod_SetChapter_, context, request);
+}
+
+::grpc::ClientAsyncResponseReader< ::player::SetChapterReply>* Player::Stub::AsyncSetChapterRaw(::grpc::ClientContext* context, const ::player::SetChapterRequest& request, ::grpc::CompletionQueue* cq) {
+  auto* result =
+    this->PrepareAsyncSetChapterRaw(context, request, cq);
+  result->StartCall();
+  return result;
+}
+
 ::grpc::Status Player::Stub::Stop(::grpc::ClientContext* context, const ::player::StopRequest& request, ::player::StopReply* response) {
   return ::grpc::internal::BlockingUnaryCall< ::player::StopRequest, ::player::StopReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(channel_.get(), rpcmethod_Stop_, context, request, response);
 }
@@ -551,6 +828,22 @@ void Player::Stub::async::Close(::grpc::ClientContext* context, const ::player::
   return result;
 }
 
+::grpc::ClientReader< ::player::Event>* Player::Stub::EventsRaw(::grpc::ClientContext* context, const ::player::EventsRequest& request) {
+  return ::grpc::internal::ClientReaderFactory< ::player::Event>::Create(channel_.get(), rpcmethod_Events_, context, request);
+}
+
+void Player::Stub::async::Events(::grpc::ClientContext* context, const ::player::EventsRequest* request, ::grpc::ClientReadReactor< ::player::Event>* reactor) {
+  ::grpc::internal::ClientCallbackReaderFactory< ::player::Event>::Create(stub_->channel_.get(), stub_->rpcmethod_Events_, context, request, reactor);
+}
+
+::grpc::ClientAsyncReader< ::player::Event>* Player::Stub::AsyncEventsRaw(::grpc::ClientContext* context, const ::player::EventsRequest& request, ::grpc::CompletionQueue* cq, void* tag) {
+  return ::grpc::internal::ClientAsyncReaderFactory< ::player::Event>::Create(channel_.get(), cq, rpcmethod_Events_, context, request, true, tag);
+}
+
+::grpc::ClientAsyncReader< ::player::Event>* Player::Stub::PrepareAsyncEventsRaw(::grpc::ClientContext* context, const ::player::EventsRequest& request, ::grpc::CompletionQueue* cq) {
+  return ::grpc::internal::ClientAsyncReaderFactory< ::player::Event>::Create(channel_.get(), cq, rpcmethod_Events_, context, request, false, nullptr);
+}
+
 Player::Service::Service() {
   AddMethod(new ::grpc::internal::RpcServiceMethod(
       Player_method_names[0],
@@ -625,6 +918,16 @@ Player::Service::Service() {
   AddMethod(new ::grpc::internal::RpcServiceMethod(
       Player_method_names[7],
       ::grpc::internal::RpcMethod::NORMAL_RPC,
+      new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::GetAudioPositionRequest, ::player::GetAudioPositionReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
+          [](Player::Service* service,
+             ::grpc::ServerContext* ctx,
+             const ::player::GetAudioPositionRequest* req,
+             ::player::GetAudioPositionReply* resp) {
+               return service->GetAudioPosition(ctx, req, resp);
+             }, this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      Player_method_names[8],
+      ::grpc::internal::RpcMethod::NORMAL_RPC,
       new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::GetLengthRequest, ::player::GetLengthReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
           [](Player::Service* service,
              ::grpc::ServerContext* ctx,
@@ -633,7 +936,7 @@ Player::Service::Service() {
                return service->GetLength(ctx, req, resp);
              }, this)));
   AddMethod(new ::grpc::internal::RpcServiceMethod(
-      Player_method_names[8],
+      Player_method_names[9],
       ::grpc::internal::RpcMethod::NORMAL_RPC,
       new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::GetSpeedRequest, ::player::GetSpeedReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
           [](Player::Service* service,
@@ -643,7 +946,7 @@ Player::Service::Service() {
                return service->GetSpeed(ctx, req, resp);
              }, this)));
   AddMethod(new ::grpc::internal::RpcServiceMethod(
-      Player_method_names[9],
+      Player_method_names[10],
       ::grpc::internal::RpcMethod::NORMAL_RPC,
       new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::SetSpeedRequest, ::player::SetSpeedReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
           [](Player::Service* service,
@@ -653,7 +956,7 @@ Player::Service::Service() {
                return service->SetSpeed(ctx, req, resp);
              }, this)));
   AddMethod(new ::grpc::internal::RpcServiceMethod(
-      Player_method_names[10],
+      Player_method_names[11],
       ::grpc::internal::RpcMethod::NORMAL_RPC,
       new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::GetPauseRequest, ::player::GetPauseReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
           [](Player::Service* service,
@@ -663,7 +966,7 @@ Player::Service::Service() {
                return service->GetPause(ctx, req, resp);
              }, this)));
   AddMethod(new ::grpc::internal::RpcServiceMethod(
-      Player_method_names[11],
+      Player_method_names[12],
       ::grpc::internal::RpcMethod::NORMAL_RPC,
       new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::SetPauseRequest, ::player::SetPauseReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
           [](Player::Service* service,
@@ -673,7 +976,47 @@ Player::Service::Service() {
                return service->SetPause(ctx, req, resp);
              }, this)));
   AddMethod(new ::grpc::internal::RpcServiceMethod(
-      Player_method_names[12],
+      Player_method_names[13],
+      ::grpc::internal::RpcMethod::NORMAL_RPC,
+      new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::GetVolumeRequest, ::player::GetVolumeReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
+          [](Player::Service* service,
+             ::grpc::ServerContext* ctx,
+             const ::player::GetVolumeRequest* req,
+             ::player::GetVolumeReply* resp) {
+               return service->GetVolume(ctx, req, resp);
+             }, this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      Player_method_names[14],
+      ::grpc::internal::RpcMethod::NORMAL_RPC,
+      new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::SetVolumeRequest, ::player::SetVolumeReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
+          [](Player::Service* service,
+             ::grpc::ServerContext* ctx,
+             const ::player::SetVolumeRequest* req,
+             ::player::SetVolumeReply* resp) {
+               return service->SetVolume(ctx, req, resp);
+             }, this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      Player_method_names[15],
+      ::grpc::internal::RpcMethod::NORMAL_RPC,
+      new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::GetMuteRequest, ::player::GetMuteReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
+          [](Player::Service* service,
+             ::grpc::ServerContext* ctx,
+             const ::player::GetMuteRequest* req,
+             ::player::GetMuteReply* resp) {
+               return service->GetMute(ctx, req, resp);
+             }, this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      Player_method_names[16],
+      ::grpc::internal::RpcMethod::NORMAL_RPC,
+      new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::SetMuteRequest, ::player::SetMuteReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
+          [](Player::Service* service,
+             ::grpc::ServerContext* ctx,
+             const ::player::SetMuteRequest* req,
+             ::player::SetMuteReply* resp) {
+               return service->SetMute(ctx, req, resp);
+             }, this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      Player_method_names[17],
       ::grpc::internal::RpcMethod::NORMAL_RPC,
       new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::SeekRequest, ::player::SeekReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
           [](Player::Service* service,
@@ -683,7 +1026,27 @@ Player::Service::Service() {
                return service->Seek(ctx, req, resp);
              }, this)));
   AddMethod(new ::grpc::internal::RpcServiceMethod(
-      Player_method_names[13],
+      Player_method_names[18],
+      ::grpc::internal::RpcMethod::NORMAL_RPC,
+      new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::FrameStepRequest, ::player::FrameStepReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
+          [](Player::Service* service,
+             ::grpc::ServerContext* ctx,
+             const ::player::FrameStepRequest* req,
+             ::player::FrameStepReply* resp) {
+               return service->FrameStep(ctx, req, resp);
+             }, this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      Player_method_names[19],
+      ::grpc::internal::RpcMethod::NORMAL_RPC,
+      new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::ScreenshotRequest, ::player::ScreenshotReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
+          [](Player::Service* service,
+             ::grpc::ServerContext* ctx,
+             const ::player::ScreenshotRequest* req,
+             ::player::ScreenshotReply* resp) {
+               return service->Screenshot(ctx, req, resp);
+             }, this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      Player_method_names[20],
       ::grpc::internal::RpcMethod::NORMAL_RPC,
       new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::GetVideoTracksRequest, ::player::GetVideoTracksReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
           [](Player::Service* service,
@@ -693,7 +1056,7 @@ Player::Service::Service() {
                return service->GetVideoTracks(ctx, req, resp);
              }, this)));
   AddMethod(new ::grpc::internal::RpcServiceMethod(
-      Player_method_names[14],
+      Player_method_names[21],
       ::grpc::internal::RpcMethod::NORMAL_RPC,
       new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::GetAudioTracksRequest, ::player::GetAudioTracksReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
           [](Player::Service* service,
@@ -703,7 +1066,7 @@ Player::Service::Service() {
                return service->GetAudioTracks(ctx, req, resp);
              }, this)));
   AddMethod(new ::grpc::internal::RpcServiceMethod(
-      Player_method_names[15],
+      Player_method_names[22],
       ::grpc::internal::RpcMethod::NORMAL_RPC,
       new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::GetSubtitlesTracksRequest, ::player::GetSubtitlesTracksReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
           [](Player::Service* service,
@@ -713,7 +1076,7 @@ Player::Service::Service() {
                return service->GetSubtitlesTracks(ctx, req, resp);
              }, this)));
   AddMethod(new ::grpc::internal::RpcServiceMethod(
-      Player_method_names[16],
+      Player_method_names[23],
       ::grpc::internal::RpcMethod::NORMAL_RPC,
       new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::SetVideoTrackRequest, ::player::SetVideoTrackReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
           [](Player::Service* service,
@@ -723,7 +1086,7 @@ Player::Service::Service() {
                return service->SetVideoTrack(ctx, req, resp);
              }, this)));
   AddMethod(new ::grpc::internal::RpcServiceMethod(
-      Player_method_names[17],
+      Player_method_names[24],
       ::grpc::internal::RpcMethod::NORMAL_RPC,
       new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::SetAudioTrackRequest, ::player::SetAudioTrackReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
           [](Player::Service* service,
@@ -733,7 +1096,7 @@ Player::Service::Service() {
                return service->SetAudioTrack(ctx, req, resp);
              }, this)));
   AddMethod(new ::grpc::internal::RpcServiceMethod(
-      Player_method_names[18],
+      Player_method_names[25],
       ::grpc::internal::RpcMethod::NORMAL_RPC,
       new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::SetSubtitlesTrackRequest, ::player::SetSubtitlesTrackReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
           [](Player::Service* service,
@@ -743,7 +1106,47 @@ Player::Service::Service() {
                return service->SetSubtitlesTrack(ctx, req, resp);
              }, this)));
   AddMethod(new ::grpc::internal::RpcServiceMethod(
-      Player_method_names[19],
+      Player_method_names[26],
+      ::grpc::internal::RpcMethod::NORMAL_RPC,
+      new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::GetMediaInfoRequest, ::player::GetMediaInfoReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
+          [](Player::Service* service,
+             ::grpc::ServerContext* ctx,
+             const ::player::GetMediaInfoRequest* req,
+             ::player::GetMediaInfoReply* resp) {
+               return service->GetMediaInfo(ctx, req, resp);
+             }, this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      Player_method_names[27],
+      ::grpc::internal::RpcMethod::NORMAL_RPC,
+      new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::GetChaptersRequest, ::player::GetChaptersReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
+          [](Player::Service* service,
+             ::grpc::ServerContext* ctx,
+             const ::player::GetChaptersRequest* req,
+             ::player::GetChaptersReply* resp) {
+               return service->GetChapters(ctx, req, resp);
+             }, this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      Player_method_names[28],
+      ::grpc::internal::RpcMethod::NORMAL_RPC,
+      new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::GetChapterRequest, ::player::GetChapterReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
+          [](Player::Service* service,
+             ::grpc::ServerContext* ctx,
+             const ::player::GetChapterRequest* req,
+             ::player::GetChapterReply* resp) {
+               return service->GetChapter(ctx, req, resp);
+             }, this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      Player_method_names[29],
+      ::grpc::internal::RpcMethod::NORMAL_RPC,
+      new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::SetChapterRequest, ::player::SetChapterReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
+          [](Player::Service* service,
+             ::grpc::ServerContext* ctx,
+             const ::player::SetChapterRequest* req,
+             ::player::SetChapterReply* resp) {
+               return service->SetChapter(ctx, req, resp);
+             }, this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      Player_method_names[30],
       ::grpc::internal::RpcMethod::NORMAL_RPC,
       new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::StopRequest, ::player::StopReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
           [](Player::Service* service,
@@ -753,7 +1156,7 @@ Player::Service::Service() {
                return service->Stop(ctx, req, resp);
              }, this)));
   AddMethod(new ::grpc::internal::RpcServiceMethod(
-      Player_method_names[20],
+      Player_method_names[31],
       ::grpc::internal::RpcMethod::NORMAL_RPC,
       new ::grpc::internal::RpcMethodHandler< Player::Service, ::player::CloseRequest, ::player::CloseReply, ::grpc::protobuf::MessageLite, ::grpc::protobuf::MessageLite>(
           [](Player::Service* service,
@@ -762,6 +1165,16 @@ Player::Service::Service() {
              ::player::CloseReply* resp) {
                return service->Close(ctx, req, resp);
              }, this)));
+  AddMethod(new ::grpc::internal::RpcServiceMethod(
+      Player_method_names[32],
+      ::grpc::internal::RpcMethod::SERVER_STREAMING,
+      new ::grpc::internal::ServerStreamingHandler< Player::Service, ::player::EventsRequest, ::player::Event>(
+          [](Player::Service* service,
+             ::grpc::ServerContext* ctx,
+             const ::player::EventsRequest* req,
+             ::grpc::ServerWriter<::player::Event>* writer) {
+               return service->Events(ctx, req, writer);
+             }, this)));
 }
 
 Player::Service::~Service() {
@@ -816,6 +1229,13 @@ Player::Service::~Service() {
   return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
 }
 
+::grpc::Status Player::Service::GetAudioPosition(::grpc::ServerContext* context, const ::player::GetAudioPositionRequest* request, ::player::GetAudioPositionReply* response) {
+  (void) context;
+  (void) request;
+  (void) response;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
 ::grpc::Status Player::Service::GetLength(::grpc::ServerContext* context, const ::player::GetLengthRequest* request, ::player::GetLengthReply* response) {
   (void) context;
   (void) request;
@@ -851,6 +1271,34 @@ Player::Service::~Service() {
   return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
 }
 
+::grpc::Status Player::Service::GetVolume(::grpc::ServerContext* context, const ::player::GetVolumeRequest* request, ::player::GetVolumeReply* response) {
+  (void) context;
+  (void) request;
+  (void) response;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
+::grpc::Status Player::Service::SetVolume(::grpc::ServerContext* context, const ::player::SetVolumeRequest* request, ::player::SetVolumeReply* response) {
+  (void) context;
+  (void) request;
+  (void) response;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
+::grpc::Status Player::Service::GetMute(::grpc::ServerContext* context, const ::player::GetMuteRequest* request, ::player::GetMuteReply* response) {
+  (void) context;
+  (void) request;
+  (void) response;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
+::grpc::Status Player::Service::SetMute(::grpc::ServerContext* context, const ::player::SetMuteRequest* request, ::player::SetMuteReply* response) {
+  (void) context;
+  (void) request;
+  (void) response;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
 ::grpc::Status Player::Service::Seek(::grpc::ServerContext* context, const ::player::SeekRequest* request, ::player::SeekReply* response) {
   (void) context;
   (void) request;
@@ -858,6 +1306,20 @@ Player::Service::~Service() {
   return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
 }
 
+::grpc::Status Player::Service::FrameStep(::grpc::ServerContext* context, const ::player::FrameStepRequest* request, ::player::FrameStepReply* response) {
+  (void) context;
+  (void) request;
+  (void) response;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
+::grpc::Status Player::Service::Screenshot(::grpc::ServerContext* context, const ::player::ScreenshotRequest* request, ::player::ScreenshotReply* response) {
+  (void) context;
+  (void) request;
+  (void) response;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
 ::grpc::Status Player::Service::GetVideoTracks(::grpc::ServerContext* context, const ::player::GetVideoTracksRequest* request, ::player::GetVideoTracksReply* response) {
   (void) context;
   (void) request;
@@ -900,6 +1362,34 @@ Player::Service::~Service() {
   return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
 }
 
+::grpc::Status Player::Service::GetMediaInfo(::grpc::ServerContext* context, const ::player::GetMediaInfoRequest* request, ::player::GetMediaInfoReply* response) {
+  (void) context;
+  (void) request;
+  (void) response;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
+::grpc::Status Player::Service::GetChapters(::grpc::ServerContext* context, const ::player::GetChaptersRequest* request, ::player::GetChaptersReply* response) {
+  (void) context;
+  (void) request;
+  (void) response;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
+::grpc::Status Player::Service::GetChapter(::grpc::ServerContext* context, const ::player::GetChapterRequest* request, ::player::GetChapterReply* response) {
+  (void) context;
+  (void) request;
+  (void) response;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
+::grpc::Status Player::Service::SetChapter(::grpc::ServerContext* context, const ::player::SetChapterRequest* request, ::player::SetChapterReply* response) {
+  (void) context;
+  (void) request;
+  (void) response;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
 ::grpc::Status Player::Service::Stop(::grpc::ServerContext* context, const ::player::StopRequest* request, ::player::StopReply* response) {
   (void) context;
   (void) request;
@@ -914,6 +1404,13 @@ Player::Service::~Service() {
   return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
 }
 
+::grpc::Status Player::Service::Events(::grpc::ServerContext* context, const ::player::EventsRequest* request, ::grpc::ServerWriter< ::player::Event>* writer) {
+  (void) context;
+  (void) request;
+  (void) writer;
+  return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+}
+
 
 }  // namespace player
 
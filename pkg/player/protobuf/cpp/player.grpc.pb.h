@@ -86,6 +86,13 @@ class Player final {
     std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetPositionReply>> PrepareAsyncGetPosition(::grpc::ClientContext* context, const ::player::GetPositionRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetPositionReply>>(PrepareAsyncGetPositionRaw(context, request, cq));
     }
+    virtual ::grpc::Status GetAudioPosition(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest& request, ::player::GetAudioPositionReply* response) = 0;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetAudioPositionReply>> AsyncGetAudioPosition(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetAudioPositionReply>>(AsyncGetAudioPositionRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetAudioPositionReply>> PrepareAsyncGetAudioPosition(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetAudioPositionReply>>(PrepareAsyncGetAudioPositionRaw(context, request, cq));
+    }
     virtual ::grpc::Status GetLength(::grpc::ClientContext* context, const ::player::GetLengthRequest& request, ::player::GetLengthReply* response) = 0;
     std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetLengthReply>> AsyncGetLength(::grpc::ClientContext* context, const ::player::GetLengthRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetLengthReply>>(AsyncGetLengthRaw(context, request, cq));
@@ -121,6 +128,34 @@ class Player final {
     std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetPauseReply>> PrepareAsyncSetPause(::grpc::ClientContext* context, const ::player::SetPauseRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetPauseReply>>(PrepareAsyncSetPauseRaw(context, request, cq));
     }
+    virtual ::grpc::Status GetVolume(::grpc::ClientContext* context, const ::player::GetVolumeRequest& request, ::player::GetVolumeReply* response) = 0;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetVolumeReply>> AsyncGetVolume(::grpc::ClientContext* context, const ::player::GetVolumeRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetVolumeReply>>(AsyncGetVolumeRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetVolumeReply>> PrepareAsyncGetVolume(::grpc::ClientContext* context, const ::player::GetVolumeRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetVolumeReply>>(PrepareAsyncGetVolumeRaw(context, request, cq));
+    }
+    virtual ::grpc::Status SetVolume(::grpc::ClientContext* context, const ::player::SetVolumeRequest& request, ::player::SetVolumeReply* response) = 0;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetVolumeReply>> AsyncSetVolume(::grpc::ClientContext* context, const ::player::SetVolumeRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetVolumeReply>>(AsyncSetVolumeRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetVolumeReply>> PrepareAsyncSetVolume(::grpc::ClientContext* context, const ::player::SetVolumeRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetVolumeReply>>(PrepareAsyncSetVolumeRaw(context, request, cq));
+    }
+    virtual ::grpc::Status GetMute(::grpc::ClientContext* context, const ::player::GetMuteRequest& request, ::player::GetMuteReply* response) = 0;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetMuteReply>> AsyncGetMute(::grpc::ClientContext* context, const ::player::GetMuteRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetMuteReply>>(AsyncGetMuteRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetMuteReply>> PrepareAsyncGetMute(::grpc::ClientContext* context, const ::player::GetMuteRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetMuteReply>>(PrepareAsyncGetMuteRaw(context, request, cq));
+    }
+    virtual ::grpc::Status SetMute(::grpc::ClientContext* context, const ::player::SetMuteRequest& request, ::player::SetMuteReply* response) = 0;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetMuteReply>> AsyncSetMute(::grpc::ClientContext* context, const ::player::SetMuteRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetMuteReply>>(AsyncSetMuteRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetMuteReply>> PrepareAsyncSetMute(::grpc::ClientContext* context, const ::player::SetMuteRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetMuteReply>>(PrepareAsyncSetMuteRaw(context, request, cq));
+    }
     virtual ::grpc::Status Seek(::grpc::ClientContext* context, const ::player::SeekRequest& request, ::player::SeekReply* response) = 0;
     std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SeekReply>> AsyncSeek(::grpc::ClientContext* context, const ::player::SeekRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SeekReply>>(AsyncSeekRaw(context, request, cq));
@@ -128,6 +163,20 @@ class Player final {
     std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SeekReply>> PrepareAsyncSeek(::grpc::ClientContext* context, const ::player::SeekRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SeekReply>>(PrepareAsyncSeekRaw(context, request, cq));
     }
+    virtual ::grpc::Status FrameStep(::grpc::ClientContext* context, const ::player::FrameStepRequest& request, ::player::FrameStepReply* response) = 0;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::FrameStepReply>> AsyncFrameStep(::grpc::ClientContext* context, const ::player::FrameStepRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::FrameStepReply>>(AsyncFrameStepRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::FrameStepReply>> PrepareAsyncFrameStep(::grpc::ClientContext* context, const ::player::FrameStepRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::FrameStepReply>>(PrepareAsyncFrameStepRaw(context, request, cq));
+    }
+    virtual ::grpc::Status Screenshot(::grpc::ClientContext* context, const ::player::ScreenshotRequest& request, ::player::ScreenshotReply* response) = 0;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::ScreenshotReply>> AsyncScreenshot(::grpc::ClientContext* context, const ::player::ScreenshotRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::ScreenshotReply>>(AsyncScreenshotRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::ScreenshotReply>> PrepareAsyncScreenshot(::grpc::ClientContext* context, const ::player::ScreenshotRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::ScreenshotReply>>(PrepareAsyncScreenshotRaw(context, request, cq));
+    }
     virtual ::grpc::Status GetVideoTracks(::grpc::ClientContext* context, const ::player::GetVideoTracksRequest& request, ::player::GetVideoTracksReply* response) = 0;
     std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetVideoTracksReply>> AsyncGetVideoTracks(::grpc::ClientContext* context, const ::player::GetVideoTracksRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetVideoTracksReply>>(AsyncGetVideoTracksRaw(context, request, cq));
@@ -170,6 +219,34 @@ class Player final {
     std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetSubtitlesTrackReply>> PrepareAsyncSetSubtitlesTrack(::grpc::ClientContext* context, const ::player::SetSubtitlesTrackRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetSubtitlesTrackReply>>(PrepareAsyncSetSubtitlesTrackRaw(context, request, cq));
     }
+    virtual ::grpc::Status GetMediaInfo(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest& request, ::player::GetMediaInfoReply* response) = 0;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetMediaInfoReply>> AsyncGetMediaInfo(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetMediaInfoReply>>(AsyncGetMediaInfoRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetMediaInfoReply>> PrepareAsyncGetMediaInfo(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetMediaInfoReply>>(PrepareAsyncGetMediaInfoRaw(context, request, cq));
+    }
+    virtual ::grpc::Status GetChapters(::grpc::ClientContext* context, const ::player::GetChaptersRequest& request, ::player::GetChaptersReply* response) = 0;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetChaptersReply>> AsyncGetChapters(::grpc::ClientContext* context, const ::player::GetChaptersRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetChaptersReply>>(AsyncGetChaptersRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetChaptersReply>> PrepareAsyncGetChapters(::grpc::ClientContext* context, const ::player::GetChaptersRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetChaptersReply>>(PrepareAsyncGetChaptersRaw(context, request, cq));
+    }
+    virtual ::grpc::Status GetChapter(::grpc::ClientContext* context, const ::player::GetChapterRequest& request, ::player::GetChapterReply* response) = 0;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetChapterReply>> AsyncGetChapter(::grpc::ClientContext* context, const ::player::GetChapterRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetChapterReply>>(AsyncGetChapterRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetChapterReply>> PrepareAsyncGetChapter(::grpc::ClientContext* context, const ::player::GetChapterRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::GetChapterReply>>(PrepareAsyncGetChapterRaw(context, request, cq));
+    }
+    virtual ::grpc::Status SetChapter(::grpc::ClientContext* context, const ::player::SetChapterRequest& request, ::player::SetChapterReply* response) = 0;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetChapterReply>> AsyncSetChapter(::grpc::ClientContext* context, const ::player::SetChapterRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetChapterReply>>(AsyncSetChapterRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetChapterReply>> PrepareAsyncSetChapter(::grpc::ClientContext* context, const ::player::SetChapterRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::SetChapterReply>>(PrepareAsyncSetChapterRaw(context, request, cq));
+    }
     virtual ::grpc::Status Stop(::grpc::ClientContext* context, const ::player::StopRequest& request, ::player::StopReply* response) = 0;
     std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::StopReply>> AsyncStop(::grpc::ClientContext* context, const ::player::StopRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::StopReply>>(AsyncStopRaw(context, request, cq));
@@ -184,6 +261,15 @@ class Player final {
     std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::CloseReply>> PrepareAsyncClose(::grpc::ClientContext* context, const ::player::CloseRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReaderInterface< ::player::CloseReply>>(PrepareAsyncCloseRaw(context, request, cq));
     }
+    std::unique_ptr< ::grpc::ClientReaderInterface< ::player::Event>> Events(::grpc::ClientContext* context, const ::player::EventsRequest& request) {
+      return std::unique_ptr< ::grpc::ClientReaderInterface< ::player::Event>>(EventsRaw(context, request));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncReaderInterface< ::player::Event>> AsyncEvents(::grpc::ClientContext* context, const ::player::EventsRequest& request, ::grpc::CompletionQueue* cq, void* tag) {
+      return std::unique_ptr< ::grpc::ClientAsyncReaderInterface< ::player::Event>>(AsyncEventsRaw(context, request, cq, tag));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncReaderInterface< ::player::Event>> PrepareAsyncEvents(::grpc::ClientContext* context, const ::player::EventsRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncReaderInterface< ::player::Event>>(PrepareAsyncEventsRaw(context, request, cq));
+    }
     class async_interface {
      public:
       virtual ~async_interface() {}
@@ -200,6 +286,8 @@ class Player final {
       virtual void IsEnded(::grpc::ClientContext* context, const ::player::IsEndedRequest* request, ::player::IsEndedReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
       virtual void GetPosition(::grpc::ClientContext* context, const ::player::GetPositionRequest* request, ::player::GetPositionReply* response, std::function<void(::grpc::Status)>) = 0;
       virtual void GetPosition(::grpc::ClientContext* context, const ::player::GetPositionRequest* request, ::player::GetPositionReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
+      virtual void GetAudioPosition(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest* request, ::player::GetAudioPositionReply* response, std::function<void(::grpc::Status)>) = 0;
+      virtual void GetAudioPosition(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest* request, ::player::GetAudioPositionReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
       virtual void GetLength(::grpc::ClientContext* context, const ::player::GetLengthRequest* request, ::player::GetLengthReply* response, std::function<void(::grpc::Status)>) = 0;
       virtual void GetLength(::grpc::ClientContext* context, const ::player::GetLengthRequest* request, ::player::GetLengthReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
       virtual void GetSpeed(::grpc::ClientContext* context, const ::player::GetSpeedRequest* request, ::player::GetSpeedReply* response, std::function<void(::grpc::Status)>) = 0;
@@ -210,8 +298,20 @@ class Player final {
       virtual void GetPause(::grpc::ClientContext* context, const ::player::GetPauseRequest* request, ::player::GetPauseReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
       virtual void SetPause(::grpc::ClientContext* context, const ::player::SetPauseRequest* request, ::player::SetPauseReply* response, std::function<void(::grpc::Status)>) = 0;
       virtual void SetPause(::grpc::ClientContext* context, const ::player::SetPauseRequest* request, ::player::SetPauseReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
+      virtual void GetVolume(::grpc::ClientContext* context, const ::player::GetVolumeRequest* request, ::player::GetVolumeReply* response, std::function<void(::grpc::Status)>) = 0;
+      virtual void GetVolume(::grpc::ClientContext* context, const ::player::GetVolumeRequest* request, ::player::GetVolumeReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
+      virtual void SetVolume(::grpc::ClientContext* context, const ::player::SetVolumeRequest* request, ::player::SetVolumeReply* response, std::function<void(::grpc::Status)>) = 0;
+      virtual void SetVolume(::grpc::ClientContext* context, const ::player::SetVolumeRequest* request, ::player::SetVolumeReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
+      virtual void GetMute(::grpc::ClientContext* context, const ::player::GetMuteRequest* request, ::player::GetMuteReply* response, std::function<void(::grpc::Status)>) = 0;
+      virtual void GetMute(::grpc::ClientContext* context, const ::player::GetMuteRequest* request, ::player::GetMuteReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
+      virtual void SetMute(::grpc::ClientContext* context, const ::player::SetMuteRequest* request, ::player::SetMuteReply* response, std::function<void(::grpc::Status)>) = 0;
+      virtual void SetMute(::grpc::ClientContext* context, const ::player::SetMuteRequest* request, ::player::SetMuteReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
       virtual void Seek(::grpc::ClientContext* context, const ::player::SeekRequest* request, ::player::SeekReply* response, std::function<void(::grpc::Status)>) = 0;
       virtual void Seek(::grpc::ClientContext* context, const ::player::SeekRequest* request, ::player::SeekReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
+      virtual void FrameStep(::grpc::ClientContext* context, const ::player::FrameStepRequest* request, ::player::FrameStepReply* response, std::function<void(::grpc::Status)>) = 0;
+      virtual void FrameStep(::grpc::ClientContext* context, const ::player::FrameStepRequest* request, ::player::FrameStepReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
+      virtual void Screenshot(::grpc::ClientContext* context, const ::player::ScreenshotRequest* request, ::player::ScreenshotReply* response, std::function<void(::grpc::Status)>) = 0;
+      virtual void Screenshot(::grpc::ClientContext* context, const ::player::ScreenshotRequest* request, ::player::ScreenshotReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
       virtual void GetVideoTracks(::grpc::ClientContext* context, const ::player::GetVideoTracksRequest* request, ::player::GetVideoTracksReply* response, std::function<void(::grpc::Status)>) = 0;
       virtual void GetVideoTracks(::grpc::ClientContext* context, const ::player::GetVideoTracksRequest* request, ::player::GetVideoTracksReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
       virtual void GetAudioTracks(::grpc::ClientContext* context, const ::player::GetAudioTracksRequest* request, ::player::GetAudioTracksReply* response, std::function<void(::grpc::Status)>) = 0;
@@ -224,10 +324,19 @@ class Player final {
       virtual void SetAudioTrack(::grpc::ClientContext* context, const ::player::SetAudioTrackRequest* request, ::player::SetAudioTrackReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
       virtual void SetSubtitlesTrack(::grpc::ClientContext* context, const ::player::SetSubtitlesTrackRequest* request, ::player::SetSubtitlesTrackReply* response, std::function<void(::grpc::Status)>) = 0;
       virtual void SetSubtitlesTrack(::grpc::ClientContext* context, const ::player::SetSubtitlesTrackRequest* request, ::player::SetSubtitlesTrackReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
+      virtual void GetMediaInfo(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest* request, ::player::GetMediaInfoReply* response, std::function<void(::grpc::Status)>) = 0;
+      virtual void GetMediaInfo(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest* request, ::player::GetMediaInfoReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
+      virtual void GetChapters(::grpc::ClientContext* context, const ::player::GetChaptersRequest* request, ::player::GetChaptersReply* response, std::function<void(::grpc::Status)>) = 0;
+      virtual void GetChapters(::grpc::ClientContext* context, const ::player::GetChaptersRequest* request, ::player::GetChaptersReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
+      virtual void GetChapter(::grpc::ClientContext* context, const ::player::GetChapterRequest* request, ::player::GetChapterReply* response, std::function<void(::grpc::Status)>) = 0;
+      virtual void GetChapter(::grpc::ClientContext* context, const ::player::GetChapterRequest* request, ::player::GetChapterReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
+      virtual void SetChapter(::grpc::ClientContext* context, const ::player::SetChapterRequest* request, ::player::SetChapterReply* response, std::function<void(::grpc::Status)>) = 0;
+      virtual void SetChapter(::grpc::ClientContext* context, const ::player::SetChapterRequest* request, ::player::SetChapterReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
       virtual void Stop(::grpc::ClientContext* context, const ::player::StopRequest* request, ::player::StopReply* response, std::function<void(::grpc::Status)>) = 0;
       virtual void Stop(::grpc::ClientContext* context, const ::player::StopRequest* request, ::player::StopReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
       virtual void Close(::grpc::ClientContext* context, const ::player::CloseRequest* request, ::player::CloseReply* response, std::function<void(::grpc::Status)>) = 0;
       virtual void Close(::grpc::ClientContext* context, const ::player::CloseRequest* request, ::player::CloseReply* response, ::grpc::ClientUnaryReactor* reactor) = 0;
+      virtual void Events(::grpc::ClientContext* context, const ::player::EventsRequest* request, ::grpc::ClientReadReactor< ::player::Event>* reactor) = 0;
     };
     typedef class async_interface experimental_async_interface;
     virtual class async_interface* async() { return nullptr; }
@@ -248,6 +357,8 @@ class Player final {
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::IsEndedReply>* PrepareAsyncIsEndedRaw(::grpc::ClientContext* context, const ::player::IsEndedRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetPositionReply>* AsyncGetPositionRaw(::grpc::ClientContext* context, const ::player::GetPositionRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetPositionReply>* PrepareAsyncGetPositionRaw(::grpc::ClientContext* context, const ::player::GetPositionRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetAudioPositionReply>* AsyncGetAudioPositionRaw(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetAudioPositionReply>* PrepareAsyncGetAudioPositionRaw(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetLengthReply>* AsyncGetLengthRaw(::grpc::ClientContext* context, const ::player::GetLengthRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetLengthReply>* PrepareAsyncGetLengthRaw(::grpc::ClientContext* context, const ::player::GetLengthRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetSpeedReply>* AsyncGetSpeedRaw(::grpc::ClientContext* context, const ::player::GetSpeedRequest& request, ::grpc::CompletionQueue* cq) = 0;
@@ -258,8 +369,20 @@ class Player final {
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetPauseReply>* PrepareAsyncGetPauseRaw(::grpc::ClientContext* context, const ::player::GetPauseRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::SetPauseReply>* AsyncSetPauseRaw(::grpc::ClientContext* context, const ::player::SetPauseRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::SetPauseReply>* PrepareAsyncSetPauseRaw(::grpc::ClientContext* context, const ::player::SetPauseRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetVolumeReply>* AsyncGetVolumeRaw(::grpc::ClientContext* context, const ::player::GetVolumeRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetVolumeReply>* PrepareAsyncGetVolumeRaw(::grpc::ClientContext* context, const ::player::GetVolumeRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::SetVolumeReply>* AsyncSetVolumeRaw(::grpc::ClientContext* context, const ::player::SetVolumeRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::SetVolumeReply>* PrepareAsyncSetVolumeRaw(::grpc::ClientContext* context, const ::player::SetVolumeRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetMuteReply>* AsyncGetMuteRaw(::grpc::ClientContext* context, const ::player::GetMuteRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetMuteReply>* PrepareAsyncGetMuteRaw(::grpc::ClientContext* context, const ::player::GetMuteRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::SetMuteReply>* AsyncSetMuteRaw(::grpc::ClientContext* context, const ::player::SetMuteRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::SetMuteReply>* PrepareAsyncSetMuteRaw(::grpc::ClientContext* context, const ::player::SetMuteRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::SeekReply>* AsyncSeekRaw(::grpc::ClientContext* context, const ::player::SeekRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::SeekReply>* PrepareAsyncSeekRaw(::grpc::ClientContext* context, const ::player::SeekRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::FrameStepReply>* AsyncFrameStepRaw(::grpc::ClientContext* context, const ::player::FrameStepRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::FrameStepReply>* PrepareAsyncFrameStepRaw(::grpc::ClientContext* context, const ::player::FrameStepRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::ScreenshotReply>* AsyncScreenshotRaw(::grpc::ClientContext* context, const ::player::ScreenshotRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::ScreenshotReply>* PrepareAsyncScreenshotRaw(::grpc::ClientContext* context, const ::player::ScreenshotRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetVideoTracksReply>* AsyncGetVideoTracksRaw(::grpc::ClientContext* context, const ::player::GetVideoTracksRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetVideoTracksReply>* PrepareAsyncGetVideoTracksRaw(::grpc::ClientContext* context, const ::player::GetVideoTracksRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetAudioTracksReply>* AsyncGetAudioTracksRaw(::grpc::ClientContext* context, const ::player::GetAudioTracksRequest& request, ::grpc::CompletionQueue* cq) = 0;
@@ -272,10 +395,21 @@ class Player final {
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::SetAudioTrackReply>* PrepareAsyncSetAudioTrackRaw(::grpc::ClientContext* context, const ::player::SetAudioTrackRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::SetSubtitlesTrackReply>* AsyncSetSubtitlesTrackRaw(::grpc::ClientContext* context, const ::player::SetSubtitlesTrackRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::SetSubtitlesTrackReply>* PrepareAsyncSetSubtitlesTrackRaw(::grpc::ClientContext* context, const ::player::SetSubtitlesTrackRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetMediaInfoReply>* AsyncGetMediaInfoRaw(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetMediaInfoReply>* PrepareAsyncGetMediaInfoRaw(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetChaptersReply>* AsyncGetChaptersRaw(::grpc::ClientContext* context, const ::player::GetChaptersRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetChaptersReply>* PrepareAsyncGetChaptersRaw(::grpc::ClientContext* context, const ::player::GetChaptersRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetChapterReply>* AsyncGetChapterRaw(::grpc::ClientContext* context, const ::player::GetChapterRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::GetChapterReply>* PrepareAsyncGetChapterRaw(::grpc::ClientContext* context, const ::player::GetChapterRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::SetChapterReply>* AsyncSetChapterRaw(::grpc::ClientContext* context, const ::player::SetChapterRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::SetChapterReply>* PrepareAsyncSetChapterRaw(::grpc::ClientContext* context, const ::player::SetChapterRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::StopReply>* AsyncStopRaw(::grpc::ClientContext* context, const ::player::StopRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::StopReply>* PrepareAsyncStopRaw(::grpc::ClientContext* context, const ::player::StopRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::CloseReply>* AsyncCloseRaw(::grpc::ClientContext* context, const ::player::CloseRequest& request, ::grpc::CompletionQueue* cq) = 0;
     virtual ::grpc::ClientAsyncResponseReaderInterface< ::player::CloseReply>* PrepareAsyncCloseRaw(::grpc::ClientContext* context, const ::player::CloseRequest& request, ::grpc::CompletionQueue* cq) = 0;
+    virtual ::grpc::ClientReaderInterface< ::player::Event>* EventsRaw(::grpc::ClientContext* context, const ::player::EventsRequest& request) = 0;
+    virtual ::grpc::ClientAsyncReaderInterface< ::player::Event>* AsyncEventsRaw(::grpc::ClientContext* context, const ::player::EventsRequest& request, ::grpc::CompletionQueue* cq, void* tag) = 0;
+    virtual ::grpc::ClientAsyncReaderInterface< ::player::Event>* PrepareAsyncEventsRaw(::grpc::ClientContext* context, const ::player::EventsRequest& request, ::grpc::CompletionQueue* cq) = 0;
   };
   class Stub final : public StubInterface {
    public:
@@ -331,6 +465,13 @@ class Player final {
     std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetPositionReply>> PrepareAsyncGetPosition(::grpc::ClientContext* context, const ::player::GetPositionRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetPositionReply>>(PrepareAsyncGetPositionRaw(context, request, cq));
     }
+    ::grpc::Status GetAudioPosition(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest& request, ::player::GetAudioPositionReply* response) override;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetAudioPositionReply>> AsyncGetAudioPosition(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetAudioPositionReply>>(AsyncGetAudioPositionRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetAudioPositionReply>> PrepareAsyncGetAudioPosition(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetAudioPositionReply>>(PrepareAsyncGetAudioPositionRaw(context, request, cq));
+    }
     ::grpc::Status GetLength(::grpc::ClientContext* context, const ::player::GetLengthRequest& request, ::player::GetLengthReply* response) override;
     std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetLengthReply>> AsyncGetLength(::grpc::ClientContext* context, const ::player::GetLengthRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetLengthReply>>(AsyncGetLengthRaw(context, request, cq));
@@ -366,6 +507,34 @@ class Player final {
     std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetPauseReply>> PrepareAsyncSetPause(::grpc::ClientContext* context, const ::player::SetPauseRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetPauseReply>>(PrepareAsyncSetPauseRaw(context, request, cq));
     }
+    ::grpc::Status GetVolume(::grpc::ClientContext* context, const ::player::GetVolumeRequest& request, ::player::GetVolumeReply* response) override;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetVolumeReply>> AsyncGetVolume(::grpc::ClientContext* context, const ::player::GetVolumeRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetVolumeReply>>(AsyncGetVolumeRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetVolumeReply>> PrepareAsyncGetVolume(::grpc::ClientContext* context, const ::player::GetVolumeRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetVolumeReply>>(PrepareAsyncGetVolumeRaw(context, request, cq));
+    }
+    ::grpc::Status SetVolume(::grpc::ClientContext* context, const ::player::SetVolumeRequest& request, ::player::SetVolumeReply* response) override;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetVolumeReply>> AsyncSetVolume(::grpc::ClientContext* context, const ::player::SetVolumeRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetVolumeReply>>(AsyncSetVolumeRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetVolumeReply>> PrepareAsyncSetVolume(::grpc::ClientContext* context, const ::player::SetVolumeRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetVolumeReply>>(PrepareAsyncSetVolumeRaw(context, request, cq));
+    }
+    ::grpc::Status GetMute(::grpc::ClientContext* context, const ::player::GetMuteRequest& request, ::player::GetMuteReply* response) override;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetMuteReply>> AsyncGetMute(::grpc::ClientContext* context, const ::player::GetMuteRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetMuteReply>>(AsyncGetMuteRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetMuteReply>> PrepareAsyncGetMute(::grpc::ClientContext* context, const ::player::GetMuteRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetMuteReply>>(PrepareAsyncGetMuteRaw(context, request, cq));
+    }
+    ::grpc::Status SetMute(::grpc::ClientContext* context, const ::player::SetMuteRequest& request, ::player::SetMuteReply* response) override;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetMuteReply>> AsyncSetMute(::grpc::ClientContext* context, const ::player::SetMuteRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetMuteReply>>(AsyncSetMuteRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetMuteReply>> PrepareAsyncSetMute(::grpc::ClientContext* context, const ::player::SetMuteRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetMuteReply>>(PrepareAsyncSetMuteRaw(context, request, cq));
+    }
     ::grpc::Status Seek(::grpc::ClientContext* context, const ::player::SeekRequest& request, ::player::SeekReply* response) override;
     std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SeekReply>> AsyncSeek(::grpc::ClientContext* context, const ::player::SeekRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SeekReply>>(AsyncSeekRaw(context, request, cq));
@@ -373,6 +542,20 @@ class Player final {
     std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SeekReply>> PrepareAsyncSeek(::grpc::ClientContext* context, const ::player::SeekRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SeekReply>>(PrepareAsyncSeekRaw(context, request, cq));
     }
+    ::grpc::Status FrameStep(::grpc::ClientContext* context, const ::player::FrameStepRequest& request, ::player::FrameStepReply* response) override;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::FrameStepReply>> AsyncFrameStep(::grpc::ClientContext* context, const ::player::FrameStepRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::FrameStepReply>>(AsyncFrameStepRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::FrameStepReply>> PrepareAsyncFrameStep(::grpc::ClientContext* context, const ::player::FrameStepRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::FrameStepReply>>(PrepareAsyncFrameStepRaw(context, request, cq));
+    }
+    ::grpc::Status Screenshot(::grpc::ClientContext* context, const ::player::ScreenshotRequest& request, ::player::ScreenshotReply* response) override;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::ScreenshotReply>> AsyncScreenshot(::grpc::ClientContext* context, const ::player::ScreenshotRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::ScreenshotReply>>(AsyncScreenshotRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::ScreenshotReply>> PrepareAsyncScreenshot(::grpc::ClientContext* context, const ::player::ScreenshotRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::ScreenshotReply>>(PrepareAsyncScreenshotRaw(context, request, cq));
+    }
     ::grpc::Status GetVideoTracks(::grpc::ClientContext* context, const ::player::GetVideoTracksRequest& request, ::player::GetVideoTracksReply* response) override;
     std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetVideoTracksReply>> AsyncGetVideoTracks(::grpc::ClientContext* context, const ::player::GetVideoTracksRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetVideoTracksReply>>(AsyncGetVideoTracksRaw(context, request, cq));
@@ -415,6 +598,34 @@ class Player final {
     std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetSubtitlesTrackReply>> PrepareAsyncSetSubtitlesTrack(::grpc::ClientContext* context, const ::player::SetSubtitlesTrackRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetSubtitlesTrackReply>>(PrepareAsyncSetSubtitlesTrackRaw(context, request, cq));
     }
+    ::grpc::Status GetMediaInfo(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest& request, ::player::GetMediaInfoReply* response) override;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetMediaInfoReply>> AsyncGetMediaInfo(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetMediaInfoReply>>(AsyncGetMediaInfoRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetMediaInfoReply>> PrepareAsyncGetMediaInfo(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetMediaInfoReply>>(PrepareAsyncGetMediaInfoRaw(context, request, cq));
+    }
+    ::grpc::Status GetChapters(::grpc::ClientContext* context, const ::player::GetChaptersRequest& request, ::player::GetChaptersReply* response) override;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetChaptersReply>> AsyncGetChapters(::grpc::ClientContext* context, const ::player::GetChaptersRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetChaptersReply>>(AsyncGetChaptersRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetChaptersReply>> PrepareAsyncGetChapters(::grpc::ClientContext* context, const ::player::GetChaptersRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetChaptersReply>>(PrepareAsyncGetChaptersRaw(context, request, cq));
+    }
+    ::grpc::Status GetChapter(::grpc::ClientContext* context, const ::player::GetChapterRequest& request, ::player::GetChapterReply* response) override;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetChapterReply>> AsyncGetChapter(::grpc::ClientContext* context, const ::player::GetChapterRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetChapterReply>>(AsyncGetChapterRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetChapterReply>> PrepareAsyncGetChapter(::grpc::ClientContext* context, const ::player::GetChapterRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::GetChapterReply>>(PrepareAsyncGetChapterRaw(context, request, cq));
+    }
+    ::grpc::Status SetChapter(::grpc::ClientContext* context, const ::player::SetChapterRequest& request, ::player::SetChapterReply* response) override;
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetChapterReply>> AsyncSetChapter(::grpc::ClientContext* context, const ::player::SetChapterRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetChapterReply>>(AsyncSetChapterRaw(context, request, cq));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetChapterReply>> PrepareAsyncSetChapter(::grpc::ClientContext* context, const ::player::SetChapterRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::SetChapterReply>>(PrepareAsyncSetChapterRaw(context, request, cq));
+    }
     ::grpc::Status Stop(::grpc::ClientContext* context, const ::player::StopRequest& request, ::player::StopReply* response) override;
     std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::StopReply>> AsyncStop(::grpc::ClientContext* context, const ::player::StopRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::StopReply>>(AsyncStopRaw(context, request, cq));
@@ -429,6 +640,15 @@ class Player final {
     std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::CloseReply>> PrepareAsyncClose(::grpc::ClientContext* context, const ::player::CloseRequest& request, ::grpc::CompletionQueue* cq) {
       return std::unique_ptr< ::grpc::ClientAsyncResponseReader< ::player::CloseReply>>(PrepareAsyncCloseRaw(context, request, cq));
     }
+    std::unique_ptr< ::grpc::ClientReader< ::player::Event>> Events(::grpc::ClientContext* context, const ::player::EventsRequest& request) {
+      return std::unique_ptr< ::grpc::ClientReader< ::player::Event>>(EventsRaw(context, request));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncReader< ::player::Event>> AsyncEvents(::grpc::ClientContext* context, const ::player::EventsRequest& request, ::grpc::CompletionQueue* cq, void* tag) {
+      return std::unique_ptr< ::grpc::ClientAsyncReader< ::player::Event>>(AsyncEventsRaw(context, request, cq, tag));
+    }
+    std::unique_ptr< ::grpc::ClientAsyncReader< ::player::Event>> PrepareAsyncEvents(::grpc::ClientContext* context, const ::player::EventsRequest& request, ::grpc::CompletionQueue* cq) {
+      return std::unique_ptr< ::grpc::ClientAsyncReader< ::player::Event>>(PrepareAsyncEventsRaw(context, request, cq));
+    }
     class async final :
       public StubInterface::async_interface {
      public:
@@ -445,6 +665,8 @@ class Player final {
       void IsEnded(::grpc::ClientContext* context, const ::player::IsEndedRequest* request, ::player::IsEndedReply* response, ::grpc::ClientUnaryReactor* reactor) override;
       void GetPosition(::grpc::ClientContext* context, const ::player::GetPositionRequest* request, ::player::GetPositionReply* response, std::function<void(::grpc::Status)>) override;
       void GetPosition(::grpc::ClientContext* context, const ::player::GetPositionRequest* request, ::player::GetPositionReply* response, ::grpc::ClientUnaryReactor* reactor) override;
+      void GetAudioPosition(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest* request, ::player::GetAudioPositionReply* response, std::function<void(::grpc::Status)>) override;
+      void GetAudioPosition(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest* request, ::player::GetAudioPositionReply* response, ::grpc::ClientUnaryReactor* reactor) override;
       void GetLength(::grpc::ClientContext* context, const ::player::GetLengthRequest* request, ::player::GetLengthReply* response, std::function<void(::grpc::Status)>) override;
       void GetLength(::grpc::ClientContext* context, const ::player::GetLengthRequest* request, ::player::GetLengthReply* response, ::grpc::ClientUnaryReactor* reactor) override;
       void GetSpeed(::grpc::ClientContext* context, const ::player::GetSpeedRequest* request, ::player::GetSpeedReply* response, std::function<void(::grpc::Status)>) override;
@@ -455,8 +677,20 @@ class Player final {
       void GetPause(::grpc::ClientContext* context, const ::player::GetPauseRequest* request, ::player::GetPauseReply* response, ::grpc::ClientUnaryReactor* reactor) override;
       void SetPause(::grpc::ClientContext* context, const ::player::SetPauseRequest* request, ::player::SetPauseReply* response, std::function<void(::grpc::Status)>) override;
       void SetPause(::grpc::ClientContext* context, const ::player::SetPauseRequest* request, ::player::SetPauseReply* response, ::grpc::ClientUnaryReactor* reactor) override;
+      void GetVolume(::grpc::ClientContext* context, const ::player::GetVolumeRequest* request, ::player::GetVolumeReply* response, std::function<void(::grpc::Status)>) override;
+      void GetVolume(::grpc::ClientContext* context, const ::player::GetVolumeRequest* request, ::player::GetVolumeReply* response, ::grpc::ClientUnaryReactor* reactor) override;
+      void SetVolume(::grpc::ClientContext* context, const ::player::SetVolumeRequest* request, ::player::SetVolumeReply* response, std::function<void(::grpc::Status)>) override;
+      void SetVolume(::grpc::ClientContext* context, const ::player::SetVolumeRequest* request, ::player::SetVolumeReply* response, ::grpc::ClientUnaryReactor* reactor) override;
+      void GetMute(::grpc::ClientContext* context, const ::player::GetMuteRequest* request, ::player::GetMuteReply* response, std::function<void(::grpc::Status)>) override;
+      void GetMute(::grpc::ClientContext* context, const ::player::GetMuteRequest* request, ::player::GetMuteReply* response, ::grpc::ClientUnaryReactor* reactor) override;
+      void SetMute(::grpc::ClientContext* context, const ::player::SetMuteRequest* request, ::player::SetMuteReply* response, std::function<void(::grpc::Status)>) override;
+      void SetMute(::grpc::ClientContext* context, const ::player::SetMuteRequest* request, ::player::SetMuteReply* response, ::grpc::ClientUnaryReactor* reactor) override;
       void Seek(::grpc::ClientContext* context, const ::player::SeekRequest* request, ::player::SeekReply* response, std::function<void(::grpc::Status)>) override;
       void Seek(::grpc::ClientContext* context, const ::player::SeekRequest* request, ::player::SeekReply* response, ::grpc::ClientUnaryReactor* reactor) override;
+      void FrameStep(::grpc::ClientContext* context, const ::player::FrameStepRequest* request, ::player::FrameStepReply* response, std::function<void(::grpc::Status)>) override;
+      void FrameStep(::grpc::ClientContext* context, const ::player::FrameStepRequest* request, ::player::FrameStepReply* response, ::grpc::ClientUnaryReactor* reactor) override;
+      void Screenshot(::grpc::ClientContext* context, const ::player::ScreenshotRequest* request, ::player::ScreenshotReply* response, std::function<void(::grpc::Status)>) override;
+      void Screenshot(::grpc::ClientContext* context, const ::player::ScreenshotRequest* request, ::player::ScreenshotReply* response, ::grpc::ClientUnaryReactor* reactor) override;
       void GetVideoTracks(::grpc::ClientContext* context, const ::player::GetVideoTracksRequest* request, ::player::GetVideoTracksReply* response, std::function<void(::grpc::Status)>) override;
       void GetVideoTracks(::grpc::ClientContext* context, const ::player::GetVideoTracksRequest* request, ::player::GetVideoTracksReply* response, ::grpc::ClientUnaryReactor* reactor) override;
       void GetAudioTracks(::grpc::ClientContext* context, const ::player::GetAudioTracksRequest* request, ::player::GetAudioTracksReply* response, std::function<void(::grpc::Status)>) override;
@@ -469,10 +703,19 @@ class Player final {
       void SetAudioTrack(::grpc::ClientContext* context, const ::player::SetAudioTrackRequest* request, ::player::SetAudioTrackReply* response, ::grpc::ClientUnaryReactor* reactor) override;
       void SetSubtitlesTrack(::grpc::ClientContext* context, const ::player::SetSubtitlesTrackRequest* request, ::player::SetSubtitlesTrackReply* response, std::function<void(::grpc::Status)>) override;
       void SetSubtitlesTrack(::grpc::ClientContext* context, const ::player::SetSubtitlesTrackRequest* request, ::player::SetSubtitlesTrackReply* response, ::grpc::ClientUnaryReactor* reactor) override;
+      void GetMediaInfo(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest* request, ::player::GetMediaInfoReply* response, std::function<void(::grpc::Status)>) override;
+      void GetMediaInfo(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest* request, ::player::GetMediaInfoReply* response, ::grpc::ClientUnaryReactor* reactor) override;
+      void GetChapters(::grpc::ClientContext* context, const ::player::GetChaptersRequest* request, ::player::GetChaptersReply* response, std::function<void(::grpc::Status)>) override;
+      void GetChapters(::grpc::ClientContext* context, const ::player::GetChaptersRequest* request, ::player::GetChaptersReply* response, ::grpc::ClientUnaryReactor* reactor) override;
+      void GetChapter(::grpc::ClientContext* context, const ::player::GetChapterRequest* request, ::player::GetChapterReply* response, std::function<void(::grpc::Status)>) override;
+      void GetChapter(::grpc::ClientContext* context, const ::player::GetChapterRequest* request, ::player::GetChapterReply* response, ::grpc::ClientUnaryReactor* reactor) override;
+      void SetChapter(::grpc::ClientContext* context, const ::player::SetChapterRequest* request, ::player::SetChapterReply* response, std::function<void(::grpc::Status)>) override;
+      void SetChapter(::grpc::ClientContext* context, const ::player::SetChapterRequest* request, ::player::SetChapterReply* response, ::grpc::ClientUnaryReactor* reactor) override;
       void Stop(::grpc::ClientContext* context, const ::player::StopRequest* request, ::player::StopReply* response, std::function<void(::grpc::Status)>) override;
       void Stop(::grpc::ClientContext* context, const ::player::StopRequest* request, ::player::StopReply* response, ::grpc::ClientUnaryReactor* reactor) override;
       void Close(::grpc::ClientContext* context, const ::player::CloseRequest* request, ::player::CloseReply* response, std::function<void(::grpc::Status)>) override;
       void Close(::grpc::ClientContext* context, const ::player::CloseRequest* request, ::player::CloseReply* response, ::grpc::ClientUnaryReactor* reactor) override;
+      void Events(::grpc::ClientContext* context, const ::player::EventsRequest* request, ::grpc::ClientReadReactor< ::player::Event>* reactor) override;
      private:
       friend class Stub;
       explicit async(Stub* stub): stub_(stub) { }
@@ -499,6 +742,8 @@ class Player final {
     ::grpc::ClientAsyncResponseReader< ::player::IsEndedReply>* PrepareAsyncIsEndedRaw(::grpc::ClientContext* context, const ::player::IsEndedRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::GetPositionReply>* AsyncGetPositionRaw(::grpc::ClientContext* context, const ::player::GetPositionRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::GetPositionReply>* PrepareAsyncGetPositionRaw(::grpc::ClientContext* context, const ::player::GetPositionRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::GetAudioPositionReply>* AsyncGetAudioPositionRaw(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::GetAudioPositionReply>* PrepareAsyncGetAudioPositionRaw(::grpc::ClientContext* context, const ::player::GetAudioPositionRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::GetLengthReply>* AsyncGetLengthRaw(::grpc::ClientContext* context, const ::player::GetLengthRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::GetLengthReply>* PrepareAsyncGetLengthRaw(::grpc::ClientContext* context, const ::player::GetLengthRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::GetSpeedReply>* AsyncGetSpeedRaw(::grpc::ClientContext* context, const ::player::GetSpeedRequest& request, ::grpc::CompletionQueue* cq) override;
@@ -509,8 +754,20 @@ class Player final {
     ::grpc::ClientAsyncResponseReader< ::player::GetPauseReply>* PrepareAsyncGetPauseRaw(::grpc::ClientContext* context, const ::player::GetPauseRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::SetPauseReply>* AsyncSetPauseRaw(::grpc::ClientContext* context, const ::player::SetPauseRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::SetPauseReply>* PrepareAsyncSetPauseRaw(::grpc::ClientContext* context, const ::player::SetPauseRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::GetVolumeReply>* AsyncGetVolumeRaw(::grpc::ClientContext* context, const ::player::GetVolumeRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::GetVolumeReply>* PrepareAsyncGetVolumeRaw(::grpc::ClientContext* context, const ::player::GetVolumeRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::SetVolumeReply>* AsyncSetVolumeRaw(::grpc::ClientContext* context, const ::player::SetVolumeRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::SetVolumeReply>* PrepareAsyncSetVolumeRaw(::grpc::ClientContext* context, const ::player::SetVolumeRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::GetMuteReply>* AsyncGetMuteRaw(::grpc::ClientContext* context, const ::player::GetMuteRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::GetMuteReply>* PrepareAsyncGetMuteRaw(::grpc::ClientContext* context, const ::player::GetMuteRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::SetMuteReply>* AsyncSetMuteRaw(::grpc::ClientContext* context, const ::player::SetMuteRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::SetMuteReply>* PrepareAsyncSetMuteRaw(::grpc::ClientContext* context, const ::player::SetMuteRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::SeekReply>* AsyncSeekRaw(::grpc::ClientContext* context, const ::player::SeekRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::SeekReply>* PrepareAsyncSeekRaw(::grpc::ClientContext* context, const ::player::SeekRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::FrameStepReply>* AsyncFrameStepRaw(::grpc::ClientContext* context, const ::player::FrameStepRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::FrameStepReply>* PrepareAsyncFrameStepRaw(::grpc::ClientContext* context, const ::player::FrameStepRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::ScreenshotReply>* AsyncScreenshotRaw(::grpc::ClientContext* context, const ::player::ScreenshotRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::ScreenshotReply>* PrepareAsyncScreenshotRaw(::grpc::ClientContext* context, const ::player::ScreenshotRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::GetVideoTracksReply>* AsyncGetVideoTracksRaw(::grpc::ClientContext* context, const ::player::GetVideoTracksRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::GetVideoTracksReply>* PrepareAsyncGetVideoTracksRaw(::grpc::ClientContext* context, const ::player::GetVideoTracksRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::GetAudioTracksReply>* AsyncGetAudioTracksRaw(::grpc::ClientContext* context, const ::player::GetAudioTracksRequest& request, ::grpc::CompletionQueue* cq) override;
@@ -523,10 +780,21 @@ class Player final {
     ::grpc::ClientAsyncResponseReader< ::player::SetAudioTrackReply>* PrepareAsyncSetAudioTrackRaw(::grpc::ClientContext* context, const ::player::SetAudioTrackRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::SetSubtitlesTrackReply>* AsyncSetSubtitlesTrackRaw(::grpc::ClientContext* context, const ::player::SetSubtitlesTrackRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::SetSubtitlesTrackReply>* PrepareAsyncSetSubtitlesTrackRaw(::grpc::ClientContext* context, const ::player::SetSubtitlesTrackRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::GetMediaInfoReply>* AsyncGetMediaInfoRaw(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::GetMediaInfoReply>* PrepareAsyncGetMediaInfoRaw(::grpc::ClientContext* context, const ::player::GetMediaInfoRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::GetChaptersReply>* AsyncGetChaptersRaw(::grpc::ClientContext* context, const ::player::GetChaptersRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::GetChaptersReply>* PrepareAsyncGetChaptersRaw(::grpc::ClientContext* context, const ::player::GetChaptersRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::GetChapterReply>* AsyncGetChapterRaw(::grpc::ClientContext* context, const ::player::GetChapterRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::GetChapterReply>* PrepareAsyncGetChapterRaw(::grpc::ClientContext* context, const ::player::GetChapterRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::SetChapterReply>* AsyncSetChapterRaw(::grpc::ClientContext* context, const ::player::SetChapterRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientAsyncResponseReader< ::player::SetChapterReply>* PrepareAsyncSetChapterRaw(::grpc::ClientContext* context, const ::player::SetChapterRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::StopReply>* AsyncStopRaw(::grpc::ClientContext* context, const ::player::StopRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::StopReply>* PrepareAsyncStopRaw(::grpc::ClientContext* context, const ::player::StopRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::CloseReply>* AsyncCloseRaw(::grpc::ClientContext* context, const ::player::CloseRequest& request, ::grpc::CompletionQueue* cq) override;
     ::grpc::ClientAsyncResponseReader< ::player::CloseReply>* PrepareAsyncCloseRaw(::grpc::ClientContext* context, const ::player::CloseRequest& request, ::grpc::CompletionQueue* cq) override;
+    ::grpc::ClientReader< ::player::Event>* EventsRaw(::grpc::ClientContext* context, const ::player::EventsRequest& request) override;
+    ::grpc::ClientAsyncReader< ::player::Event>* AsyncEventsRaw(::grpc::ClientContext* context, const ::player::EventsRequest& request, ::grpc::CompletionQueue* cq, void* tag) override;
+    ::grpc::ClientAsyncReader< ::player::Event>* PrepareAsyncEventsRaw(::grpc::ClientContext* context, const ::player::EventsRequest& request, ::grpc::CompletionQueue* cq) override;
     const ::grpc::internal::RpcMethod rpcmethod_Open_;
     const ::grpc::internal::RpcMethod rpcmethod_SetupForStreaming_;
     const ::grpc::internal::RpcMethod rpcmethod_ProcessTitle_;
@@ -534,20 +802,32 @@ class Player final {
     const ::grpc::internal::RpcMethod rpcmethod_EndChan_;
     const ::grpc::internal::RpcMethod rpcmethod_IsEnded_;
     const ::grpc::internal::RpcMethod rpcmethod_GetPosition_;
+    const ::grpc::internal::RpcMethod rpcmethod_GetAudioPosition_;
     const ::grpc::internal::RpcMethod rpcmethod_GetLength_;
     const ::grpc::internal::RpcMethod rpcmethod_GetSpeed_;
     const ::grpc::internal::RpcMethod rpcmethod_SetSpeed_;
     const ::grpc::internal::RpcMethod rpcmethod_GetPause_;
     const ::grpc::internal::RpcMethod rpcmethod_SetPause_;
+    const ::grpc::internal::RpcMethod rpcmethod_GetVolume_;
+    const ::grpc::internal::RpcMethod rpcmethod_SetVolume_;
+    const ::grpc::internal::RpcMethod rpcmethod_GetMute_;
+    const ::grpc::internal::RpcMethod rpcmethod_SetMute_;
     const ::grpc::internal::RpcMethod rpcmethod_Seek_;
+    const ::grpc::internal::RpcMethod rpcmethod_FrameStep_;
+    const ::grpc::internal::RpcMethod rpcmethod_Screenshot_;
     const ::grpc::internal::RpcMethod rpcmethod_GetVideoTracks_;
     const ::grpc::internal::RpcMethod rpcmethod_GetAudioTracks_;
     const ::grpc::internal::RpcMethod rpcmethod_GetSubtitlesTracks_;
     const ::grpc::internal::RpcMethod rpcmethod_SetVideoTrack_;
     const ::grpc::internal::RpcMethod rpcmethod_SetAudioTrack_;
     const ::grpc::internal::RpcMethod rpcmethod_SetSubtitlesTrack_;
+    const ::grpc::internal::RpcMethod rpcmethod_GetMediaInfo_;
+    const ::grpc::internal::RpcMethod rpcmethod_GetChapters_;
+    const ::grpc::internal::RpcMethod rpcmethod_GetChapter_;
+    const ::grpc::internal::RpcMethod rpcmethod_SetChapter_;
     const ::grpc::internal::RpcMethod rpcmethod_Stop_;
     const ::grpc::internal::RpcMethod rpcmethod_Close_;
+    const ::grpc::internal::RpcMethod rpcmethod_Events_;
   };
   static std::unique_ptr<Stub> NewStub(const std::shared_ptr< ::grpc::ChannelInterface>& channel, const ::grpc::StubOptions& options = ::grpc::StubOptions());
 
@@ -562,20 +842,32 @@ class Player final {
     virtual ::grpc::Status EndChan(::grpc::ServerContext* context, const ::player::EndChanRequest* request, ::grpc::ServerWriter< ::player::EndChanReply>* writer);
     virtual ::grpc::Status IsEnded(::grpc::ServerContext* context, const ::player::IsEndedRequest* request, ::player::IsEndedReply* response);
     virtual ::grpc::Status GetPosition(::grpc::ServerContext* context, const ::player::GetPositionRequest* request, ::player::GetPositionReply* response);
+    virtual ::grpc::Status GetAudioPosition(::grpc::ServerContext* context, const ::player::GetAudioPositionRequest* request, ::player::GetAudioPositionReply* response);
     virtual ::grpc::Status GetLength(::grpc::ServerContext* context, const ::player::GetLengthRequest* request, ::player::GetLengthReply* response);
     virtual ::grpc::Status GetSpeed(::grpc::ServerContext* context, const ::player::GetSpeedRequest* request, ::player::GetSpeedReply* response);
     virtual ::grpc::Status SetSpeed(::grpc::ServerContext* context, const ::player::SetSpeedRequest* request, ::player::SetSpeedReply* response);
     virtual ::grpc::Status GetPause(::grpc::ServerContext* context, const ::player::GetPauseRequest* request, ::player::GetPauseReply* response);
     virtual ::grpc::Status SetPause(::grpc::ServerContext* context, const ::player::SetPauseRequest* request, ::player::SetPauseReply* response);
+    virtual ::grpc::Status GetVolume(::grpc::ServerContext* context, const ::player::GetVolumeRequest* request, ::player::GetVolumeReply* response);
+    virtual ::grpc::Status SetVolume(::grpc::ServerContext* context, const ::player::SetVolumeRequest* request, ::player::SetVolumeReply* response);
+    virtual ::grpc::Status GetMute(::grpc::ServerContext* context, const ::player::GetMuteRequest* request, ::player::GetMuteReply* response);
+    virtual ::grpc::Status SetMute(::grpc::ServerContext* context, const ::player::SetMuteRequest* request, ::player::SetMuteReply* response);
     virtual ::grpc::Status Seek(::grpc::ServerContext* context, const ::player::SeekRequest* request, ::player::SeekReply* response);
+    virtual ::grpc::Status FrameStep(::grpc::ServerContext* context, const ::player::FrameStepRequest* request, ::player::FrameStepReply* response);
+    virtual ::grpc::Status Screenshot(::grpc::ServerContext* context, const ::player::ScreenshotRequest* request, ::player::ScreenshotReply* response);
     virtual ::grpc::Status GetVideoTracks(::grpc::ServerContext* context, const ::player::GetVideoTracksRequest* request, ::player::GetVideoTracksReply* response);
     virtual ::grpc::Status GetAudioTracks(::grpc::ServerContext* context, const ::player::GetAudioTracksRequest* request, ::player::GetAudioTracksReply* response);
     virtual ::grpc::Status GetSubtitlesTracks(::grpc::ServerContext* context, const ::player::GetSubtitlesTracksRequest* request, ::player::GetSubtitlesTracksReply* response);
     virtual ::grpc::Status SetVideoTrack(::grpc::ServerContext* context, const ::player::SetVideoTrackRequest* request, ::player::SetVideoTrackReply* response);
     virtual ::grpc::Status SetAudioTrack(::grpc::ServerContext* context, const ::player::SetAudioTrackRequest* request, ::player::SetAudioTrackReply* response);
     virtual ::grpc::Status SetSubtitlesTrack(::grpc::ServerContext* context, const ::player::SetSubtitlesTrackRequest* request, ::player::SetSubtitlesTrackReply* response);
+    virtual ::grpc::Status GetMediaInfo(::grpc::ServerContext* context, const ::player::GetMediaInfoRequest* request, ::player::GetMediaInfoReply* response);
+    virtual ::grpc::Status GetChapters(::grpc::ServerContext* context, const ::player::GetChaptersRequest* request, ::player::GetChaptersReply* response);
+    virtual ::grpc::Status GetChapter(::grpc::ServerContext* context, const ::player::GetChapterRequest* request, ::player::GetChapterReply* response);
+    virtual ::grpc::Status SetChapter(::grpc::ServerContext* context, const ::player::SetChapterRequest* request, ::player::SetChapterReply* response);
     virtual ::grpc::Status Stop(::grpc::ServerContext* context, const ::player::StopRequest* request, ::player::StopReply* response);
     virtual ::grpc::Status Close(::grpc::ServerContext* context, const ::player::CloseRequest* request, ::player::CloseReply* response);
+    virtual ::grpc::Status Events(::grpc::ServerContext* context, const ::player::EventsRequest* request, ::grpc::ServerWriter< ::player::Event>* writer);
   };
   template <class BaseClass>
   class WithAsyncMethod_Open : public BaseClass {
@@ -718,12 +1010,32 @@ class Player final {
     }
   };
   template <class BaseClass>
+  class WithAsyncMethod_GetAudioPosition : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithAsyncMethod_GetAudioPosition() {
+      ::grpc::Service::MarkMethodAsync(7);
+    }
+    ~WithAsyncMethod_GetAudioPosition() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetAudioPosition(::grpc::ServerContext* /*context*/, const ::player::GetAudioPositionRequest* /*request*/, ::player::GetAudioPositionReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestGetAudioPosition(::grpc::ServerContext* context, ::player::GetAudioPositionRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::GetAudioPositionReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(7, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
   class WithAsyncMethod_GetLength : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithAsyncMethod_GetLength() {
-      ::grpc::Service::MarkMethodAsync(7);
+      ::grpc::Service::MarkMethodAsync(8);
     }
     ~WithAsyncMethod_GetLength() override {
       BaseClassMustBeDerivedFromService(this);
@@ -734,7 +1046,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestGetLength(::grpc::ServerContext* context, ::player::GetLengthRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::GetLengthReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(7, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(8, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -743,7 +1055,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithAsyncMethod_GetSpeed() {
-      ::grpc::Service::MarkMethodAsync(8);
+      ::grpc::Service::MarkMethodAsync(9);
     }
     ~WithAsyncMethod_GetSpeed() override {
       BaseClassMustBeDerivedFromService(this);
@@ -754,7 +1066,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestGetSpeed(::grpc::ServerContext* context, ::player::GetSpeedRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::GetSpeedReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(8, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(9, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -763,7 +1075,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithAsyncMethod_SetSpeed() {
-      ::grpc::Service::MarkMethodAsync(9);
+      ::grpc::Service::MarkMethodAsync(10);
     }
     ~WithAsyncMethod_SetSpeed() override {
       BaseClassMustBeDerivedFromService(this);
@@ -774,7 +1086,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestSetSpeed(::grpc::ServerContext* context, ::player::SetSpeedRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::SetSpeedReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(9, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(10, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -783,7 +1095,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithAsyncMethod_GetPause() {
-      ::grpc::Service::MarkMethodAsync(10);
+      ::grpc::Service::MarkMethodAsync(11);
     }
     ~WithAsyncMethod_GetPause() override {
       BaseClassMustBeDerivedFromService(this);
@@ -794,7 +1106,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestGetPause(::grpc::ServerContext* context, ::player::GetPauseRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::GetPauseReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(10, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(11, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -803,7 +1115,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithAsyncMethod_SetPause() {
-      ::grpc::Service::MarkMethodAsync(11);
+      ::grpc::Service::MarkMethodAsync(12);
     }
     ~WithAsyncMethod_SetPause() override {
       BaseClassMustBeDerivedFromService(this);
@@ -814,7 +1126,87 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestSetPause(::grpc::ServerContext* context, ::player::SetPauseRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::SetPauseReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(11, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(12, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithAsyncMethod_GetVolume : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithAsyncMethod_GetVolume() {
+      ::grpc::Service::MarkMethodAsync(13);
+    }
+    ~WithAsyncMethod_GetVolume() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetVolume(::grpc::ServerContext* /*context*/, const ::player::GetVolumeRequest* /*request*/, ::player::GetVolumeReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestGetVolume(::grpc::ServerContext* context, ::player::GetVolumeRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::GetVolumeReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(13, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithAsyncMethod_SetVolume : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithAsyncMethod_SetVolume() {
+      ::grpc::Service::MarkMethodAsync(14);
+    }
+    ~WithAsyncMethod_SetVolume() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetVolume(::grpc::ServerContext* /*context*/, const ::player::SetVolumeRequest* /*request*/, ::player::SetVolumeReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestSetVolume(::grpc::ServerContext* context, ::player::SetVolumeRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::SetVolumeReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(14, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithAsyncMethod_GetMute : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithAsyncMethod_GetMute() {
+      ::grpc::Service::MarkMethodAsync(15);
+    }
+    ~WithAsyncMethod_GetMute() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetMute(::grpc::ServerContext* /*context*/, const ::player::GetMuteRequest* /*request*/, ::player::GetMuteReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestGetMute(::grpc::ServerContext* context, ::player::GetMuteRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::GetMuteReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(15, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithAsyncMethod_SetMute : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithAsyncMethod_SetMute() {
+      ::grpc::Service::MarkMethodAsync(16);
+    }
+    ~WithAsyncMethod_SetMute() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetMute(::grpc::ServerContext* /*context*/, const ::player::SetMuteRequest* /*request*/, ::player::SetMuteReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestSetMute(::grpc::ServerContext* context, ::player::SetMuteRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::SetMuteReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(16, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -823,7 +1215,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithAsyncMethod_Seek() {
-      ::grpc::Service::MarkMethodAsync(12);
+      ::grpc::Service::MarkMethodAsync(17);
     }
     ~WithAsyncMethod_Seek() override {
       BaseClassMustBeDerivedFromService(this);
@@ -834,7 +1226,47 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestSeek(::grpc::ServerContext* context, ::player::SeekRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::SeekReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(12, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(17, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithAsyncMethod_FrameStep : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithAsyncMethod_FrameStep() {
+      ::grpc::Service::MarkMethodAsync(18);
+    }
+    ~WithAsyncMethod_FrameStep() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status FrameStep(::grpc::ServerContext* /*context*/, const ::player::FrameStepRequest* /*request*/, ::player::FrameStepReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestFrameStep(::grpc::ServerContext* context, ::player::FrameStepRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::FrameStepReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(18, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithAsyncMethod_Screenshot : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithAsyncMethod_Screenshot() {
+      ::grpc::Service::MarkMethodAsync(19);
+    }
+    ~WithAsyncMethod_Screenshot() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status Screenshot(::grpc::ServerContext* /*context*/, const ::player::ScreenshotRequest* /*request*/, ::player::ScreenshotReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestScreenshot(::grpc::ServerContext* context, ::player::ScreenshotRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::ScreenshotReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(19, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -843,7 +1275,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithAsyncMethod_GetVideoTracks() {
-      ::grpc::Service::MarkMethodAsync(13);
+      ::grpc::Service::MarkMethodAsync(20);
     }
     ~WithAsyncMethod_GetVideoTracks() override {
       BaseClassMustBeDerivedFromService(this);
@@ -854,7 +1286,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestGetVideoTracks(::grpc::ServerContext* context, ::player::GetVideoTracksRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::GetVideoTracksReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(13, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(20, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -863,7 +1295,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithAsyncMethod_GetAudioTracks() {
-      ::grpc::Service::MarkMethodAsync(14);
+      ::grpc::Service::MarkMethodAsync(21);
     }
     ~WithAsyncMethod_GetAudioTracks() override {
       BaseClassMustBeDerivedFromService(this);
@@ -874,7 +1306,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestGetAudioTracks(::grpc::ServerContext* context, ::player::GetAudioTracksRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::GetAudioTracksReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(14, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(21, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -883,7 +1315,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithAsyncMethod_GetSubtitlesTracks() {
-      ::grpc::Service::MarkMethodAsync(15);
+      ::grpc::Service::MarkMethodAsync(22);
     }
     ~WithAsyncMethod_GetSubtitlesTracks() override {
       BaseClassMustBeDerivedFromService(this);
@@ -894,7 +1326,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestGetSubtitlesTracks(::grpc::ServerContext* context, ::player::GetSubtitlesTracksRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::GetSubtitlesTracksReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(15, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(22, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -903,7 +1335,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithAsyncMethod_SetVideoTrack() {
-      ::grpc::Service::MarkMethodAsync(16);
+      ::grpc::Service::MarkMethodAsync(23);
     }
     ~WithAsyncMethod_SetVideoTrack() override {
       BaseClassMustBeDerivedFromService(this);
@@ -914,7 +1346,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestSetVideoTrack(::grpc::ServerContext* context, ::player::SetVideoTrackRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::SetVideoTrackReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(16, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(23, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -923,7 +1355,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithAsyncMethod_SetAudioTrack() {
-      ::grpc::Service::MarkMethodAsync(17);
+      ::grpc::Service::MarkMethodAsync(24);
     }
     ~WithAsyncMethod_SetAudioTrack() override {
       BaseClassMustBeDerivedFromService(this);
@@ -934,7 +1366,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestSetAudioTrack(::grpc::ServerContext* context, ::player::SetAudioTrackRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::SetAudioTrackReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(17, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(24, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -943,7 +1375,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithAsyncMethod_SetSubtitlesTrack() {
-      ::grpc::Service::MarkMethodAsync(18);
+      ::grpc::Service::MarkMethodAsync(25);
     }
     ~WithAsyncMethod_SetSubtitlesTrack() override {
       BaseClassMustBeDerivedFromService(this);
@@ -954,125 +1386,225 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestSetSubtitlesTrack(::grpc::ServerContext* context, ::player::SetSubtitlesTrackRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::SetSubtitlesTrackReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(18, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(25, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
-  class WithAsyncMethod_Stop : public BaseClass {
+  class WithAsyncMethod_GetMediaInfo : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithAsyncMethod_Stop() {
-      ::grpc::Service::MarkMethodAsync(19);
+    WithAsyncMethod_GetMediaInfo() {
+      ::grpc::Service::MarkMethodAsync(26);
     }
-    ~WithAsyncMethod_Stop() override {
+    ~WithAsyncMethod_GetMediaInfo() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status Stop(::grpc::ServerContext* /*context*/, const ::player::StopRequest* /*request*/, ::player::StopReply* /*response*/) override {
+    ::grpc::Status GetMediaInfo(::grpc::ServerContext* /*context*/, const ::player::GetMediaInfoRequest* /*request*/, ::player::GetMediaInfoReply* /*response*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
-    void RequestStop(::grpc::ServerContext* context, ::player::StopRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::StopReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(19, context, request, response, new_call_cq, notification_cq, tag);
+    void RequestGetMediaInfo(::grpc::ServerContext* context, ::player::GetMediaInfoRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::GetMediaInfoReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(26, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
-  class WithAsyncMethod_Close : public BaseClass {
+  class WithAsyncMethod_GetChapters : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithAsyncMethod_Close() {
-      ::grpc::Service::MarkMethodAsync(20);
+    WithAsyncMethod_GetChapters() {
+      ::grpc::Service::MarkMethodAsync(27);
     }
-    ~WithAsyncMethod_Close() override {
+    ~WithAsyncMethod_GetChapters() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status Close(::grpc::ServerContext* /*context*/, const ::player::CloseRequest* /*request*/, ::player::CloseReply* /*response*/) override {
+    ::grpc::Status GetChapters(::grpc::ServerContext* /*context*/, const ::player::GetChaptersRequest* /*request*/, ::player::GetChaptersReply* /*response*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
-    void RequestClose(::grpc::ServerContext* context, ::player::CloseRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::CloseReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(20, context, request, response, new_call_cq, notification_cq, tag);
+    void RequestGetChapters(::grpc::ServerContext* context, ::player::GetChaptersRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::GetChaptersReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(27, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
-  typedef WithAsyncMethod_Open<WithAsyncMethod_SetupForStreaming<WithAsyncMethod_ProcessTitle<WithAsyncMethod_GetLink<WithAsyncMethod_EndChan<WithAsyncMethod_IsEnded<WithAsyncMethod_GetPosition<WithAsyncMethod_GetLength<WithAsyncMethod_GetSpeed<WithAsyncMethod_SetSpeed<WithAsyncMethod_GetPause<WithAsyncMethod_SetPause<WithAsyncMethod_Seek<WithAsyncMethod_GetVideoTracks<WithAsyncMethod_GetAudioTracks<WithAsyncMethod_GetSubtitlesTracks<WithAsyncMethod_SetVideoTrack<WithAsyncMethod_SetAudioTrack<WithAsyncMethod_SetSubtitlesTrack<WithAsyncMethod_Stop<WithAsyncMethod_Close<Service > > > > > > > > > > > > > > > > > > > > > AsyncService;
   template <class BaseClass>
-  class WithCallbackMethod_Open : public BaseClass {
+  class WithAsyncMethod_GetChapter : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithCallbackMethod_Open() {
-      ::grpc::Service::MarkMethodCallback(0,
-          new ::grpc::internal::CallbackUnaryHandler< ::player::OpenRequest, ::player::OpenReply>(
-            [this](
-                   ::grpc::CallbackServerContext* context, const ::player::OpenRequest* request, ::player::OpenReply* response) { return this->Open(context, request, response); }));}
-    void SetMessageAllocatorFor_Open(
-        ::grpc::MessageAllocator< ::player::OpenRequest, ::player::OpenReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(0);
-      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::OpenRequest, ::player::OpenReply>*>(handler)
-              ->SetMessageAllocator(allocator);
+    WithAsyncMethod_GetChapter() {
+      ::grpc::Service::MarkMethodAsync(28);
     }
-    ~WithCallbackMethod_Open() override {
+    ~WithAsyncMethod_GetChapter() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status Open(::grpc::ServerContext* /*context*/, const ::player::OpenRequest* /*request*/, ::player::OpenReply* /*response*/) override {
+    ::grpc::Status GetChapter(::grpc::ServerContext* /*context*/, const ::player::GetChapterRequest* /*request*/, ::player::GetChapterReply* /*response*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
-    virtual ::grpc::ServerUnaryReactor* Open(
-      ::grpc::CallbackServerContext* /*context*/, const ::player::OpenRequest* /*request*/, ::player::OpenReply* /*response*/)  { return nullptr; }
+    void RequestGetChapter(::grpc::ServerContext* context, ::player::GetChapterRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::GetChapterReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(28, context, request, response, new_call_cq, notification_cq, tag);
+    }
   };
   template <class BaseClass>
-  class WithCallbackMethod_SetupForStreaming : public BaseClass {
+  class WithAsyncMethod_SetChapter : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithCallbackMethod_SetupForStreaming() {
-      ::grpc::Service::MarkMethodCallback(1,
-          new ::grpc::internal::CallbackUnaryHandler< ::player::SetupForStreamingRequest, ::player::SetupForStreamingReply>(
-            [this](
-                   ::grpc::CallbackServerContext* context, const ::player::SetupForStreamingRequest* request, ::player::SetupForStreamingReply* response) { return this->SetupForStreaming(context, request, response); }));}
-    void SetMessageAllocatorFor_SetupForStreaming(
-        ::grpc::MessageAllocator< ::player::SetupForStreamingRequest, ::player::SetupForStreamingReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(1);
-      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::SetupForStreamingRequest, ::player::SetupForStreamingReply>*>(handler)
-              ->SetMessageAllocator(allocator);
+    WithAsyncMethod_SetChapter() {
+      ::grpc::Service::MarkMethodAsync(29);
     }
-    ~WithCallbackMethod_SetupForStreaming() override {
+    ~WithAsyncMethod_SetChapter() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status SetupForStreaming(::grpc::ServerContext* /*context*/, const ::player::SetupForStreamingRequest* /*request*/, ::player::SetupForStreamingReply* /*response*/) override {
+    ::grpc::Status SetChapter(::grpc::ServerContext* /*context*/, const ::player::SetChapterRequest* /*request*/, ::player::SetChapterReply* /*response*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
-    virtual ::grpc::ServerUnaryReactor* SetupForStreaming(
-      ::grpc::CallbackServerContext* /*context*/, const ::player::SetupForStreamingRequest* /*request*/, ::player::SetupForStreamingReply* /*response*/)  { return nullptr; }
+    void RequestSetChapter(::grpc::ServerContext* context, ::player::SetChapterRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::SetChapterReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(29, context, request, response, new_call_cq, notification_cq, tag);
+    }
   };
   template <class BaseClass>
-  class WithCallbackMethod_ProcessTitle : public BaseClass {
+  class WithAsyncMethod_Stop : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithCallbackMethod_ProcessTitle() {
-      ::grpc::Service::MarkMethodCallback(2,
-          new ::grpc::internal::CallbackUnaryHandler< ::player::ProcessTitleRequest, ::player::ProcessTitleReply>(
-            [this](
-                   ::grpc::CallbackServerContext* context, const ::player::ProcessTitleRequest* request, ::player::ProcessTitleReply* response) { return this->ProcessTitle(context, request, response); }));}
-    void SetMessageAllocatorFor_ProcessTitle(
-        ::grpc::MessageAllocator< ::player::ProcessTitleRequest, ::player::ProcessTitleReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(2);
-      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::ProcessTitleRequest, ::player::ProcessTitleReply>*>(handler)
-              ->SetMessageAllocator(allocator);
+    WithAsyncMethod_Stop() {
+      ::grpc::Service::MarkMethodAsync(30);
     }
-    ~WithCallbackMethod_ProcessTitle() override {
+    ~WithAsyncMethod_Stop() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status ProcessTitle(::grpc::ServerContext* /*context*/, const ::player::ProcessTitleRequest* /*request*/, ::player::ProcessTitleReply* /*response*/) override {
+    ::grpc::Status Stop(::grpc::ServerContext* /*context*/, const ::player::StopRequest* /*request*/, ::player::StopReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestStop(::grpc::ServerContext* context, ::player::StopRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::StopReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(30, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithAsyncMethod_Close : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithAsyncMethod_Close() {
+      ::grpc::Service::MarkMethodAsync(31);
+    }
+    ~WithAsyncMethod_Close() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status Close(::grpc::ServerContext* /*context*/, const ::player::CloseRequest* /*request*/, ::player::CloseReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestClose(::grpc::ServerContext* context, ::player::CloseRequest* request, ::grpc::ServerAsyncResponseWriter< ::player::CloseReply>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(31, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithAsyncMethod_Events : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithAsyncMethod_Events() {
+      ::grpc::Service::MarkMethodAsync(32);
+    }
+    ~WithAsyncMethod_Events() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status Events(::grpc::ServerContext* /*context*/, const ::player::EventsRequest* /*request*/, ::grpc::ServerWriter< ::player::Event>* /*writer*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestEvents(::grpc::ServerContext* context, ::player::EventsRequest* request, ::grpc::ServerAsyncWriter< ::player::Event>* writer, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncServerStreaming(32, context, request, writer, new_call_cq, notification_cq, tag);
+    }
+  };
+  typedef WithAsyncMethod_Open<WithAsyncMethod_SetupForStreaming<WithAsyncMethod_ProcessTitle<WithAsyncMethod_GetLink<WithAsyncMethod_EndChan<WithAsyncMethod_IsEnded<WithAsyncMethod_GetPosition<WithAsyncMethod_GetAudioPosition<WithAsyncMethod_GetLength<WithAsyncMethod_GetSpeed<WithAsyncMethod_SetSpeed<WithAsyncMethod_GetPause<WithAsyncMethod_SetPause<WithAsyncMethod_GetVolume<WithAsyncMethod_SetVolume<WithAsyncMethod_GetMute<WithAsyncMethod_SetMute<WithAsyncMethod_Seek<WithAsyncMethod_FrameStep<WithAsyncMethod_Screenshot<WithAsyncMethod_GetVideoTracks<WithAsyncMethod_GetAudioTracks<WithAsyncMethod_GetSubtitlesTracks<WithAsyncMethod_SetVideoTrack<WithAsyncMethod_SetAudioTrack<WithAsyncMethod_SetSubtitlesTrack<WithAsyncMethod_GetMediaInfo<WithAsyncMethod_GetChapters<WithAsyncMethod_GetChapter<WithAsyncMethod_SetChapter<WithAsyncMethod_Stop<WithAsyncMethod_Close<WithAsyncMethod_Events<Service > > > > > > > > > > > > > > > > > > > > > > > > > > > > > > > > > AsyncService;
+  template <class BaseClass>
+  class WithCallbackMethod_Open : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithCallbackMethod_Open() {
+      ::grpc::Service::MarkMethodCallback(0,
+          new ::grpc::internal::CallbackUnaryHandler< ::player::OpenRequest, ::player::OpenReply>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::player::OpenRequest* request, ::player::OpenReply* response) { return this->Open(context, request, response); }));}
+    void SetMessageAllocatorFor_Open(
+        ::grpc::MessageAllocator< ::player::OpenRequest, ::player::OpenReply>* allocator) {
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(0);
+      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::OpenRequest, ::player::OpenReply>*>(handler)
+              ->SetMessageAllocator(allocator);
+    }
+    ~WithCallbackMethod_Open() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status Open(::grpc::ServerContext* /*context*/, const ::player::OpenRequest* /*request*/, ::player::OpenReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* Open(
+      ::grpc::CallbackServerContext* /*context*/, const ::player::OpenRequest* /*request*/, ::player::OpenReply* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithCallbackMethod_SetupForStreaming : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithCallbackMethod_SetupForStreaming() {
+      ::grpc::Service::MarkMethodCallback(1,
+          new ::grpc::internal::CallbackUnaryHandler< ::player::SetupForStreamingRequest, ::player::SetupForStreamingReply>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::player::SetupForStreamingRequest* request, ::player::SetupForStreamingReply* response) { return this->SetupForStreaming(context, request, response); }));}
+    void SetMessageAllocatorFor_SetupForStreaming(
+        ::grpc::MessageAllocator< ::player::SetupForStreamingRequest, ::player::SetupForStreamingReply>* allocator) {
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(1);
+      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::SetupForStreamingRequest, ::player::SetupForStreamingReply>*>(handler)
+              ->SetMessageAllocator(allocator);
+    }
+    ~WithCallbackMethod_SetupForStreaming() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetupForStreaming(::grpc::ServerContext* /*context*/, const ::player::SetupForStreamingRequest* /*request*/, ::player::SetupForStreamingReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* SetupForStreaming(
+      ::grpc::CallbackServerContext* /*context*/, const ::player::SetupForStreamingRequest* /*request*/, ::player::SetupForStreamingReply* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithCallbackMethod_ProcessTitle : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithCallbackMethod_ProcessTitle() {
+      ::grpc::Service::MarkMethodCallback(2,
+          new ::grpc::internal::CallbackUnaryHandler< ::player::ProcessTitleRequest, ::player::ProcessTitleReply>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::player::ProcessTitleRequest* request, ::player::ProcessTitleReply* response) { return this->ProcessTitle(context, request, response); }));}
+    void SetMessageAllocatorFor_ProcessTitle(
+        ::grpc::MessageAllocator< ::player::ProcessTitleRequest, ::player::ProcessTitleReply>* allocator) {
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(2);
+      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::ProcessTitleRequest, ::player::ProcessTitleReply>*>(handler)
+              ->SetMessageAllocator(allocator);
+    }
+    ~WithCallbackMethod_ProcessTitle() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status ProcessTitle(::grpc::ServerContext* /*context*/, const ::player::ProcessTitleRequest* /*request*/, ::player::ProcessTitleReply* /*response*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
@@ -1183,18 +1715,45 @@ class Player final {
       ::grpc::CallbackServerContext* /*context*/, const ::player::GetPositionRequest* /*request*/, ::player::GetPositionReply* /*response*/)  { return nullptr; }
   };
   template <class BaseClass>
+  class WithCallbackMethod_GetAudioPosition : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithCallbackMethod_GetAudioPosition() {
+      ::grpc::Service::MarkMethodCallback(7,
+          new ::grpc::internal::CallbackUnaryHandler< ::player::GetAudioPositionRequest, ::player::GetAudioPositionReply>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::player::GetAudioPositionRequest* request, ::player::GetAudioPositionReply* response) { return this->GetAudioPosition(context, request, response); }));}
+    void SetMessageAllocatorFor_GetAudioPosition(
+        ::grpc::MessageAllocator< ::player::GetAudioPositionRequest, ::player::GetAudioPositionReply>* allocator) {
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(7);
+      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::GetAudioPositionRequest, ::player::GetAudioPositionReply>*>(handler)
+              ->SetMessageAllocator(allocator);
+    }
+    ~WithCallbackMethod_GetAudioPosition() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetAudioPosition(::grpc::ServerContext* /*context*/, const ::player::GetAudioPositionRequest* /*request*/, ::player::GetAudioPositionReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* GetAudioPosition(
+      ::grpc::CallbackServerContext* /*context*/, const ::player::GetAudioPositionRequest* /*request*/, ::player::GetAudioPositionReply* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
   class WithCallbackMethod_GetLength : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithCallbackMethod_GetLength() {
-      ::grpc::Service::MarkMethodCallback(7,
+      ::grpc::Service::MarkMethodCallback(8,
           new ::grpc::internal::CallbackUnaryHandler< ::player::GetLengthRequest, ::player::GetLengthReply>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::player::GetLengthRequest* request, ::player::GetLengthReply* response) { return this->GetLength(context, request, response); }));}
     void SetMessageAllocatorFor_GetLength(
         ::grpc::MessageAllocator< ::player::GetLengthRequest, ::player::GetLengthReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(7);
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(8);
       static_cast<::grpc::internal::CallbackUnaryHandler< ::player::GetLengthRequest, ::player::GetLengthReply>*>(handler)
               ->SetMessageAllocator(allocator);
     }
@@ -1215,13 +1774,13 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithCallbackMethod_GetSpeed() {
-      ::grpc::Service::MarkMethodCallback(8,
+      ::grpc::Service::MarkMethodCallback(9,
           new ::grpc::internal::CallbackUnaryHandler< ::player::GetSpeedRequest, ::player::GetSpeedReply>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::player::GetSpeedRequest* request, ::player::GetSpeedReply* response) { return this->GetSpeed(context, request, response); }));}
     void SetMessageAllocatorFor_GetSpeed(
         ::grpc::MessageAllocator< ::player::GetSpeedRequest, ::player::GetSpeedReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(8);
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(9);
       static_cast<::grpc::internal::CallbackUnaryHandler< ::player::GetSpeedRequest, ::player::GetSpeedReply>*>(handler)
               ->SetMessageAllocator(allocator);
     }
@@ -1242,13 +1801,13 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithCallbackMethod_SetSpeed() {
-      ::grpc::Service::MarkMethodCallback(9,
+      ::grpc::Service::MarkMethodCallback(10,
           new ::grpc::internal::CallbackUnaryHandler< ::player::SetSpeedRequest, ::player::SetSpeedReply>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::player::SetSpeedRequest* request, ::player::SetSpeedReply* response) { return this->SetSpeed(context, request, response); }));}
     void SetMessageAllocatorFor_SetSpeed(
         ::grpc::MessageAllocator< ::player::SetSpeedRequest, ::player::SetSpeedReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(9);
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(10);
       static_cast<::grpc::internal::CallbackUnaryHandler< ::player::SetSpeedRequest, ::player::SetSpeedReply>*>(handler)
               ->SetMessageAllocator(allocator);
     }
@@ -1269,13 +1828,13 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithCallbackMethod_GetPause() {
-      ::grpc::Service::MarkMethodCallback(10,
+      ::grpc::Service::MarkMethodCallback(11,
           new ::grpc::internal::CallbackUnaryHandler< ::player::GetPauseRequest, ::player::GetPauseReply>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::player::GetPauseRequest* request, ::player::GetPauseReply* response) { return this->GetPause(context, request, response); }));}
     void SetMessageAllocatorFor_GetPause(
         ::grpc::MessageAllocator< ::player::GetPauseRequest, ::player::GetPauseReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(10);
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(11);
       static_cast<::grpc::internal::CallbackUnaryHandler< ::player::GetPauseRequest, ::player::GetPauseReply>*>(handler)
               ->SetMessageAllocator(allocator);
     }
@@ -1296,13 +1855,13 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithCallbackMethod_SetPause() {
-      ::grpc::Service::MarkMethodCallback(11,
+      ::grpc::Service::MarkMethodCallback(12,
           new ::grpc::internal::CallbackUnaryHandler< ::player::SetPauseRequest, ::player::SetPauseReply>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::player::SetPauseRequest* request, ::player::SetPauseReply* response) { return this->SetPause(context, request, response); }));}
     void SetMessageAllocatorFor_SetPause(
         ::grpc::MessageAllocator< ::player::SetPauseRequest, ::player::SetPauseReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(11);
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(12);
       static_cast<::grpc::internal::CallbackUnaryHandler< ::player::SetPauseRequest, ::player::SetPauseReply>*>(handler)
               ->SetMessageAllocator(allocator);
     }
@@ -1318,18 +1877,126 @@ class Player final {
       ::grpc::CallbackServerContext* /*context*/, const ::player::SetPauseRequest* /*request*/, ::player::SetPauseReply* /*response*/)  { return nullptr; }
   };
   template <class BaseClass>
+  class WithCallbackMethod_GetVolume : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithCallbackMethod_GetVolume() {
+      ::grpc::Service::MarkMethodCallback(13,
+          new ::grpc::internal::CallbackUnaryHandler< ::player::GetVolumeRequest, ::player::GetVolumeReply>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::player::GetVolumeRequest* request, ::player::GetVolumeReply* response) { return this->GetVolume(context, request, response); }));}
+    void SetMessageAllocatorFor_GetVolume(
+        ::grpc::MessageAllocator< ::player::GetVolumeRequest, ::player::GetVolumeReply>* allocator) {
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(13);
+      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::GetVolumeRequest, ::player::GetVolumeReply>*>(handler)
+              ->SetMessageAllocator(allocator);
+    }
+    ~WithCallbackMethod_GetVolume() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetVolume(::grpc::ServerContext* /*context*/, const ::player::GetVolumeRequest* /*request*/, ::player::GetVolumeReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* GetVolume(
+      ::grpc::CallbackServerContext* /*context*/, const ::player::GetVolumeRequest* /*request*/, ::player::GetVolumeReply* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithCallbackMethod_SetVolume : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithCallbackMethod_SetVolume() {
+      ::grpc::Service::MarkMethodCallback(14,
+          new ::grpc::internal::CallbackUnaryHandler< ::player::SetVolumeRequest, ::player::SetVolumeReply>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::player::SetVolumeRequest* request, ::player::SetVolumeReply* response) { return this->SetVolume(context, request, response); }));}
+    void SetMessageAllocatorFor_SetVolume(
+        ::grpc::MessageAllocator< ::player::SetVolumeRequest, ::player::SetVolumeReply>* allocator) {
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(14);
+      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::SetVolumeRequest, ::player::SetVolumeReply>*>(handler)
+              ->SetMessageAllocator(allocator);
+    }
+    ~WithCallbackMethod_SetVolume() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetVolume(::grpc::ServerContext* /*context*/, const ::player::SetVolumeRequest* /*request*/, ::player::SetVolumeReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* SetVolume(
+      ::grpc::CallbackServerContext* /*context*/, const ::player::SetVolumeRequest* /*request*/, ::player::SetVolumeReply* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithCallbackMethod_GetMute : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithCallbackMethod_GetMute() {
+      ::grpc::Service::MarkMethodCallback(15,
+          new ::grpc::internal::CallbackUnaryHandler< ::player::GetMuteRequest, ::player::GetMuteReply>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::player::GetMuteRequest* request, ::player::GetMuteReply* response) { return this->GetMute(context, request, response); }));}
+    void SetMessageAllocatorFor_GetMute(
+        ::grpc::MessageAllocator< ::player::GetMuteRequest, ::player::GetMuteReply>* allocator) {
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(15);
+      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::GetMuteRequest, ::player::GetMuteReply>*>(handler)
+              ->SetMessageAllocator(allocator);
+    }
+    ~WithCallbackMethod_GetMute() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetMute(::grpc::ServerContext* /*context*/, const ::player::GetMuteRequest* /*request*/, ::player::GetMuteReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* GetMute(
+      ::grpc::CallbackServerContext* /*context*/, const ::player::GetMuteRequest* /*request*/, ::player::GetMuteReply* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithCallbackMethod_SetMute : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithCallbackMethod_SetMute() {
+      ::grpc::Service::MarkMethodCallback(16,
+          new ::grpc::internal::CallbackUnaryHandler< ::player::SetMuteRequest, ::player::SetMuteReply>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::player::SetMuteRequest* request, ::player::SetMuteReply* response) { return this->SetMute(context, request, response); }));}
+    void SetMessageAllocatorFor_SetMute(
+        ::grpc::MessageAllocator< ::player::SetMuteRequest, ::player::SetMuteReply>* allocator) {
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(16);
+      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::SetMuteRequest, ::player::SetMuteReply>*>(handler)
+              ->SetMessageAllocator(allocator);
+    }
+    ~WithCallbackMethod_SetMute() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetMute(::grpc::ServerContext* /*context*/, const ::player::SetMuteRequest* /*request*/, ::player::SetMuteReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* SetMute(
+      ::grpc::CallbackServerContext* /*context*/, const ::player::SetMuteRequest* /*request*/, ::player::SetMuteReply* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
   class WithCallbackMethod_Seek : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithCallbackMethod_Seek() {
-      ::grpc::Service::MarkMethodCallback(12,
+      ::grpc::Service::MarkMethodCallback(17,
           new ::grpc::internal::CallbackUnaryHandler< ::player::SeekRequest, ::player::SeekReply>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::player::SeekRequest* request, ::player::SeekReply* response) { return this->Seek(context, request, response); }));}
     void SetMessageAllocatorFor_Seek(
         ::grpc::MessageAllocator< ::player::SeekRequest, ::player::SeekReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(12);
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(17);
       static_cast<::grpc::internal::CallbackUnaryHandler< ::player::SeekRequest, ::player::SeekReply>*>(handler)
               ->SetMessageAllocator(allocator);
     }
@@ -1345,18 +2012,72 @@ class Player final {
       ::grpc::CallbackServerContext* /*context*/, const ::player::SeekRequest* /*request*/, ::player::SeekReply* /*response*/)  { return nullptr; }
   };
   template <class BaseClass>
+  class WithCallbackMethod_FrameStep : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithCallbackMethod_FrameStep() {
+      ::grpc::Service::MarkMethodCallback(18,
+          new ::grpc::internal::CallbackUnaryHandler< ::player::FrameStepRequest, ::player::FrameStepReply>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::player::FrameStepRequest* request, ::player::FrameStepReply* response) { return this->FrameStep(context, request, response); }));}
+    void SetMessageAllocatorFor_FrameStep(
+        ::grpc::MessageAllocator< ::player::FrameStepRequest, ::player::FrameStepReply>* allocator) {
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(18);
+      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::FrameStepRequest, ::player::FrameStepReply>*>(handler)
+              ->SetMessageAllocator(allocator);
+    }
+    ~WithCallbackMethod_FrameStep() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status FrameStep(::grpc::ServerContext* /*context*/, const ::player::FrameStepRequest* /*request*/, ::player::FrameStepReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* FrameStep(
+      ::grpc::CallbackServerContext* /*context*/, const ::player::FrameStepRequest* /*request*/, ::player::FrameStepReply* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithCallbackMethod_Screenshot : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithCallbackMethod_Screenshot() {
+      ::grpc::Service::MarkMethodCallback(19,
+          new ::grpc::internal::CallbackUnaryHandler< ::player::ScreenshotRequest, ::player::ScreenshotReply>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::player::ScreenshotRequest* request, ::player::ScreenshotReply* response) { return this->Screenshot(context, request, response); }));}
+    void SetMessageAllocatorFor_Screenshot(
+        ::grpc::MessageAllocator< ::player::ScreenshotRequest, ::player::ScreenshotReply>* allocator) {
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(19);
+      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::ScreenshotRequest, ::player::ScreenshotReply>*>(handler)
+              ->SetMessageAllocator(allocator);
+    }
+    ~WithCallbackMethod_Screenshot() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status Screenshot(::grpc::ServerContext* /*context*/, const ::player::ScreenshotRequest* /*request*/, ::player::ScreenshotReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* Screenshot(
+      ::grpc::CallbackServerContext* /*context*/, const ::player::ScreenshotRequest* /*request*/, ::player::ScreenshotReply* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
   class WithCallbackMethod_GetVideoTracks : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithCallbackMethod_GetVideoTracks() {
-      ::grpc::Service::MarkMethodCallback(13,
+      ::grpc::Service::MarkMethodCallback(20,
           new ::grpc::internal::CallbackUnaryHandler< ::player::GetVideoTracksRequest, ::player::GetVideoTracksReply>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::player::GetVideoTracksRequest* request, ::player::GetVideoTracksReply* response) { return this->GetVideoTracks(context, request, response); }));}
     void SetMessageAllocatorFor_GetVideoTracks(
         ::grpc::MessageAllocator< ::player::GetVideoTracksRequest, ::player::GetVideoTracksReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(13);
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(20);
       static_cast<::grpc::internal::CallbackUnaryHandler< ::player::GetVideoTracksRequest, ::player::GetVideoTracksReply>*>(handler)
               ->SetMessageAllocator(allocator);
     }
@@ -1377,13 +2098,13 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithCallbackMethod_GetAudioTracks() {
-      ::grpc::Service::MarkMethodCallback(14,
+      ::grpc::Service::MarkMethodCallback(21,
           new ::grpc::internal::CallbackUnaryHandler< ::player::GetAudioTracksRequest, ::player::GetAudioTracksReply>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::player::GetAudioTracksRequest* request, ::player::GetAudioTracksReply* response) { return this->GetAudioTracks(context, request, response); }));}
     void SetMessageAllocatorFor_GetAudioTracks(
         ::grpc::MessageAllocator< ::player::GetAudioTracksRequest, ::player::GetAudioTracksReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(14);
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(21);
       static_cast<::grpc::internal::CallbackUnaryHandler< ::player::GetAudioTracksRequest, ::player::GetAudioTracksReply>*>(handler)
               ->SetMessageAllocator(allocator);
     }
@@ -1404,13 +2125,13 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithCallbackMethod_GetSubtitlesTracks() {
-      ::grpc::Service::MarkMethodCallback(15,
+      ::grpc::Service::MarkMethodCallback(22,
           new ::grpc::internal::CallbackUnaryHandler< ::player::GetSubtitlesTracksRequest, ::player::GetSubtitlesTracksReply>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::player::GetSubtitlesTracksRequest* request, ::player::GetSubtitlesTracksReply* response) { return this->GetSubtitlesTracks(context, request, response); }));}
     void SetMessageAllocatorFor_GetSubtitlesTracks(
         ::grpc::MessageAllocator< ::player::GetSubtitlesTracksRequest, ::player::GetSubtitlesTracksReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(15);
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(22);
       static_cast<::grpc::internal::CallbackUnaryHandler< ::player::GetSubtitlesTracksRequest, ::player::GetSubtitlesTracksReply>*>(handler)
               ->SetMessageAllocator(allocator);
     }
@@ -1431,13 +2152,13 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithCallbackMethod_SetVideoTrack() {
-      ::grpc::Service::MarkMethodCallback(16,
+      ::grpc::Service::MarkMethodCallback(23,
           new ::grpc::internal::CallbackUnaryHandler< ::player::SetVideoTrackRequest, ::player::SetVideoTrackReply>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::player::SetVideoTrackRequest* request, ::player::SetVideoTrackReply* response) { return this->SetVideoTrack(context, request, response); }));}
     void SetMessageAllocatorFor_SetVideoTrack(
         ::grpc::MessageAllocator< ::player::SetVideoTrackRequest, ::player::SetVideoTrackReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(16);
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(23);
       static_cast<::grpc::internal::CallbackUnaryHandler< ::player::SetVideoTrackRequest, ::player::SetVideoTrackReply>*>(handler)
               ->SetMessageAllocator(allocator);
     }
@@ -1458,13 +2179,13 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithCallbackMethod_SetAudioTrack() {
-      ::grpc::Service::MarkMethodCallback(17,
+      ::grpc::Service::MarkMethodCallback(24,
           new ::grpc::internal::CallbackUnaryHandler< ::player::SetAudioTrackRequest, ::player::SetAudioTrackReply>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::player::SetAudioTrackRequest* request, ::player::SetAudioTrackReply* response) { return this->SetAudioTrack(context, request, response); }));}
     void SetMessageAllocatorFor_SetAudioTrack(
         ::grpc::MessageAllocator< ::player::SetAudioTrackRequest, ::player::SetAudioTrackReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(17);
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(24);
       static_cast<::grpc::internal::CallbackUnaryHandler< ::player::SetAudioTrackRequest, ::player::SetAudioTrackReply>*>(handler)
               ->SetMessageAllocator(allocator);
     }
@@ -1485,13 +2206,13 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithCallbackMethod_SetSubtitlesTrack() {
-      ::grpc::Service::MarkMethodCallback(18,
+      ::grpc::Service::MarkMethodCallback(25,
           new ::grpc::internal::CallbackUnaryHandler< ::player::SetSubtitlesTrackRequest, ::player::SetSubtitlesTrackReply>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::player::SetSubtitlesTrackRequest* request, ::player::SetSubtitlesTrackReply* response) { return this->SetSubtitlesTrack(context, request, response); }));}
     void SetMessageAllocatorFor_SetSubtitlesTrack(
         ::grpc::MessageAllocator< ::player::SetSubtitlesTrackRequest, ::player::SetSubtitlesTrackReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(18);
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(25);
       static_cast<::grpc::internal::CallbackUnaryHandler< ::player::SetSubtitlesTrackRequest, ::player::SetSubtitlesTrackReply>*>(handler)
               ->SetMessageAllocator(allocator);
     }
@@ -1507,18 +2228,126 @@ class Player final {
       ::grpc::CallbackServerContext* /*context*/, const ::player::SetSubtitlesTrackRequest* /*request*/, ::player::SetSubtitlesTrackReply* /*response*/)  { return nullptr; }
   };
   template <class BaseClass>
+  class WithCallbackMethod_GetMediaInfo : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithCallbackMethod_GetMediaInfo() {
+      ::grpc::Service::MarkMethodCallback(26,
+          new ::grpc::internal::CallbackUnaryHandler< ::player::GetMediaInfoRequest, ::player::GetMediaInfoReply>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::player::GetMediaInfoRequest* request, ::player::GetMediaInfoReply* response) { return this->GetMediaInfo(context, request, response); }));}
+    void SetMessageAllocatorFor_GetMediaInfo(
+        ::grpc::MessageAllocator< ::player::GetMediaInfoRequest, ::player::GetMediaInfoReply>* allocator) {
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(26);
+      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::GetMediaInfoRequest, ::player::GetMediaInfoReply>*>(handler)
+              ->SetMessageAllocator(allocator);
+    }
+    ~WithCallbackMethod_GetMediaInfo() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetMediaInfo(::grpc::ServerContext* /*context*/, const ::player::GetMediaInfoRequest* /*request*/, ::player::GetMediaInfoReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* GetMediaInfo(
+      ::grpc::CallbackServerContext* /*context*/, const ::player::GetMediaInfoRequest* /*request*/, ::player::GetMediaInfoReply* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithCallbackMethod_GetChapters : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithCallbackMethod_GetChapters() {
+      ::grpc::Service::MarkMethodCallback(27,
+          new ::grpc::internal::CallbackUnaryHandler< ::player::GetChaptersRequest, ::player::GetChaptersReply>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::player::GetChaptersRequest* request, ::player::GetChaptersReply* response) { return this->GetChapters(context, request, response); }));}
+    void SetMessageAllocatorFor_GetChapters(
+        ::grpc::MessageAllocator< ::player::GetChaptersRequest, ::player::GetChaptersReply>* allocator) {
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(27);
+      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::GetChaptersRequest, ::player::GetChaptersReply>*>(handler)
+              ->SetMessageAllocator(allocator);
+    }
+    ~WithCallbackMethod_GetChapters() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetChapters(::grpc::ServerContext* /*context*/, const ::player::GetChaptersRequest* /*request*/, ::player::GetChaptersReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* GetChapters(
+      ::grpc::CallbackServerContext* /*context*/, const ::player::GetChaptersRequest* /*request*/, ::player::GetChaptersReply* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithCallbackMethod_GetChapter : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithCallbackMethod_GetChapter() {
+      ::grpc::Service::MarkMethodCallback(28,
+          new ::grpc::internal::CallbackUnaryHandler< ::player::GetChapterRequest, ::player::GetChapterReply>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::player::GetChapterRequest* request, ::player::GetChapterReply* response) { return this->GetChapter(context, request, response); }));}
+    void SetMessageAllocatorFor_GetChapter(
+        ::grpc::MessageAllocator< ::player::GetChapterRequest, ::player::GetChapterReply>* allocator) {
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(28);
+      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::GetChapterRequest, ::player::GetChapterReply>*>(handler)
+              ->SetMessageAllocator(allocator);
+    }
+    ~WithCallbackMethod_GetChapter() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetChapter(::grpc::ServerContext* /*context*/, const ::player::GetChapterRequest* /*request*/, ::player::GetChapterReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* GetChapter(
+      ::grpc::CallbackServerContext* /*context*/, const ::player::GetChapterRequest* /*request*/, ::player::GetChapterReply* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithCallbackMethod_SetChapter : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithCallbackMethod_SetChapter() {
+      ::grpc::Service::MarkMethodCallback(29,
+          new ::grpc::internal::CallbackUnaryHandler< ::player::SetChapterRequest, ::player::SetChapterReply>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::player::SetChapterRequest* request, ::player::SetChapterReply* response) { return this->SetChapter(context, request, response); }));}
+    void SetMessageAllocatorFor_SetChapter(
+        ::grpc::MessageAllocator< ::player::SetChapterRequest, ::player::SetChapterReply>* allocator) {
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(29);
+      static_cast<::grpc::internal::CallbackUnaryHandler< ::player::SetChapterRequest, ::player::SetChapterReply>*>(handler)
+              ->SetMessageAllocator(allocator);
+    }
+    ~WithCallbackMethod_SetChapter() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetChapter(::grpc::ServerContext* /*context*/, const ::player::SetChapterRequest* /*request*/, ::player::SetChapterReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* SetChapter(
+      ::grpc::CallbackServerContext* /*context*/, const ::player::SetChapterRequest* /*request*/, ::player::SetChapterReply* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
   class WithCallbackMethod_Stop : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithCallbackMethod_Stop() {
-      ::grpc::Service::MarkMethodCallback(19,
+      ::grpc::Service::MarkMethodCallback(30,
           new ::grpc::internal::CallbackUnaryHandler< ::player::StopRequest, ::player::StopReply>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::player::StopRequest* request, ::player::StopReply* response) { return this->Stop(context, request, response); }));}
     void SetMessageAllocatorFor_Stop(
         ::grpc::MessageAllocator< ::player::StopRequest, ::player::StopReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(19);
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(30);
       static_cast<::grpc::internal::CallbackUnaryHandler< ::player::StopRequest, ::player::StopReply>*>(handler)
               ->SetMessageAllocator(allocator);
     }
@@ -1539,13 +2368,13 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithCallbackMethod_Close() {
-      ::grpc::Service::MarkMethodCallback(20,
+      ::grpc::Service::MarkMethodCallback(31,
           new ::grpc::internal::CallbackUnaryHandler< ::player::CloseRequest, ::player::CloseReply>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::player::CloseRequest* request, ::player::CloseReply* response) { return this->Close(context, request, response); }));}
     void SetMessageAllocatorFor_Close(
         ::grpc::MessageAllocator< ::player::CloseRequest, ::player::CloseReply>* allocator) {
-      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(20);
+      ::grpc::internal::MethodHandler* const handler = ::grpc::Service::GetHandler(31);
       static_cast<::grpc::internal::CallbackUnaryHandler< ::player::CloseRequest, ::player::CloseReply>*>(handler)
               ->SetMessageAllocator(allocator);
     }
@@ -1560,7 +2389,29 @@ class Player final {
     virtual ::grpc::ServerUnaryReactor* Close(
       ::grpc::CallbackServerContext* /*context*/, const ::player::CloseRequest* /*request*/, ::player::CloseReply* /*response*/)  { return nullptr; }
   };
-  typedef WithCallbackMethod_Open<WithCallbackMethod_SetupForStreaming<WithCallbackMethod_ProcessTitle<WithCallbackMethod_GetLink<WithCallbackMethod_EndChan<WithCallbackMethod_IsEnded<WithCallbackMethod_GetPosition<WithCallbackMethod_GetLength<WithCallbackMethod_GetSpeed<WithCallbackMethod_SetSpeed<WithCallbackMethod_GetPause<WithCallbackMethod_SetPause<WithCallbackMethod_Seek<WithCallbackMethod_GetVideoTracks<WithCallbackMethod_GetAudioTracks<WithCallbackMethod_GetSubtitlesTracks<WithCallbackMethod_SetVideoTrack<WithCallbackMethod_SetAudioTrack<WithCallbackMethod_SetSubtitlesTrack<WithCallbackMethod_Stop<WithCallbackMethod_Close<Service > > > > > > > > > > > > > > > > > > > > > CallbackService;
+  template <class BaseClass>
+  class WithCallbackMethod_Events : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithCallbackMethod_Events() {
+      ::grpc::Service::MarkMethodCallback(32,
+          new ::grpc::internal::CallbackServerStreamingHandler< ::player::EventsRequest, ::player::Event>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::player::EventsRequest* request) { return this->Events(context, request); }));
+    }
+    ~WithCallbackMethod_Events() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status Events(::grpc::ServerContext* /*context*/, const ::player::EventsRequest* /*request*/, ::grpc::ServerWriter< ::player::Event>* /*writer*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerWriteReactor< ::player::Event>* Events(
+      ::grpc::CallbackServerContext* /*context*/, const ::player::EventsRequest* /*request*/)  { return nullptr; }
+  };
+  typedef WithCallbackMethod_Open<WithCallbackMethod_SetupForStreaming<WithCallbackMethod_ProcessTitle<WithCallbackMethod_GetLink<WithCallbackMethod_EndChan<WithCallbackMethod_IsEnded<WithCallbackMethod_GetPosition<WithCallbackMethod_GetAudioPosition<WithCallbackMethod_GetLength<WithCallbackMethod_GetSpeed<WithCallbackMethod_SetSpeed<WithCallbackMethod_GetPause<WithCallbackMethod_SetPause<WithCallbackMethod_GetVolume<WithCallbackMethod_SetVolume<WithCallbackMethod_GetMute<WithCallbackMethod_SetMute<WithCallbackMethod_Seek<WithCallbackMethod_FrameStep<WithCallbackMethod_Screenshot<WithCallbackMethod_GetVideoTracks<WithCallbackMethod_GetAudioTracks<WithCallbackMethod_GetSubtitlesTracks<WithCallbackMethod_SetVideoTrack<WithCallbackMethod_SetAudioTrack<WithCallbackMethod_SetSubtitlesTrack<WithCallbackMethod_GetMediaInfo<WithCallbackMethod_GetChapters<WithCallbackMethod_GetChapter<WithCallbackMethod_SetChapter<WithCallbackMethod_Stop<WithCallbackMethod_Close<WithCallbackMethod_Events<Service > > > > > > > > > > > > > > > > > > > > > > > > > > > > > > > > > CallbackService;
   typedef CallbackService ExperimentalCallbackService;
   template <class BaseClass>
   class WithGenericMethod_Open : public BaseClass {
@@ -1682,12 +2533,29 @@ class Player final {
     }
   };
   template <class BaseClass>
+  class WithGenericMethod_GetAudioPosition : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithGenericMethod_GetAudioPosition() {
+      ::grpc::Service::MarkMethodGeneric(7);
+    }
+    ~WithGenericMethod_GetAudioPosition() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetAudioPosition(::grpc::ServerContext* /*context*/, const ::player::GetAudioPositionRequest* /*request*/, ::player::GetAudioPositionReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
   class WithGenericMethod_GetLength : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithGenericMethod_GetLength() {
-      ::grpc::Service::MarkMethodGeneric(7);
+      ::grpc::Service::MarkMethodGeneric(8);
     }
     ~WithGenericMethod_GetLength() override {
       BaseClassMustBeDerivedFromService(this);
@@ -1704,7 +2572,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithGenericMethod_GetSpeed() {
-      ::grpc::Service::MarkMethodGeneric(8);
+      ::grpc::Service::MarkMethodGeneric(9);
     }
     ~WithGenericMethod_GetSpeed() override {
       BaseClassMustBeDerivedFromService(this);
@@ -1721,7 +2589,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithGenericMethod_SetSpeed() {
-      ::grpc::Service::MarkMethodGeneric(9);
+      ::grpc::Service::MarkMethodGeneric(10);
     }
     ~WithGenericMethod_SetSpeed() override {
       BaseClassMustBeDerivedFromService(this);
@@ -1738,7 +2606,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithGenericMethod_GetPause() {
-      ::grpc::Service::MarkMethodGeneric(10);
+      ::grpc::Service::MarkMethodGeneric(11);
     }
     ~WithGenericMethod_GetPause() override {
       BaseClassMustBeDerivedFromService(this);
@@ -1755,7 +2623,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithGenericMethod_SetPause() {
-      ::grpc::Service::MarkMethodGeneric(11);
+      ::grpc::Service::MarkMethodGeneric(12);
     }
     ~WithGenericMethod_SetPause() override {
       BaseClassMustBeDerivedFromService(this);
@@ -1767,12 +2635,80 @@ class Player final {
     }
   };
   template <class BaseClass>
+  class WithGenericMethod_GetVolume : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithGenericMethod_GetVolume() {
+      ::grpc::Service::MarkMethodGeneric(13);
+    }
+    ~WithGenericMethod_GetVolume() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetVolume(::grpc::ServerContext* /*context*/, const ::player::GetVolumeRequest* /*request*/, ::player::GetVolumeReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
+  class WithGenericMethod_SetVolume : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithGenericMethod_SetVolume() {
+      ::grpc::Service::MarkMethodGeneric(14);
+    }
+    ~WithGenericMethod_SetVolume() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetVolume(::grpc::ServerContext* /*context*/, const ::player::SetVolumeRequest* /*request*/, ::player::SetVolumeReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
+  class WithGenericMethod_GetMute : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithGenericMethod_GetMute() {
+      ::grpc::Service::MarkMethodGeneric(15);
+    }
+    ~WithGenericMethod_GetMute() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetMute(::grpc::ServerContext* /*context*/, const ::player::GetMuteRequest* /*request*/, ::player::GetMuteReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
+  class WithGenericMethod_SetMute : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithGenericMethod_SetMute() {
+      ::grpc::Service::MarkMethodGeneric(16);
+    }
+    ~WithGenericMethod_SetMute() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetMute(::grpc::ServerContext* /*context*/, const ::player::SetMuteRequest* /*request*/, ::player::SetMuteReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
   class WithGenericMethod_Seek : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithGenericMethod_Seek() {
-      ::grpc::Service::MarkMethodGeneric(12);
+      ::grpc::Service::MarkMethodGeneric(17);
     }
     ~WithGenericMethod_Seek() override {
       BaseClassMustBeDerivedFromService(this);
@@ -1784,103 +2720,205 @@ class Player final {
     }
   };
   template <class BaseClass>
+  class WithGenericMethod_FrameStep : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithGenericMethod_FrameStep() {
+      ::grpc::Service::MarkMethodGeneric(18);
+    }
+    ~WithGenericMethod_FrameStep() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status FrameStep(::grpc::ServerContext* /*context*/, const ::player::FrameStepRequest* /*request*/, ::player::FrameStepReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
+  class WithGenericMethod_Screenshot : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithGenericMethod_Screenshot() {
+      ::grpc::Service::MarkMethodGeneric(19);
+    }
+    ~WithGenericMethod_Screenshot() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status Screenshot(::grpc::ServerContext* /*context*/, const ::player::ScreenshotRequest* /*request*/, ::player::ScreenshotReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
   class WithGenericMethod_GetVideoTracks : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithGenericMethod_GetVideoTracks() {
-      ::grpc::Service::MarkMethodGeneric(13);
+    WithGenericMethod_GetVideoTracks() {
+      ::grpc::Service::MarkMethodGeneric(20);
+    }
+    ~WithGenericMethod_GetVideoTracks() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetVideoTracks(::grpc::ServerContext* /*context*/, const ::player::GetVideoTracksRequest* /*request*/, ::player::GetVideoTracksReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
+  class WithGenericMethod_GetAudioTracks : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithGenericMethod_GetAudioTracks() {
+      ::grpc::Service::MarkMethodGeneric(21);
+    }
+    ~WithGenericMethod_GetAudioTracks() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetAudioTracks(::grpc::ServerContext* /*context*/, const ::player::GetAudioTracksRequest* /*request*/, ::player::GetAudioTracksReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
+  class WithGenericMethod_GetSubtitlesTracks : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithGenericMethod_GetSubtitlesTracks() {
+      ::grpc::Service::MarkMethodGeneric(22);
+    }
+    ~WithGenericMethod_GetSubtitlesTracks() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetSubtitlesTracks(::grpc::ServerContext* /*context*/, const ::player::GetSubtitlesTracksRequest* /*request*/, ::player::GetSubtitlesTracksReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
+  class WithGenericMethod_SetVideoTrack : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithGenericMethod_SetVideoTrack() {
+      ::grpc::Service::MarkMethodGeneric(23);
+    }
+    ~WithGenericMethod_SetVideoTrack() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetVideoTrack(::grpc::ServerContext* /*context*/, const ::player::SetVideoTrackRequest* /*request*/, ::player::SetVideoTrackReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
+  class WithGenericMethod_SetAudioTrack : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithGenericMethod_SetAudioTrack() {
+      ::grpc::Service::MarkMethodGeneric(24);
     }
-    ~WithGenericMethod_GetVideoTracks() override {
+    ~WithGenericMethod_SetAudioTrack() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status GetVideoTracks(::grpc::ServerContext* /*context*/, const ::player::GetVideoTracksRequest* /*request*/, ::player::GetVideoTracksReply* /*response*/) override {
+    ::grpc::Status SetAudioTrack(::grpc::ServerContext* /*context*/, const ::player::SetAudioTrackRequest* /*request*/, ::player::SetAudioTrackReply* /*response*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
   };
   template <class BaseClass>
-  class WithGenericMethod_GetAudioTracks : public BaseClass {
+  class WithGenericMethod_SetSubtitlesTrack : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithGenericMethod_GetAudioTracks() {
-      ::grpc::Service::MarkMethodGeneric(14);
+    WithGenericMethod_SetSubtitlesTrack() {
+      ::grpc::Service::MarkMethodGeneric(25);
     }
-    ~WithGenericMethod_GetAudioTracks() override {
+    ~WithGenericMethod_SetSubtitlesTrack() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status GetAudioTracks(::grpc::ServerContext* /*context*/, const ::player::GetAudioTracksRequest* /*request*/, ::player::GetAudioTracksReply* /*response*/) override {
+    ::grpc::Status SetSubtitlesTrack(::grpc::ServerContext* /*context*/, const ::player::SetSubtitlesTrackRequest* /*request*/, ::player::SetSubtitlesTrackReply* /*response*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
   };
   template <class BaseClass>
-  class WithGenericMethod_GetSubtitlesTracks : public BaseClass {
+  class WithGenericMethod_GetMediaInfo : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithGenericMethod_GetSubtitlesTracks() {
-      ::grpc::Service::MarkMethodGeneric(15);
+    WithGenericMethod_GetMediaInfo() {
+      ::grpc::Service::MarkMethodGeneric(26);
     }
-    ~WithGenericMethod_GetSubtitlesTracks() override {
+    ~WithGenericMethod_GetMediaInfo() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status GetSubtitlesTracks(::grpc::ServerContext* /*context*/, const ::player::GetSubtitlesTracksRequest* /*request*/, ::player::GetSubtitlesTracksReply* /*response*/) override {
+    ::grpc::Status GetMediaInfo(::grpc::ServerContext* /*context*/, const ::player::GetMediaInfoRequest* /*request*/, ::player::GetMediaInfoReply* /*response*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
   };
   template <class BaseClass>
-  class WithGenericMethod_SetVideoTrack : public BaseClass {
+  class WithGenericMethod_GetChapters : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithGenericMethod_SetVideoTrack() {
-      ::grpc::Service::MarkMethodGeneric(16);
+    WithGenericMethod_GetChapters() {
+      ::grpc::Service::MarkMethodGeneric(27);
     }
-    ~WithGenericMethod_SetVideoTrack() override {
+    ~WithGenericMethod_GetChapters() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status SetVideoTrack(::grpc::ServerContext* /*context*/, const ::player::SetVideoTrackRequest* /*request*/, ::player::SetVideoTrackReply* /*response*/) override {
+    ::grpc::Status GetChapters(::grpc::ServerContext* /*context*/, const ::player::GetChaptersRequest* /*request*/, ::player::GetChaptersReply* /*response*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
   };
   template <class BaseClass>
-  class WithGenericMethod_SetAudioTrack : public BaseClass {
+  class WithGenericMethod_GetChapter : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithGenericMethod_SetAudioTrack() {
-      ::grpc::Service::MarkMethodGeneric(17);
+    WithGenericMethod_GetChapter() {
+      ::grpc::Service::MarkMethodGeneric(28);
     }
-    ~WithGenericMethod_SetAudioTrack() override {
+    ~WithGenericMethod_GetChapter() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status SetAudioTrack(::grpc::ServerContext* /*context*/, const ::player::SetAudioTrackRequest* /*request*/, ::player::SetAudioTrackReply* /*response*/) override {
+    ::grpc::Status GetChapter(::grpc::ServerContext* /*context*/, const ::player::GetChapterRequest* /*request*/, ::player::GetChapterReply* /*response*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
   };
   template <class BaseClass>
-  class WithGenericMethod_SetSubtitlesTrack : public BaseClass {
+  class WithGenericMethod_SetChapter : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithGenericMethod_SetSubtitlesTrack() {
-      ::grpc::Service::MarkMethodGeneric(18);
+    WithGenericMethod_SetChapter() {
+      ::grpc::Service::MarkMethodGeneric(29);
     }
-    ~WithGenericMethod_SetSubtitlesTrack() override {
+    ~WithGenericMethod_SetChapter() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status SetSubtitlesTrack(::grpc::ServerContext* /*context*/, const ::player::SetSubtitlesTrackRequest* /*request*/, ::player::SetSubtitlesTrackReply* /*response*/) override {
+    ::grpc::Status SetChapter(::grpc::ServerContext* /*context*/, const ::player::SetChapterRequest* /*request*/, ::player::SetChapterReply* /*response*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
@@ -1891,7 +2929,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithGenericMethod_Stop() {
-      ::grpc::Service::MarkMethodGeneric(19);
+      ::grpc::Service::MarkMethodGeneric(30);
     }
     ~WithGenericMethod_Stop() override {
       BaseClassMustBeDerivedFromService(this);
@@ -1908,7 +2946,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithGenericMethod_Close() {
-      ::grpc::Service::MarkMethodGeneric(20);
+      ::grpc::Service::MarkMethodGeneric(31);
     }
     ~WithGenericMethod_Close() override {
       BaseClassMustBeDerivedFromService(this);
@@ -1920,6 +2958,23 @@ class Player final {
     }
   };
   template <class BaseClass>
+  class WithGenericMethod_Events : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithGenericMethod_Events() {
+      ::grpc::Service::MarkMethodGeneric(32);
+    }
+    ~WithGenericMethod_Events() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status Events(::grpc::ServerContext* /*context*/, const ::player::EventsRequest* /*request*/, ::grpc::ServerWriter< ::player::Event>* /*writer*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+  };
+  template <class BaseClass>
   class WithRawMethod_Open : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
@@ -2060,12 +3115,32 @@ class Player final {
     }
   };
   template <class BaseClass>
+  class WithRawMethod_GetAudioPosition : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawMethod_GetAudioPosition() {
+      ::grpc::Service::MarkMethodRaw(7);
+    }
+    ~WithRawMethod_GetAudioPosition() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetAudioPosition(::grpc::ServerContext* /*context*/, const ::player::GetAudioPositionRequest* /*request*/, ::player::GetAudioPositionReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestGetAudioPosition(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(7, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
   class WithRawMethod_GetLength : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawMethod_GetLength() {
-      ::grpc::Service::MarkMethodRaw(7);
+      ::grpc::Service::MarkMethodRaw(8);
     }
     ~WithRawMethod_GetLength() override {
       BaseClassMustBeDerivedFromService(this);
@@ -2076,7 +3151,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestGetLength(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(7, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(8, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -2085,7 +3160,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawMethod_GetSpeed() {
-      ::grpc::Service::MarkMethodRaw(8);
+      ::grpc::Service::MarkMethodRaw(9);
     }
     ~WithRawMethod_GetSpeed() override {
       BaseClassMustBeDerivedFromService(this);
@@ -2096,7 +3171,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestGetSpeed(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(8, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(9, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -2105,7 +3180,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawMethod_SetSpeed() {
-      ::grpc::Service::MarkMethodRaw(9);
+      ::grpc::Service::MarkMethodRaw(10);
     }
     ~WithRawMethod_SetSpeed() override {
       BaseClassMustBeDerivedFromService(this);
@@ -2116,7 +3191,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestSetSpeed(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(9, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(10, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -2125,7 +3200,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawMethod_GetPause() {
-      ::grpc::Service::MarkMethodRaw(10);
+      ::grpc::Service::MarkMethodRaw(11);
     }
     ~WithRawMethod_GetPause() override {
       BaseClassMustBeDerivedFromService(this);
@@ -2136,7 +3211,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestGetPause(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(10, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(11, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -2145,7 +3220,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawMethod_SetPause() {
-      ::grpc::Service::MarkMethodRaw(11);
+      ::grpc::Service::MarkMethodRaw(12);
     }
     ~WithRawMethod_SetPause() override {
       BaseClassMustBeDerivedFromService(this);
@@ -2156,7 +3231,87 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestSetPause(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(11, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(12, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithRawMethod_GetVolume : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawMethod_GetVolume() {
+      ::grpc::Service::MarkMethodRaw(13);
+    }
+    ~WithRawMethod_GetVolume() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetVolume(::grpc::ServerContext* /*context*/, const ::player::GetVolumeRequest* /*request*/, ::player::GetVolumeReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestGetVolume(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(13, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithRawMethod_SetVolume : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawMethod_SetVolume() {
+      ::grpc::Service::MarkMethodRaw(14);
+    }
+    ~WithRawMethod_SetVolume() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetVolume(::grpc::ServerContext* /*context*/, const ::player::SetVolumeRequest* /*request*/, ::player::SetVolumeReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestSetVolume(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(14, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithRawMethod_GetMute : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawMethod_GetMute() {
+      ::grpc::Service::MarkMethodRaw(15);
+    }
+    ~WithRawMethod_GetMute() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetMute(::grpc::ServerContext* /*context*/, const ::player::GetMuteRequest* /*request*/, ::player::GetMuteReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestGetMute(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(15, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithRawMethod_SetMute : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawMethod_SetMute() {
+      ::grpc::Service::MarkMethodRaw(16);
+    }
+    ~WithRawMethod_SetMute() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetMute(::grpc::ServerContext* /*context*/, const ::player::SetMuteRequest* /*request*/, ::player::SetMuteReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestSetMute(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(16, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -2165,7 +3320,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawMethod_Seek() {
-      ::grpc::Service::MarkMethodRaw(12);
+      ::grpc::Service::MarkMethodRaw(17);
     }
     ~WithRawMethod_Seek() override {
       BaseClassMustBeDerivedFromService(this);
@@ -2176,7 +3331,47 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestSeek(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(12, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(17, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithRawMethod_FrameStep : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawMethod_FrameStep() {
+      ::grpc::Service::MarkMethodRaw(18);
+    }
+    ~WithRawMethod_FrameStep() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status FrameStep(::grpc::ServerContext* /*context*/, const ::player::FrameStepRequest* /*request*/, ::player::FrameStepReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestFrameStep(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(18, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithRawMethod_Screenshot : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawMethod_Screenshot() {
+      ::grpc::Service::MarkMethodRaw(19);
+    }
+    ~WithRawMethod_Screenshot() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status Screenshot(::grpc::ServerContext* /*context*/, const ::player::ScreenshotRequest* /*request*/, ::player::ScreenshotReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestScreenshot(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(19, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -2185,7 +3380,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawMethod_GetVideoTracks() {
-      ::grpc::Service::MarkMethodRaw(13);
+      ::grpc::Service::MarkMethodRaw(20);
     }
     ~WithRawMethod_GetVideoTracks() override {
       BaseClassMustBeDerivedFromService(this);
@@ -2196,7 +3391,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestGetVideoTracks(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(13, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(20, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -2205,7 +3400,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawMethod_GetAudioTracks() {
-      ::grpc::Service::MarkMethodRaw(14);
+      ::grpc::Service::MarkMethodRaw(21);
     }
     ~WithRawMethod_GetAudioTracks() override {
       BaseClassMustBeDerivedFromService(this);
@@ -2216,7 +3411,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestGetAudioTracks(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(14, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(21, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -2224,79 +3419,159 @@ class Player final {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithRawMethod_GetSubtitlesTracks() {
-      ::grpc::Service::MarkMethodRaw(15);
+    WithRawMethod_GetSubtitlesTracks() {
+      ::grpc::Service::MarkMethodRaw(22);
+    }
+    ~WithRawMethod_GetSubtitlesTracks() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetSubtitlesTracks(::grpc::ServerContext* /*context*/, const ::player::GetSubtitlesTracksRequest* /*request*/, ::player::GetSubtitlesTracksReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestGetSubtitlesTracks(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(22, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithRawMethod_SetVideoTrack : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawMethod_SetVideoTrack() {
+      ::grpc::Service::MarkMethodRaw(23);
+    }
+    ~WithRawMethod_SetVideoTrack() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetVideoTrack(::grpc::ServerContext* /*context*/, const ::player::SetVideoTrackRequest* /*request*/, ::player::SetVideoTrackReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestSetVideoTrack(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(23, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithRawMethod_SetAudioTrack : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawMethod_SetAudioTrack() {
+      ::grpc::Service::MarkMethodRaw(24);
+    }
+    ~WithRawMethod_SetAudioTrack() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetAudioTrack(::grpc::ServerContext* /*context*/, const ::player::SetAudioTrackRequest* /*request*/, ::player::SetAudioTrackReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestSetAudioTrack(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(24, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithRawMethod_SetSubtitlesTrack : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawMethod_SetSubtitlesTrack() {
+      ::grpc::Service::MarkMethodRaw(25);
+    }
+    ~WithRawMethod_SetSubtitlesTrack() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetSubtitlesTrack(::grpc::ServerContext* /*context*/, const ::player::SetSubtitlesTrackRequest* /*request*/, ::player::SetSubtitlesTrackReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestSetSubtitlesTrack(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(25, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithRawMethod_GetMediaInfo : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawMethod_GetMediaInfo() {
+      ::grpc::Service::MarkMethodRaw(26);
     }
-    ~WithRawMethod_GetSubtitlesTracks() override {
+    ~WithRawMethod_GetMediaInfo() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status GetSubtitlesTracks(::grpc::ServerContext* /*context*/, const ::player::GetSubtitlesTracksRequest* /*request*/, ::player::GetSubtitlesTracksReply* /*response*/) override {
+    ::grpc::Status GetMediaInfo(::grpc::ServerContext* /*context*/, const ::player::GetMediaInfoRequest* /*request*/, ::player::GetMediaInfoReply* /*response*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
-    void RequestGetSubtitlesTracks(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(15, context, request, response, new_call_cq, notification_cq, tag);
+    void RequestGetMediaInfo(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(26, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
-  class WithRawMethod_SetVideoTrack : public BaseClass {
+  class WithRawMethod_GetChapters : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithRawMethod_SetVideoTrack() {
-      ::grpc::Service::MarkMethodRaw(16);
+    WithRawMethod_GetChapters() {
+      ::grpc::Service::MarkMethodRaw(27);
     }
-    ~WithRawMethod_SetVideoTrack() override {
+    ~WithRawMethod_GetChapters() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status SetVideoTrack(::grpc::ServerContext* /*context*/, const ::player::SetVideoTrackRequest* /*request*/, ::player::SetVideoTrackReply* /*response*/) override {
+    ::grpc::Status GetChapters(::grpc::ServerContext* /*context*/, const ::player::GetChaptersRequest* /*request*/, ::player::GetChaptersReply* /*response*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
-    void RequestSetVideoTrack(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(16, context, request, response, new_call_cq, notification_cq, tag);
+    void RequestGetChapters(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(27, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
-  class WithRawMethod_SetAudioTrack : public BaseClass {
+  class WithRawMethod_GetChapter : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithRawMethod_SetAudioTrack() {
-      ::grpc::Service::MarkMethodRaw(17);
+    WithRawMethod_GetChapter() {
+      ::grpc::Service::MarkMethodRaw(28);
     }
-    ~WithRawMethod_SetAudioTrack() override {
+    ~WithRawMethod_GetChapter() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status SetAudioTrack(::grpc::ServerContext* /*context*/, const ::player::SetAudioTrackRequest* /*request*/, ::player::SetAudioTrackReply* /*response*/) override {
+    ::grpc::Status GetChapter(::grpc::ServerContext* /*context*/, const ::player::GetChapterRequest* /*request*/, ::player::GetChapterReply* /*response*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
-    void RequestSetAudioTrack(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(17, context, request, response, new_call_cq, notification_cq, tag);
+    void RequestGetChapter(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(28, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
-  class WithRawMethod_SetSubtitlesTrack : public BaseClass {
+  class WithRawMethod_SetChapter : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithRawMethod_SetSubtitlesTrack() {
-      ::grpc::Service::MarkMethodRaw(18);
+    WithRawMethod_SetChapter() {
+      ::grpc::Service::MarkMethodRaw(29);
     }
-    ~WithRawMethod_SetSubtitlesTrack() override {
+    ~WithRawMethod_SetChapter() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status SetSubtitlesTrack(::grpc::ServerContext* /*context*/, const ::player::SetSubtitlesTrackRequest* /*request*/, ::player::SetSubtitlesTrackReply* /*response*/) override {
+    ::grpc::Status SetChapter(::grpc::ServerContext* /*context*/, const ::player::SetChapterRequest* /*request*/, ::player::SetChapterReply* /*response*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
-    void RequestSetSubtitlesTrack(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(18, context, request, response, new_call_cq, notification_cq, tag);
+    void RequestSetChapter(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncUnary(29, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -2305,7 +3580,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawMethod_Stop() {
-      ::grpc::Service::MarkMethodRaw(19);
+      ::grpc::Service::MarkMethodRaw(30);
     }
     ~WithRawMethod_Stop() override {
       BaseClassMustBeDerivedFromService(this);
@@ -2316,7 +3591,7 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestStop(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(19, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(30, context, request, response, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -2325,7 +3600,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawMethod_Close() {
-      ::grpc::Service::MarkMethodRaw(20);
+      ::grpc::Service::MarkMethodRaw(31);
     }
     ~WithRawMethod_Close() override {
       BaseClassMustBeDerivedFromService(this);
@@ -2336,7 +3611,27 @@ class Player final {
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
     void RequestClose(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncResponseWriter< ::grpc::ByteBuffer>* response, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
-      ::grpc::Service::RequestAsyncUnary(20, context, request, response, new_call_cq, notification_cq, tag);
+      ::grpc::Service::RequestAsyncUnary(31, context, request, response, new_call_cq, notification_cq, tag);
+    }
+  };
+  template <class BaseClass>
+  class WithRawMethod_Events : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawMethod_Events() {
+      ::grpc::Service::MarkMethodRaw(32);
+    }
+    ~WithRawMethod_Events() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status Events(::grpc::ServerContext* /*context*/, const ::player::EventsRequest* /*request*/, ::grpc::ServerWriter< ::player::Event>* /*writer*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    void RequestEvents(::grpc::ServerContext* context, ::grpc::ByteBuffer* request, ::grpc::ServerAsyncWriter< ::grpc::ByteBuffer>* writer, ::grpc::CompletionQueue* new_call_cq, ::grpc::ServerCompletionQueue* notification_cq, void *tag) {
+      ::grpc::Service::RequestAsyncServerStreaming(32, context, request, writer, new_call_cq, notification_cq, tag);
     }
   };
   template <class BaseClass>
@@ -2494,12 +3789,34 @@ class Player final {
       ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
   };
   template <class BaseClass>
+  class WithRawCallbackMethod_GetAudioPosition : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawCallbackMethod_GetAudioPosition() {
+      ::grpc::Service::MarkMethodRawCallback(7,
+          new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->GetAudioPosition(context, request, response); }));
+    }
+    ~WithRawCallbackMethod_GetAudioPosition() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetAudioPosition(::grpc::ServerContext* /*context*/, const ::player::GetAudioPositionRequest* /*request*/, ::player::GetAudioPositionReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* GetAudioPosition(
+      ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
   class WithRawCallbackMethod_GetLength : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawCallbackMethod_GetLength() {
-      ::grpc::Service::MarkMethodRawCallback(7,
+      ::grpc::Service::MarkMethodRawCallback(8,
           new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->GetLength(context, request, response); }));
@@ -2521,7 +3838,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawCallbackMethod_GetSpeed() {
-      ::grpc::Service::MarkMethodRawCallback(8,
+      ::grpc::Service::MarkMethodRawCallback(9,
           new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->GetSpeed(context, request, response); }));
@@ -2543,7 +3860,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawCallbackMethod_SetSpeed() {
-      ::grpc::Service::MarkMethodRawCallback(9,
+      ::grpc::Service::MarkMethodRawCallback(10,
           new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->SetSpeed(context, request, response); }));
@@ -2565,7 +3882,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawCallbackMethod_GetPause() {
-      ::grpc::Service::MarkMethodRawCallback(10,
+      ::grpc::Service::MarkMethodRawCallback(11,
           new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->GetPause(context, request, response); }));
@@ -2587,7 +3904,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawCallbackMethod_SetPause() {
-      ::grpc::Service::MarkMethodRawCallback(11,
+      ::grpc::Service::MarkMethodRawCallback(12,
           new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->SetPause(context, request, response); }));
@@ -2604,12 +3921,100 @@ class Player final {
       ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
   };
   template <class BaseClass>
+  class WithRawCallbackMethod_GetVolume : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawCallbackMethod_GetVolume() {
+      ::grpc::Service::MarkMethodRawCallback(13,
+          new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->GetVolume(context, request, response); }));
+    }
+    ~WithRawCallbackMethod_GetVolume() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetVolume(::grpc::ServerContext* /*context*/, const ::player::GetVolumeRequest* /*request*/, ::player::GetVolumeReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* GetVolume(
+      ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithRawCallbackMethod_SetVolume : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawCallbackMethod_SetVolume() {
+      ::grpc::Service::MarkMethodRawCallback(14,
+          new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->SetVolume(context, request, response); }));
+    }
+    ~WithRawCallbackMethod_SetVolume() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetVolume(::grpc::ServerContext* /*context*/, const ::player::SetVolumeRequest* /*request*/, ::player::SetVolumeReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* SetVolume(
+      ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithRawCallbackMethod_GetMute : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawCallbackMethod_GetMute() {
+      ::grpc::Service::MarkMethodRawCallback(15,
+          new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->GetMute(context, request, response); }));
+    }
+    ~WithRawCallbackMethod_GetMute() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetMute(::grpc::ServerContext* /*context*/, const ::player::GetMuteRequest* /*request*/, ::player::GetMuteReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* GetMute(
+      ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithRawCallbackMethod_SetMute : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawCallbackMethod_SetMute() {
+      ::grpc::Service::MarkMethodRawCallback(16,
+          new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->SetMute(context, request, response); }));
+    }
+    ~WithRawCallbackMethod_SetMute() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetMute(::grpc::ServerContext* /*context*/, const ::player::SetMuteRequest* /*request*/, ::player::SetMuteReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* SetMute(
+      ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
   class WithRawCallbackMethod_Seek : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawCallbackMethod_Seek() {
-      ::grpc::Service::MarkMethodRawCallback(12,
+      ::grpc::Service::MarkMethodRawCallback(17,
           new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->Seek(context, request, response); }));
@@ -2626,12 +4031,56 @@ class Player final {
       ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
   };
   template <class BaseClass>
+  class WithRawCallbackMethod_FrameStep : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawCallbackMethod_FrameStep() {
+      ::grpc::Service::MarkMethodRawCallback(18,
+          new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->FrameStep(context, request, response); }));
+    }
+    ~WithRawCallbackMethod_FrameStep() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status FrameStep(::grpc::ServerContext* /*context*/, const ::player::FrameStepRequest* /*request*/, ::player::FrameStepReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* FrameStep(
+      ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithRawCallbackMethod_Screenshot : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawCallbackMethod_Screenshot() {
+      ::grpc::Service::MarkMethodRawCallback(19,
+          new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->Screenshot(context, request, response); }));
+    }
+    ~WithRawCallbackMethod_Screenshot() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status Screenshot(::grpc::ServerContext* /*context*/, const ::player::ScreenshotRequest* /*request*/, ::player::ScreenshotReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* Screenshot(
+      ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
   class WithRawCallbackMethod_GetVideoTracks : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawCallbackMethod_GetVideoTracks() {
-      ::grpc::Service::MarkMethodRawCallback(13,
+      ::grpc::Service::MarkMethodRawCallback(20,
           new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->GetVideoTracks(context, request, response); }));
@@ -2653,7 +4102,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawCallbackMethod_GetAudioTracks() {
-      ::grpc::Service::MarkMethodRawCallback(14,
+      ::grpc::Service::MarkMethodRawCallback(21,
           new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->GetAudioTracks(context, request, response); }));
@@ -2675,7 +4124,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawCallbackMethod_GetSubtitlesTracks() {
-      ::grpc::Service::MarkMethodRawCallback(15,
+      ::grpc::Service::MarkMethodRawCallback(22,
           new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->GetSubtitlesTracks(context, request, response); }));
@@ -2697,7 +4146,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawCallbackMethod_SetVideoTrack() {
-      ::grpc::Service::MarkMethodRawCallback(16,
+      ::grpc::Service::MarkMethodRawCallback(23,
           new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->SetVideoTrack(context, request, response); }));
@@ -2719,7 +4168,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawCallbackMethod_SetAudioTrack() {
-      ::grpc::Service::MarkMethodRawCallback(17,
+      ::grpc::Service::MarkMethodRawCallback(24,
           new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->SetAudioTrack(context, request, response); }));
@@ -2741,7 +4190,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawCallbackMethod_SetSubtitlesTrack() {
-      ::grpc::Service::MarkMethodRawCallback(18,
+      ::grpc::Service::MarkMethodRawCallback(25,
           new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->SetSubtitlesTrack(context, request, response); }));
@@ -2758,12 +4207,100 @@ class Player final {
       ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
   };
   template <class BaseClass>
+  class WithRawCallbackMethod_GetMediaInfo : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawCallbackMethod_GetMediaInfo() {
+      ::grpc::Service::MarkMethodRawCallback(26,
+          new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->GetMediaInfo(context, request, response); }));
+    }
+    ~WithRawCallbackMethod_GetMediaInfo() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetMediaInfo(::grpc::ServerContext* /*context*/, const ::player::GetMediaInfoRequest* /*request*/, ::player::GetMediaInfoReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* GetMediaInfo(
+      ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithRawCallbackMethod_GetChapters : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawCallbackMethod_GetChapters() {
+      ::grpc::Service::MarkMethodRawCallback(27,
+          new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->GetChapters(context, request, response); }));
+    }
+    ~WithRawCallbackMethod_GetChapters() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetChapters(::grpc::ServerContext* /*context*/, const ::player::GetChaptersRequest* /*request*/, ::player::GetChaptersReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* GetChapters(
+      ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithRawCallbackMethod_GetChapter : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawCallbackMethod_GetChapter() {
+      ::grpc::Service::MarkMethodRawCallback(28,
+          new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->GetChapter(context, request, response); }));
+    }
+    ~WithRawCallbackMethod_GetChapter() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status GetChapter(::grpc::ServerContext* /*context*/, const ::player::GetChapterRequest* /*request*/, ::player::GetChapterReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* GetChapter(
+      ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithRawCallbackMethod_SetChapter : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawCallbackMethod_SetChapter() {
+      ::grpc::Service::MarkMethodRawCallback(29,
+          new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->SetChapter(context, request, response); }));
+    }
+    ~WithRawCallbackMethod_SetChapter() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status SetChapter(::grpc::ServerContext* /*context*/, const ::player::SetChapterRequest* /*request*/, ::player::SetChapterReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* SetChapter(
+      ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
   class WithRawCallbackMethod_Stop : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithRawCallbackMethod_Stop() {
-      ::grpc::Service::MarkMethodRawCallback(19,
+      ::grpc::Service::MarkMethodRawCallback(30,
           new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
             [this](
                    ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->Stop(context, request, response); }));
@@ -2780,26 +4317,48 @@ class Player final {
       ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
   };
   template <class BaseClass>
-  class WithRawCallbackMethod_Close : public BaseClass {
+  class WithRawCallbackMethod_Close : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithRawCallbackMethod_Close() {
+      ::grpc::Service::MarkMethodRawCallback(31,
+          new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
+            [this](
+                   ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->Close(context, request, response); }));
+    }
+    ~WithRawCallbackMethod_Close() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable synchronous version of this method
+    ::grpc::Status Close(::grpc::ServerContext* /*context*/, const ::player::CloseRequest* /*request*/, ::player::CloseReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    virtual ::grpc::ServerUnaryReactor* Close(
+      ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
+  };
+  template <class BaseClass>
+  class WithRawCallbackMethod_Events : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
-    WithRawCallbackMethod_Close() {
-      ::grpc::Service::MarkMethodRawCallback(20,
-          new ::grpc::internal::CallbackUnaryHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
+    WithRawCallbackMethod_Events() {
+      ::grpc::Service::MarkMethodRawCallback(32,
+          new ::grpc::internal::CallbackServerStreamingHandler< ::grpc::ByteBuffer, ::grpc::ByteBuffer>(
             [this](
-                   ::grpc::CallbackServerContext* context, const ::grpc::ByteBuffer* request, ::grpc::ByteBuffer* response) { return this->Close(context, request, response); }));
+                   ::grpc::CallbackServerContext* context, const::grpc::ByteBuffer* request) { return this->Events(context, request); }));
     }
-    ~WithRawCallbackMethod_Close() override {
+    ~WithRawCallbackMethod_Events() override {
       BaseClassMustBeDerivedFromService(this);
     }
     // disable synchronous version of this method
-    ::grpc::Status Close(::grpc::ServerContext* /*context*/, const ::player::CloseRequest* /*request*/, ::player::CloseReply* /*response*/) override {
+    ::grpc::Status Events(::grpc::ServerContext* /*context*/, const ::player::EventsRequest* /*request*/, ::grpc::ServerWriter< ::player::Event>* /*writer*/) override {
       abort();
       return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
     }
-    virtual ::grpc::ServerUnaryReactor* Close(
-      ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/, ::grpc::ByteBuffer* /*response*/)  { return nullptr; }
+    virtual ::grpc::ServerWriteReactor< ::grpc::ByteBuffer>* Events(
+      ::grpc::CallbackServerContext* /*context*/, const ::grpc::ByteBuffer* /*request*/)  { return nullptr; }
   };
   template <class BaseClass>
   class WithStreamedUnaryMethod_Open : public BaseClass {
@@ -2964,12 +4523,39 @@ class Player final {
     virtual ::grpc::Status StreamedGetPosition(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::GetPositionRequest,::player::GetPositionReply>* server_unary_streamer) = 0;
   };
   template <class BaseClass>
+  class WithStreamedUnaryMethod_GetAudioPosition : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithStreamedUnaryMethod_GetAudioPosition() {
+      ::grpc::Service::MarkMethodStreamed(7,
+        new ::grpc::internal::StreamedUnaryHandler<
+          ::player::GetAudioPositionRequest, ::player::GetAudioPositionReply>(
+            [this](::grpc::ServerContext* context,
+                   ::grpc::ServerUnaryStreamer<
+                     ::player::GetAudioPositionRequest, ::player::GetAudioPositionReply>* streamer) {
+                       return this->StreamedGetAudioPosition(context,
+                         streamer);
+                  }));
+    }
+    ~WithStreamedUnaryMethod_GetAudioPosition() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status GetAudioPosition(::grpc::ServerContext* /*context*/, const ::player::GetAudioPositionRequest* /*request*/, ::player::GetAudioPositionReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with streamed unary
+    virtual ::grpc::Status StreamedGetAudioPosition(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::GetAudioPositionRequest,::player::GetAudioPositionReply>* server_unary_streamer) = 0;
+  };
+  template <class BaseClass>
   class WithStreamedUnaryMethod_GetLength : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithStreamedUnaryMethod_GetLength() {
-      ::grpc::Service::MarkMethodStreamed(7,
+      ::grpc::Service::MarkMethodStreamed(8,
         new ::grpc::internal::StreamedUnaryHandler<
           ::player::GetLengthRequest, ::player::GetLengthReply>(
             [this](::grpc::ServerContext* context,
@@ -2996,7 +4582,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithStreamedUnaryMethod_GetSpeed() {
-      ::grpc::Service::MarkMethodStreamed(8,
+      ::grpc::Service::MarkMethodStreamed(9,
         new ::grpc::internal::StreamedUnaryHandler<
           ::player::GetSpeedRequest, ::player::GetSpeedReply>(
             [this](::grpc::ServerContext* context,
@@ -3023,7 +4609,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithStreamedUnaryMethod_SetSpeed() {
-      ::grpc::Service::MarkMethodStreamed(9,
+      ::grpc::Service::MarkMethodStreamed(10,
         new ::grpc::internal::StreamedUnaryHandler<
           ::player::SetSpeedRequest, ::player::SetSpeedReply>(
             [this](::grpc::ServerContext* context,
@@ -3050,7 +4636,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithStreamedUnaryMethod_GetPause() {
-      ::grpc::Service::MarkMethodStreamed(10,
+      ::grpc::Service::MarkMethodStreamed(11,
         new ::grpc::internal::StreamedUnaryHandler<
           ::player::GetPauseRequest, ::player::GetPauseReply>(
             [this](::grpc::ServerContext* context,
@@ -3077,7 +4663,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithStreamedUnaryMethod_SetPause() {
-      ::grpc::Service::MarkMethodStreamed(11,
+      ::grpc::Service::MarkMethodStreamed(12,
         new ::grpc::internal::StreamedUnaryHandler<
           ::player::SetPauseRequest, ::player::SetPauseReply>(
             [this](::grpc::ServerContext* context,
@@ -3099,12 +4685,120 @@ class Player final {
     virtual ::grpc::Status StreamedSetPause(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::SetPauseRequest,::player::SetPauseReply>* server_unary_streamer) = 0;
   };
   template <class BaseClass>
+  class WithStreamedUnaryMethod_GetVolume : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithStreamedUnaryMethod_GetVolume() {
+      ::grpc::Service::MarkMethodStreamed(13,
+        new ::grpc::internal::StreamedUnaryHandler<
+          ::player::GetVolumeRequest, ::player::GetVolumeReply>(
+            [this](::grpc::ServerContext* context,
+                   ::grpc::ServerUnaryStreamer<
+                     ::player::GetVolumeRequest, ::player::GetVolumeReply>* streamer) {
+                       return this->StreamedGetVolume(context,
+                         streamer);
+                  }));
+    }
+    ~WithStreamedUnaryMethod_GetVolume() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status GetVolume(::grpc::ServerContext* /*context*/, const ::player::GetVolumeRequest* /*request*/, ::player::GetVolumeReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with streamed unary
+    virtual ::grpc::Status StreamedGetVolume(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::GetVolumeRequest,::player::GetVolumeReply>* server_unary_streamer) = 0;
+  };
+  template <class BaseClass>
+  class WithStreamedUnaryMethod_SetVolume : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithStreamedUnaryMethod_SetVolume() {
+      ::grpc::Service::MarkMethodStreamed(14,
+        new ::grpc::internal::StreamedUnaryHandler<
+          ::player::SetVolumeRequest, ::player::SetVolumeReply>(
+            [this](::grpc::ServerContext* context,
+                   ::grpc::ServerUnaryStreamer<
+                     ::player::SetVolumeRequest, ::player::SetVolumeReply>* streamer) {
+                       return this->StreamedSetVolume(context,
+                         streamer);
+                  }));
+    }
+    ~WithStreamedUnaryMethod_SetVolume() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status SetVolume(::grpc::ServerContext* /*context*/, const ::player::SetVolumeRequest* /*request*/, ::player::SetVolumeReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with streamed unary
+    virtual ::grpc::Status StreamedSetVolume(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::SetVolumeRequest,::player::SetVolumeReply>* server_unary_streamer) = 0;
+  };
+  template <class BaseClass>
+  class WithStreamedUnaryMethod_GetMute : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithStreamedUnaryMethod_GetMute() {
+      ::grpc::Service::MarkMethodStreamed(15,
+        new ::grpc::internal::StreamedUnaryHandler<
+          ::player::GetMuteRequest, ::player::GetMuteReply>(
+            [this](::grpc::ServerContext* context,
+                   ::grpc::ServerUnaryStreamer<
+                     ::player::GetMuteRequest, ::player::GetMuteReply>* streamer) {
+                       return this->StreamedGetMute(context,
+                         streamer);
+                  }));
+    }
+    ~WithStreamedUnaryMethod_GetMute() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status GetMute(::grpc::ServerContext* /*context*/, const ::player::GetMuteRequest* /*request*/, ::player::GetMuteReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with streamed unary
+    virtual ::grpc::Status StreamedGetMute(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::GetMuteRequest,::player::GetMuteReply>* server_unary_streamer) = 0;
+  };
+  template <class BaseClass>
+  class WithStreamedUnaryMethod_SetMute : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithStreamedUnaryMethod_SetMute() {
+      ::grpc::Service::MarkMethodStreamed(16,
+        new ::grpc::internal::StreamedUnaryHandler<
+          ::player::SetMuteRequest, ::player::SetMuteReply>(
+            [this](::grpc::ServerContext* context,
+                   ::grpc::ServerUnaryStreamer<
+                     ::player::SetMuteRequest, ::player::SetMuteReply>* streamer) {
+                       return this->StreamedSetMute(context,
+                         streamer);
+                  }));
+    }
+    ~WithStreamedUnaryMethod_SetMute() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status SetMute(::grpc::ServerContext* /*context*/, const ::player::SetMuteRequest* /*request*/, ::player::SetMuteReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with streamed unary
+    virtual ::grpc::Status StreamedSetMute(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::SetMuteRequest,::player::SetMuteReply>* server_unary_streamer) = 0;
+  };
+  template <class BaseClass>
   class WithStreamedUnaryMethod_Seek : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithStreamedUnaryMethod_Seek() {
-      ::grpc::Service::MarkMethodStreamed(12,
+      ::grpc::Service::MarkMethodStreamed(17,
         new ::grpc::internal::StreamedUnaryHandler<
           ::player::SeekRequest, ::player::SeekReply>(
             [this](::grpc::ServerContext* context,
@@ -3126,12 +4820,66 @@ class Player final {
     virtual ::grpc::Status StreamedSeek(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::SeekRequest,::player::SeekReply>* server_unary_streamer) = 0;
   };
   template <class BaseClass>
+  class WithStreamedUnaryMethod_FrameStep : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithStreamedUnaryMethod_FrameStep() {
+      ::grpc::Service::MarkMethodStreamed(18,
+        new ::grpc::internal::StreamedUnaryHandler<
+          ::player::FrameStepRequest, ::player::FrameStepReply>(
+            [this](::grpc::ServerContext* context,
+                   ::grpc::ServerUnaryStreamer<
+                     ::player::FrameStepRequest, ::player::FrameStepReply>* streamer) {
+                       return this->StreamedFrameStep(context,
+                         streamer);
+                  }));
+    }
+    ~WithStreamedUnaryMethod_FrameStep() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status FrameStep(::grpc::ServerContext* /*context*/, const ::player::FrameStepRequest* /*request*/, ::player::FrameStepReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with streamed unary
+    virtual ::grpc::Status StreamedFrameStep(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::FrameStepRequest,::player::FrameStepReply>* server_unary_streamer) = 0;
+  };
+  template <class BaseClass>
+  class WithStreamedUnaryMethod_Screenshot : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithStreamedUnaryMethod_Screenshot() {
+      ::grpc::Service::MarkMethodStreamed(19,
+        new ::grpc::internal::StreamedUnaryHandler<
+          ::player::ScreenshotRequest, ::player::ScreenshotReply>(
+            [this](::grpc::ServerContext* context,
+                   ::grpc::ServerUnaryStreamer<
+                     ::player::ScreenshotRequest, ::player::ScreenshotReply>* streamer) {
+                       return this->StreamedScreenshot(context,
+                         streamer);
+                  }));
+    }
+    ~WithStreamedUnaryMethod_Screenshot() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status Screenshot(::grpc::ServerContext* /*context*/, const ::player::ScreenshotRequest* /*request*/, ::player::ScreenshotReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with streamed unary
+    virtual ::grpc::Status StreamedScreenshot(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::ScreenshotRequest,::player::ScreenshotReply>* server_unary_streamer) = 0;
+  };
+  template <class BaseClass>
   class WithStreamedUnaryMethod_GetVideoTracks : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithStreamedUnaryMethod_GetVideoTracks() {
-      ::grpc::Service::MarkMethodStreamed(13,
+      ::grpc::Service::MarkMethodStreamed(20,
         new ::grpc::internal::StreamedUnaryHandler<
           ::player::GetVideoTracksRequest, ::player::GetVideoTracksReply>(
             [this](::grpc::ServerContext* context,
@@ -3158,7 +4906,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithStreamedUnaryMethod_GetAudioTracks() {
-      ::grpc::Service::MarkMethodStreamed(14,
+      ::grpc::Service::MarkMethodStreamed(21,
         new ::grpc::internal::StreamedUnaryHandler<
           ::player::GetAudioTracksRequest, ::player::GetAudioTracksReply>(
             [this](::grpc::ServerContext* context,
@@ -3185,7 +4933,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithStreamedUnaryMethod_GetSubtitlesTracks() {
-      ::grpc::Service::MarkMethodStreamed(15,
+      ::grpc::Service::MarkMethodStreamed(22,
         new ::grpc::internal::StreamedUnaryHandler<
           ::player::GetSubtitlesTracksRequest, ::player::GetSubtitlesTracksReply>(
             [this](::grpc::ServerContext* context,
@@ -3212,7 +4960,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithStreamedUnaryMethod_SetVideoTrack() {
-      ::grpc::Service::MarkMethodStreamed(16,
+      ::grpc::Service::MarkMethodStreamed(23,
         new ::grpc::internal::StreamedUnaryHandler<
           ::player::SetVideoTrackRequest, ::player::SetVideoTrackReply>(
             [this](::grpc::ServerContext* context,
@@ -3239,7 +4987,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithStreamedUnaryMethod_SetAudioTrack() {
-      ::grpc::Service::MarkMethodStreamed(17,
+      ::grpc::Service::MarkMethodStreamed(24,
         new ::grpc::internal::StreamedUnaryHandler<
           ::player::SetAudioTrackRequest, ::player::SetAudioTrackReply>(
             [this](::grpc::ServerContext* context,
@@ -3266,7 +5014,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithStreamedUnaryMethod_SetSubtitlesTrack() {
-      ::grpc::Service::MarkMethodStreamed(18,
+      ::grpc::Service::MarkMethodStreamed(25,
         new ::grpc::internal::StreamedUnaryHandler<
           ::player::SetSubtitlesTrackRequest, ::player::SetSubtitlesTrackReply>(
             [this](::grpc::ServerContext* context,
@@ -3288,12 +5036,120 @@ class Player final {
     virtual ::grpc::Status StreamedSetSubtitlesTrack(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::SetSubtitlesTrackRequest,::player::SetSubtitlesTrackReply>* server_unary_streamer) = 0;
   };
   template <class BaseClass>
+  class WithStreamedUnaryMethod_GetMediaInfo : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithStreamedUnaryMethod_GetMediaInfo() {
+      ::grpc::Service::MarkMethodStreamed(26,
+        new ::grpc::internal::StreamedUnaryHandler<
+          ::player::GetMediaInfoRequest, ::player::GetMediaInfoReply>(
+            [this](::grpc::ServerContext* context,
+                   ::grpc::ServerUnaryStreamer<
+                     ::player::GetMediaInfoRequest, ::player::GetMediaInfoReply>* streamer) {
+                       return this->StreamedGetMediaInfo(context,
+                         streamer);
+                  }));
+    }
+    ~WithStreamedUnaryMethod_GetMediaInfo() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status GetMediaInfo(::grpc::ServerContext* /*context*/, const ::player::GetMediaInfoRequest* /*request*/, ::player::GetMediaInfoReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with streamed unary
+    virtual ::grpc::Status StreamedGetMediaInfo(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::GetMediaInfoRequest,::player::GetMediaInfoReply>* server_unary_streamer) = 0;
+  };
+  template <class BaseClass>
+  class WithStreamedUnaryMethod_GetChapters : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithStreamedUnaryMethod_GetChapters() {
+      ::grpc::Service::MarkMethodStreamed(27,
+        new ::grpc::internal::StreamedUnaryHandler<
+          ::player::GetChaptersRequest, ::player::GetChaptersReply>(
+            [this](::grpc::ServerContext* context,
+                   ::grpc::ServerUnaryStreamer<
+                     ::player::GetChaptersRequest, ::player::GetChaptersReply>* streamer) {
+                       return this->StreamedGetChapters(context,
+                         streamer);
+                  }));
+    }
+    ~WithStreamedUnaryMethod_GetChapters() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status GetChapters(::grpc::ServerContext* /*context*/, const ::player::GetChaptersRequest* /*request*/, ::player::GetChaptersReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with streamed unary
+    virtual ::grpc::Status StreamedGetChapters(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::GetChaptersRequest,::player::GetChaptersReply>* server_unary_streamer) = 0;
+  };
+  template <class BaseClass>
+  class WithStreamedUnaryMethod_GetChapter : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithStreamedUnaryMethod_GetChapter() {
+      ::grpc::Service::MarkMethodStreamed(28,
+        new ::grpc::internal::StreamedUnaryHandler<
+          ::player::GetChapterRequest, ::player::GetChapterReply>(
+            [this](::grpc::ServerContext* context,
+                   ::grpc::ServerUnaryStreamer<
+                     ::player::GetChapterRequest, ::player::GetChapterReply>* streamer) {
+                       return this->StreamedGetChapter(context,
+                         streamer);
+                  }));
+    }
+    ~WithStreamedUnaryMethod_GetChapter() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status GetChapter(::grpc::ServerContext* /*context*/, const ::player::GetChapterRequest* /*request*/, ::player::GetChapterReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with streamed unary
+    virtual ::grpc::Status StreamedGetChapter(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::GetChapterRequest,::player::GetChapterReply>* server_unary_streamer) = 0;
+  };
+  template <class BaseClass>
+  class WithStreamedUnaryMethod_SetChapter : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithStreamedUnaryMethod_SetChapter() {
+      ::grpc::Service::MarkMethodStreamed(29,
+        new ::grpc::internal::StreamedUnaryHandler<
+          ::player::SetChapterRequest, ::player::SetChapterReply>(
+            [this](::grpc::ServerContext* context,
+                   ::grpc::ServerUnaryStreamer<
+                     ::player::SetChapterRequest, ::player::SetChapterReply>* streamer) {
+                       return this->StreamedSetChapter(context,
+                         streamer);
+                  }));
+    }
+    ~WithStreamedUnaryMethod_SetChapter() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status SetChapter(::grpc::ServerContext* /*context*/, const ::player::SetChapterRequest* /*request*/, ::player::SetChapterReply* /*response*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with streamed unary
+    virtual ::grpc::Status StreamedSetChapter(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::SetChapterRequest,::player::SetChapterReply>* server_unary_streamer) = 0;
+  };
+  template <class BaseClass>
   class WithStreamedUnaryMethod_Stop : public BaseClass {
    private:
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithStreamedUnaryMethod_Stop() {
-      ::grpc::Service::MarkMethodStreamed(19,
+      ::grpc::Service::MarkMethodStreamed(30,
         new ::grpc::internal::StreamedUnaryHandler<
           ::player::StopRequest, ::player::StopReply>(
             [this](::grpc::ServerContext* context,
@@ -3320,7 +5176,7 @@ class Player final {
     void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
    public:
     WithStreamedUnaryMethod_Close() {
-      ::grpc::Service::MarkMethodStreamed(20,
+      ::grpc::Service::MarkMethodStreamed(31,
         new ::grpc::internal::StreamedUnaryHandler<
           ::player::CloseRequest, ::player::CloseReply>(
             [this](::grpc::ServerContext* context,
@@ -3341,7 +5197,7 @@ class Player final {
     // replace default version of method with streamed unary
     virtual ::grpc::Status StreamedClose(::grpc::ServerContext* context, ::grpc::ServerUnaryStreamer< ::player::CloseRequest,::player::CloseReply>* server_unary_streamer) = 0;
   };
-  typedef WithStreamedUnaryMethod_Open<WithStreamedUnaryMethod_SetupForStreaming<WithStreamedUnaryMethod_ProcessTitle<WithStreamedUnaryMethod_GetLink<WithStreamedUnaryMethod_IsEnded<WithStreamedUnaryMethod_GetPosition<WithStreamedUnaryMethod_GetLength<WithStreamedUnaryMethod_GetSpeed<WithStreamedUnaryMethod_SetSpeed<WithStreamedUnaryMethod_GetPause<WithStreamedUnaryMethod_SetPause<WithStreamedUnaryMethod_Seek<WithStreamedUnaryMethod_GetVideoTracks<WithStreamedUnaryMethod_GetAudioTracks<WithStreamedUnaryMethod_GetSubtitlesTracks<WithStreamedUnaryMethod_SetVideoTrack<WithStreamedUnaryMethod_SetAudioTrack<WithStreamedUnaryMethod_SetSubtitlesTrack<WithStreamedUnaryMethod_Stop<WithStreamedUnaryMethod_Close<Service > > > > > > > > > > > > > > > > > > > > StreamedUnaryService;
+  typedef WithStreamedUnaryMethod_Open<WithStreamedUnaryMethod_SetupForStreaming<WithStreamedUnaryMethod_ProcessTitle<WithStreamedUnaryMethod_GetLink<WithStreamedUnaryMethod_IsEnded<WithStreamedUnaryMethod_GetPosition<WithStreamedUnaryMethod_GetAudioPosition<WithStreamedUnaryMethod_GetLength<WithStreamedUnaryMethod_GetSpeed<WithStreamedUnaryMethod_SetSpeed<WithStreamedUnaryMethod_GetPause<WithStreamedUnaryMethod_SetPause<WithStreamedUnaryMethod_GetVolume<WithStreamedUnaryMethod_SetVolume<WithStreamedUnaryMethod_GetMute<WithStreamedUnaryMethod_SetMute<WithStreamedUnaryMethod_Seek<WithStreamedUnaryMethod_FrameStep<WithStreamedUnaryMethod_Screenshot<WithStreamedUnaryMethod_GetVideoTracks<WithStreamedUnaryMethod_GetAudioTracks<WithStreamedUnaryMethod_GetSubtitlesTracks<WithStreamedUnaryMethod_SetVideoTrack<WithStreamedUnaryMethod_SetAudioTrack<WithStreamedUnaryMethod_SetSubtitlesTrack<WithStreamedUnaryMethod_GetMediaInfo<WithStreamedUnaryMethod_GetChapters<WithStreamedUnaryMethod_GetChapter<WithStreamedUnaryMethod_SetChapter<WithStreamedUnaryMethod_Stop<WithStreamedUnaryMethod_Close<Service > > > > > > > > > > > > > > > > > > > > > > > > > > > > > > > StreamedUnaryService;
   template <class BaseClass>
   class WithSplitStreamingMethod_EndChan : public BaseClass {
    private:
@@ -3370,7 +5226,35 @@ class Player final {
     virtual ::grpc::Status StreamedEndChan(::grpc::ServerContext* context, ::grpc::ServerSplitStreamer< ::player::EndChanRequest,::player::EndChanReply>* server_split_streamer) = 0;
   };
   typedef WithSplitStreamingMethod_EndChan<Service > SplitStreamedService;
-  typedef WithStreamedUnaryMethod_Open<WithStreamedUnaryMethod_SetupForStreaming<WithStreamedUnaryMethod_ProcessTitle<WithStreamedUnaryMethod_GetLink<WithSplitStreamingMethod_EndChan<WithStreamedUnaryMethod_IsEnded<WithStreamedUnaryMethod_GetPosition<WithStreamedUnaryMethod_GetLength<WithStreamedUnaryMethod_GetSpeed<WithStreamedUnaryMethod_SetSpeed<WithStreamedUnaryMethod_GetPause<WithStreamedUnaryMethod_SetPause<WithStreamedUnaryMethod_Seek<WithStreamedUnaryMethod_GetVideoTracks<WithStreamedUnaryMethod_GetAudioTracks<WithStreamedUnaryMethod_GetSubtitlesTracks<WithStreamedUnaryMethod_SetVideoTrack<WithStreamedUnaryMethod_SetAudioTrack<WithStreamedUnaryMethod_SetSubtitlesTrack<WithStreamedUnaryMethod_Stop<WithStreamedUnaryMethod_Close<Service > > > > > > > > > > > > > > > > > > > > > StreamedService;
+  template <class BaseClass>
+  class WithSplitStreamingMethod_Events : public BaseClass {
+   private:
+    void BaseClassMustBeDerivedFromService(const Service* /*service*/) {}
+   public:
+    WithSplitStreamingMethod_Events() {
+      ::grpc::Service::MarkMethodStreamed(32,
+        new ::grpc::internal::SplitServerStreamingHandler<
+          ::player::EventsRequest, ::player::Event>(
+            [this](::grpc::ServerContext* context,
+                   ::grpc::ServerSplitStreamer<
+                     ::player::EventsRequest, ::player::Event>* streamer) {
+                       return this->StreamedEvents(context,
+                         streamer);
+                  }));
+    }
+    ~WithSplitStreamingMethod_Events() override {
+      BaseClassMustBeDerivedFromService(this);
+    }
+    // disable regular version of this method
+    ::grpc::Status Events(::grpc::ServerContext* /*context*/, const ::player::EventsRequest* /*request*/, ::grpc::ServerWriter< ::player::Event>* /*writer*/) override {
+      abort();
+      return ::grpc::Status(::grpc::StatusCode::UNIMPLEMENTED, "");
+    }
+    // replace default version of method with split streamed
+    virtual ::grpc::Status StreamedEvents(::grpc::ServerContext* context, ::grpc::ServerSplitStreamer< ::player::EventsRequest,::player::Event>* server_split_streamer) = 0;
+  };
+  typedef WithSplitStreamingMethod_Events<Service > SplitStreamedService;
+  typedef WithStreamedUnaryMethod_Open<WithStreamedUnaryMethod_SetupForStreaming<WithStreamedUnaryMethod_ProcessTitle<WithStreamedUnaryMethod_GetLink<WithSplitStreamingMethod_EndChan<WithStreamedUnaryMethod_IsEnded<WithStreamedUnaryMethod_GetPosition<WithStreamedUnaryMethod_GetAudioPosition<WithStreamedUnaryMethod_GetLength<WithStreamedUnaryMethod_GetSpeed<WithStreamedUnaryMethod_SetSpeed<WithStreamedUnaryMethod_GetPause<WithStreamedUnaryMethod_SetPause<WithStreamedUnaryMethod_GetVolume<WithStreamedUnaryMethod_SetVolume<WithStreamedUnaryMethod_GetMute<WithStreamedUnaryMethod_SetMute<WithStreamedUnaryMethod_Seek<WithStreamedUnaryMethod_FrameStep<WithStreamedUnaryMethod_Screenshot<WithStreamedUnaryMethod_GetVideoTracks<WithStreamedUnaryMethod_GetAudioTracks<WithStreamedUnaryMethod_GetSubtitlesTracks<WithStreamedUnaryMethod_SetVideoTrack<WithStreamedUnaryMethod_SetAudioTrack<WithStreamedUnaryMethod_SetSubtitlesTrack<WithStreamedUnaryMethod_GetMediaInfo<WithStreamedUnaryMethod_GetChapters<WithStreamedUnaryMethod_GetChapter<WithStreamedUnaryMethod_SetChapter<WithStreamedUnaryMethod_Stop<WithStreamedUnaryMethod_Close<WithSplitStreamingMethod_Events<Service > > > > > > > > > > > > > > > > > > > > > > > > > > > > > > > > > StreamedService;
 };
 
 }  // namespace player
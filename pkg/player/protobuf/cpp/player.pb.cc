@@ -187,6 +187,30 @@ struct GetPositionReplyDefaultTypeInternal {
   };
 };
 PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetPositionReplyDefaultTypeInternal _GetPositionReply_default_instance_;
+PROTOBUF_CONSTEXPR GetAudioPositionRequest::GetAudioPositionRequest(
+    ::_pbi::ConstantInitialized) {}
+struct GetAudioPositionRequestDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR GetAudioPositionRequestDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~GetAudioPositionRequestDefaultTypeInternal() {}
+  union {
+    GetAudioPositionRequest _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetAudioPositionRequestDefaultTypeInternal _GetAudioPositionRequest_default_instance_;
+PROTOBUF_CONSTEXPR GetAudioPositionReply::GetAudioPositionReply(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.positionsecs_)*/0
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct GetAudioPositionReplyDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR GetAudioPositionReplyDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~GetAudioPositionReplyDefaultTypeInternal() {}
+  union {
+    GetAudioPositionReply _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetAudioPositionReplyDefaultTypeInternal _GetAudioPositionReply_default_instance_;
 PROTOBUF_CONSTEXPR GetLengthRequest::GetLengthRequest(
     ::_pbi::ConstantInitialized) {}
 struct GetLengthRequestDefaultTypeInternal {
@@ -307,6 +331,102 @@ struct SetPauseReplyDefaultTypeInternal {
   };
 };
 PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 SetPauseReplyDefaultTypeInternal _SetPauseReply_default_instance_;
+PROTOBUF_CONSTEXPR GetVolumeRequest::GetVolumeRequest(
+    ::_pbi::ConstantInitialized) {}
+struct GetVolumeRequestDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR GetVolumeRequestDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~GetVolumeRequestDefaultTypeInternal() {}
+  union {
+    GetVolumeRequest _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetVolumeRequestDefaultTypeInternal _GetVolumeRequest_default_instance_;
+PROTOBUF_CONSTEXPR GetVolumeReply::GetVolumeReply(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.volume_)*/0
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct GetVolumeReplyDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR GetVolumeReplyDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~GetVolumeReplyDefaultTypeInternal() {}
+  union {
+    GetVolumeReply _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetVolumeReplyDefaultTypeInternal _GetVolumeReply_default_instance_;
+PROTOBUF_CONSTEXPR SetVolumeRequest::SetVolumeRequest(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.volume_)*/0
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct SetVolumeRequestDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR SetVolumeRequestDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~SetVolumeRequestDefaultTypeInternal() {}
+  union {
+    SetVolumeRequest _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 SetVolumeRequestDefaultTypeInternal _SetVolumeRequest_default_instance_;
+PROTOBUF_CONSTEXPR SetVolumeReply::SetVolumeReply(
+    ::_pbi::ConstantInitialized) {}
+struct SetVolumeReplyDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR SetVolumeReplyDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~SetVolumeReplyDefaultTypeInternal() {}
+  union {
+    SetVolumeReply _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 SetVolumeReplyDefaultTypeInternal _SetVolumeReply_default_instance_;
+PROTOBUF_CONSTEXPR GetMuteRequest::GetMuteRequest(
+    ::_pbi::ConstantInitialized) {}
+struct GetMuteRequestDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR GetMuteRequestDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~GetMuteRequestDefaultTypeInternal() {}
+  union {
+    GetMuteRequest _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetMuteRequestDefaultTypeInternal _GetMuteRequest_default_instance_;
+PROTOBUF_CONSTEXPR GetMuteReply::GetMuteReply(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.ismuted_)*/false
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct GetMuteReplyDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR GetMuteReplyDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~GetMuteReplyDefaultTypeInternal() {}
+  union {
+    GetMuteReply _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetMuteReplyDefaultTypeInternal _GetMuteReply_default_instance_;
+PROTOBUF_CONSTEXPR SetMuteRequest::SetMuteRequest(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.ismuted_)*/false
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct SetMuteRequestDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR SetMuteRequestDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~SetMuteRequestDefaultTypeInternal() {}
+  union {
+    SetMuteRequest _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 SetMuteRequestDefaultTypeInternal _SetMuteRequest_default_instance_;
+PROTOBUF_CONSTEXPR SetMuteReply::SetMuteReply(
+    ::_pbi::ConstantInitialized) {}
+struct SetMuteReplyDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR SetMuteReplyDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~SetMuteReplyDefaultTypeInternal() {}
+  union {
+    SetMuteReply _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 SetMuteReplyDefaultTypeInternal _SetMuteReply_default_instance_;
 PROTOBUF_CONSTEXPR SetVideoTrackRequest::SetVideoTrackRequest(
     ::_pbi::ConstantInitialized): _impl_{
     /*decltype(_impl_.videotrackid_)*/int64_t{0}
@@ -379,10 +499,31 @@ struct SetSubtitlesTrackReplyDefaultTypeInternal {
   };
 };
 PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 SetSubtitlesTrackReplyDefaultTypeInternal _SetSubtitlesTrackReply_default_instance_;
+PROTOBUF_CONSTEXPR TrackInfo::TrackInfo(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.codec_)*/{&::_pbi::fixed_address_empty_string, ::_pbi::ConstantInitialized{}}
+  , /*decltype(_impl_.language_)*/{&::_pbi::fixed_address_empty_string, ::_pbi::ConstantInitialized{}}
+  , /*decltype(_impl_.title_)*/{&::_pbi::fixed_address_empty_string, ::_pbi::ConstantInitialized{}}
+  , /*decltype(_impl_.isdefault_)*/false
+  , /*decltype(_impl_.isforced_)*/false
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct TrackInfoDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR TrackInfoDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~TrackInfoDefaultTypeInternal() {}
+  union {
+    TrackInfo _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 TrackInfoDefaultTypeInternal _TrackInfo_default_instance_;
 PROTOBUF_CONSTEXPR VideoTrack::VideoTrack(
     ::_pbi::ConstantInitialized): _impl_{
-    /*decltype(_impl_.id_)*/int64_t{0}
+    /*decltype(_impl_.info_)*/nullptr
+  , /*decltype(_impl_.id_)*/int64_t{0}
   , /*decltype(_impl_.isactive_)*/false
+  , /*decltype(_impl_.width_)*/0
+  , /*decltype(_impl_.fps_)*/0
+  , /*decltype(_impl_.height_)*/0
   , /*decltype(_impl_._cached_size_)*/{}} {}
 struct VideoTrackDefaultTypeInternal {
   PROTOBUF_CONSTEXPR VideoTrackDefaultTypeInternal()
@@ -395,7 +536,7 @@ struct VideoTrackDefaultTypeInternal {
 PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 VideoTrackDefaultTypeInternal _VideoTrack_default_instance_;
 PROTOBUF_CONSTEXPR SeekRequest::SeekRequest(
     ::_pbi::ConstantInitialized): _impl_{
-    /*decltype(_impl_.pos_)*/int64_t{0}
+    /*decltype(_impl_.posnanoseconds_)*/int64_t{0}
   , /*decltype(_impl_.isrelative_)*/false
   , /*decltype(_impl_.isquick_)*/false
   , /*decltype(_impl_._cached_size_)*/{}} {}
@@ -419,6 +560,54 @@ struct SeekReplyDefaultTypeInternal {
   };
 };
 PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 SeekReplyDefaultTypeInternal _SeekReply_default_instance_;
+PROTOBUF_CONSTEXPR FrameStepRequest::FrameStepRequest(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.forward_)*/false
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct FrameStepRequestDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR FrameStepRequestDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~FrameStepRequestDefaultTypeInternal() {}
+  union {
+    FrameStepRequest _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 FrameStepRequestDefaultTypeInternal _FrameStepRequest_default_instance_;
+PROTOBUF_CONSTEXPR FrameStepReply::FrameStepReply(
+    ::_pbi::ConstantInitialized) {}
+struct FrameStepReplyDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR FrameStepReplyDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~FrameStepReplyDefaultTypeInternal() {}
+  union {
+    FrameStepReply _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 FrameStepReplyDefaultTypeInternal _FrameStepReply_default_instance_;
+PROTOBUF_CONSTEXPR ScreenshotRequest::ScreenshotRequest(
+    ::_pbi::ConstantInitialized) {}
+struct ScreenshotRequestDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR ScreenshotRequestDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~ScreenshotRequestDefaultTypeInternal() {}
+  union {
+    ScreenshotRequest _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 ScreenshotRequestDefaultTypeInternal _ScreenshotRequest_default_instance_;
+PROTOBUF_CONSTEXPR ScreenshotReply::ScreenshotReply(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.png_)*/{&::_pbi::fixed_address_empty_string, ::_pbi::ConstantInitialized{}}
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct ScreenshotReplyDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR ScreenshotReplyDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~ScreenshotReplyDefaultTypeInternal() {}
+  union {
+    ScreenshotReply _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 ScreenshotReplyDefaultTypeInternal _ScreenshotReply_default_instance_;
 PROTOBUF_CONSTEXPR GetVideoTracksRequest::GetVideoTracksRequest(
     ::_pbi::ConstantInitialized) {}
 struct GetVideoTracksRequestDefaultTypeInternal {
@@ -445,8 +634,12 @@ struct GetVideoTracksReplyDefaultTypeInternal {
 PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetVideoTracksReplyDefaultTypeInternal _GetVideoTracksReply_default_instance_;
 PROTOBUF_CONSTEXPR AudioTrack::AudioTrack(
     ::_pbi::ConstantInitialized): _impl_{
-    /*decltype(_impl_.id_)*/int64_t{0}
+    /*decltype(_impl_.channellayout_)*/{&::_pbi::fixed_address_empty_string, ::_pbi::ConstantInitialized{}}
+  , /*decltype(_impl_.info_)*/nullptr
+  , /*decltype(_impl_.id_)*/int64_t{0}
   , /*decltype(_impl_.isactive_)*/false
+  , /*decltype(_impl_.samplerate_)*/0
+  , /*decltype(_impl_.channels_)*/0
   , /*decltype(_impl_._cached_size_)*/{}} {}
 struct AudioTrackDefaultTypeInternal {
   PROTOBUF_CONSTEXPR AudioTrackDefaultTypeInternal()
@@ -483,7 +676,8 @@ struct GetAudioTracksReplyDefaultTypeInternal {
 PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetAudioTracksReplyDefaultTypeInternal _GetAudioTracksReply_default_instance_;
 PROTOBUF_CONSTEXPR SubtitlesTrack::SubtitlesTrack(
     ::_pbi::ConstantInitialized): _impl_{
-    /*decltype(_impl_.id_)*/int64_t{0}
+    /*decltype(_impl_.info_)*/nullptr
+  , /*decltype(_impl_.id_)*/int64_t{0}
   , /*decltype(_impl_.isactive_)*/false
   , /*decltype(_impl_._cached_size_)*/{}} {}
 struct SubtitlesTrackDefaultTypeInternal {
@@ -519,6 +713,145 @@ struct GetSubtitlesTracksReplyDefaultTypeInternal {
   };
 };
 PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetSubtitlesTracksReplyDefaultTypeInternal _GetSubtitlesTracksReply_default_instance_;
+PROTOBUF_CONSTEXPR Chapter::Chapter(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.title_)*/{&::_pbi::fixed_address_empty_string, ::_pbi::ConstantInitialized{}}
+  , /*decltype(_impl_.startsecs_)*/0
+  , /*decltype(_impl_.endsecs_)*/0
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct ChapterDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR ChapterDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~ChapterDefaultTypeInternal() {}
+  union {
+    Chapter _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 ChapterDefaultTypeInternal _Chapter_default_instance_;
+PROTOBUF_CONSTEXPR MediaInfo_TagsEntry_DoNotUse::MediaInfo_TagsEntry_DoNotUse(
+    ::_pbi::ConstantInitialized) {}
+struct MediaInfo_TagsEntry_DoNotUseDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR MediaInfo_TagsEntry_DoNotUseDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~MediaInfo_TagsEntry_DoNotUseDefaultTypeInternal() {}
+  union {
+    MediaInfo_TagsEntry_DoNotUse _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 MediaInfo_TagsEntry_DoNotUseDefaultTypeInternal _MediaInfo_TagsEntry_DoNotUse_default_instance_;
+PROTOBUF_CONSTEXPR MediaInfo::MediaInfo(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.tags_)*/{::_pbi::ConstantInitialized()}
+  , /*decltype(_impl_.chapters_)*/{}
+  , /*decltype(_impl_.formatname_)*/{&::_pbi::fixed_address_empty_string, ::_pbi::ConstantInitialized{}}
+  , /*decltype(_impl_.bitrate_)*/int64_t{0}
+  , /*decltype(_impl_.streamcount_)*/0
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct MediaInfoDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR MediaInfoDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~MediaInfoDefaultTypeInternal() {}
+  union {
+    MediaInfo _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 MediaInfoDefaultTypeInternal _MediaInfo_default_instance_;
+PROTOBUF_CONSTEXPR GetMediaInfoRequest::GetMediaInfoRequest(
+    ::_pbi::ConstantInitialized) {}
+struct GetMediaInfoRequestDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR GetMediaInfoRequestDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~GetMediaInfoRequestDefaultTypeInternal() {}
+  union {
+    GetMediaInfoRequest _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetMediaInfoRequestDefaultTypeInternal _GetMediaInfoRequest_default_instance_;
+PROTOBUF_CONSTEXPR GetMediaInfoReply::GetMediaInfoReply(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.mediainfo_)*/nullptr
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct GetMediaInfoReplyDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR GetMediaInfoReplyDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~GetMediaInfoReplyDefaultTypeInternal() {}
+  union {
+    GetMediaInfoReply _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetMediaInfoReplyDefaultTypeInternal _GetMediaInfoReply_default_instance_;
+PROTOBUF_CONSTEXPR GetChaptersRequest::GetChaptersRequest(
+    ::_pbi::ConstantInitialized) {}
+struct GetChaptersRequestDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR GetChaptersRequestDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~GetChaptersRequestDefaultTypeInternal() {}
+  union {
+    GetChaptersRequest _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetChaptersRequestDefaultTypeInternal _GetChaptersRequest_default_instance_;
+PROTOBUF_CONSTEXPR GetChaptersReply::GetChaptersReply(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.chapters_)*/{}
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct GetChaptersReplyDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR GetChaptersReplyDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~GetChaptersReplyDefaultTypeInternal() {}
+  union {
+    GetChaptersReply _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetChaptersReplyDefaultTypeInternal _GetChaptersReply_default_instance_;
+PROTOBUF_CONSTEXPR GetChapterRequest::GetChapterRequest(
+    ::_pbi::ConstantInitialized) {}
+struct GetChapterRequestDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR GetChapterRequestDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~GetChapterRequestDefaultTypeInternal() {}
+  union {
+    GetChapterRequest _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetChapterRequestDefaultTypeInternal _GetChapterRequest_default_instance_;
+PROTOBUF_CONSTEXPR GetChapterReply::GetChapterReply(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.chapteridx_)*/0
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct GetChapterReplyDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR GetChapterReplyDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~GetChapterReplyDefaultTypeInternal() {}
+  union {
+    GetChapterReply _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 GetChapterReplyDefaultTypeInternal _GetChapterReply_default_instance_;
+PROTOBUF_CONSTEXPR SetChapterRequest::SetChapterRequest(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.chapteridx_)*/0
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct SetChapterRequestDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR SetChapterRequestDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~SetChapterRequestDefaultTypeInternal() {}
+  union {
+    SetChapterRequest _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 SetChapterRequestDefaultTypeInternal _SetChapterRequest_default_instance_;
+PROTOBUF_CONSTEXPR SetChapterReply::SetChapterReply(
+    ::_pbi::ConstantInitialized) {}
+struct SetChapterReplyDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR SetChapterReplyDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~SetChapterReplyDefaultTypeInternal() {}
+  union {
+    SetChapterReply _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 SetChapterReplyDefaultTypeInternal _SetChapterReply_default_instance_;
 PROTOBUF_CONSTEXPR StopRequest::StopRequest(
     ::_pbi::ConstantInitialized) {}
 struct StopRequestDefaultTypeInternal {
@@ -563,9 +896,122 @@ struct CloseReplyDefaultTypeInternal {
   };
 };
 PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 CloseReplyDefaultTypeInternal _CloseReply_default_instance_;
+PROTOBUF_CONSTEXPR EventsRequest::EventsRequest(
+    ::_pbi::ConstantInitialized) {}
+struct EventsRequestDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR EventsRequestDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~EventsRequestDefaultTypeInternal() {}
+  union {
+    EventsRequest _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 EventsRequestDefaultTypeInternal _EventsRequest_default_instance_;
+PROTOBUF_CONSTEXPR EventStateChange::EventStateChange(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.state_)*/0
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct EventStateChangeDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR EventStateChangeDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~EventStateChangeDefaultTypeInternal() {}
+  union {
+    EventStateChange _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 EventStateChangeDefaultTypeInternal _EventStateChange_default_instance_;
+PROTOBUF_CONSTEXPR EventPosition::EventPosition(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.positionsecs_)*/0
+  , /*decltype(_impl_.lengthsecs_)*/0
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct EventPositionDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR EventPositionDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~EventPositionDefaultTypeInternal() {}
+  union {
+    EventPosition _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 EventPositionDefaultTypeInternal _EventPosition_default_instance_;
+PROTOBUF_CONSTEXPR EventTracksChange::EventTracksChange(
+    ::_pbi::ConstantInitialized) {}
+struct EventTracksChangeDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR EventTracksChangeDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~EventTracksChangeDefaultTypeInternal() {}
+  union {
+    EventTracksChange _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 EventTracksChangeDefaultTypeInternal _EventTracksChange_default_instance_;
+PROTOBUF_CONSTEXPR EventBuffering::EventBuffering(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.percent_)*/0
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct EventBufferingDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR EventBufferingDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~EventBufferingDefaultTypeInternal() {}
+  union {
+    EventBuffering _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 EventBufferingDefaultTypeInternal _EventBuffering_default_instance_;
+PROTOBUF_CONSTEXPR EventError::EventError(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.error_)*/{&::_pbi::fixed_address_empty_string, ::_pbi::ConstantInitialized{}}
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct EventErrorDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR EventErrorDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~EventErrorDefaultTypeInternal() {}
+  union {
+    EventError _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 EventErrorDefaultTypeInternal _EventError_default_instance_;
+PROTOBUF_CONSTEXPR EventEndOfFile::EventEndOfFile(
+    ::_pbi::ConstantInitialized) {}
+struct EventEndOfFileDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR EventEndOfFileDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~EventEndOfFileDefaultTypeInternal() {}
+  union {
+    EventEndOfFile _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 EventEndOfFileDefaultTypeInternal _EventEndOfFile_default_instance_;
+PROTOBUF_CONSTEXPR EventMediaChange::EventMediaChange(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.link_)*/{&::_pbi::fixed_address_empty_string, ::_pbi::ConstantInitialized{}}
+  , /*decltype(_impl_._cached_size_)*/{}} {}
+struct EventMediaChangeDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR EventMediaChangeDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~EventMediaChangeDefaultTypeInternal() {}
+  union {
+    EventMediaChange _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 EventMediaChangeDefaultTypeInternal _EventMediaChange_default_instance_;
+PROTOBUF_CONSTEXPR Event::Event(
+    ::_pbi::ConstantInitialized): _impl_{
+    /*decltype(_impl_.event_)*/{}
+  , /*decltype(_impl_._cached_size_)*/{}
+  , /*decltype(_impl_._oneof_case_)*/{}} {}
+struct EventDefaultTypeInternal {
+  PROTOBUF_CONSTEXPR EventDefaultTypeInternal()
+      : _instance(::_pbi::ConstantInitialized{}) {}
+  ~EventDefaultTypeInternal() {}
+  union {
+    Event _instance;
+  };
+};
+PROTOBUF_ATTRIBUTE_NO_DESTROY PROTOBUF_CONSTINIT PROTOBUF_ATTRIBUTE_INIT_PRIORITY1 EventDefaultTypeInternal _Event_default_instance_;
 }  // namespace player
-static ::_pb::Metadata file_level_metadata_player_2eproto[45];
-static const ::_pb::EnumDescriptor* file_level_enum_descriptors_player_2eproto[1];
+static ::_pb::Metadata file_level_metadata_player_2eproto[80];
+static const ::_pb::EnumDescriptor* file_level_enum_descriptors_player_2eproto[2];
 static constexpr ::_pb::ServiceDescriptor const** file_level_service_descriptors_player_2eproto = nullptr;
 
 const uint32_t TableStruct_player_2eproto::offsets[] PROTOBUF_SECTION_VARIABLE(protodesc_cold) = {
@@ -661,6 +1107,19 @@ const uint32_t TableStruct_player_2eproto::offsets[] PROTOBUF_SECTION_VARIABLE(p
   ~0u,  // no _inlined_string_donated_
   PROTOBUF_FIELD_OFFSET(::player::GetPositionReply, _impl_.positionsecs_),
   ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::GetAudioPositionRequest, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::GetAudioPositionReply, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::GetAudioPositionReply, _impl_.positionsecs_),
+  ~0u,  // no _has_bits_
   PROTOBUF_FIELD_OFFSET(::player::GetLengthRequest, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
@@ -726,124 +1185,304 @@ const uint32_t TableStruct_player_2eproto::offsets[] PROTOBUF_SECTION_VARIABLE(p
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::SetVideoTrackRequest, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::GetVolumeRequest, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
-  PROTOBUF_FIELD_OFFSET(::player::SetVideoTrackRequest, _impl_.videotrackid_),
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::SetVideoTrackReply, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::GetVolumeReply, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::GetVolumeReply, _impl_.volume_),
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::SetAudioTrackRequest, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::SetVolumeRequest, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
-  PROTOBUF_FIELD_OFFSET(::player::SetAudioTrackRequest, _impl_.audiotrackid_),
+  PROTOBUF_FIELD_OFFSET(::player::SetVolumeRequest, _impl_.volume_),
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::SetAudioTrackReply, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::SetVolumeReply, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::SetSubtitlesTrackRequest, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::GetMuteRequest, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
-  PROTOBUF_FIELD_OFFSET(::player::SetSubtitlesTrackRequest, _impl_.subtitlestrackid_),
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::SetSubtitlesTrackReply, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::GetMuteReply, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::GetMuteReply, _impl_.ismuted_),
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::VideoTrack, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::SetMuteRequest, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
-  PROTOBUF_FIELD_OFFSET(::player::VideoTrack, _impl_.id_),
-  PROTOBUF_FIELD_OFFSET(::player::VideoTrack, _impl_.isactive_),
+  PROTOBUF_FIELD_OFFSET(::player::SetMuteRequest, _impl_.ismuted_),
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::SeekRequest, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::SetMuteReply, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
-  PROTOBUF_FIELD_OFFSET(::player::SeekRequest, _impl_.pos_),
-  PROTOBUF_FIELD_OFFSET(::player::SeekRequest, _impl_.isrelative_),
-  PROTOBUF_FIELD_OFFSET(::player::SeekRequest, _impl_.isquick_),
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::SeekReply, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::SetVideoTrackRequest, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::SetVideoTrackRequest, _impl_.videotrackid_),
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::GetVideoTracksRequest, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::SetVideoTrackReply, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::GetVideoTracksReply, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::SetAudioTrackRequest, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
-  PROTOBUF_FIELD_OFFSET(::player::GetVideoTracksReply, _impl_.videotrack_),
+  PROTOBUF_FIELD_OFFSET(::player::SetAudioTrackRequest, _impl_.audiotrackid_),
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::AudioTrack, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::SetAudioTrackReply, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
-  PROTOBUF_FIELD_OFFSET(::player::AudioTrack, _impl_.id_),
-  PROTOBUF_FIELD_OFFSET(::player::AudioTrack, _impl_.isactive_),
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::GetAudioTracksRequest, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::SetSubtitlesTrackRequest, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::SetSubtitlesTrackRequest, _impl_.subtitlestrackid_),
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::GetAudioTracksReply, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::SetSubtitlesTrackReply, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
-  PROTOBUF_FIELD_OFFSET(::player::GetAudioTracksReply, _impl_.audiotrack_),
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::SubtitlesTrack, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::TrackInfo, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
-  PROTOBUF_FIELD_OFFSET(::player::SubtitlesTrack, _impl_.id_),
-  PROTOBUF_FIELD_OFFSET(::player::SubtitlesTrack, _impl_.isactive_),
+  PROTOBUF_FIELD_OFFSET(::player::TrackInfo, _impl_.codec_),
+  PROTOBUF_FIELD_OFFSET(::player::TrackInfo, _impl_.language_),
+  PROTOBUF_FIELD_OFFSET(::player::TrackInfo, _impl_.title_),
+  PROTOBUF_FIELD_OFFSET(::player::TrackInfo, _impl_.isdefault_),
+  PROTOBUF_FIELD_OFFSET(::player::TrackInfo, _impl_.isforced_),
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::GetSubtitlesTracksRequest, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::VideoTrack, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::VideoTrack, _impl_.id_),
+  PROTOBUF_FIELD_OFFSET(::player::VideoTrack, _impl_.isactive_),
+  PROTOBUF_FIELD_OFFSET(::player::VideoTrack, _impl_.info_),
+  PROTOBUF_FIELD_OFFSET(::player::VideoTrack, _impl_.width_),
+  PROTOBUF_FIELD_OFFSET(::player::VideoTrack, _impl_.height_),
+  PROTOBUF_FIELD_OFFSET(::player::VideoTrack, _impl_.fps_),
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::GetSubtitlesTracksReply, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::SeekRequest, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
-  PROTOBUF_FIELD_OFFSET(::player::GetSubtitlesTracksReply, _impl_.subtitlestrack_),
+  PROTOBUF_FIELD_OFFSET(::player::SeekRequest, _impl_.posnanoseconds_),
+  PROTOBUF_FIELD_OFFSET(::player::SeekRequest, _impl_.isrelative_),
+  PROTOBUF_FIELD_OFFSET(::player::SeekRequest, _impl_.isquick_),
   ~0u,  // no _has_bits_
-  PROTOBUF_FIELD_OFFSET(::player::StopRequest, _internal_metadata_),
+  PROTOBUF_FIELD_OFFSET(::player::SeekReply, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::FrameStepRequest, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::FrameStepRequest, _impl_.forward_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::FrameStepReply, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::ScreenshotRequest, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::ScreenshotReply, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::ScreenshotReply, _impl_.png_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::GetVideoTracksRequest, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::GetVideoTracksReply, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::GetVideoTracksReply, _impl_.videotrack_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::AudioTrack, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::AudioTrack, _impl_.id_),
+  PROTOBUF_FIELD_OFFSET(::player::AudioTrack, _impl_.isactive_),
+  PROTOBUF_FIELD_OFFSET(::player::AudioTrack, _impl_.info_),
+  PROTOBUF_FIELD_OFFSET(::player::AudioTrack, _impl_.samplerate_),
+  PROTOBUF_FIELD_OFFSET(::player::AudioTrack, _impl_.channels_),
+  PROTOBUF_FIELD_OFFSET(::player::AudioTrack, _impl_.channellayout_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::GetAudioTracksRequest, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::GetAudioTracksReply, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::GetAudioTracksReply, _impl_.audiotrack_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::SubtitlesTrack, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::SubtitlesTrack, _impl_.id_),
+  PROTOBUF_FIELD_OFFSET(::player::SubtitlesTrack, _impl_.isactive_),
+  PROTOBUF_FIELD_OFFSET(::player::SubtitlesTrack, _impl_.info_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::GetSubtitlesTracksRequest, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::GetSubtitlesTracksReply, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::GetSubtitlesTracksReply, _impl_.subtitlestrack_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::Chapter, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::Chapter, _impl_.title_),
+  PROTOBUF_FIELD_OFFSET(::player::Chapter, _impl_.startsecs_),
+  PROTOBUF_FIELD_OFFSET(::player::Chapter, _impl_.endsecs_),
+  PROTOBUF_FIELD_OFFSET(::player::MediaInfo_TagsEntry_DoNotUse, _has_bits_),
+  PROTOBUF_FIELD_OFFSET(::player::MediaInfo_TagsEntry_DoNotUse, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::MediaInfo_TagsEntry_DoNotUse, key_),
+  PROTOBUF_FIELD_OFFSET(::player::MediaInfo_TagsEntry_DoNotUse, value_),
+  0,
+  1,
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::MediaInfo, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::MediaInfo, _impl_.formatname_),
+  PROTOBUF_FIELD_OFFSET(::player::MediaInfo, _impl_.bitrate_),
+  PROTOBUF_FIELD_OFFSET(::player::MediaInfo, _impl_.streamcount_),
+  PROTOBUF_FIELD_OFFSET(::player::MediaInfo, _impl_.tags_),
+  PROTOBUF_FIELD_OFFSET(::player::MediaInfo, _impl_.chapters_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::GetMediaInfoRequest, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::GetMediaInfoReply, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::GetMediaInfoReply, _impl_.mediainfo_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::GetChaptersRequest, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::GetChaptersReply, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::GetChaptersReply, _impl_.chapters_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::GetChapterRequest, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::GetChapterReply, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::GetChapterReply, _impl_.chapteridx_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::SetChapterRequest, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::SetChapterRequest, _impl_.chapteridx_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::SetChapterReply, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::StopRequest, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
@@ -866,6 +1505,74 @@ const uint32_t TableStruct_player_2eproto::offsets[] PROTOBUF_SECTION_VARIABLE(p
   ~0u,  // no _oneof_case_
   ~0u,  // no _weak_field_map_
   ~0u,  // no _inlined_string_donated_
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::EventsRequest, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::EventStateChange, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::EventStateChange, _impl_.state_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::EventPosition, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::EventPosition, _impl_.positionsecs_),
+  PROTOBUF_FIELD_OFFSET(::player::EventPosition, _impl_.lengthsecs_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::EventTracksChange, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::EventBuffering, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::EventBuffering, _impl_.percent_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::EventError, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::EventError, _impl_.error_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::EventEndOfFile, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::EventMediaChange, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  PROTOBUF_FIELD_OFFSET(::player::EventMediaChange, _impl_.link_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::player::Event, _internal_metadata_),
+  ~0u,  // no _extensions_
+  PROTOBUF_FIELD_OFFSET(::player::Event, _impl_._oneof_case_[0]),
+  ~0u,  // no _weak_field_map_
+  ~0u,  // no _inlined_string_donated_
+  ::_pbi::kInvalidFieldOffsetTag,
+  ::_pbi::kInvalidFieldOffsetTag,
+  ::_pbi::kInvalidFieldOffsetTag,
+  ::_pbi::kInvalidFieldOffsetTag,
+  ::_pbi::kInvalidFieldOffsetTag,
+  ::_pbi::kInvalidFieldOffsetTag,
+  ::_pbi::kInvalidFieldOffsetTag,
+  PROTOBUF_FIELD_OFFSET(::player::Event, _impl_.event_),
 };
 static const ::_pbi::MigrationSchema schemas[] PROTOBUF_SECTION_VARIABLE(protodesc_cold) = {
   { 0, -1, -1, sizeof(::player::SetupForStreamingRequest)},
@@ -882,37 +1589,72 @@ static const ::_pbi::MigrationSchema schemas[] PROTOBUF_SECTION_VARIABLE(protode
   { 71, -1, -1, sizeof(::player::IsEndedReply)},
   { 78, -1, -1, sizeof(::player::GetPositionRequest)},
   { 84, -1, -1, sizeof(::player::GetPositionReply)},
-  { 91, -1, -1, sizeof(::player::GetLengthRequest)},
-  { 97, -1, -1, sizeof(::player::GetLengthReply)},
-  { 104, -1, -1, sizeof(::player::GetSpeedRequest)},
-  { 110, -1, -1, sizeof(::player::GetSpeedReply)},
-  { 117, -1, -1, sizeof(::player::SetSpeedRequest)},
-  { 124, -1, -1, sizeof(::player::SetSpeedReply)},
-  { 130, -1, -1, sizeof(::player::GetPauseRequest)},
-  { 136, -1, -1, sizeof(::player::GetPauseReply)},
-  { 143, -1, -1, sizeof(::player::SetPauseRequest)},
-  { 150, -1, -1, sizeof(::player::SetPauseReply)},
-  { 156, -1, -1, sizeof(::player::SetVideoTrackRequest)},
-  { 163, -1, -1, sizeof(::player::SetVideoTrackReply)},
-  { 169, -1, -1, sizeof(::player::SetAudioTrackRequest)},
-  { 176, -1, -1, sizeof(::player::SetAudioTrackReply)},
-  { 182, -1, -1, sizeof(::player::SetSubtitlesTrackRequest)},
-  { 189, -1, -1, sizeof(::player::SetSubtitlesTrackReply)},
-  { 195, -1, -1, sizeof(::player::VideoTrack)},
-  { 203, -1, -1, sizeof(::player::SeekRequest)},
-  { 212, -1, -1, sizeof(::player::SeekReply)},
-  { 218, -1, -1, sizeof(::player::GetVideoTracksRequest)},
-  { 224, -1, -1, sizeof(::player::GetVideoTracksReply)},
-  { 231, -1, -1, sizeof(::player::AudioTrack)},
-  { 239, -1, -1, sizeof(::player::GetAudioTracksRequest)},
-  { 245, -1, -1, sizeof(::player::GetAudioTracksReply)},
-  { 252, -1, -1, sizeof(::player::SubtitlesTrack)},
-  { 260, -1, -1, sizeof(::player::GetSubtitlesTracksRequest)},
-  { 266, -1, -1, sizeof(::player::GetSubtitlesTracksReply)},
-  { 273, -1, -1, sizeof(::player::StopRequest)},
-  { 279, -1, -1, sizeof(::player::StopReply)},
-  { 285, -1, -1, sizeof(::player::CloseRequest)},
-  { 291, -1, -1, sizeof(::player::CloseReply)},
+  { 91, -1, -1, sizeof(::player::GetAudioPositionRequest)},
+  { 97, -1, -1, sizeof(::player::GetAudioPositionReply)},
+  { 104, -1, -1, sizeof(::player::GetLengthRequest)},
+  { 110, -1, -1, sizeof(::player::GetLengthReply)},
+  { 117, -1, -1, sizeof(::player::GetSpeedRequest)},
+  { 123, -1, -1, sizeof(::player::GetSpeedReply)},
+  { 130, -1, -1, sizeof(::player::SetSpeedRequest)},
+  { 137, -1, -1, sizeof(::player::SetSpeedReply)},
+  { 143, -1, -1, sizeof(::player::GetPauseRequest)},
+  { 149, -1, -1, sizeof(::player::GetPauseReply)},
+  { 156, -1, -1, sizeof(::player::SetPauseRequest)},
+  { 163, -1, -1, sizeof(::player::SetPauseReply)},
+  { 169, -1, -1, sizeof(::player::GetVolumeRequest)},
+  { 175, -1, -1, sizeof(::player::GetVolumeReply)},
+  { 182, -1, -1, sizeof(::player::SetVolumeRequest)},
+  { 189, -1, -1, sizeof(::player::SetVolumeReply)},
+  { 195, -1, -1, sizeof(::player::GetMuteRequest)},
+  { 201, -1, -1, sizeof(::player::GetMuteReply)},
+  { 208, -1, -1, sizeof(::player::SetMuteRequest)},
+  { 215, -1, -1, sizeof(::player::SetMuteReply)},
+  { 221, -1, -1, sizeof(::player::SetVideoTrackRequest)},
+  { 228, -1, -1, sizeof(::player::SetVideoTrackReply)},
+  { 234, -1, -1, sizeof(::player::SetAudioTrackRequest)},
+  { 241, -1, -1, sizeof(::player::SetAudioTrackReply)},
+  { 247, -1, -1, sizeof(::player::SetSubtitlesTrackRequest)},
+  { 254, -1, -1, sizeof(::player::SetSubtitlesTrackReply)},
+  { 260, -1, -1, sizeof(::player::TrackInfo)},
+  { 271, -1, -1, sizeof(::player::VideoTrack)},
+  { 283, -1, -1, sizeof(::player::SeekRequest)},
+  { 292, -1, -1, sizeof(::player::SeekReply)},
+  { 298, -1, -1, sizeof(::player::FrameStepRequest)},
+  { 305, -1, -1, sizeof(::player::FrameStepReply)},
+  { 311, -1, -1, sizeof(::player::ScreenshotRequest)},
+  { 317, -1, -1, sizeof(::player::ScreenshotReply)},
+  { 324, -1, -1, sizeof(::player::GetVideoTracksRequest)},
+  { 330, -1, -1, sizeof(::player::GetVideoTracksReply)},
+  { 337, -1, -1, sizeof(::player::AudioTrack)},
+  { 349, -1, -1, sizeof(::player::GetAudioTracksRequest)},
+  { 355, -1, -1, sizeof(::player::GetAudioTracksReply)},
+  { 362, -1, -1, sizeof(::player::SubtitlesTrack)},
+  { 371, -1, -1, sizeof(::player::GetSubtitlesTracksRequest)},
+  { 377, -1, -1, sizeof(::player::GetSubtitlesTracksReply)},
+  { 384, -1, -1, sizeof(::player::Chapter)},
+  { 393, 401, -1, sizeof(::player::MediaInfo_TagsEntry_DoNotUse)},
+  { 403, -1, -1, sizeof(::player::MediaInfo)},
+  { 414, -1, -1, sizeof(::player::GetMediaInfoRequest)},
+  { 420, -1, -1, sizeof(::player::GetMediaInfoReply)},
+  { 427, -1, -1, sizeof(::player::GetChaptersRequest)},
+  { 433, -1, -1, sizeof(::player::GetChaptersReply)},
+  { 440, -1, -1, sizeof(::player::GetChapterRequest)},
+  { 446, -1, -1, sizeof(::player::GetChapterReply)},
+  { 453, -1, -1, sizeof(::player::SetChapterRequest)},
+  { 460, -1, -1, sizeof(::player::SetChapterReply)},
+  { 466, -1, -1, sizeof(::player::StopRequest)},
+  { 472, -1, -1, sizeof(::player::StopReply)},
+  { 478, -1, -1, sizeof(::player::CloseRequest)},
+  { 484, -1, -1, sizeof(::player::CloseReply)},
+  { 490, -1, -1, sizeof(::player::EventsRequest)},
+  { 496, -1, -1, sizeof(::player::EventStateChange)},
+  { 503, -1, -1, sizeof(::player::EventPosition)},
+  { 511, -1, -1, sizeof(::player::EventTracksChange)},
+  { 517, -1, -1, sizeof(::player::EventBuffering)},
+  { 524, -1, -1, sizeof(::player::EventError)},
+  { 531, -1, -1, sizeof(::player::EventEndOfFile)},
+  { 537, -1, -1, sizeof(::player::EventMediaChange)},
+  { 544, -1, -1, sizeof(::player::Event)},
 };
 
 static const ::_pb::Message* const file_default_instances[] = {
@@ -930,6 +1672,8 @@ static const ::_pb::Message* const file_default_instances[] = {
   &::player::_IsEndedReply_default_instance_._instance,
   &::player::_GetPositionRequest_default_instance_._instance,
   &::player::_GetPositionReply_default_instance_._instance,
+  &::player::_GetAudioPositionRequest_default_instance_._instance,
+  &::player::_GetAudioPositionReply_default_instance_._instance,
   &::player::_GetLengthRequest_default_instance_._instance,
   &::player::_GetLengthReply_default_instance_._instance,
   &::player::_GetSpeedRequest_default_instance_._instance,
@@ -940,15 +1684,28 @@ static const ::_pb::Message* const file_default_instances[] = {
   &::player::_GetPauseReply_default_instance_._instance,
   &::player::_SetPauseRequest_default_instance_._instance,
   &::player::_SetPauseReply_default_instance_._instance,
+  &::player::_GetVolumeRequest_default_instance_._instance,
+  &::player::_GetVolumeReply_default_instance_._instance,
+  &::player::_SetVolumeRequest_default_instance_._instance,
+  &::player::_SetVolumeReply_default_instance_._instance,
+  &::player::_GetMuteRequest_default_instance_._instance,
+  &::player::_GetMuteReply_default_instance_._instance,
+  &::player::_SetMuteRequest_default_instance_._instance,
+  &::player::_SetMuteReply_default_instance_._instance,
   &::player::_SetVideoTrackRequest_default_instance_._instance,
   &::player::_SetVideoTrackReply_default_instance_._instance,
   &::player::_SetAudioTrackRequest_default_instance_._instance,
   &::player::_SetAudioTrackReply_default_instance_._instance,
   &::player::_SetSubtitlesTrackRequest_default_instance_._instance,
   &::player::_SetSubtitlesTrackReply_default_instance_._instance,
+  &::player::_TrackInfo_default_instance_._instance,
   &::player::_VideoTrack_default_instance_._instance,
   &::player::_SeekRequest_default_instance_._instance,
   &::player::_SeekReply_default_instance_._instance,
+  &::player::_FrameStepRequest_default_instance_._instance,
+  &::player::_FrameStepReply_default_instance_._instance,
+  &::player::_ScreenshotRequest_default_instance_._instance,
+  &::player::_ScreenshotReply_default_instance_._instance,
   &::player::_GetVideoTracksRequest_default_instance_._instance,
   &::player::_GetVideoTracksReply_default_instance_._instance,
   &::player::_AudioTrack_default_instance_._instance,
@@ -957,10 +1714,30 @@ static const ::_pb::Message* const file_default_instances[] = {
   &::player::_SubtitlesTrack_default_instance_._instance,
   &::player::_GetSubtitlesTracksRequest_default_instance_._instance,
   &::player::_GetSubtitlesTracksReply_default_instance_._instance,
+  &::player::_Chapter_default_instance_._instance,
+  &::player::_MediaInfo_TagsEntry_DoNotUse_default_instance_._instance,
+  &::player::_MediaInfo_default_instance_._instance,
+  &::player::_GetMediaInfoRequest_default_instance_._instance,
+  &::player::_GetMediaInfoReply_default_instance_._instance,
+  &::player::_GetChaptersRequest_default_instance_._instance,
+  &::player::_GetChaptersReply_default_instance_._instance,
+  &::player::_GetChapterRequest_default_instance_._instance,
+  &::player::_GetChapterReply_default_instance_._instance,
+  &::player::_SetChapterRequest_default_instance_._instance,
+  &::player::_SetChapterReply_default_instance_._instance,
   &::player::_StopRequest_default_instance_._instance,
   &::player::_StopReply_default_instance_._instance,
   &::player::_CloseRequest_default_instance_._instance,
   &::player::_CloseReply_default_instance_._instance,
+  &::player::_EventsRequest_default_instance_._instance,
+  &::player::_EventStateChange_default_instance_._instance,
+  &::player::_EventPosition_default_instance_._instance,
+  &::player::_EventTracksChange_default_instance_._instance,
+  &::player::_EventBuffering_default_instance_._instance,
+  &::player::_EventError_default_instance_._instance,
+  &::player::_EventEndOfFile_default_instance_._instance,
+  &::player::_EventMediaChange_default_instance_._instance,
+  &::player::_Event_default_instance_._instance,
 };
 
 const char descriptor_table_protodef_player_2eproto[] PROTOBUF_SECTION_VARIABLE(protodesc_cold) =
@@ -974,59 +1751,120 @@ const char descriptor_table_protodef_player_2eproto[] PROTOBUF_SECTION_VARIABLE(
   " \001(\t\"\020\n\016EndChanRequest\"\016\n\014EndChanReply\"\020"
   "\n\016IsEndedRequest\"\037\n\014IsEndedReply\022\017\n\007isEn"
   "ded\030\001 \001(\010\"\024\n\022GetPositionRequest\"(\n\020GetPo"
-  "sitionReply\022\024\n\014positionSecs\030\001 \001(\001\"\022\n\020Get"
-  "LengthRequest\"$\n\016GetLengthReply\022\022\n\nlengt"
-  "hSecs\030\001 \001(\001\"\021\n\017GetSpeedRequest\"\036\n\rGetSpe"
-  "edReply\022\r\n\005speed\030\001 \001(\001\" \n\017SetSpeedReques"
-  "t\022\r\n\005speed\030\001 \001(\001\"\017\n\rSetSpeedReply\"\021\n\017Get"
-  "PauseRequest\"!\n\rGetPauseReply\022\020\n\010isPause"
-  "d\030\001 \001(\010\"#\n\017SetPauseRequest\022\020\n\010isPaused\030\001"
-  " \001(\010\"\017\n\rSetPauseReply\",\n\024SetVideoTrackRe"
-  "quest\022\024\n\014videoTrackID\030\001 \001(\003\"\024\n\022SetVideoT"
-  "rackReply\",\n\024SetAudioTrackRequest\022\024\n\014aud"
-  "ioTrackID\030\001 \001(\003\"\024\n\022SetAudioTrackReply\"4\n"
-  "\030SetSubtitlesTrackRequest\022\030\n\020subtitlesTr"
-  "ackID\030\001 \001(\003\"\030\n\026SetSubtitlesTrackReply\"*\n"
-  "\nVideoTrack\022\n\n\002id\030\001 \001(\003\022\020\n\010isActive\030\002 \001("
-  "\010\"\?\n\013SeekRequest\022\013\n\003pos\030\001 \001(\003\022\022\n\nisRelat"
-  "ive\030\002 \001(\010\022\017\n\007isQuick\030\003 \001(\010\"\013\n\tSeekReply\""
-  "\027\n\025GetVideoTracksRequest\"=\n\023GetVideoTrac"
-  "ksReply\022&\n\nvideoTrack\030\001 \003(\0132\022.player.Vid"
-  "eoTrack\"*\n\nAudioTrack\022\n\n\002id\030\001 \001(\003\022\020\n\010isA"
-  "ctive\030\002 \001(\010\"\027\n\025GetAudioTracksRequest\"=\n\023"
-  "GetAudioTracksReply\022&\n\naudioTrack\030\001 \003(\0132"
-  "\022.player.AudioTrack\".\n\016SubtitlesTrack\022\n\n"
-  "\002id\030\001 \001(\003\022\020\n\010isActive\030\002 \001(\010\"\033\n\031GetSubtit"
-  "lesTracksRequest\"I\n\027GetSubtitlesTracksRe"
-  "ply\022.\n\016subtitlesTrack\030\001 \003(\0132\026.player.Sub"
-  "titlesTrack\"\r\n\013StopRequest\"\013\n\tStopReply\""
-  "\016\n\014CloseRequest\"\014\n\nCloseReply*\303\001\n\014Loggin"
-  "gLevel\022\024\n\020LoggingLevelNone\020\000\022\025\n\021LoggingL"
-  "evelFatal\020\001\022\025\n\021LoggingLevelPanic\020\002\022\025\n\021Lo"
-  "ggingLevelError\020\003\022\024\n\020LoggingLevelWarn\020\004\022"
-  "\024\n\020LoggingLevelInfo\020\005\022\025\n\021LoggingLevelDeb"
-  "ug\020\006\022\025\n\021LoggingLevelTrace\020\0072\230\013\n\006Player\0220"
-  "\n\004Open\022\023.player.OpenRequest\032\021.player.Ope"
-  "nReply\"\000\022W\n\021SetupForStreaming\022 .player.S"
-  "etupForStreamingRequest\032\036.player.SetupFo"
-  "rStreamingReply\"\000\022H\n\014ProcessTitle\022\033.play"
-  "er.ProcessTitleRequest\032\031.player.ProcessT"
-  "itleReply\"\000\0229\n\007GetLink\022\026.player.GetLinkR"
-  "equest\032\024.player.GetLinkReply\"\000\022;\n\007EndCha"
-  "n\022\026.player.EndChanRequest\032\024.player.EndCh"
-  "anReply\"\0000\001\0229\n\007IsEnded\022\026.player.IsEndedR"
-  "equest\032\024.player.IsEndedReply\"\000\022E\n\013GetPos"
-  "ition\022\032.player.GetPositionRequest\032\030.play"
-  "er.GetPositionReply\"\000\022\?\n\tGetLength\022\030.pla"
-  "yer.GetLengthRequest\032\026.player.GetLengthR"
-  "eply\"\000\022<\n\010GetSpeed\022\027.player.GetSpeedRequ"
-  "est\032\025.player.GetSpeedReply\"\000\022<\n\010SetSpeed"
-  "\022\027.player.SetSpeedRequest\032\025.player.SetSp"
-  "eedReply\"\000\022<\n\010GetPause\022\027.player.GetPause"
-  "Request\032\025.player.GetPauseReply\"\000\022<\n\010SetP"
-  "ause\022\027.player.SetPauseRequest\032\025.player.S"
-  "etPauseReply\"\000\0220\n\004Seek\022\023.player.SeekRequ"
-  "est\032\021.player.SeekReply\"\000\022N\n\016GetVideoTrac"
+  "sitionReply\022\024\n\014positionSecs\030\001 \001(\001\"\031\n\027Get"
+  "AudioPositionRequest\"-\n\025GetAudioPosition"
+  "Reply\022\024\n\014positionSecs\030\001 \001(\001\"\022\n\020GetLength"
+  "Request\"$\n\016GetLengthReply\022\022\n\nlengthSecs\030"
+  "\001 \001(\001\"\021\n\017GetSpeedRequest\"\036\n\rGetSpeedRepl"
+  "y\022\r\n\005speed\030\001 \001(\001\" \n\017SetSpeedRequest\022\r\n\005s"
+  "peed\030\001 \001(\001\"\017\n\rSetSpeedReply\"\021\n\017GetPauseR"
+  "equest\"!\n\rGetPauseReply\022\020\n\010isPaused\030\001 \001("
+  "\010\"#\n\017SetPauseRequest\022\020\n\010isPaused\030\001 \001(\010\"\017"
+  "\n\rSetPauseReply\"\022\n\020GetVolumeRequest\" \n\016G"
+  "etVolumeReply\022\016\n\006volume\030\001 \001(\001\"\"\n\020SetVolu"
+  "meRequest\022\016\n\006volume\030\001 \001(\001\"\020\n\016SetVolumeRe"
+  "ply\"\020\n\016GetMuteRequest\"\037\n\014GetMuteReply\022\017\n"
+  "\007isMuted\030\001 \001(\010\"!\n\016SetMuteRequest\022\017\n\007isMu"
+  "ted\030\001 \001(\010\"\016\n\014SetMuteReply\",\n\024SetVideoTra"
+  "ckRequest\022\024\n\014videoTrackID\030\001 \001(\003\"\024\n\022SetVi"
+  "deoTrackReply\",\n\024SetAudioTrackRequest\022\024\n"
+  "\014audioTrackID\030\001 \001(\003\"\024\n\022SetAudioTrackRepl"
+  "y\"4\n\030SetSubtitlesTrackRequest\022\030\n\020subtitl"
+  "esTrackID\030\001 \001(\003\"\030\n\026SetSubtitlesTrackRepl"
+  "y\"`\n\tTrackInfo\022\r\n\005codec\030\001 \001(\t\022\020\n\010languag"
+  "e\030\002 \001(\t\022\r\n\005title\030\003 \001(\t\022\021\n\tisDefault\030\004 \001("
+  "\010\022\020\n\010isForced\030\005 \001(\010\"w\n\nVideoTrack\022\n\n\002id\030"
+  "\001 \001(\003\022\020\n\010isActive\030\002 \001(\010\022\037\n\004info\030\003 \001(\0132\021."
+  "player.TrackInfo\022\r\n\005width\030\004 \001(\005\022\016\n\006heigh"
+  "t\030\005 \001(\005\022\013\n\003fps\030\006 \001(\001\"J\n\013SeekRequest\022\026\n\016p"
+  "osNanoseconds\030\001 \001(\003\022\022\n\nisRelative\030\002 \001(\010\022"
+  "\017\n\007isQuick\030\003 \001(\010\"\013\n\tSeekReply\"#\n\020FrameSt"
+  "epRequest\022\017\n\007forward\030\001 \001(\010\"\020\n\016FrameStepR"
+  "eply\"\023\n\021ScreenshotRequest\"\036\n\017ScreenshotR"
+  "eply\022\013\n\003png\030\001 \001(\014\"\027\n\025GetVideoTracksReque"
+  "st\"=\n\023GetVideoTracksReply\022&\n\nvideoTrack\030"
+  "\001 \003(\0132\022.player.VideoTrack\"\210\001\n\nAudioTrack"
+  "\022\n\n\002id\030\001 \001(\003\022\020\n\010isActive\030\002 \001(\010\022\037\n\004info\030\003"
+  " \001(\0132\021.player.TrackInfo\022\022\n\nsampleRate\030\004 "
+  "\001(\005\022\020\n\010channels\030\005 \001(\005\022\025\n\rchannelLayout\030\006"
+  " \001(\t\"\027\n\025GetAudioTracksRequest\"=\n\023GetAudi"
+  "oTracksReply\022&\n\naudioTrack\030\001 \003(\0132\022.playe"
+  "r.AudioTrack\"O\n\016SubtitlesTrack\022\n\n\002id\030\001 \001"
+  "(\003\022\020\n\010isActive\030\002 \001(\010\022\037\n\004info\030\003 \001(\0132\021.pla"
+  "yer.TrackInfo\"\033\n\031GetSubtitlesTracksReque"
+  "st\"I\n\027GetSubtitlesTracksReply\022.\n\016subtitl"
+  "esTrack\030\001 \003(\0132\026.player.SubtitlesTrack\"<\n"
+  "\007Chapter\022\r\n\005title\030\001 \001(\t\022\021\n\tstartSecs\030\002 \001"
+  "(\001\022\017\n\007endSecs\030\003 \001(\001\"\300\001\n\tMediaInfo\022\022\n\nfor"
+  "matName\030\001 \001(\t\022\017\n\007bitrate\030\002 \001(\003\022\023\n\013stream"
+  "Count\030\003 \001(\005\022)\n\004tags\030\004 \003(\0132\033.player.Media"
+  "Info.TagsEntry\022!\n\010chapters\030\005 \003(\0132\017.playe"
+  "r.Chapter\032+\n\tTagsEntry\022\013\n\003key\030\001 \001(\t\022\r\n\005v"
+  "alue\030\002 \001(\t:\0028\001\"\025\n\023GetMediaInfoRequest\"9\n"
+  "\021GetMediaInfoReply\022$\n\tmediaInfo\030\001 \001(\0132\021."
+  "player.MediaInfo\"\024\n\022GetChaptersRequest\"5"
+  "\n\020GetChaptersReply\022!\n\010chapters\030\001 \003(\0132\017.p"
+  "layer.Chapter\"\023\n\021GetChapterRequest\"%\n\017Ge"
+  "tChapterReply\022\022\n\nchapterIdx\030\001 \001(\005\"\'\n\021Set"
+  "ChapterRequest\022\022\n\nchapterIdx\030\001 \001(\005\"\021\n\017Se"
+  "tChapterReply\"\r\n\013StopRequest\"\013\n\tStopRepl"
+  "y\"\016\n\014CloseRequest\"\014\n\nCloseReply\"\017\n\rEvent"
+  "sRequest\"8\n\020EventStateChange\022$\n\005state\030\001 "
+  "\001(\0162\025.player.PlaybackState\"9\n\rEventPosit"
+  "ion\022\024\n\014positionSecs\030\001 \001(\001\022\022\n\nlengthSecs\030"
+  "\002 \001(\001\"\023\n\021EventTracksChange\"!\n\016EventBuffe"
+  "ring\022\017\n\007percent\030\001 \001(\001\"\033\n\nEventError\022\r\n\005e"
+  "rror\030\001 \001(\t\"\020\n\016EventEndOfFile\" \n\020EventMed"
+  "iaChange\022\014\n\004link\030\001 \001(\t\"\317\002\n\005Event\022/\n\013stat"
+  "eChange\030\001 \001(\0132\030.player.EventStateChangeH"
+  "\000\022)\n\010position\030\002 \001(\0132\025.player.EventPositi"
+  "onH\000\0221\n\014tracksChange\030\003 \001(\0132\031.player.Even"
+  "tTracksChangeH\000\022+\n\tbuffering\030\004 \001(\0132\026.pla"
+  "yer.EventBufferingH\000\022#\n\005error\030\005 \001(\0132\022.pl"
+  "ayer.EventErrorH\000\022+\n\tendOfFile\030\006 \001(\0132\026.p"
+  "layer.EventEndOfFileH\000\022/\n\013mediaChange\030\007 "
+  "\001(\0132\030.player.EventMediaChangeH\000B\007\n\005event"
+  "*\303\001\n\014LoggingLevel\022\024\n\020LoggingLevelNone\020\000\022"
+  "\025\n\021LoggingLevelFatal\020\001\022\025\n\021LoggingLevelPa"
+  "nic\020\002\022\025\n\021LoggingLevelError\020\003\022\024\n\020LoggingL"
+  "evelWarn\020\004\022\024\n\020LoggingLevelInfo\020\005\022\025\n\021Logg"
+  "ingLevelDebug\020\006\022\025\n\021LoggingLevelTrace\020\007*x"
+  "\n\rPlaybackState\022\032\n\026PlaybackStateUndefine"
+  "d\020\000\022\030\n\024PlaybackStateStopped\020\001\022\030\n\024Playbac"
+  "kStatePlaying\020\002\022\027\n\023PlaybackStatePaused\020\003"
+  "2\270\021\n\006Player\0220\n\004Open\022\023.player.OpenRequest"
+  "\032\021.player.OpenReply\"\000\022W\n\021SetupForStreami"
+  "ng\022 .player.SetupForStreamingRequest\032\036.p"
+  "layer.SetupForStreamingReply\"\000\022H\n\014Proces"
+  "sTitle\022\033.player.ProcessTitleRequest\032\031.pl"
+  "ayer.ProcessTitleReply\"\000\0229\n\007GetLink\022\026.pl"
+  "ayer.GetLinkRequest\032\024.player.GetLinkRepl"
+  "y\"\000\022;\n\007EndChan\022\026.player.EndChanRequest\032\024"
+  ".player.EndChanReply\"\0000\001\0229\n\007IsEnded\022\026.pl"
+  "ayer.IsEndedRequest\032\024.player.IsEndedRepl"
+  "y\"\000\022E\n\013GetPosition\022\032.player.GetPositionR"
+  "equest\032\030.player.GetPositionReply\"\000\022T\n\020Ge"
+  "tAudioPosition\022\037.player.GetAudioPosition"
+  "Request\032\035.player.GetAudioPositionReply\"\000"
+  "\022\?\n\tGetLength\022\030.player.GetLengthRequest\032"
+  "\026.player.GetLengthReply\"\000\022<\n\010GetSpeed\022\027."
+  "player.GetSpeedRequest\032\025.player.GetSpeed"
+  "Reply\"\000\022<\n\010SetSpeed\022\027.player.SetSpeedReq"
+  "uest\032\025.player.SetSpeedReply\"\000\022<\n\010GetPaus"
+  "e\022\027.player.GetPauseRequest\032\025.player.GetP"
+  "auseReply\"\000\022<\n\010SetPause\022\027.player.SetPaus"
+  "eRequest\032\025.player.SetPauseReply\"\000\022\?\n\tGet"
+  "Volume\022\030.player.GetVolumeRequest\032\026.playe"
+  "r.GetVolumeReply\"\000\022\?\n\tSetVolume\022\030.player"
+  ".SetVolumeRequest\032\026.player.SetVolumeRepl"
+  "y\"\000\0229\n\007GetMute\022\026.player.GetMuteRequest\032\024"
+  ".player.GetMuteReply\"\000\0229\n\007SetMute\022\026.play"
+  "er.SetMuteRequest\032\024.player.SetMuteReply\""
+  "\000\0220\n\004Seek\022\023.player.SeekRequest\032\021.player."
+  "SeekReply\"\000\022\?\n\tFrameStep\022\030.player.FrameS"
+  "tepRequest\032\026.player.FrameStepReply\"\000\022B\n\n"
+  "Screenshot\022\031.player.ScreenshotRequest\032\027."
+  "player.ScreenshotReply\"\000\022N\n\016GetVideoTrac"
   "ks\022\035.player.GetVideoTracksRequest\032\033.play"
   "er.GetVideoTracksReply\"\000\022N\n\016GetAudioTrac"
   "ks\022\035.player.GetAudioTracksRequest\032\033.play"
@@ -1039,17 +1877,25 @@ const char descriptor_table_protodef_player_2eproto[] PROTOBUF_SECTION_VARIABLE(
   "\032\032.player.SetAudioTrackReply\"\000\022W\n\021SetSub"
   "titlesTrack\022 .player.SetSubtitlesTrackRe"
   "quest\032\036.player.SetSubtitlesTrackReply\"\000\022"
-  "0\n\004Stop\022\023.player.StopRequest\032\021.player.St"
-  "opReply\"\000\0223\n\005Close\022\024.player.CloseRequest"
-  "\032\022.player.CloseReply\"\000BBZ@github.com/xai"
-  "onaro-go/player/pkg/player/protobuf/go/p"
-  "layer_grpcb\006proto3"
+  "H\n\014GetMediaInfo\022\033.player.GetMediaInfoReq"
+  "uest\032\031.player.GetMediaInfoReply\"\000\022E\n\013Get"
+  "Chapters\022\032.player.GetChaptersRequest\032\030.p"
+  "layer.GetChaptersReply\"\000\022B\n\nGetChapter\022\031"
+  ".player.GetChapterRequest\032\027.player.GetCh"
+  "apterReply\"\000\022B\n\nSetChapter\022\031.player.SetC"
+  "hapterRequest\032\027.player.SetChapterReply\"\000"
+  "\0220\n\004Stop\022\023.player.StopRequest\032\021.player.S"
+  "topReply\"\000\0223\n\005Close\022\024.player.CloseReques"
+  "t\032\022.player.CloseReply\"\000\0222\n\006Events\022\025.play"
+  "er.EventsRequest\032\r.player.Event\"\0000\001BBZ@g"
+  "ithub.com/xaionaro-go/player/pkg/player/"
+  "protobuf/go/player_grpcb\006proto3"
   ;
 static ::_pbi::once_flag descriptor_table_player_2eproto_once;
 const ::_pbi::DescriptorTable descriptor_table_player_2eproto = {
-    false, false, 3178, descriptor_table_protodef_player_2eproto,
+    false, false, 5951, descriptor_table_protodef_player_2eproto,
     "player.proto",
-    &descriptor_table_player_2eproto_once, nullptr, 0, 45,
+    &descriptor_table_player_2eproto_once, nullptr, 0, 80,
     schemas, file_default_instances, TableStruct_player_2eproto::offsets,
     file_level_metadata_player_2eproto, file_level_enum_descriptors_player_2eproto,
     file_level_service_descriptors_player_2eproto,
@@ -1081,6 +1927,22 @@ bool LoggingLevel_IsValid(int value) {
   }
 }
 
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* PlaybackState_descriptor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&descriptor_table_player_2eproto);
+  return file_level_enum_descriptors_player_2eproto[1];
+}
+bool PlaybackState_IsValid(int value) {
+  switch (value) {
+    case 0:
+    case 1:
+    case 2:
+    case 3:
+      return true;
+    default:
+      return false;
+  }
+}
+
 
 // ===================================================================
 
@@ -2501,31 +3363,31 @@ void GetPositionReply::InternalSwap(GetPositionReply* other) {
 
 // ===================================================================
 
-class GetLengthRequest::_Internal {
+class GetAudioPositionRequest::_Internal {
  public:
 };
 
-GetLengthRequest::GetLengthRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+GetAudioPositionRequest::GetAudioPositionRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                          bool is_message_owned)
   : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
-  // @@protoc_insertion_point(arena_constructor:player.GetLengthRequest)
+  // @@protoc_insertion_point(arena_constructor:player.GetAudioPositionRequest)
 }
-GetLengthRequest::GetLengthRequest(const GetLengthRequest& from)
+GetAudioPositionRequest::GetAudioPositionRequest(const GetAudioPositionRequest& from)
   : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
-  GetLengthRequest* const _this = this; (void)_this;
+  GetAudioPositionRequest* const _this = this; (void)_this;
   _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:player.GetLengthRequest)
+  // @@protoc_insertion_point(copy_constructor:player.GetAudioPositionRequest)
 }
 
 
 
 
 
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetLengthRequest::_class_data_ = {
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetAudioPositionRequest::_class_data_ = {
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
 };
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetLengthRequest::GetClassData() const { return &_class_data_; }
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetAudioPositionRequest::GetClassData() const { return &_class_data_; }
 
 
 
@@ -2533,7 +3395,7 @@ const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetLengthRequest::GetClassData
 
 
 
-::PROTOBUF_NAMESPACE_ID::Metadata GetLengthRequest::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata GetAudioPositionRequest::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
       file_level_metadata_player_2eproto[14]);
@@ -2541,40 +3403,40 @@ const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetLengthRequest::GetClassData
 
 // ===================================================================
 
-class GetLengthReply::_Internal {
+class GetAudioPositionReply::_Internal {
  public:
 };
 
-GetLengthReply::GetLengthReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+GetAudioPositionReply::GetAudioPositionReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                          bool is_message_owned)
   : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
   SharedCtor(arena, is_message_owned);
-  // @@protoc_insertion_point(arena_constructor:player.GetLengthReply)
+  // @@protoc_insertion_point(arena_constructor:player.GetAudioPositionReply)
 }
-GetLengthReply::GetLengthReply(const GetLengthReply& from)
+GetAudioPositionReply::GetAudioPositionReply(const GetAudioPositionReply& from)
   : ::PROTOBUF_NAMESPACE_ID::Message() {
-  GetLengthReply* const _this = this; (void)_this;
+  GetAudioPositionReply* const _this = this; (void)_this;
   new (&_impl_) Impl_{
-      decltype(_impl_.lengthsecs_){}
+      decltype(_impl_.positionsecs_){}
     , /*decltype(_impl_._cached_size_)*/{}};
 
   _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  _this->_impl_.lengthsecs_ = from._impl_.lengthsecs_;
-  // @@protoc_insertion_point(copy_constructor:player.GetLengthReply)
+  _this->_impl_.positionsecs_ = from._impl_.positionsecs_;
+  // @@protoc_insertion_point(copy_constructor:player.GetAudioPositionReply)
 }
 
-inline void GetLengthReply::SharedCtor(
+inline void GetAudioPositionReply::SharedCtor(
     ::_pb::Arena* arena, bool is_message_owned) {
   (void)arena;
   (void)is_message_owned;
   new (&_impl_) Impl_{
-      decltype(_impl_.lengthsecs_){0}
+      decltype(_impl_.positionsecs_){0}
     , /*decltype(_impl_._cached_size_)*/{}
   };
 }
 
-GetLengthReply::~GetLengthReply() {
-  // @@protoc_insertion_point(destructor:player.GetLengthReply)
+GetAudioPositionReply::~GetAudioPositionReply() {
+  // @@protoc_insertion_point(destructor:player.GetAudioPositionReply)
   if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
   (void)arena;
     return;
@@ -2582,34 +3444,34 @@ GetLengthReply::~GetLengthReply() {
   SharedDtor();
 }
 
-inline void GetLengthReply::SharedDtor() {
+inline void GetAudioPositionReply::SharedDtor() {
   GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
 }
 
-void GetLengthReply::SetCachedSize(int size) const {
+void GetAudioPositionReply::SetCachedSize(int size) const {
   _impl_._cached_size_.Set(size);
 }
 
-void GetLengthReply::Clear() {
-// @@protoc_insertion_point(message_clear_start:player.GetLengthReply)
+void GetAudioPositionReply::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.GetAudioPositionReply)
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  _impl_.lengthsecs_ = 0;
+  _impl_.positionsecs_ = 0;
   _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
 }
 
-const char* GetLengthReply::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+const char* GetAudioPositionReply::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     uint32_t tag;
     ptr = ::_pbi::ReadTag(ptr, &tag);
     switch (tag >> 3) {
-      // double lengthSecs = 1;
+      // double positionSecs = 1;
       case 1:
         if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 9)) {
-          _impl_.lengthsecs_ = ::PROTOBUF_NAMESPACE_ID::internal::UnalignedLoad<double>(ptr);
+          _impl_.positionsecs_ = ::PROTOBUF_NAMESPACE_ID::internal::UnalignedLoad<double>(ptr);
           ptr += sizeof(double);
         } else
           goto handle_unusual;
@@ -2637,51 +3499,281 @@ failure:
 #undef CHK_
 }
 
-uint8_t* GetLengthReply::_InternalSerialize(
+uint8_t* GetAudioPositionReply::_InternalSerialize(
     uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
-  // @@protoc_insertion_point(serialize_to_array_start:player.GetLengthReply)
+  // @@protoc_insertion_point(serialize_to_array_start:player.GetAudioPositionReply)
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // double lengthSecs = 1;
+  // double positionSecs = 1;
   static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
-  double tmp_lengthsecs = this->_internal_lengthsecs();
-  uint64_t raw_lengthsecs;
-  memcpy(&raw_lengthsecs, &tmp_lengthsecs, sizeof(tmp_lengthsecs));
-  if (raw_lengthsecs != 0) {
+  double tmp_positionsecs = this->_internal_positionsecs();
+  uint64_t raw_positionsecs;
+  memcpy(&raw_positionsecs, &tmp_positionsecs, sizeof(tmp_positionsecs));
+  if (raw_positionsecs != 0) {
     target = stream->EnsureSpace(target);
-    target = ::_pbi::WireFormatLite::WriteDoubleToArray(1, this->_internal_lengthsecs(), target);
+    target = ::_pbi::WireFormatLite::WriteDoubleToArray(1, this->_internal_positionsecs(), target);
   }
 
   if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
     target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:player.GetLengthReply)
+  // @@protoc_insertion_point(serialize_to_array_end:player.GetAudioPositionReply)
   return target;
 }
 
-size_t GetLengthReply::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:player.GetLengthReply)
+size_t GetAudioPositionReply::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.GetAudioPositionReply)
   size_t total_size = 0;
 
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // double lengthSecs = 1;
+  // double positionSecs = 1;
   static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
-  double tmp_lengthsecs = this->_internal_lengthsecs();
-  uint64_t raw_lengthsecs;
-  memcpy(&raw_lengthsecs, &tmp_lengthsecs, sizeof(tmp_lengthsecs));
-  if (raw_lengthsecs != 0) {
+  double tmp_positionsecs = this->_internal_positionsecs();
+  uint64_t raw_positionsecs;
+  memcpy(&raw_positionsecs, &tmp_positionsecs, sizeof(tmp_positionsecs));
+  if (raw_positionsecs != 0) {
     total_size += 1 + 8;
   }
 
   return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
 }
 
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetLengthReply::_class_data_ = {
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetAudioPositionReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    GetAudioPositionReply::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetAudioPositionReply::GetClassData() const { return &_class_data_; }
+
+
+void GetAudioPositionReply::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<GetAudioPositionReply*>(&to_msg);
+  auto& from = static_cast<const GetAudioPositionReply&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.GetAudioPositionReply)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_positionsecs = from._internal_positionsecs();
+  uint64_t raw_positionsecs;
+  memcpy(&raw_positionsecs, &tmp_positionsecs, sizeof(tmp_positionsecs));
+  if (raw_positionsecs != 0) {
+    _this->_internal_set_positionsecs(from._internal_positionsecs());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void GetAudioPositionReply::CopyFrom(const GetAudioPositionReply& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.GetAudioPositionReply)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GetAudioPositionReply::IsInitialized() const {
+  return true;
+}
+
+void GetAudioPositionReply::InternalSwap(GetAudioPositionReply* other) {
+  using std::swap;
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  swap(_impl_.positionsecs_, other->_impl_.positionsecs_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetAudioPositionReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[15]);
+}
+
+// ===================================================================
+
+class GetLengthRequest::_Internal {
+ public:
+};
+
+GetLengthRequest::GetLengthRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.GetLengthRequest)
+}
+GetLengthRequest::GetLengthRequest(const GetLengthRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  GetLengthRequest* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.GetLengthRequest)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetLengthRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetLengthRequest::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetLengthRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[16]);
+}
+
+// ===================================================================
+
+class GetLengthReply::_Internal {
+ public:
+};
+
+GetLengthReply::GetLengthReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.GetLengthReply)
+}
+GetLengthReply::GetLengthReply(const GetLengthReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  GetLengthReply* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.lengthsecs_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  _this->_impl_.lengthsecs_ = from._impl_.lengthsecs_;
+  // @@protoc_insertion_point(copy_constructor:player.GetLengthReply)
+}
+
+inline void GetLengthReply::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.lengthsecs_){0}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+GetLengthReply::~GetLengthReply() {
+  // @@protoc_insertion_point(destructor:player.GetLengthReply)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void GetLengthReply::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+}
+
+void GetLengthReply::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void GetLengthReply::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.GetLengthReply)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.lengthsecs_ = 0;
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* GetLengthReply::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // double lengthSecs = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 9)) {
+          _impl_.lengthsecs_ = ::PROTOBUF_NAMESPACE_ID::internal::UnalignedLoad<double>(ptr);
+          ptr += sizeof(double);
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* GetLengthReply::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.GetLengthReply)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // double lengthSecs = 1;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_lengthsecs = this->_internal_lengthsecs();
+  uint64_t raw_lengthsecs;
+  memcpy(&raw_lengthsecs, &tmp_lengthsecs, sizeof(tmp_lengthsecs));
+  if (raw_lengthsecs != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteDoubleToArray(1, this->_internal_lengthsecs(), target);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.GetLengthReply)
+  return target;
+}
+
+size_t GetLengthReply::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.GetLengthReply)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // double lengthSecs = 1;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_lengthsecs = this->_internal_lengthsecs();
+  uint64_t raw_lengthsecs;
+  memcpy(&raw_lengthsecs, &tmp_lengthsecs, sizeof(tmp_lengthsecs));
+  if (raw_lengthsecs != 0) {
+    total_size += 1 + 8;
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetLengthReply::_class_data_ = {
     ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
     GetLengthReply::MergeImpl
 };
@@ -2726,7 +3818,7 @@ void GetLengthReply::InternalSwap(GetLengthReply* other) {
 ::PROTOBUF_NAMESPACE_ID::Metadata GetLengthReply::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[15]);
+      file_level_metadata_player_2eproto[17]);
 }
 
 // ===================================================================
@@ -2766,7 +3858,7 @@ const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetSpeedRequest::GetClassData(
 ::PROTOBUF_NAMESPACE_ID::Metadata GetSpeedRequest::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[16]);
+      file_level_metadata_player_2eproto[18]);
 }
 
 // ===================================================================
@@ -2956,7 +4048,7 @@ void GetSpeedReply::InternalSwap(GetSpeedReply* other) {
 ::PROTOBUF_NAMESPACE_ID::Metadata GetSpeedReply::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[17]);
+      file_level_metadata_player_2eproto[19]);
 }
 
 // ===================================================================
@@ -3146,7 +4238,7 @@ void SetSpeedRequest::InternalSwap(SetSpeedRequest* other) {
 ::PROTOBUF_NAMESPACE_ID::Metadata SetSpeedRequest::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[18]);
+      file_level_metadata_player_2eproto[20]);
 }
 
 // ===================================================================
@@ -3186,7 +4278,7 @@ const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetSpeedReply::GetClassData()
 ::PROTOBUF_NAMESPACE_ID::Metadata SetSpeedReply::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[19]);
+      file_level_metadata_player_2eproto[21]);
 }
 
 // ===================================================================
@@ -3226,7 +4318,7 @@ const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetPauseRequest::GetClassData(
 ::PROTOBUF_NAMESPACE_ID::Metadata GetPauseRequest::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[20]);
+      file_level_metadata_player_2eproto[22]);
 }
 
 // ===================================================================
@@ -3404,7 +4496,7 @@ void GetPauseReply::InternalSwap(GetPauseReply* other) {
 ::PROTOBUF_NAMESPACE_ID::Metadata GetPauseReply::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[21]);
+      file_level_metadata_player_2eproto[23]);
 }
 
 // ===================================================================
@@ -3582,7 +4674,7 @@ void SetPauseRequest::InternalSwap(SetPauseRequest* other) {
 ::PROTOBUF_NAMESPACE_ID::Metadata SetPauseRequest::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[22]);
+      file_level_metadata_player_2eproto[24]);
 }
 
 // ===================================================================
@@ -3622,45 +4714,85 @@ const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetPauseReply::GetClassData()
 ::PROTOBUF_NAMESPACE_ID::Metadata SetPauseReply::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[23]);
+      file_level_metadata_player_2eproto[25]);
 }
 
 // ===================================================================
 
-class SetVideoTrackRequest::_Internal {
+class GetVolumeRequest::_Internal {
  public:
 };
 
-SetVideoTrackRequest::SetVideoTrackRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+GetVolumeRequest::GetVolumeRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                          bool is_message_owned)
-  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
-  SharedCtor(arena, is_message_owned);
-  // @@protoc_insertion_point(arena_constructor:player.SetVideoTrackRequest)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.GetVolumeRequest)
 }
-SetVideoTrackRequest::SetVideoTrackRequest(const SetVideoTrackRequest& from)
-  : ::PROTOBUF_NAMESPACE_ID::Message() {
-  SetVideoTrackRequest* const _this = this; (void)_this;
-  new (&_impl_) Impl_{
-      decltype(_impl_.videotrackid_){}
-    , /*decltype(_impl_._cached_size_)*/{}};
-
+GetVolumeRequest::GetVolumeRequest(const GetVolumeRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  GetVolumeRequest* const _this = this; (void)_this;
   _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  _this->_impl_.videotrackid_ = from._impl_.videotrackid_;
-  // @@protoc_insertion_point(copy_constructor:player.SetVideoTrackRequest)
+  // @@protoc_insertion_point(copy_constructor:player.GetVolumeRequest)
 }
 
-inline void SetVideoTrackRequest::SharedCtor(
-    ::_pb::Arena* arena, bool is_message_owned) {
-  (void)arena;
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetVolumeRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetVolumeRequest::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetVolumeRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[26]);
+}
+
+// ===================================================================
+
+class GetVolumeReply::_Internal {
+ public:
+};
+
+GetVolumeReply::GetVolumeReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.GetVolumeReply)
+}
+GetVolumeReply::GetVolumeReply(const GetVolumeReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  GetVolumeReply* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.volume_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  _this->_impl_.volume_ = from._impl_.volume_;
+  // @@protoc_insertion_point(copy_constructor:player.GetVolumeReply)
+}
+
+inline void GetVolumeReply::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
   (void)is_message_owned;
   new (&_impl_) Impl_{
-      decltype(_impl_.videotrackid_){int64_t{0}}
+      decltype(_impl_.volume_){0}
     , /*decltype(_impl_._cached_size_)*/{}
   };
 }
 
-SetVideoTrackRequest::~SetVideoTrackRequest() {
-  // @@protoc_insertion_point(destructor:player.SetVideoTrackRequest)
+GetVolumeReply::~GetVolumeReply() {
+  // @@protoc_insertion_point(destructor:player.GetVolumeReply)
   if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
   (void)arena;
     return;
@@ -3668,35 +4800,35 @@ SetVideoTrackRequest::~SetVideoTrackRequest() {
   SharedDtor();
 }
 
-inline void SetVideoTrackRequest::SharedDtor() {
+inline void GetVolumeReply::SharedDtor() {
   GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
 }
 
-void SetVideoTrackRequest::SetCachedSize(int size) const {
+void GetVolumeReply::SetCachedSize(int size) const {
   _impl_._cached_size_.Set(size);
 }
 
-void SetVideoTrackRequest::Clear() {
-// @@protoc_insertion_point(message_clear_start:player.SetVideoTrackRequest)
+void GetVolumeReply::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.GetVolumeReply)
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  _impl_.videotrackid_ = int64_t{0};
+  _impl_.volume_ = 0;
   _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
 }
 
-const char* SetVideoTrackRequest::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+const char* GetVolumeReply::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     uint32_t tag;
     ptr = ::_pbi::ReadTag(ptr, &tag);
     switch (tag >> 3) {
-      // int64 videoTrackID = 1;
+      // double volume = 1;
       case 1:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
-          _impl_.videotrackid_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
-          CHK_(ptr);
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 9)) {
+          _impl_.volume_ = ::PROTOBUF_NAMESPACE_ID::internal::UnalignedLoad<double>(ptr);
+          ptr += sizeof(double);
         } else
           goto handle_unusual;
         continue;
@@ -3723,162 +4855,134 @@ failure:
 #undef CHK_
 }
 
-uint8_t* SetVideoTrackRequest::_InternalSerialize(
+uint8_t* GetVolumeReply::_InternalSerialize(
     uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
-  // @@protoc_insertion_point(serialize_to_array_start:player.SetVideoTrackRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:player.GetVolumeReply)
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // int64 videoTrackID = 1;
-  if (this->_internal_videotrackid() != 0) {
+  // double volume = 1;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_volume = this->_internal_volume();
+  uint64_t raw_volume;
+  memcpy(&raw_volume, &tmp_volume, sizeof(tmp_volume));
+  if (raw_volume != 0) {
     target = stream->EnsureSpace(target);
-    target = ::_pbi::WireFormatLite::WriteInt64ToArray(1, this->_internal_videotrackid(), target);
+    target = ::_pbi::WireFormatLite::WriteDoubleToArray(1, this->_internal_volume(), target);
   }
 
   if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
     target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:player.SetVideoTrackRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:player.GetVolumeReply)
   return target;
 }
 
-size_t SetVideoTrackRequest::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:player.SetVideoTrackRequest)
+size_t GetVolumeReply::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.GetVolumeReply)
   size_t total_size = 0;
 
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // int64 videoTrackID = 1;
-  if (this->_internal_videotrackid() != 0) {
-    total_size += ::_pbi::WireFormatLite::Int64SizePlusOne(this->_internal_videotrackid());
+  // double volume = 1;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_volume = this->_internal_volume();
+  uint64_t raw_volume;
+  memcpy(&raw_volume, &tmp_volume, sizeof(tmp_volume));
+  if (raw_volume != 0) {
+    total_size += 1 + 8;
   }
 
   return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
 }
 
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetVideoTrackRequest::_class_data_ = {
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetVolumeReply::_class_data_ = {
     ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
-    SetVideoTrackRequest::MergeImpl
+    GetVolumeReply::MergeImpl
 };
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetVideoTrackRequest::GetClassData() const { return &_class_data_; }
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetVolumeReply::GetClassData() const { return &_class_data_; }
 
 
-void SetVideoTrackRequest::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
-  auto* const _this = static_cast<SetVideoTrackRequest*>(&to_msg);
-  auto& from = static_cast<const SetVideoTrackRequest&>(from_msg);
-  // @@protoc_insertion_point(class_specific_merge_from_start:player.SetVideoTrackRequest)
+void GetVolumeReply::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<GetVolumeReply*>(&to_msg);
+  auto& from = static_cast<const GetVolumeReply&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.GetVolumeReply)
   GOOGLE_DCHECK_NE(&from, _this);
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from._internal_videotrackid() != 0) {
-    _this->_internal_set_videotrackid(from._internal_videotrackid());
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_volume = from._internal_volume();
+  uint64_t raw_volume;
+  memcpy(&raw_volume, &tmp_volume, sizeof(tmp_volume));
+  if (raw_volume != 0) {
+    _this->_internal_set_volume(from._internal_volume());
   }
   _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
 }
 
-void SetVideoTrackRequest::CopyFrom(const SetVideoTrackRequest& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:player.SetVideoTrackRequest)
+void GetVolumeReply::CopyFrom(const GetVolumeReply& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.GetVolumeReply)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool SetVideoTrackRequest::IsInitialized() const {
+bool GetVolumeReply::IsInitialized() const {
   return true;
 }
 
-void SetVideoTrackRequest::InternalSwap(SetVideoTrackRequest* other) {
+void GetVolumeReply::InternalSwap(GetVolumeReply* other) {
   using std::swap;
   _internal_metadata_.InternalSwap(&other->_internal_metadata_);
-  swap(_impl_.videotrackid_, other->_impl_.videotrackid_);
-}
-
-::PROTOBUF_NAMESPACE_ID::Metadata SetVideoTrackRequest::GetMetadata() const {
-  return ::_pbi::AssignDescriptors(
-      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[24]);
-}
-
-// ===================================================================
-
-class SetVideoTrackReply::_Internal {
- public:
-};
-
-SetVideoTrackReply::SetVideoTrackReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
-                         bool is_message_owned)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
-  // @@protoc_insertion_point(arena_constructor:player.SetVideoTrackReply)
-}
-SetVideoTrackReply::SetVideoTrackReply(const SetVideoTrackReply& from)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
-  SetVideoTrackReply* const _this = this; (void)_this;
-  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:player.SetVideoTrackReply)
+  swap(_impl_.volume_, other->_impl_.volume_);
 }
 
-
-
-
-
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetVideoTrackReply::_class_data_ = {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
-};
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetVideoTrackReply::GetClassData() const { return &_class_data_; }
-
-
-
-
-
-
-
-::PROTOBUF_NAMESPACE_ID::Metadata SetVideoTrackReply::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata GetVolumeReply::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[25]);
+      file_level_metadata_player_2eproto[27]);
 }
 
 // ===================================================================
 
-class SetAudioTrackRequest::_Internal {
+class SetVolumeRequest::_Internal {
  public:
 };
 
-SetAudioTrackRequest::SetAudioTrackRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+SetVolumeRequest::SetVolumeRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                          bool is_message_owned)
   : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
   SharedCtor(arena, is_message_owned);
-  // @@protoc_insertion_point(arena_constructor:player.SetAudioTrackRequest)
+  // @@protoc_insertion_point(arena_constructor:player.SetVolumeRequest)
 }
-SetAudioTrackRequest::SetAudioTrackRequest(const SetAudioTrackRequest& from)
+SetVolumeRequest::SetVolumeRequest(const SetVolumeRequest& from)
   : ::PROTOBUF_NAMESPACE_ID::Message() {
-  SetAudioTrackRequest* const _this = this; (void)_this;
+  SetVolumeRequest* const _this = this; (void)_this;
   new (&_impl_) Impl_{
-      decltype(_impl_.audiotrackid_){}
+      decltype(_impl_.volume_){}
     , /*decltype(_impl_._cached_size_)*/{}};
 
   _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  _this->_impl_.audiotrackid_ = from._impl_.audiotrackid_;
-  // @@protoc_insertion_point(copy_constructor:player.SetAudioTrackRequest)
+  _this->_impl_.volume_ = from._impl_.volume_;
+  // @@protoc_insertion_point(copy_constructor:player.SetVolumeRequest)
 }
 
-inline void SetAudioTrackRequest::SharedCtor(
+inline void SetVolumeRequest::SharedCtor(
     ::_pb::Arena* arena, bool is_message_owned) {
   (void)arena;
   (void)is_message_owned;
   new (&_impl_) Impl_{
-      decltype(_impl_.audiotrackid_){int64_t{0}}
+      decltype(_impl_.volume_){0}
     , /*decltype(_impl_._cached_size_)*/{}
   };
 }
 
-SetAudioTrackRequest::~SetAudioTrackRequest() {
-  // @@protoc_insertion_point(destructor:player.SetAudioTrackRequest)
+SetVolumeRequest::~SetVolumeRequest() {
+  // @@protoc_insertion_point(destructor:player.SetVolumeRequest)
   if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
   (void)arena;
     return;
@@ -3886,35 +4990,35 @@ SetAudioTrackRequest::~SetAudioTrackRequest() {
   SharedDtor();
 }
 
-inline void SetAudioTrackRequest::SharedDtor() {
+inline void SetVolumeRequest::SharedDtor() {
   GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
 }
 
-void SetAudioTrackRequest::SetCachedSize(int size) const {
+void SetVolumeRequest::SetCachedSize(int size) const {
   _impl_._cached_size_.Set(size);
 }
 
-void SetAudioTrackRequest::Clear() {
-// @@protoc_insertion_point(message_clear_start:player.SetAudioTrackRequest)
+void SetVolumeRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.SetVolumeRequest)
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  _impl_.audiotrackid_ = int64_t{0};
+  _impl_.volume_ = 0;
   _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
 }
 
-const char* SetAudioTrackRequest::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+const char* SetVolumeRequest::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     uint32_t tag;
     ptr = ::_pbi::ReadTag(ptr, &tag);
     switch (tag >> 3) {
-      // int64 audioTrackID = 1;
+      // double volume = 1;
       case 1:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
-          _impl_.audiotrackid_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
-          CHK_(ptr);
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 9)) {
+          _impl_.volume_ = ::PROTOBUF_NAMESPACE_ID::internal::UnalignedLoad<double>(ptr);
+          ptr += sizeof(double);
         } else
           goto handle_unusual;
         continue;
@@ -3941,113 +5045,125 @@ failure:
 #undef CHK_
 }
 
-uint8_t* SetAudioTrackRequest::_InternalSerialize(
+uint8_t* SetVolumeRequest::_InternalSerialize(
     uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
-  // @@protoc_insertion_point(serialize_to_array_start:player.SetAudioTrackRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:player.SetVolumeRequest)
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // int64 audioTrackID = 1;
-  if (this->_internal_audiotrackid() != 0) {
+  // double volume = 1;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_volume = this->_internal_volume();
+  uint64_t raw_volume;
+  memcpy(&raw_volume, &tmp_volume, sizeof(tmp_volume));
+  if (raw_volume != 0) {
     target = stream->EnsureSpace(target);
-    target = ::_pbi::WireFormatLite::WriteInt64ToArray(1, this->_internal_audiotrackid(), target);
+    target = ::_pbi::WireFormatLite::WriteDoubleToArray(1, this->_internal_volume(), target);
   }
 
   if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
     target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:player.SetAudioTrackRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:player.SetVolumeRequest)
   return target;
 }
 
-size_t SetAudioTrackRequest::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:player.SetAudioTrackRequest)
+size_t SetVolumeRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.SetVolumeRequest)
   size_t total_size = 0;
 
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // int64 audioTrackID = 1;
-  if (this->_internal_audiotrackid() != 0) {
-    total_size += ::_pbi::WireFormatLite::Int64SizePlusOne(this->_internal_audiotrackid());
+  // double volume = 1;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_volume = this->_internal_volume();
+  uint64_t raw_volume;
+  memcpy(&raw_volume, &tmp_volume, sizeof(tmp_volume));
+  if (raw_volume != 0) {
+    total_size += 1 + 8;
   }
 
   return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
 }
 
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetAudioTrackRequest::_class_data_ = {
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetVolumeRequest::_class_data_ = {
     ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
-    SetAudioTrackRequest::MergeImpl
+    SetVolumeRequest::MergeImpl
 };
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetAudioTrackRequest::GetClassData() const { return &_class_data_; }
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetVolumeRequest::GetClassData() const { return &_class_data_; }
 
 
-void SetAudioTrackRequest::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
-  auto* const _this = static_cast<SetAudioTrackRequest*>(&to_msg);
-  auto& from = static_cast<const SetAudioTrackRequest&>(from_msg);
-  // @@protoc_insertion_point(class_specific_merge_from_start:player.SetAudioTrackRequest)
+void SetVolumeRequest::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<SetVolumeRequest*>(&to_msg);
+  auto& from = static_cast<const SetVolumeRequest&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.SetVolumeRequest)
   GOOGLE_DCHECK_NE(&from, _this);
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from._internal_audiotrackid() != 0) {
-    _this->_internal_set_audiotrackid(from._internal_audiotrackid());
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_volume = from._internal_volume();
+  uint64_t raw_volume;
+  memcpy(&raw_volume, &tmp_volume, sizeof(tmp_volume));
+  if (raw_volume != 0) {
+    _this->_internal_set_volume(from._internal_volume());
   }
   _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
 }
 
-void SetAudioTrackRequest::CopyFrom(const SetAudioTrackRequest& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:player.SetAudioTrackRequest)
+void SetVolumeRequest::CopyFrom(const SetVolumeRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.SetVolumeRequest)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool SetAudioTrackRequest::IsInitialized() const {
+bool SetVolumeRequest::IsInitialized() const {
   return true;
 }
 
-void SetAudioTrackRequest::InternalSwap(SetAudioTrackRequest* other) {
+void SetVolumeRequest::InternalSwap(SetVolumeRequest* other) {
   using std::swap;
   _internal_metadata_.InternalSwap(&other->_internal_metadata_);
-  swap(_impl_.audiotrackid_, other->_impl_.audiotrackid_);
+  swap(_impl_.volume_, other->_impl_.volume_);
 }
 
-::PROTOBUF_NAMESPACE_ID::Metadata SetAudioTrackRequest::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata SetVolumeRequest::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[26]);
+      file_level_metadata_player_2eproto[28]);
 }
 
 // ===================================================================
 
-class SetAudioTrackReply::_Internal {
+class SetVolumeReply::_Internal {
  public:
 };
 
-SetAudioTrackReply::SetAudioTrackReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+SetVolumeReply::SetVolumeReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                          bool is_message_owned)
   : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
-  // @@protoc_insertion_point(arena_constructor:player.SetAudioTrackReply)
+  // @@protoc_insertion_point(arena_constructor:player.SetVolumeReply)
 }
-SetAudioTrackReply::SetAudioTrackReply(const SetAudioTrackReply& from)
+SetVolumeReply::SetVolumeReply(const SetVolumeReply& from)
   : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
-  SetAudioTrackReply* const _this = this; (void)_this;
+  SetVolumeReply* const _this = this; (void)_this;
   _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:player.SetAudioTrackReply)
+  // @@protoc_insertion_point(copy_constructor:player.SetVolumeReply)
 }
 
 
 
 
 
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetAudioTrackReply::_class_data_ = {
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetVolumeReply::_class_data_ = {
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
 };
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetAudioTrackReply::GetClassData() const { return &_class_data_; }
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetVolumeReply::GetClassData() const { return &_class_data_; }
 
 
 
@@ -4055,48 +5171,88 @@ const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetAudioTrackReply::GetClassDa
 
 
 
-::PROTOBUF_NAMESPACE_ID::Metadata SetAudioTrackReply::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata SetVolumeReply::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[27]);
+      file_level_metadata_player_2eproto[29]);
 }
 
 // ===================================================================
 
-class SetSubtitlesTrackRequest::_Internal {
+class GetMuteRequest::_Internal {
  public:
 };
 
-SetSubtitlesTrackRequest::SetSubtitlesTrackRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+GetMuteRequest::GetMuteRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.GetMuteRequest)
+}
+GetMuteRequest::GetMuteRequest(const GetMuteRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  GetMuteRequest* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.GetMuteRequest)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetMuteRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetMuteRequest::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetMuteRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[30]);
+}
+
+// ===================================================================
+
+class GetMuteReply::_Internal {
+ public:
+};
+
+GetMuteReply::GetMuteReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                          bool is_message_owned)
   : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
   SharedCtor(arena, is_message_owned);
-  // @@protoc_insertion_point(arena_constructor:player.SetSubtitlesTrackRequest)
+  // @@protoc_insertion_point(arena_constructor:player.GetMuteReply)
 }
-SetSubtitlesTrackRequest::SetSubtitlesTrackRequest(const SetSubtitlesTrackRequest& from)
+GetMuteReply::GetMuteReply(const GetMuteReply& from)
   : ::PROTOBUF_NAMESPACE_ID::Message() {
-  SetSubtitlesTrackRequest* const _this = this; (void)_this;
+  GetMuteReply* const _this = this; (void)_this;
   new (&_impl_) Impl_{
-      decltype(_impl_.subtitlestrackid_){}
+      decltype(_impl_.ismuted_){}
     , /*decltype(_impl_._cached_size_)*/{}};
 
   _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  _this->_impl_.subtitlestrackid_ = from._impl_.subtitlestrackid_;
-  // @@protoc_insertion_point(copy_constructor:player.SetSubtitlesTrackRequest)
+  _this->_impl_.ismuted_ = from._impl_.ismuted_;
+  // @@protoc_insertion_point(copy_constructor:player.GetMuteReply)
 }
 
-inline void SetSubtitlesTrackRequest::SharedCtor(
+inline void GetMuteReply::SharedCtor(
     ::_pb::Arena* arena, bool is_message_owned) {
   (void)arena;
   (void)is_message_owned;
   new (&_impl_) Impl_{
-      decltype(_impl_.subtitlestrackid_){int64_t{0}}
+      decltype(_impl_.ismuted_){false}
     , /*decltype(_impl_._cached_size_)*/{}
   };
 }
 
-SetSubtitlesTrackRequest::~SetSubtitlesTrackRequest() {
-  // @@protoc_insertion_point(destructor:player.SetSubtitlesTrackRequest)
+GetMuteReply::~GetMuteReply() {
+  // @@protoc_insertion_point(destructor:player.GetMuteReply)
   if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
   (void)arena;
     return;
@@ -4104,34 +5260,34 @@ SetSubtitlesTrackRequest::~SetSubtitlesTrackRequest() {
   SharedDtor();
 }
 
-inline void SetSubtitlesTrackRequest::SharedDtor() {
+inline void GetMuteReply::SharedDtor() {
   GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
 }
 
-void SetSubtitlesTrackRequest::SetCachedSize(int size) const {
+void GetMuteReply::SetCachedSize(int size) const {
   _impl_._cached_size_.Set(size);
 }
 
-void SetSubtitlesTrackRequest::Clear() {
-// @@protoc_insertion_point(message_clear_start:player.SetSubtitlesTrackRequest)
+void GetMuteReply::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.GetMuteReply)
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  _impl_.subtitlestrackid_ = int64_t{0};
+  _impl_.ismuted_ = false;
   _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
 }
 
-const char* SetSubtitlesTrackRequest::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+const char* GetMuteReply::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     uint32_t tag;
     ptr = ::_pbi::ReadTag(ptr, &tag);
     switch (tag >> 3) {
-      // int64 subtitlesTrackID = 1;
+      // bool isMuted = 1;
       case 1:
         if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
-          _impl_.subtitlestrackid_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          _impl_.ismuted_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
           CHK_(ptr);
         } else
           goto handle_unusual;
@@ -4159,166 +5315,122 @@ failure:
 #undef CHK_
 }
 
-uint8_t* SetSubtitlesTrackRequest::_InternalSerialize(
+uint8_t* GetMuteReply::_InternalSerialize(
     uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
-  // @@protoc_insertion_point(serialize_to_array_start:player.SetSubtitlesTrackRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:player.GetMuteReply)
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // int64 subtitlesTrackID = 1;
-  if (this->_internal_subtitlestrackid() != 0) {
+  // bool isMuted = 1;
+  if (this->_internal_ismuted() != 0) {
     target = stream->EnsureSpace(target);
-    target = ::_pbi::WireFormatLite::WriteInt64ToArray(1, this->_internal_subtitlestrackid(), target);
+    target = ::_pbi::WireFormatLite::WriteBoolToArray(1, this->_internal_ismuted(), target);
   }
 
   if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
     target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:player.SetSubtitlesTrackRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:player.GetMuteReply)
   return target;
 }
 
-size_t SetSubtitlesTrackRequest::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:player.SetSubtitlesTrackRequest)
+size_t GetMuteReply::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.GetMuteReply)
   size_t total_size = 0;
 
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // int64 subtitlesTrackID = 1;
-  if (this->_internal_subtitlestrackid() != 0) {
-    total_size += ::_pbi::WireFormatLite::Int64SizePlusOne(this->_internal_subtitlestrackid());
+  // bool isMuted = 1;
+  if (this->_internal_ismuted() != 0) {
+    total_size += 1 + 1;
   }
 
   return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
 }
 
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetSubtitlesTrackRequest::_class_data_ = {
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetMuteReply::_class_data_ = {
     ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
-    SetSubtitlesTrackRequest::MergeImpl
+    GetMuteReply::MergeImpl
 };
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetSubtitlesTrackRequest::GetClassData() const { return &_class_data_; }
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetMuteReply::GetClassData() const { return &_class_data_; }
 
 
-void SetSubtitlesTrackRequest::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
-  auto* const _this = static_cast<SetSubtitlesTrackRequest*>(&to_msg);
-  auto& from = static_cast<const SetSubtitlesTrackRequest&>(from_msg);
-  // @@protoc_insertion_point(class_specific_merge_from_start:player.SetSubtitlesTrackRequest)
+void GetMuteReply::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<GetMuteReply*>(&to_msg);
+  auto& from = static_cast<const GetMuteReply&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.GetMuteReply)
   GOOGLE_DCHECK_NE(&from, _this);
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from._internal_subtitlestrackid() != 0) {
-    _this->_internal_set_subtitlestrackid(from._internal_subtitlestrackid());
+  if (from._internal_ismuted() != 0) {
+    _this->_internal_set_ismuted(from._internal_ismuted());
   }
   _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
 }
 
-void SetSubtitlesTrackRequest::CopyFrom(const SetSubtitlesTrackRequest& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:player.SetSubtitlesTrackRequest)
+void GetMuteReply::CopyFrom(const GetMuteReply& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.GetMuteReply)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool SetSubtitlesTrackRequest::IsInitialized() const {
+bool GetMuteReply::IsInitialized() const {
   return true;
 }
 
-void SetSubtitlesTrackRequest::InternalSwap(SetSubtitlesTrackRequest* other) {
+void GetMuteReply::InternalSwap(GetMuteReply* other) {
   using std::swap;
   _internal_metadata_.InternalSwap(&other->_internal_metadata_);
-  swap(_impl_.subtitlestrackid_, other->_impl_.subtitlestrackid_);
-}
-
-::PROTOBUF_NAMESPACE_ID::Metadata SetSubtitlesTrackRequest::GetMetadata() const {
-  return ::_pbi::AssignDescriptors(
-      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[28]);
-}
-
-// ===================================================================
-
-class SetSubtitlesTrackReply::_Internal {
- public:
-};
-
-SetSubtitlesTrackReply::SetSubtitlesTrackReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
-                         bool is_message_owned)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
-  // @@protoc_insertion_point(arena_constructor:player.SetSubtitlesTrackReply)
-}
-SetSubtitlesTrackReply::SetSubtitlesTrackReply(const SetSubtitlesTrackReply& from)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
-  SetSubtitlesTrackReply* const _this = this; (void)_this;
-  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:player.SetSubtitlesTrackReply)
+  swap(_impl_.ismuted_, other->_impl_.ismuted_);
 }
 
-
-
-
-
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetSubtitlesTrackReply::_class_data_ = {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
-};
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetSubtitlesTrackReply::GetClassData() const { return &_class_data_; }
-
-
-
-
-
-
-
-::PROTOBUF_NAMESPACE_ID::Metadata SetSubtitlesTrackReply::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata GetMuteReply::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[29]);
+      file_level_metadata_player_2eproto[31]);
 }
 
 // ===================================================================
 
-class VideoTrack::_Internal {
+class SetMuteRequest::_Internal {
  public:
 };
 
-VideoTrack::VideoTrack(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+SetMuteRequest::SetMuteRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                          bool is_message_owned)
   : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
   SharedCtor(arena, is_message_owned);
-  // @@protoc_insertion_point(arena_constructor:player.VideoTrack)
+  // @@protoc_insertion_point(arena_constructor:player.SetMuteRequest)
 }
-VideoTrack::VideoTrack(const VideoTrack& from)
+SetMuteRequest::SetMuteRequest(const SetMuteRequest& from)
   : ::PROTOBUF_NAMESPACE_ID::Message() {
-  VideoTrack* const _this = this; (void)_this;
+  SetMuteRequest* const _this = this; (void)_this;
   new (&_impl_) Impl_{
-      decltype(_impl_.id_){}
-    , decltype(_impl_.isactive_){}
+      decltype(_impl_.ismuted_){}
     , /*decltype(_impl_._cached_size_)*/{}};
 
   _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  ::memcpy(&_impl_.id_, &from._impl_.id_,
-    static_cast<size_t>(reinterpret_cast<char*>(&_impl_.isactive_) -
-    reinterpret_cast<char*>(&_impl_.id_)) + sizeof(_impl_.isactive_));
-  // @@protoc_insertion_point(copy_constructor:player.VideoTrack)
+  _this->_impl_.ismuted_ = from._impl_.ismuted_;
+  // @@protoc_insertion_point(copy_constructor:player.SetMuteRequest)
 }
 
-inline void VideoTrack::SharedCtor(
+inline void SetMuteRequest::SharedCtor(
     ::_pb::Arena* arena, bool is_message_owned) {
   (void)arena;
   (void)is_message_owned;
   new (&_impl_) Impl_{
-      decltype(_impl_.id_){int64_t{0}}
-    , decltype(_impl_.isactive_){false}
+      decltype(_impl_.ismuted_){false}
     , /*decltype(_impl_._cached_size_)*/{}
   };
 }
 
-VideoTrack::~VideoTrack() {
-  // @@protoc_insertion_point(destructor:player.VideoTrack)
+SetMuteRequest::~SetMuteRequest() {
+  // @@protoc_insertion_point(destructor:player.SetMuteRequest)
   if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
   (void)arena;
     return;
@@ -4326,44 +5438,34 @@ VideoTrack::~VideoTrack() {
   SharedDtor();
 }
 
-inline void VideoTrack::SharedDtor() {
+inline void SetMuteRequest::SharedDtor() {
   GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
 }
 
-void VideoTrack::SetCachedSize(int size) const {
+void SetMuteRequest::SetCachedSize(int size) const {
   _impl_._cached_size_.Set(size);
 }
 
-void VideoTrack::Clear() {
-// @@protoc_insertion_point(message_clear_start:player.VideoTrack)
+void SetMuteRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.SetMuteRequest)
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  ::memset(&_impl_.id_, 0, static_cast<size_t>(
-      reinterpret_cast<char*>(&_impl_.isactive_) -
-      reinterpret_cast<char*>(&_impl_.id_)) + sizeof(_impl_.isactive_));
+  _impl_.ismuted_ = false;
   _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
 }
 
-const char* VideoTrack::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+const char* SetMuteRequest::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     uint32_t tag;
     ptr = ::_pbi::ReadTag(ptr, &tag);
     switch (tag >> 3) {
-      // int64 id = 1;
+      // bool isMuted = 1;
       case 1:
         if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
-          _impl_.id_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
-          CHK_(ptr);
-        } else
-          goto handle_unusual;
-        continue;
-      // bool isActive = 2;
-      case 2:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 16)) {
-          _impl_.isactive_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          _impl_.ismuted_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
           CHK_(ptr);
         } else
           goto handle_unusual;
@@ -4391,147 +5493,162 @@ failure:
 #undef CHK_
 }
 
-uint8_t* VideoTrack::_InternalSerialize(
+uint8_t* SetMuteRequest::_InternalSerialize(
     uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
-  // @@protoc_insertion_point(serialize_to_array_start:player.VideoTrack)
+  // @@protoc_insertion_point(serialize_to_array_start:player.SetMuteRequest)
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // int64 id = 1;
-  if (this->_internal_id() != 0) {
-    target = stream->EnsureSpace(target);
-    target = ::_pbi::WireFormatLite::WriteInt64ToArray(1, this->_internal_id(), target);
-  }
-
-  // bool isActive = 2;
-  if (this->_internal_isactive() != 0) {
+  // bool isMuted = 1;
+  if (this->_internal_ismuted() != 0) {
     target = stream->EnsureSpace(target);
-    target = ::_pbi::WireFormatLite::WriteBoolToArray(2, this->_internal_isactive(), target);
+    target = ::_pbi::WireFormatLite::WriteBoolToArray(1, this->_internal_ismuted(), target);
   }
 
   if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
     target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:player.VideoTrack)
+  // @@protoc_insertion_point(serialize_to_array_end:player.SetMuteRequest)
   return target;
 }
 
-size_t VideoTrack::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:player.VideoTrack)
+size_t SetMuteRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.SetMuteRequest)
   size_t total_size = 0;
 
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // int64 id = 1;
-  if (this->_internal_id() != 0) {
-    total_size += ::_pbi::WireFormatLite::Int64SizePlusOne(this->_internal_id());
-  }
-
-  // bool isActive = 2;
-  if (this->_internal_isactive() != 0) {
+  // bool isMuted = 1;
+  if (this->_internal_ismuted() != 0) {
     total_size += 1 + 1;
   }
 
   return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
 }
 
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData VideoTrack::_class_data_ = {
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetMuteRequest::_class_data_ = {
     ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
-    VideoTrack::MergeImpl
+    SetMuteRequest::MergeImpl
 };
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*VideoTrack::GetClassData() const { return &_class_data_; }
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetMuteRequest::GetClassData() const { return &_class_data_; }
 
 
-void VideoTrack::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
-  auto* const _this = static_cast<VideoTrack*>(&to_msg);
-  auto& from = static_cast<const VideoTrack&>(from_msg);
-  // @@protoc_insertion_point(class_specific_merge_from_start:player.VideoTrack)
+void SetMuteRequest::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<SetMuteRequest*>(&to_msg);
+  auto& from = static_cast<const SetMuteRequest&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.SetMuteRequest)
   GOOGLE_DCHECK_NE(&from, _this);
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from._internal_id() != 0) {
-    _this->_internal_set_id(from._internal_id());
-  }
-  if (from._internal_isactive() != 0) {
-    _this->_internal_set_isactive(from._internal_isactive());
+  if (from._internal_ismuted() != 0) {
+    _this->_internal_set_ismuted(from._internal_ismuted());
   }
   _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
 }
 
-void VideoTrack::CopyFrom(const VideoTrack& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:player.VideoTrack)
+void SetMuteRequest::CopyFrom(const SetMuteRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.SetMuteRequest)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool VideoTrack::IsInitialized() const {
+bool SetMuteRequest::IsInitialized() const {
   return true;
 }
 
-void VideoTrack::InternalSwap(VideoTrack* other) {
+void SetMuteRequest::InternalSwap(SetMuteRequest* other) {
   using std::swap;
   _internal_metadata_.InternalSwap(&other->_internal_metadata_);
-  ::PROTOBUF_NAMESPACE_ID::internal::memswap<
-      PROTOBUF_FIELD_OFFSET(VideoTrack, _impl_.isactive_)
-      + sizeof(VideoTrack::_impl_.isactive_)
-      - PROTOBUF_FIELD_OFFSET(VideoTrack, _impl_.id_)>(
-          reinterpret_cast<char*>(&_impl_.id_),
-          reinterpret_cast<char*>(&other->_impl_.id_));
+  swap(_impl_.ismuted_, other->_impl_.ismuted_);
 }
 
-::PROTOBUF_NAMESPACE_ID::Metadata VideoTrack::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata SetMuteRequest::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[30]);
+      file_level_metadata_player_2eproto[32]);
 }
 
 // ===================================================================
 
-class SeekRequest::_Internal {
+class SetMuteReply::_Internal {
  public:
 };
 
-SeekRequest::SeekRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+SetMuteReply::SetMuteReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.SetMuteReply)
+}
+SetMuteReply::SetMuteReply(const SetMuteReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  SetMuteReply* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.SetMuteReply)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetMuteReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetMuteReply::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata SetMuteReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[33]);
+}
+
+// ===================================================================
+
+class SetVideoTrackRequest::_Internal {
+ public:
+};
+
+SetVideoTrackRequest::SetVideoTrackRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                          bool is_message_owned)
   : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
   SharedCtor(arena, is_message_owned);
-  // @@protoc_insertion_point(arena_constructor:player.SeekRequest)
+  // @@protoc_insertion_point(arena_constructor:player.SetVideoTrackRequest)
 }
-SeekRequest::SeekRequest(const SeekRequest& from)
+SetVideoTrackRequest::SetVideoTrackRequest(const SetVideoTrackRequest& from)
   : ::PROTOBUF_NAMESPACE_ID::Message() {
-  SeekRequest* const _this = this; (void)_this;
+  SetVideoTrackRequest* const _this = this; (void)_this;
   new (&_impl_) Impl_{
-      decltype(_impl_.pos_){}
-    , decltype(_impl_.isrelative_){}
-    , decltype(_impl_.isquick_){}
+      decltype(_impl_.videotrackid_){}
     , /*decltype(_impl_._cached_size_)*/{}};
 
   _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  ::memcpy(&_impl_.pos_, &from._impl_.pos_,
-    static_cast<size_t>(reinterpret_cast<char*>(&_impl_.isquick_) -
-    reinterpret_cast<char*>(&_impl_.pos_)) + sizeof(_impl_.isquick_));
-  // @@protoc_insertion_point(copy_constructor:player.SeekRequest)
+  _this->_impl_.videotrackid_ = from._impl_.videotrackid_;
+  // @@protoc_insertion_point(copy_constructor:player.SetVideoTrackRequest)
 }
 
-inline void SeekRequest::SharedCtor(
+inline void SetVideoTrackRequest::SharedCtor(
     ::_pb::Arena* arena, bool is_message_owned) {
   (void)arena;
   (void)is_message_owned;
   new (&_impl_) Impl_{
-      decltype(_impl_.pos_){int64_t{0}}
-    , decltype(_impl_.isrelative_){false}
-    , decltype(_impl_.isquick_){false}
+      decltype(_impl_.videotrackid_){int64_t{0}}
     , /*decltype(_impl_._cached_size_)*/{}
   };
 }
 
-SeekRequest::~SeekRequest() {
-  // @@protoc_insertion_point(destructor:player.SeekRequest)
+SetVideoTrackRequest::~SetVideoTrackRequest() {
+  // @@protoc_insertion_point(destructor:player.SetVideoTrackRequest)
   if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
   (void)arena;
     return;
@@ -4539,52 +5656,34 @@ SeekRequest::~SeekRequest() {
   SharedDtor();
 }
 
-inline void SeekRequest::SharedDtor() {
+inline void SetVideoTrackRequest::SharedDtor() {
   GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
 }
 
-void SeekRequest::SetCachedSize(int size) const {
+void SetVideoTrackRequest::SetCachedSize(int size) const {
   _impl_._cached_size_.Set(size);
 }
 
-void SeekRequest::Clear() {
-// @@protoc_insertion_point(message_clear_start:player.SeekRequest)
+void SetVideoTrackRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.SetVideoTrackRequest)
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  ::memset(&_impl_.pos_, 0, static_cast<size_t>(
-      reinterpret_cast<char*>(&_impl_.isquick_) -
-      reinterpret_cast<char*>(&_impl_.pos_)) + sizeof(_impl_.isquick_));
+  _impl_.videotrackid_ = int64_t{0};
   _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
 }
 
-const char* SeekRequest::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+const char* SetVideoTrackRequest::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     uint32_t tag;
     ptr = ::_pbi::ReadTag(ptr, &tag);
     switch (tag >> 3) {
-      // int64 pos = 1;
+      // int64 videoTrackID = 1;
       case 1:
         if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
-          _impl_.pos_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
-          CHK_(ptr);
-        } else
-          goto handle_unusual;
-        continue;
-      // bool isRelative = 2;
-      case 2:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 16)) {
-          _impl_.isrelative_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
-          CHK_(ptr);
-        } else
-          goto handle_unusual;
-        continue;
-      // bool isQuick = 3;
-      case 3:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 24)) {
-          _impl_.isquick_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          _impl_.videotrackid_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
           CHK_(ptr);
         } else
           goto handle_unusual;
@@ -4612,186 +5711,113 @@ failure:
 #undef CHK_
 }
 
-uint8_t* SeekRequest::_InternalSerialize(
+uint8_t* SetVideoTrackRequest::_InternalSerialize(
     uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
-  // @@protoc_insertion_point(serialize_to_array_start:player.SeekRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:player.SetVideoTrackRequest)
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // int64 pos = 1;
-  if (this->_internal_pos() != 0) {
-    target = stream->EnsureSpace(target);
-    target = ::_pbi::WireFormatLite::WriteInt64ToArray(1, this->_internal_pos(), target);
-  }
-
-  // bool isRelative = 2;
-  if (this->_internal_isrelative() != 0) {
-    target = stream->EnsureSpace(target);
-    target = ::_pbi::WireFormatLite::WriteBoolToArray(2, this->_internal_isrelative(), target);
-  }
-
-  // bool isQuick = 3;
-  if (this->_internal_isquick() != 0) {
+  // int64 videoTrackID = 1;
+  if (this->_internal_videotrackid() != 0) {
     target = stream->EnsureSpace(target);
-    target = ::_pbi::WireFormatLite::WriteBoolToArray(3, this->_internal_isquick(), target);
+    target = ::_pbi::WireFormatLite::WriteInt64ToArray(1, this->_internal_videotrackid(), target);
   }
 
   if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
     target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:player.SeekRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:player.SetVideoTrackRequest)
   return target;
 }
 
-size_t SeekRequest::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:player.SeekRequest)
+size_t SetVideoTrackRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.SetVideoTrackRequest)
   size_t total_size = 0;
 
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // int64 pos = 1;
-  if (this->_internal_pos() != 0) {
-    total_size += ::_pbi::WireFormatLite::Int64SizePlusOne(this->_internal_pos());
-  }
-
-  // bool isRelative = 2;
-  if (this->_internal_isrelative() != 0) {
-    total_size += 1 + 1;
-  }
-
-  // bool isQuick = 3;
-  if (this->_internal_isquick() != 0) {
-    total_size += 1 + 1;
+  // int64 videoTrackID = 1;
+  if (this->_internal_videotrackid() != 0) {
+    total_size += ::_pbi::WireFormatLite::Int64SizePlusOne(this->_internal_videotrackid());
   }
 
   return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
 }
 
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SeekRequest::_class_data_ = {
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetVideoTrackRequest::_class_data_ = {
     ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
-    SeekRequest::MergeImpl
+    SetVideoTrackRequest::MergeImpl
 };
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SeekRequest::GetClassData() const { return &_class_data_; }
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetVideoTrackRequest::GetClassData() const { return &_class_data_; }
 
 
-void SeekRequest::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
-  auto* const _this = static_cast<SeekRequest*>(&to_msg);
-  auto& from = static_cast<const SeekRequest&>(from_msg);
-  // @@protoc_insertion_point(class_specific_merge_from_start:player.SeekRequest)
+void SetVideoTrackRequest::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<SetVideoTrackRequest*>(&to_msg);
+  auto& from = static_cast<const SetVideoTrackRequest&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.SetVideoTrackRequest)
   GOOGLE_DCHECK_NE(&from, _this);
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from._internal_pos() != 0) {
-    _this->_internal_set_pos(from._internal_pos());
-  }
-  if (from._internal_isrelative() != 0) {
-    _this->_internal_set_isrelative(from._internal_isrelative());
-  }
-  if (from._internal_isquick() != 0) {
-    _this->_internal_set_isquick(from._internal_isquick());
+  if (from._internal_videotrackid() != 0) {
+    _this->_internal_set_videotrackid(from._internal_videotrackid());
   }
   _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
 }
 
-void SeekRequest::CopyFrom(const SeekRequest& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:player.SeekRequest)
+void SetVideoTrackRequest::CopyFrom(const SetVideoTrackRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.SetVideoTrackRequest)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool SeekRequest::IsInitialized() const {
+bool SetVideoTrackRequest::IsInitialized() const {
   return true;
 }
 
-void SeekRequest::InternalSwap(SeekRequest* other) {
+void SetVideoTrackRequest::InternalSwap(SetVideoTrackRequest* other) {
   using std::swap;
   _internal_metadata_.InternalSwap(&other->_internal_metadata_);
-  ::PROTOBUF_NAMESPACE_ID::internal::memswap<
-      PROTOBUF_FIELD_OFFSET(SeekRequest, _impl_.isquick_)
-      + sizeof(SeekRequest::_impl_.isquick_)
-      - PROTOBUF_FIELD_OFFSET(SeekRequest, _impl_.pos_)>(
-          reinterpret_cast<char*>(&_impl_.pos_),
-          reinterpret_cast<char*>(&other->_impl_.pos_));
-}
-
-::PROTOBUF_NAMESPACE_ID::Metadata SeekRequest::GetMetadata() const {
-  return ::_pbi::AssignDescriptors(
-      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[31]);
-}
-
-// ===================================================================
-
-class SeekReply::_Internal {
- public:
-};
-
-SeekReply::SeekReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
-                         bool is_message_owned)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
-  // @@protoc_insertion_point(arena_constructor:player.SeekReply)
-}
-SeekReply::SeekReply(const SeekReply& from)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
-  SeekReply* const _this = this; (void)_this;
-  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:player.SeekReply)
+  swap(_impl_.videotrackid_, other->_impl_.videotrackid_);
 }
 
-
-
-
-
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SeekReply::_class_data_ = {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
-};
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SeekReply::GetClassData() const { return &_class_data_; }
-
-
-
-
-
-
-
-::PROTOBUF_NAMESPACE_ID::Metadata SeekReply::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata SetVideoTrackRequest::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[32]);
+      file_level_metadata_player_2eproto[34]);
 }
 
 // ===================================================================
 
-class GetVideoTracksRequest::_Internal {
+class SetVideoTrackReply::_Internal {
  public:
 };
 
-GetVideoTracksRequest::GetVideoTracksRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+SetVideoTrackReply::SetVideoTrackReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                          bool is_message_owned)
   : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
-  // @@protoc_insertion_point(arena_constructor:player.GetVideoTracksRequest)
+  // @@protoc_insertion_point(arena_constructor:player.SetVideoTrackReply)
 }
-GetVideoTracksRequest::GetVideoTracksRequest(const GetVideoTracksRequest& from)
+SetVideoTrackReply::SetVideoTrackReply(const SetVideoTrackReply& from)
   : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
-  GetVideoTracksRequest* const _this = this; (void)_this;
+  SetVideoTrackReply* const _this = this; (void)_this;
   _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:player.GetVideoTracksRequest)
+  // @@protoc_insertion_point(copy_constructor:player.SetVideoTrackReply)
 }
 
 
 
 
 
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetVideoTracksRequest::_class_data_ = {
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetVideoTrackReply::_class_data_ = {
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
 };
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetVideoTracksRequest::GetClassData() const { return &_class_data_; }
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetVideoTrackReply::GetClassData() const { return &_class_data_; }
 
 
 
@@ -4799,47 +5825,48 @@ const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetVideoTracksRequest::GetClas
 
 
 
-::PROTOBUF_NAMESPACE_ID::Metadata GetVideoTracksRequest::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata SetVideoTrackReply::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[33]);
+      file_level_metadata_player_2eproto[35]);
 }
 
 // ===================================================================
 
-class GetVideoTracksReply::_Internal {
+class SetAudioTrackRequest::_Internal {
  public:
 };
 
-GetVideoTracksReply::GetVideoTracksReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+SetAudioTrackRequest::SetAudioTrackRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                          bool is_message_owned)
   : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
   SharedCtor(arena, is_message_owned);
-  // @@protoc_insertion_point(arena_constructor:player.GetVideoTracksReply)
+  // @@protoc_insertion_point(arena_constructor:player.SetAudioTrackRequest)
 }
-GetVideoTracksReply::GetVideoTracksReply(const GetVideoTracksReply& from)
+SetAudioTrackRequest::SetAudioTrackRequest(const SetAudioTrackRequest& from)
   : ::PROTOBUF_NAMESPACE_ID::Message() {
-  GetVideoTracksReply* const _this = this; (void)_this;
+  SetAudioTrackRequest* const _this = this; (void)_this;
   new (&_impl_) Impl_{
-      decltype(_impl_.videotrack_){from._impl_.videotrack_}
+      decltype(_impl_.audiotrackid_){}
     , /*decltype(_impl_._cached_size_)*/{}};
 
   _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:player.GetVideoTracksReply)
+  _this->_impl_.audiotrackid_ = from._impl_.audiotrackid_;
+  // @@protoc_insertion_point(copy_constructor:player.SetAudioTrackRequest)
 }
 
-inline void GetVideoTracksReply::SharedCtor(
+inline void SetAudioTrackRequest::SharedCtor(
     ::_pb::Arena* arena, bool is_message_owned) {
   (void)arena;
   (void)is_message_owned;
   new (&_impl_) Impl_{
-      decltype(_impl_.videotrack_){arena}
+      decltype(_impl_.audiotrackid_){int64_t{0}}
     , /*decltype(_impl_._cached_size_)*/{}
   };
 }
 
-GetVideoTracksReply::~GetVideoTracksReply() {
-  // @@protoc_insertion_point(destructor:player.GetVideoTracksReply)
+SetAudioTrackRequest::~SetAudioTrackRequest() {
+  // @@protoc_insertion_point(destructor:player.SetAudioTrackRequest)
   if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
   (void)arena;
     return;
@@ -4847,41 +5874,35 @@ GetVideoTracksReply::~GetVideoTracksReply() {
   SharedDtor();
 }
 
-inline void GetVideoTracksReply::SharedDtor() {
+inline void SetAudioTrackRequest::SharedDtor() {
   GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
-  _impl_.videotrack_.~RepeatedPtrField();
 }
 
-void GetVideoTracksReply::SetCachedSize(int size) const {
+void SetAudioTrackRequest::SetCachedSize(int size) const {
   _impl_._cached_size_.Set(size);
 }
 
-void GetVideoTracksReply::Clear() {
-// @@protoc_insertion_point(message_clear_start:player.GetVideoTracksReply)
+void SetAudioTrackRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.SetAudioTrackRequest)
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  _impl_.videotrack_.Clear();
+  _impl_.audiotrackid_ = int64_t{0};
   _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
 }
 
-const char* GetVideoTracksReply::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+const char* SetAudioTrackRequest::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     uint32_t tag;
     ptr = ::_pbi::ReadTag(ptr, &tag);
     switch (tag >> 3) {
-      // repeated .player.VideoTrack videoTrack = 1;
+      // int64 audioTrackID = 1;
       case 1:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 10)) {
-          ptr -= 1;
-          do {
-            ptr += 1;
-            ptr = ctx->ParseMessage(_internal_add_videotrack(), ptr);
-            CHK_(ptr);
-            if (!ctx->DataAvailable(ptr)) break;
-          } while (::PROTOBUF_NAMESPACE_ID::internal::ExpectTag<10>(ptr));
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
+          _impl_.audiotrackid_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
         } else
           goto handle_unusual;
         continue;
@@ -4908,174 +5929,5341 @@ failure:
 #undef CHK_
 }
 
-uint8_t* GetVideoTracksReply::_InternalSerialize(
+uint8_t* SetAudioTrackRequest::_InternalSerialize(
     uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
-  // @@protoc_insertion_point(serialize_to_array_start:player.GetVideoTracksReply)
+  // @@protoc_insertion_point(serialize_to_array_start:player.SetAudioTrackRequest)
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // repeated .player.VideoTrack videoTrack = 1;
-  for (unsigned i = 0,
-      n = static_cast<unsigned>(this->_internal_videotrack_size()); i < n; i++) {
-    const auto& repfield = this->_internal_videotrack(i);
-    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
-        InternalWriteMessage(1, repfield, repfield.GetCachedSize(), target, stream);
+  // int64 audioTrackID = 1;
+  if (this->_internal_audiotrackid() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteInt64ToArray(1, this->_internal_audiotrackid(), target);
   }
 
   if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
     target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:player.GetVideoTracksReply)
+  // @@protoc_insertion_point(serialize_to_array_end:player.SetAudioTrackRequest)
   return target;
 }
 
-size_t GetVideoTracksReply::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:player.GetVideoTracksReply)
+size_t SetAudioTrackRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.SetAudioTrackRequest)
   size_t total_size = 0;
 
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // repeated .player.VideoTrack videoTrack = 1;
-  total_size += 1UL * this->_internal_videotrack_size();
-  for (const auto& msg : this->_impl_.videotrack_) {
-    total_size +=
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(msg);
+  // int64 audioTrackID = 1;
+  if (this->_internal_audiotrackid() != 0) {
+    total_size += ::_pbi::WireFormatLite::Int64SizePlusOne(this->_internal_audiotrackid());
   }
 
   return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
 }
 
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetVideoTracksReply::_class_data_ = {
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetAudioTrackRequest::_class_data_ = {
     ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
-    GetVideoTracksReply::MergeImpl
+    SetAudioTrackRequest::MergeImpl
 };
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetVideoTracksReply::GetClassData() const { return &_class_data_; }
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetAudioTrackRequest::GetClassData() const { return &_class_data_; }
 
 
-void GetVideoTracksReply::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
-  auto* const _this = static_cast<GetVideoTracksReply*>(&to_msg);
-  auto& from = static_cast<const GetVideoTracksReply&>(from_msg);
-  // @@protoc_insertion_point(class_specific_merge_from_start:player.GetVideoTracksReply)
+void SetAudioTrackRequest::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<SetAudioTrackRequest*>(&to_msg);
+  auto& from = static_cast<const SetAudioTrackRequest&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.SetAudioTrackRequest)
   GOOGLE_DCHECK_NE(&from, _this);
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  _this->_impl_.videotrack_.MergeFrom(from._impl_.videotrack_);
+  if (from._internal_audiotrackid() != 0) {
+    _this->_internal_set_audiotrackid(from._internal_audiotrackid());
+  }
   _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
 }
 
-void GetVideoTracksReply::CopyFrom(const GetVideoTracksReply& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:player.GetVideoTracksReply)
+void SetAudioTrackRequest::CopyFrom(const SetAudioTrackRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.SetAudioTrackRequest)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool GetVideoTracksReply::IsInitialized() const {
+bool SetAudioTrackRequest::IsInitialized() const {
   return true;
 }
 
-void GetVideoTracksReply::InternalSwap(GetVideoTracksReply* other) {
+void SetAudioTrackRequest::InternalSwap(SetAudioTrackRequest* other) {
   using std::swap;
   _internal_metadata_.InternalSwap(&other->_internal_metadata_);
-  _impl_.videotrack_.InternalSwap(&other->_impl_.videotrack_);
+  swap(_impl_.audiotrackid_, other->_impl_.audiotrackid_);
 }
 
-::PROTOBUF_NAMESPACE_ID::Metadata GetVideoTracksReply::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata SetAudioTrackRequest::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[34]);
+      file_level_metadata_player_2eproto[36]);
 }
 
 // ===================================================================
 
-class AudioTrack::_Internal {
+class SetAudioTrackReply::_Internal {
  public:
 };
 
-AudioTrack::AudioTrack(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+SetAudioTrackReply::SetAudioTrackReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                          bool is_message_owned)
-  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
-  SharedCtor(arena, is_message_owned);
-  // @@protoc_insertion_point(arena_constructor:player.AudioTrack)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.SetAudioTrackReply)
 }
-AudioTrack::AudioTrack(const AudioTrack& from)
-  : ::PROTOBUF_NAMESPACE_ID::Message() {
-  AudioTrack* const _this = this; (void)_this;
-  new (&_impl_) Impl_{
-      decltype(_impl_.id_){}
-    , decltype(_impl_.isactive_){}
-    , /*decltype(_impl_._cached_size_)*/{}};
-
+SetAudioTrackReply::SetAudioTrackReply(const SetAudioTrackReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  SetAudioTrackReply* const _this = this; (void)_this;
   _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  ::memcpy(&_impl_.id_, &from._impl_.id_,
-    static_cast<size_t>(reinterpret_cast<char*>(&_impl_.isactive_) -
-    reinterpret_cast<char*>(&_impl_.id_)) + sizeof(_impl_.isactive_));
-  // @@protoc_insertion_point(copy_constructor:player.AudioTrack)
+  // @@protoc_insertion_point(copy_constructor:player.SetAudioTrackReply)
 }
 
-inline void AudioTrack::SharedCtor(
-    ::_pb::Arena* arena, bool is_message_owned) {
-  (void)arena;
-  (void)is_message_owned;
-  new (&_impl_) Impl_{
-      decltype(_impl_.id_){int64_t{0}}
-    , decltype(_impl_.isactive_){false}
-    , /*decltype(_impl_._cached_size_)*/{}
-  };
-}
 
-AudioTrack::~AudioTrack() {
-  // @@protoc_insertion_point(destructor:player.AudioTrack)
-  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
-  (void)arena;
-    return;
-  }
-  SharedDtor();
-}
 
-inline void AudioTrack::SharedDtor() {
-  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
-}
 
-void AudioTrack::SetCachedSize(int size) const {
-  _impl_._cached_size_.Set(size);
-}
 
-void AudioTrack::Clear() {
-// @@protoc_insertion_point(message_clear_start:player.AudioTrack)
-  uint32_t cached_has_bits = 0;
-  // Prevent compiler warnings about cached_has_bits being unused
-  (void) cached_has_bits;
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetAudioTrackReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetAudioTrackReply::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata SetAudioTrackReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[37]);
+}
+
+// ===================================================================
+
+class SetSubtitlesTrackRequest::_Internal {
+ public:
+};
+
+SetSubtitlesTrackRequest::SetSubtitlesTrackRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.SetSubtitlesTrackRequest)
+}
+SetSubtitlesTrackRequest::SetSubtitlesTrackRequest(const SetSubtitlesTrackRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  SetSubtitlesTrackRequest* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.subtitlestrackid_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  _this->_impl_.subtitlestrackid_ = from._impl_.subtitlestrackid_;
+  // @@protoc_insertion_point(copy_constructor:player.SetSubtitlesTrackRequest)
+}
+
+inline void SetSubtitlesTrackRequest::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.subtitlestrackid_){int64_t{0}}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+SetSubtitlesTrackRequest::~SetSubtitlesTrackRequest() {
+  // @@protoc_insertion_point(destructor:player.SetSubtitlesTrackRequest)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void SetSubtitlesTrackRequest::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+}
+
+void SetSubtitlesTrackRequest::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void SetSubtitlesTrackRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.SetSubtitlesTrackRequest)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.subtitlestrackid_ = int64_t{0};
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* SetSubtitlesTrackRequest::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // int64 subtitlesTrackID = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
+          _impl_.subtitlestrackid_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* SetSubtitlesTrackRequest::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.SetSubtitlesTrackRequest)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // int64 subtitlesTrackID = 1;
+  if (this->_internal_subtitlestrackid() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteInt64ToArray(1, this->_internal_subtitlestrackid(), target);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.SetSubtitlesTrackRequest)
+  return target;
+}
+
+size_t SetSubtitlesTrackRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.SetSubtitlesTrackRequest)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // int64 subtitlesTrackID = 1;
+  if (this->_internal_subtitlestrackid() != 0) {
+    total_size += ::_pbi::WireFormatLite::Int64SizePlusOne(this->_internal_subtitlestrackid());
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetSubtitlesTrackRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    SetSubtitlesTrackRequest::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetSubtitlesTrackRequest::GetClassData() const { return &_class_data_; }
+
+
+void SetSubtitlesTrackRequest::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<SetSubtitlesTrackRequest*>(&to_msg);
+  auto& from = static_cast<const SetSubtitlesTrackRequest&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.SetSubtitlesTrackRequest)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from._internal_subtitlestrackid() != 0) {
+    _this->_internal_set_subtitlestrackid(from._internal_subtitlestrackid());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void SetSubtitlesTrackRequest::CopyFrom(const SetSubtitlesTrackRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.SetSubtitlesTrackRequest)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool SetSubtitlesTrackRequest::IsInitialized() const {
+  return true;
+}
+
+void SetSubtitlesTrackRequest::InternalSwap(SetSubtitlesTrackRequest* other) {
+  using std::swap;
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  swap(_impl_.subtitlestrackid_, other->_impl_.subtitlestrackid_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata SetSubtitlesTrackRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[38]);
+}
+
+// ===================================================================
+
+class SetSubtitlesTrackReply::_Internal {
+ public:
+};
+
+SetSubtitlesTrackReply::SetSubtitlesTrackReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.SetSubtitlesTrackReply)
+}
+SetSubtitlesTrackReply::SetSubtitlesTrackReply(const SetSubtitlesTrackReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  SetSubtitlesTrackReply* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.SetSubtitlesTrackReply)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetSubtitlesTrackReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetSubtitlesTrackReply::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata SetSubtitlesTrackReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[39]);
+}
+
+// ===================================================================
+
+class TrackInfo::_Internal {
+ public:
+};
+
+TrackInfo::TrackInfo(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.TrackInfo)
+}
+TrackInfo::TrackInfo(const TrackInfo& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  TrackInfo* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.codec_){}
+    , decltype(_impl_.language_){}
+    , decltype(_impl_.title_){}
+    , decltype(_impl_.isdefault_){}
+    , decltype(_impl_.isforced_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  _impl_.codec_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.codec_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (!from._internal_codec().empty()) {
+    _this->_impl_.codec_.Set(from._internal_codec(), 
+      _this->GetArenaForAllocation());
+  }
+  _impl_.language_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.language_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (!from._internal_language().empty()) {
+    _this->_impl_.language_.Set(from._internal_language(), 
+      _this->GetArenaForAllocation());
+  }
+  _impl_.title_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.title_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (!from._internal_title().empty()) {
+    _this->_impl_.title_.Set(from._internal_title(), 
+      _this->GetArenaForAllocation());
+  }
+  ::memcpy(&_impl_.isdefault_, &from._impl_.isdefault_,
+    static_cast<size_t>(reinterpret_cast<char*>(&_impl_.isforced_) -
+    reinterpret_cast<char*>(&_impl_.isdefault_)) + sizeof(_impl_.isforced_));
+  // @@protoc_insertion_point(copy_constructor:player.TrackInfo)
+}
+
+inline void TrackInfo::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.codec_){}
+    , decltype(_impl_.language_){}
+    , decltype(_impl_.title_){}
+    , decltype(_impl_.isdefault_){false}
+    , decltype(_impl_.isforced_){false}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+  _impl_.codec_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.codec_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  _impl_.language_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.language_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  _impl_.title_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.title_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+}
+
+TrackInfo::~TrackInfo() {
+  // @@protoc_insertion_point(destructor:player.TrackInfo)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void TrackInfo::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+  _impl_.codec_.Destroy();
+  _impl_.language_.Destroy();
+  _impl_.title_.Destroy();
+}
+
+void TrackInfo::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void TrackInfo::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.TrackInfo)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.codec_.ClearToEmpty();
+  _impl_.language_.ClearToEmpty();
+  _impl_.title_.ClearToEmpty();
+  ::memset(&_impl_.isdefault_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&_impl_.isforced_) -
+      reinterpret_cast<char*>(&_impl_.isdefault_)) + sizeof(_impl_.isforced_));
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* TrackInfo::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // string codec = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 10)) {
+          auto str = _internal_mutable_codec();
+          ptr = ::_pbi::InlineGreedyStringParser(str, ptr, ctx);
+          CHK_(ptr);
+          CHK_(::_pbi::VerifyUTF8(str, "player.TrackInfo.codec"));
+        } else
+          goto handle_unusual;
+        continue;
+      // string language = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 18)) {
+          auto str = _internal_mutable_language();
+          ptr = ::_pbi::InlineGreedyStringParser(str, ptr, ctx);
+          CHK_(ptr);
+          CHK_(::_pbi::VerifyUTF8(str, "player.TrackInfo.language"));
+        } else
+          goto handle_unusual;
+        continue;
+      // string title = 3;
+      case 3:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 26)) {
+          auto str = _internal_mutable_title();
+          ptr = ::_pbi::InlineGreedyStringParser(str, ptr, ctx);
+          CHK_(ptr);
+          CHK_(::_pbi::VerifyUTF8(str, "player.TrackInfo.title"));
+        } else
+          goto handle_unusual;
+        continue;
+      // bool isDefault = 4;
+      case 4:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 32)) {
+          _impl_.isdefault_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // bool isForced = 5;
+      case 5:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 40)) {
+          _impl_.isforced_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* TrackInfo::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.TrackInfo)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // string codec = 1;
+  if (!this->_internal_codec().empty()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->_internal_codec().data(), static_cast<int>(this->_internal_codec().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "player.TrackInfo.codec");
+    target = stream->WriteStringMaybeAliased(
+        1, this->_internal_codec(), target);
+  }
+
+  // string language = 2;
+  if (!this->_internal_language().empty()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->_internal_language().data(), static_cast<int>(this->_internal_language().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "player.TrackInfo.language");
+    target = stream->WriteStringMaybeAliased(
+        2, this->_internal_language(), target);
+  }
+
+  // string title = 3;
+  if (!this->_internal_title().empty()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->_internal_title().data(), static_cast<int>(this->_internal_title().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "player.TrackInfo.title");
+    target = stream->WriteStringMaybeAliased(
+        3, this->_internal_title(), target);
+  }
+
+  // bool isDefault = 4;
+  if (this->_internal_isdefault() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteBoolToArray(4, this->_internal_isdefault(), target);
+  }
+
+  // bool isForced = 5;
+  if (this->_internal_isforced() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteBoolToArray(5, this->_internal_isforced(), target);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.TrackInfo)
+  return target;
+}
+
+size_t TrackInfo::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.TrackInfo)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // string codec = 1;
+  if (!this->_internal_codec().empty()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::StringSize(
+        this->_internal_codec());
+  }
+
+  // string language = 2;
+  if (!this->_internal_language().empty()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::StringSize(
+        this->_internal_language());
+  }
+
+  // string title = 3;
+  if (!this->_internal_title().empty()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::StringSize(
+        this->_internal_title());
+  }
+
+  // bool isDefault = 4;
+  if (this->_internal_isdefault() != 0) {
+    total_size += 1 + 1;
+  }
+
+  // bool isForced = 5;
+  if (this->_internal_isforced() != 0) {
+    total_size += 1 + 1;
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData TrackInfo::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    TrackInfo::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*TrackInfo::GetClassData() const { return &_class_data_; }
+
+
+void TrackInfo::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<TrackInfo*>(&to_msg);
+  auto& from = static_cast<const TrackInfo&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.TrackInfo)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (!from._internal_codec().empty()) {
+    _this->_internal_set_codec(from._internal_codec());
+  }
+  if (!from._internal_language().empty()) {
+    _this->_internal_set_language(from._internal_language());
+  }
+  if (!from._internal_title().empty()) {
+    _this->_internal_set_title(from._internal_title());
+  }
+  if (from._internal_isdefault() != 0) {
+    _this->_internal_set_isdefault(from._internal_isdefault());
+  }
+  if (from._internal_isforced() != 0) {
+    _this->_internal_set_isforced(from._internal_isforced());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void TrackInfo::CopyFrom(const TrackInfo& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.TrackInfo)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool TrackInfo::IsInitialized() const {
+  return true;
+}
+
+void TrackInfo::InternalSwap(TrackInfo* other) {
+  using std::swap;
+  auto* lhs_arena = GetArenaForAllocation();
+  auto* rhs_arena = other->GetArenaForAllocation();
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr::InternalSwap(
+      &_impl_.codec_, lhs_arena,
+      &other->_impl_.codec_, rhs_arena
+  );
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr::InternalSwap(
+      &_impl_.language_, lhs_arena,
+      &other->_impl_.language_, rhs_arena
+  );
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr::InternalSwap(
+      &_impl_.title_, lhs_arena,
+      &other->_impl_.title_, rhs_arena
+  );
+  ::PROTOBUF_NAMESPACE_ID::internal::memswap<
+      PROTOBUF_FIELD_OFFSET(TrackInfo, _impl_.isforced_)
+      + sizeof(TrackInfo::_impl_.isforced_)
+      - PROTOBUF_FIELD_OFFSET(TrackInfo, _impl_.isdefault_)>(
+          reinterpret_cast<char*>(&_impl_.isdefault_),
+          reinterpret_cast<char*>(&other->_impl_.isdefault_));
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata TrackInfo::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[40]);
+}
+
+// ===================================================================
+
+class VideoTrack::_Internal {
+ public:
+  static const ::player::TrackInfo& info(const VideoTrack* msg);
+};
+
+const ::player::TrackInfo&
+VideoTrack::_Internal::info(const VideoTrack* msg) {
+  return *msg->_impl_.info_;
+}
+VideoTrack::VideoTrack(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.VideoTrack)
+}
+VideoTrack::VideoTrack(const VideoTrack& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  VideoTrack* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.info_){nullptr}
+    , decltype(_impl_.id_){}
+    , decltype(_impl_.isactive_){}
+    , decltype(_impl_.width_){}
+    , decltype(_impl_.fps_){}
+    , decltype(_impl_.height_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  if (from._internal_has_info()) {
+    _this->_impl_.info_ = new ::player::TrackInfo(*from._impl_.info_);
+  }
+  ::memcpy(&_impl_.id_, &from._impl_.id_,
+    static_cast<size_t>(reinterpret_cast<char*>(&_impl_.height_) -
+    reinterpret_cast<char*>(&_impl_.id_)) + sizeof(_impl_.height_));
+  // @@protoc_insertion_point(copy_constructor:player.VideoTrack)
+}
+
+inline void VideoTrack::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.info_){nullptr}
+    , decltype(_impl_.id_){int64_t{0}}
+    , decltype(_impl_.isactive_){false}
+    , decltype(_impl_.width_){0}
+    , decltype(_impl_.fps_){0}
+    , decltype(_impl_.height_){0}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+VideoTrack::~VideoTrack() {
+  // @@protoc_insertion_point(destructor:player.VideoTrack)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void VideoTrack::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+  if (this != internal_default_instance()) delete _impl_.info_;
+}
+
+void VideoTrack::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void VideoTrack::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.VideoTrack)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  if (GetArenaForAllocation() == nullptr && _impl_.info_ != nullptr) {
+    delete _impl_.info_;
+  }
+  _impl_.info_ = nullptr;
+  ::memset(&_impl_.id_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&_impl_.height_) -
+      reinterpret_cast<char*>(&_impl_.id_)) + sizeof(_impl_.height_));
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* VideoTrack::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // int64 id = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
+          _impl_.id_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // bool isActive = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 16)) {
+          _impl_.isactive_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // .player.TrackInfo info = 3;
+      case 3:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 26)) {
+          ptr = ctx->ParseMessage(_internal_mutable_info(), ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // int32 width = 4;
+      case 4:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 32)) {
+          _impl_.width_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint32(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // int32 height = 5;
+      case 5:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 40)) {
+          _impl_.height_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint32(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // double fps = 6;
+      case 6:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 49)) {
+          _impl_.fps_ = ::PROTOBUF_NAMESPACE_ID::internal::UnalignedLoad<double>(ptr);
+          ptr += sizeof(double);
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* VideoTrack::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.VideoTrack)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // int64 id = 1;
+  if (this->_internal_id() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteInt64ToArray(1, this->_internal_id(), target);
+  }
+
+  // bool isActive = 2;
+  if (this->_internal_isactive() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteBoolToArray(2, this->_internal_isactive(), target);
+  }
+
+  // .player.TrackInfo info = 3;
+  if (this->_internal_has_info()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessage(3, _Internal::info(this),
+        _Internal::info(this).GetCachedSize(), target, stream);
+  }
+
+  // int32 width = 4;
+  if (this->_internal_width() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteInt32ToArray(4, this->_internal_width(), target);
+  }
+
+  // int32 height = 5;
+  if (this->_internal_height() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteInt32ToArray(5, this->_internal_height(), target);
+  }
+
+  // double fps = 6;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_fps = this->_internal_fps();
+  uint64_t raw_fps;
+  memcpy(&raw_fps, &tmp_fps, sizeof(tmp_fps));
+  if (raw_fps != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteDoubleToArray(6, this->_internal_fps(), target);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.VideoTrack)
+  return target;
+}
+
+size_t VideoTrack::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.VideoTrack)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // .player.TrackInfo info = 3;
+  if (this->_internal_has_info()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *_impl_.info_);
+  }
+
+  // int64 id = 1;
+  if (this->_internal_id() != 0) {
+    total_size += ::_pbi::WireFormatLite::Int64SizePlusOne(this->_internal_id());
+  }
+
+  // bool isActive = 2;
+  if (this->_internal_isactive() != 0) {
+    total_size += 1 + 1;
+  }
+
+  // int32 width = 4;
+  if (this->_internal_width() != 0) {
+    total_size += ::_pbi::WireFormatLite::Int32SizePlusOne(this->_internal_width());
+  }
+
+  // double fps = 6;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_fps = this->_internal_fps();
+  uint64_t raw_fps;
+  memcpy(&raw_fps, &tmp_fps, sizeof(tmp_fps));
+  if (raw_fps != 0) {
+    total_size += 1 + 8;
+  }
+
+  // int32 height = 5;
+  if (this->_internal_height() != 0) {
+    total_size += ::_pbi::WireFormatLite::Int32SizePlusOne(this->_internal_height());
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData VideoTrack::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    VideoTrack::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*VideoTrack::GetClassData() const { return &_class_data_; }
+
+
+void VideoTrack::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<VideoTrack*>(&to_msg);
+  auto& from = static_cast<const VideoTrack&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.VideoTrack)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from._internal_has_info()) {
+    _this->_internal_mutable_info()->::player::TrackInfo::MergeFrom(
+        from._internal_info());
+  }
+  if (from._internal_id() != 0) {
+    _this->_internal_set_id(from._internal_id());
+  }
+  if (from._internal_isactive() != 0) {
+    _this->_internal_set_isactive(from._internal_isactive());
+  }
+  if (from._internal_width() != 0) {
+    _this->_internal_set_width(from._internal_width());
+  }
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_fps = from._internal_fps();
+  uint64_t raw_fps;
+  memcpy(&raw_fps, &tmp_fps, sizeof(tmp_fps));
+  if (raw_fps != 0) {
+    _this->_internal_set_fps(from._internal_fps());
+  }
+  if (from._internal_height() != 0) {
+    _this->_internal_set_height(from._internal_height());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void VideoTrack::CopyFrom(const VideoTrack& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.VideoTrack)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool VideoTrack::IsInitialized() const {
+  return true;
+}
+
+void VideoTrack::InternalSwap(VideoTrack* other) {
+  using std::swap;
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  ::PROTOBUF_NAMESPACE_ID::internal::memswap<
+      PROTOBUF_FIELD_OFFSET(VideoTrack, _impl_.height_)
+      + sizeof(VideoTrack::_impl_.height_)
+      - PROTOBUF_FIELD_OFFSET(VideoTrack, _impl_.info_)>(
+          reinterpret_cast<char*>(&_impl_.info_),
+          reinterpret_cast<char*>(&other->_impl_.info_));
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata VideoTrack::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[41]);
+}
+
+// ===================================================================
+
+class SeekRequest::_Internal {
+ public:
+};
+
+SeekRequest::SeekRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.SeekRequest)
+}
+SeekRequest::SeekRequest(const SeekRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  SeekRequest* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.posnanoseconds_){}
+    , decltype(_impl_.isrelative_){}
+    , decltype(_impl_.isquick_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  ::memcpy(&_impl_.posnanoseconds_, &from._impl_.posnanoseconds_,
+    static_cast<size_t>(reinterpret_cast<char*>(&_impl_.isquick_) -
+    reinterpret_cast<char*>(&_impl_.posnanoseconds_)) + sizeof(_impl_.isquick_));
+  // @@protoc_insertion_point(copy_constructor:player.SeekRequest)
+}
+
+inline void SeekRequest::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.posnanoseconds_){int64_t{0}}
+    , decltype(_impl_.isrelative_){false}
+    , decltype(_impl_.isquick_){false}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+SeekRequest::~SeekRequest() {
+  // @@protoc_insertion_point(destructor:player.SeekRequest)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void SeekRequest::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+}
+
+void SeekRequest::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void SeekRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.SeekRequest)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  ::memset(&_impl_.posnanoseconds_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&_impl_.isquick_) -
+      reinterpret_cast<char*>(&_impl_.posnanoseconds_)) + sizeof(_impl_.isquick_));
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* SeekRequest::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // int64 posNanoseconds = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
+          _impl_.posnanoseconds_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // bool isRelative = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 16)) {
+          _impl_.isrelative_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // bool isQuick = 3;
+      case 3:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 24)) {
+          _impl_.isquick_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* SeekRequest::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.SeekRequest)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // int64 posNanoseconds = 1;
+  if (this->_internal_posnanoseconds() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteInt64ToArray(1, this->_internal_posnanoseconds(), target);
+  }
+
+  // bool isRelative = 2;
+  if (this->_internal_isrelative() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteBoolToArray(2, this->_internal_isrelative(), target);
+  }
+
+  // bool isQuick = 3;
+  if (this->_internal_isquick() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteBoolToArray(3, this->_internal_isquick(), target);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.SeekRequest)
+  return target;
+}
+
+size_t SeekRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.SeekRequest)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // int64 posNanoseconds = 1;
+  if (this->_internal_posnanoseconds() != 0) {
+    total_size += ::_pbi::WireFormatLite::Int64SizePlusOne(this->_internal_posnanoseconds());
+  }
+
+  // bool isRelative = 2;
+  if (this->_internal_isrelative() != 0) {
+    total_size += 1 + 1;
+  }
+
+  // bool isQuick = 3;
+  if (this->_internal_isquick() != 0) {
+    total_size += 1 + 1;
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SeekRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    SeekRequest::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SeekRequest::GetClassData() const { return &_class_data_; }
+
+
+void SeekRequest::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<SeekRequest*>(&to_msg);
+  auto& from = static_cast<const SeekRequest&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.SeekRequest)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from._internal_posnanoseconds() != 0) {
+    _this->_internal_set_posnanoseconds(from._internal_posnanoseconds());
+  }
+  if (from._internal_isrelative() != 0) {
+    _this->_internal_set_isrelative(from._internal_isrelative());
+  }
+  if (from._internal_isquick() != 0) {
+    _this->_internal_set_isquick(from._internal_isquick());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void SeekRequest::CopyFrom(const SeekRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.SeekRequest)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool SeekRequest::IsInitialized() const {
+  return true;
+}
+
+void SeekRequest::InternalSwap(SeekRequest* other) {
+  using std::swap;
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  ::PROTOBUF_NAMESPACE_ID::internal::memswap<
+      PROTOBUF_FIELD_OFFSET(SeekRequest, _impl_.isquick_)
+      + sizeof(SeekRequest::_impl_.isquick_)
+      - PROTOBUF_FIELD_OFFSET(SeekRequest, _impl_.posnanoseconds_)>(
+          reinterpret_cast<char*>(&_impl_.posnanoseconds_),
+          reinterpret_cast<char*>(&other->_impl_.posnanoseconds_));
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata SeekRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[42]);
+}
+
+// ===================================================================
+
+class SeekReply::_Internal {
+ public:
+};
+
+SeekReply::SeekReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.SeekReply)
+}
+SeekReply::SeekReply(const SeekReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  SeekReply* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.SeekReply)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SeekReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SeekReply::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata SeekReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[43]);
+}
+
+// ===================================================================
+
+class FrameStepRequest::_Internal {
+ public:
+};
+
+FrameStepRequest::FrameStepRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.FrameStepRequest)
+}
+FrameStepRequest::FrameStepRequest(const FrameStepRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  FrameStepRequest* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.forward_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  _this->_impl_.forward_ = from._impl_.forward_;
+  // @@protoc_insertion_point(copy_constructor:player.FrameStepRequest)
+}
+
+inline void FrameStepRequest::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.forward_){false}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+FrameStepRequest::~FrameStepRequest() {
+  // @@protoc_insertion_point(destructor:player.FrameStepRequest)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void FrameStepRequest::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+}
+
+void FrameStepRequest::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void FrameStepRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.FrameStepRequest)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.forward_ = false;
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* FrameStepRequest::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // bool forward = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
+          _impl_.forward_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* FrameStepRequest::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.FrameStepRequest)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // bool forward = 1;
+  if (this->_internal_forward() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteBoolToArray(1, this->_internal_forward(), target);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.FrameStepRequest)
+  return target;
+}
+
+size_t FrameStepRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.FrameStepRequest)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // bool forward = 1;
+  if (this->_internal_forward() != 0) {
+    total_size += 1 + 1;
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData FrameStepRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    FrameStepRequest::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*FrameStepRequest::GetClassData() const { return &_class_data_; }
+
+
+void FrameStepRequest::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<FrameStepRequest*>(&to_msg);
+  auto& from = static_cast<const FrameStepRequest&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.FrameStepRequest)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from._internal_forward() != 0) {
+    _this->_internal_set_forward(from._internal_forward());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void FrameStepRequest::CopyFrom(const FrameStepRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.FrameStepRequest)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool FrameStepRequest::IsInitialized() const {
+  return true;
+}
+
+void FrameStepRequest::InternalSwap(FrameStepRequest* other) {
+  using std::swap;
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  swap(_impl_.forward_, other->_impl_.forward_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata FrameStepRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[44]);
+}
+
+// ===================================================================
+
+class FrameStepReply::_Internal {
+ public:
+};
+
+FrameStepReply::FrameStepReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.FrameStepReply)
+}
+FrameStepReply::FrameStepReply(const FrameStepReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  FrameStepReply* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.FrameStepReply)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData FrameStepReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*FrameStepReply::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata FrameStepReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[45]);
+}
+
+// ===================================================================
+
+class ScreenshotRequest::_Internal {
+ public:
+};
+
+ScreenshotRequest::ScreenshotRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.ScreenshotRequest)
+}
+ScreenshotRequest::ScreenshotRequest(const ScreenshotRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  ScreenshotRequest* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.ScreenshotRequest)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData ScreenshotRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*ScreenshotRequest::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata ScreenshotRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[46]);
+}
+
+// ===================================================================
+
+class ScreenshotReply::_Internal {
+ public:
+};
+
+ScreenshotReply::ScreenshotReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.ScreenshotReply)
+}
+ScreenshotReply::ScreenshotReply(const ScreenshotReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  ScreenshotReply* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.png_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  _impl_.png_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.png_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (!from._internal_png().empty()) {
+    _this->_impl_.png_.Set(from._internal_png(), 
+      _this->GetArenaForAllocation());
+  }
+  // @@protoc_insertion_point(copy_constructor:player.ScreenshotReply)
+}
+
+inline void ScreenshotReply::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.png_){}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+  _impl_.png_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.png_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+}
+
+ScreenshotReply::~ScreenshotReply() {
+  // @@protoc_insertion_point(destructor:player.ScreenshotReply)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void ScreenshotReply::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+  _impl_.png_.Destroy();
+}
+
+void ScreenshotReply::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void ScreenshotReply::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.ScreenshotReply)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.png_.ClearToEmpty();
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* ScreenshotReply::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // bytes png = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 10)) {
+          auto str = _internal_mutable_png();
+          ptr = ::_pbi::InlineGreedyStringParser(str, ptr, ctx);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* ScreenshotReply::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.ScreenshotReply)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // bytes png = 1;
+  if (!this->_internal_png().empty()) {
+    target = stream->WriteBytesMaybeAliased(
+        1, this->_internal_png(), target);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.ScreenshotReply)
+  return target;
+}
+
+size_t ScreenshotReply::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.ScreenshotReply)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // bytes png = 1;
+  if (!this->_internal_png().empty()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::BytesSize(
+        this->_internal_png());
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData ScreenshotReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    ScreenshotReply::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*ScreenshotReply::GetClassData() const { return &_class_data_; }
+
+
+void ScreenshotReply::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<ScreenshotReply*>(&to_msg);
+  auto& from = static_cast<const ScreenshotReply&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.ScreenshotReply)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (!from._internal_png().empty()) {
+    _this->_internal_set_png(from._internal_png());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void ScreenshotReply::CopyFrom(const ScreenshotReply& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.ScreenshotReply)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ScreenshotReply::IsInitialized() const {
+  return true;
+}
+
+void ScreenshotReply::InternalSwap(ScreenshotReply* other) {
+  using std::swap;
+  auto* lhs_arena = GetArenaForAllocation();
+  auto* rhs_arena = other->GetArenaForAllocation();
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr::InternalSwap(
+      &_impl_.png_, lhs_arena,
+      &other->_impl_.png_, rhs_arena
+  );
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata ScreenshotReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[47]);
+}
+
+// ===================================================================
+
+class GetVideoTracksRequest::_Internal {
+ public:
+};
+
+GetVideoTracksRequest::GetVideoTracksRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.GetVideoTracksRequest)
+}
+GetVideoTracksRequest::GetVideoTracksRequest(const GetVideoTracksRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  GetVideoTracksRequest* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.GetVideoTracksRequest)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetVideoTracksRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetVideoTracksRequest::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetVideoTracksRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[48]);
+}
+
+// ===================================================================
+
+class GetVideoTracksReply::_Internal {
+ public:
+};
+
+GetVideoTracksReply::GetVideoTracksReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.GetVideoTracksReply)
+}
+GetVideoTracksReply::GetVideoTracksReply(const GetVideoTracksReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  GetVideoTracksReply* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.videotrack_){from._impl_.videotrack_}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.GetVideoTracksReply)
+}
+
+inline void GetVideoTracksReply::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.videotrack_){arena}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+GetVideoTracksReply::~GetVideoTracksReply() {
+  // @@protoc_insertion_point(destructor:player.GetVideoTracksReply)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void GetVideoTracksReply::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+  _impl_.videotrack_.~RepeatedPtrField();
+}
+
+void GetVideoTracksReply::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void GetVideoTracksReply::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.GetVideoTracksReply)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.videotrack_.Clear();
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* GetVideoTracksReply::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // repeated .player.VideoTrack videoTrack = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 10)) {
+          ptr -= 1;
+          do {
+            ptr += 1;
+            ptr = ctx->ParseMessage(_internal_add_videotrack(), ptr);
+            CHK_(ptr);
+            if (!ctx->DataAvailable(ptr)) break;
+          } while (::PROTOBUF_NAMESPACE_ID::internal::ExpectTag<10>(ptr));
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* GetVideoTracksReply::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.GetVideoTracksReply)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // repeated .player.VideoTrack videoTrack = 1;
+  for (unsigned i = 0,
+      n = static_cast<unsigned>(this->_internal_videotrack_size()); i < n; i++) {
+    const auto& repfield = this->_internal_videotrack(i);
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+        InternalWriteMessage(1, repfield, repfield.GetCachedSize(), target, stream);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.GetVideoTracksReply)
+  return target;
+}
+
+size_t GetVideoTracksReply::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.GetVideoTracksReply)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // repeated .player.VideoTrack videoTrack = 1;
+  total_size += 1UL * this->_internal_videotrack_size();
+  for (const auto& msg : this->_impl_.videotrack_) {
+    total_size +=
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(msg);
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetVideoTracksReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    GetVideoTracksReply::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetVideoTracksReply::GetClassData() const { return &_class_data_; }
+
+
+void GetVideoTracksReply::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<GetVideoTracksReply*>(&to_msg);
+  auto& from = static_cast<const GetVideoTracksReply&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.GetVideoTracksReply)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  _this->_impl_.videotrack_.MergeFrom(from._impl_.videotrack_);
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void GetVideoTracksReply::CopyFrom(const GetVideoTracksReply& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.GetVideoTracksReply)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GetVideoTracksReply::IsInitialized() const {
+  return true;
+}
+
+void GetVideoTracksReply::InternalSwap(GetVideoTracksReply* other) {
+  using std::swap;
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  _impl_.videotrack_.InternalSwap(&other->_impl_.videotrack_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetVideoTracksReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[49]);
+}
+
+// ===================================================================
+
+class AudioTrack::_Internal {
+ public:
+  static const ::player::TrackInfo& info(const AudioTrack* msg);
+};
+
+const ::player::TrackInfo&
+AudioTrack::_Internal::info(const AudioTrack* msg) {
+  return *msg->_impl_.info_;
+}
+AudioTrack::AudioTrack(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.AudioTrack)
+}
+AudioTrack::AudioTrack(const AudioTrack& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  AudioTrack* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.channellayout_){}
+    , decltype(_impl_.info_){nullptr}
+    , decltype(_impl_.id_){}
+    , decltype(_impl_.isactive_){}
+    , decltype(_impl_.samplerate_){}
+    , decltype(_impl_.channels_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  _impl_.channellayout_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.channellayout_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (!from._internal_channellayout().empty()) {
+    _this->_impl_.channellayout_.Set(from._internal_channellayout(), 
+      _this->GetArenaForAllocation());
+  }
+  if (from._internal_has_info()) {
+    _this->_impl_.info_ = new ::player::TrackInfo(*from._impl_.info_);
+  }
+  ::memcpy(&_impl_.id_, &from._impl_.id_,
+    static_cast<size_t>(reinterpret_cast<char*>(&_impl_.channels_) -
+    reinterpret_cast<char*>(&_impl_.id_)) + sizeof(_impl_.channels_));
+  // @@protoc_insertion_point(copy_constructor:player.AudioTrack)
+}
+
+inline void AudioTrack::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.channellayout_){}
+    , decltype(_impl_.info_){nullptr}
+    , decltype(_impl_.id_){int64_t{0}}
+    , decltype(_impl_.isactive_){false}
+    , decltype(_impl_.samplerate_){0}
+    , decltype(_impl_.channels_){0}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+  _impl_.channellayout_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.channellayout_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+}
+
+AudioTrack::~AudioTrack() {
+  // @@protoc_insertion_point(destructor:player.AudioTrack)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void AudioTrack::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+  _impl_.channellayout_.Destroy();
+  if (this != internal_default_instance()) delete _impl_.info_;
+}
+
+void AudioTrack::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void AudioTrack::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.AudioTrack)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.channellayout_.ClearToEmpty();
+  if (GetArenaForAllocation() == nullptr && _impl_.info_ != nullptr) {
+    delete _impl_.info_;
+  }
+  _impl_.info_ = nullptr;
+  ::memset(&_impl_.id_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&_impl_.channels_) -
+      reinterpret_cast<char*>(&_impl_.id_)) + sizeof(_impl_.channels_));
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* AudioTrack::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // int64 id = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
+          _impl_.id_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // bool isActive = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 16)) {
+          _impl_.isactive_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // .player.TrackInfo info = 3;
+      case 3:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 26)) {
+          ptr = ctx->ParseMessage(_internal_mutable_info(), ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // int32 sampleRate = 4;
+      case 4:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 32)) {
+          _impl_.samplerate_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint32(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // int32 channels = 5;
+      case 5:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 40)) {
+          _impl_.channels_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint32(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // string channelLayout = 6;
+      case 6:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 50)) {
+          auto str = _internal_mutable_channellayout();
+          ptr = ::_pbi::InlineGreedyStringParser(str, ptr, ctx);
+          CHK_(ptr);
+          CHK_(::_pbi::VerifyUTF8(str, "player.AudioTrack.channelLayout"));
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* AudioTrack::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.AudioTrack)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // int64 id = 1;
+  if (this->_internal_id() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteInt64ToArray(1, this->_internal_id(), target);
+  }
+
+  // bool isActive = 2;
+  if (this->_internal_isactive() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteBoolToArray(2, this->_internal_isactive(), target);
+  }
+
+  // .player.TrackInfo info = 3;
+  if (this->_internal_has_info()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessage(3, _Internal::info(this),
+        _Internal::info(this).GetCachedSize(), target, stream);
+  }
+
+  // int32 sampleRate = 4;
+  if (this->_internal_samplerate() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteInt32ToArray(4, this->_internal_samplerate(), target);
+  }
+
+  // int32 channels = 5;
+  if (this->_internal_channels() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteInt32ToArray(5, this->_internal_channels(), target);
+  }
+
+  // string channelLayout = 6;
+  if (!this->_internal_channellayout().empty()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->_internal_channellayout().data(), static_cast<int>(this->_internal_channellayout().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "player.AudioTrack.channelLayout");
+    target = stream->WriteStringMaybeAliased(
+        6, this->_internal_channellayout(), target);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.AudioTrack)
+  return target;
+}
+
+size_t AudioTrack::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.AudioTrack)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // string channelLayout = 6;
+  if (!this->_internal_channellayout().empty()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::StringSize(
+        this->_internal_channellayout());
+  }
+
+  // .player.TrackInfo info = 3;
+  if (this->_internal_has_info()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *_impl_.info_);
+  }
+
+  // int64 id = 1;
+  if (this->_internal_id() != 0) {
+    total_size += ::_pbi::WireFormatLite::Int64SizePlusOne(this->_internal_id());
+  }
+
+  // bool isActive = 2;
+  if (this->_internal_isactive() != 0) {
+    total_size += 1 + 1;
+  }
+
+  // int32 sampleRate = 4;
+  if (this->_internal_samplerate() != 0) {
+    total_size += ::_pbi::WireFormatLite::Int32SizePlusOne(this->_internal_samplerate());
+  }
+
+  // int32 channels = 5;
+  if (this->_internal_channels() != 0) {
+    total_size += ::_pbi::WireFormatLite::Int32SizePlusOne(this->_internal_channels());
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData AudioTrack::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    AudioTrack::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*AudioTrack::GetClassData() const { return &_class_data_; }
+
+
+void AudioTrack::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<AudioTrack*>(&to_msg);
+  auto& from = static_cast<const AudioTrack&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.AudioTrack)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (!from._internal_channellayout().empty()) {
+    _this->_internal_set_channellayout(from._internal_channellayout());
+  }
+  if (from._internal_has_info()) {
+    _this->_internal_mutable_info()->::player::TrackInfo::MergeFrom(
+        from._internal_info());
+  }
+  if (from._internal_id() != 0) {
+    _this->_internal_set_id(from._internal_id());
+  }
+  if (from._internal_isactive() != 0) {
+    _this->_internal_set_isactive(from._internal_isactive());
+  }
+  if (from._internal_samplerate() != 0) {
+    _this->_internal_set_samplerate(from._internal_samplerate());
+  }
+  if (from._internal_channels() != 0) {
+    _this->_internal_set_channels(from._internal_channels());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void AudioTrack::CopyFrom(const AudioTrack& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.AudioTrack)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool AudioTrack::IsInitialized() const {
+  return true;
+}
+
+void AudioTrack::InternalSwap(AudioTrack* other) {
+  using std::swap;
+  auto* lhs_arena = GetArenaForAllocation();
+  auto* rhs_arena = other->GetArenaForAllocation();
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr::InternalSwap(
+      &_impl_.channellayout_, lhs_arena,
+      &other->_impl_.channellayout_, rhs_arena
+  );
+  ::PROTOBUF_NAMESPACE_ID::internal::memswap<
+      PROTOBUF_FIELD_OFFSET(AudioTrack, _impl_.channels_)
+      + sizeof(AudioTrack::_impl_.channels_)
+      - PROTOBUF_FIELD_OFFSET(AudioTrack, _impl_.info_)>(
+          reinterpret_cast<char*>(&_impl_.info_),
+          reinterpret_cast<char*>(&other->_impl_.info_));
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata AudioTrack::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[50]);
+}
+
+// ===================================================================
+
+class GetAudioTracksRequest::_Internal {
+ public:
+};
+
+GetAudioTracksRequest::GetAudioTracksRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.GetAudioTracksRequest)
+}
+GetAudioTracksRequest::GetAudioTracksRequest(const GetAudioTracksRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  GetAudioTracksRequest* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.GetAudioTracksRequest)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetAudioTracksRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetAudioTracksRequest::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetAudioTracksRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[51]);
+}
+
+// ===================================================================
+
+class GetAudioTracksReply::_Internal {
+ public:
+};
+
+GetAudioTracksReply::GetAudioTracksReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.GetAudioTracksReply)
+}
+GetAudioTracksReply::GetAudioTracksReply(const GetAudioTracksReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  GetAudioTracksReply* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.audiotrack_){from._impl_.audiotrack_}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.GetAudioTracksReply)
+}
+
+inline void GetAudioTracksReply::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.audiotrack_){arena}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+GetAudioTracksReply::~GetAudioTracksReply() {
+  // @@protoc_insertion_point(destructor:player.GetAudioTracksReply)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void GetAudioTracksReply::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+  _impl_.audiotrack_.~RepeatedPtrField();
+}
+
+void GetAudioTracksReply::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void GetAudioTracksReply::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.GetAudioTracksReply)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.audiotrack_.Clear();
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* GetAudioTracksReply::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // repeated .player.AudioTrack audioTrack = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 10)) {
+          ptr -= 1;
+          do {
+            ptr += 1;
+            ptr = ctx->ParseMessage(_internal_add_audiotrack(), ptr);
+            CHK_(ptr);
+            if (!ctx->DataAvailable(ptr)) break;
+          } while (::PROTOBUF_NAMESPACE_ID::internal::ExpectTag<10>(ptr));
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* GetAudioTracksReply::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.GetAudioTracksReply)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // repeated .player.AudioTrack audioTrack = 1;
+  for (unsigned i = 0,
+      n = static_cast<unsigned>(this->_internal_audiotrack_size()); i < n; i++) {
+    const auto& repfield = this->_internal_audiotrack(i);
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+        InternalWriteMessage(1, repfield, repfield.GetCachedSize(), target, stream);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.GetAudioTracksReply)
+  return target;
+}
+
+size_t GetAudioTracksReply::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.GetAudioTracksReply)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // repeated .player.AudioTrack audioTrack = 1;
+  total_size += 1UL * this->_internal_audiotrack_size();
+  for (const auto& msg : this->_impl_.audiotrack_) {
+    total_size +=
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(msg);
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetAudioTracksReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    GetAudioTracksReply::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetAudioTracksReply::GetClassData() const { return &_class_data_; }
+
+
+void GetAudioTracksReply::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<GetAudioTracksReply*>(&to_msg);
+  auto& from = static_cast<const GetAudioTracksReply&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.GetAudioTracksReply)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  _this->_impl_.audiotrack_.MergeFrom(from._impl_.audiotrack_);
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void GetAudioTracksReply::CopyFrom(const GetAudioTracksReply& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.GetAudioTracksReply)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GetAudioTracksReply::IsInitialized() const {
+  return true;
+}
+
+void GetAudioTracksReply::InternalSwap(GetAudioTracksReply* other) {
+  using std::swap;
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  _impl_.audiotrack_.InternalSwap(&other->_impl_.audiotrack_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetAudioTracksReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[52]);
+}
+
+// ===================================================================
+
+class SubtitlesTrack::_Internal {
+ public:
+  static const ::player::TrackInfo& info(const SubtitlesTrack* msg);
+};
+
+const ::player::TrackInfo&
+SubtitlesTrack::_Internal::info(const SubtitlesTrack* msg) {
+  return *msg->_impl_.info_;
+}
+SubtitlesTrack::SubtitlesTrack(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.SubtitlesTrack)
+}
+SubtitlesTrack::SubtitlesTrack(const SubtitlesTrack& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  SubtitlesTrack* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.info_){nullptr}
+    , decltype(_impl_.id_){}
+    , decltype(_impl_.isactive_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  if (from._internal_has_info()) {
+    _this->_impl_.info_ = new ::player::TrackInfo(*from._impl_.info_);
+  }
+  ::memcpy(&_impl_.id_, &from._impl_.id_,
+    static_cast<size_t>(reinterpret_cast<char*>(&_impl_.isactive_) -
+    reinterpret_cast<char*>(&_impl_.id_)) + sizeof(_impl_.isactive_));
+  // @@protoc_insertion_point(copy_constructor:player.SubtitlesTrack)
+}
+
+inline void SubtitlesTrack::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.info_){nullptr}
+    , decltype(_impl_.id_){int64_t{0}}
+    , decltype(_impl_.isactive_){false}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+SubtitlesTrack::~SubtitlesTrack() {
+  // @@protoc_insertion_point(destructor:player.SubtitlesTrack)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void SubtitlesTrack::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+  if (this != internal_default_instance()) delete _impl_.info_;
+}
+
+void SubtitlesTrack::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void SubtitlesTrack::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.SubtitlesTrack)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  if (GetArenaForAllocation() == nullptr && _impl_.info_ != nullptr) {
+    delete _impl_.info_;
+  }
+  _impl_.info_ = nullptr;
+  ::memset(&_impl_.id_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&_impl_.isactive_) -
+      reinterpret_cast<char*>(&_impl_.id_)) + sizeof(_impl_.isactive_));
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* SubtitlesTrack::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // int64 id = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
+          _impl_.id_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // bool isActive = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 16)) {
+          _impl_.isactive_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // .player.TrackInfo info = 3;
+      case 3:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 26)) {
+          ptr = ctx->ParseMessage(_internal_mutable_info(), ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* SubtitlesTrack::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.SubtitlesTrack)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // int64 id = 1;
+  if (this->_internal_id() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteInt64ToArray(1, this->_internal_id(), target);
+  }
+
+  // bool isActive = 2;
+  if (this->_internal_isactive() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteBoolToArray(2, this->_internal_isactive(), target);
+  }
+
+  // .player.TrackInfo info = 3;
+  if (this->_internal_has_info()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessage(3, _Internal::info(this),
+        _Internal::info(this).GetCachedSize(), target, stream);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.SubtitlesTrack)
+  return target;
+}
+
+size_t SubtitlesTrack::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.SubtitlesTrack)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // .player.TrackInfo info = 3;
+  if (this->_internal_has_info()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *_impl_.info_);
+  }
+
+  // int64 id = 1;
+  if (this->_internal_id() != 0) {
+    total_size += ::_pbi::WireFormatLite::Int64SizePlusOne(this->_internal_id());
+  }
+
+  // bool isActive = 2;
+  if (this->_internal_isactive() != 0) {
+    total_size += 1 + 1;
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SubtitlesTrack::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    SubtitlesTrack::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SubtitlesTrack::GetClassData() const { return &_class_data_; }
+
+
+void SubtitlesTrack::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<SubtitlesTrack*>(&to_msg);
+  auto& from = static_cast<const SubtitlesTrack&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.SubtitlesTrack)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from._internal_has_info()) {
+    _this->_internal_mutable_info()->::player::TrackInfo::MergeFrom(
+        from._internal_info());
+  }
+  if (from._internal_id() != 0) {
+    _this->_internal_set_id(from._internal_id());
+  }
+  if (from._internal_isactive() != 0) {
+    _this->_internal_set_isactive(from._internal_isactive());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void SubtitlesTrack::CopyFrom(const SubtitlesTrack& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.SubtitlesTrack)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool SubtitlesTrack::IsInitialized() const {
+  return true;
+}
+
+void SubtitlesTrack::InternalSwap(SubtitlesTrack* other) {
+  using std::swap;
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  ::PROTOBUF_NAMESPACE_ID::internal::memswap<
+      PROTOBUF_FIELD_OFFSET(SubtitlesTrack, _impl_.isactive_)
+      + sizeof(SubtitlesTrack::_impl_.isactive_)
+      - PROTOBUF_FIELD_OFFSET(SubtitlesTrack, _impl_.info_)>(
+          reinterpret_cast<char*>(&_impl_.info_),
+          reinterpret_cast<char*>(&other->_impl_.info_));
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata SubtitlesTrack::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[53]);
+}
+
+// ===================================================================
+
+class GetSubtitlesTracksRequest::_Internal {
+ public:
+};
+
+GetSubtitlesTracksRequest::GetSubtitlesTracksRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.GetSubtitlesTracksRequest)
+}
+GetSubtitlesTracksRequest::GetSubtitlesTracksRequest(const GetSubtitlesTracksRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  GetSubtitlesTracksRequest* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.GetSubtitlesTracksRequest)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetSubtitlesTracksRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetSubtitlesTracksRequest::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetSubtitlesTracksRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[54]);
+}
+
+// ===================================================================
+
+class GetSubtitlesTracksReply::_Internal {
+ public:
+};
+
+GetSubtitlesTracksReply::GetSubtitlesTracksReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.GetSubtitlesTracksReply)
+}
+GetSubtitlesTracksReply::GetSubtitlesTracksReply(const GetSubtitlesTracksReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  GetSubtitlesTracksReply* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.subtitlestrack_){from._impl_.subtitlestrack_}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.GetSubtitlesTracksReply)
+}
+
+inline void GetSubtitlesTracksReply::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.subtitlestrack_){arena}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+GetSubtitlesTracksReply::~GetSubtitlesTracksReply() {
+  // @@protoc_insertion_point(destructor:player.GetSubtitlesTracksReply)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void GetSubtitlesTracksReply::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+  _impl_.subtitlestrack_.~RepeatedPtrField();
+}
+
+void GetSubtitlesTracksReply::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void GetSubtitlesTracksReply::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.GetSubtitlesTracksReply)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.subtitlestrack_.Clear();
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* GetSubtitlesTracksReply::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // repeated .player.SubtitlesTrack subtitlesTrack = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 10)) {
+          ptr -= 1;
+          do {
+            ptr += 1;
+            ptr = ctx->ParseMessage(_internal_add_subtitlestrack(), ptr);
+            CHK_(ptr);
+            if (!ctx->DataAvailable(ptr)) break;
+          } while (::PROTOBUF_NAMESPACE_ID::internal::ExpectTag<10>(ptr));
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* GetSubtitlesTracksReply::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.GetSubtitlesTracksReply)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // repeated .player.SubtitlesTrack subtitlesTrack = 1;
+  for (unsigned i = 0,
+      n = static_cast<unsigned>(this->_internal_subtitlestrack_size()); i < n; i++) {
+    const auto& repfield = this->_internal_subtitlestrack(i);
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+        InternalWriteMessage(1, repfield, repfield.GetCachedSize(), target, stream);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.GetSubtitlesTracksReply)
+  return target;
+}
+
+size_t GetSubtitlesTracksReply::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.GetSubtitlesTracksReply)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // repeated .player.SubtitlesTrack subtitlesTrack = 1;
+  total_size += 1UL * this->_internal_subtitlestrack_size();
+  for (const auto& msg : this->_impl_.subtitlestrack_) {
+    total_size +=
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(msg);
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetSubtitlesTracksReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    GetSubtitlesTracksReply::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetSubtitlesTracksReply::GetClassData() const { return &_class_data_; }
+
+
+void GetSubtitlesTracksReply::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<GetSubtitlesTracksReply*>(&to_msg);
+  auto& from = static_cast<const GetSubtitlesTracksReply&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.GetSubtitlesTracksReply)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  _this->_impl_.subtitlestrack_.MergeFrom(from._impl_.subtitlestrack_);
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void GetSubtitlesTracksReply::CopyFrom(const GetSubtitlesTracksReply& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.GetSubtitlesTracksReply)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GetSubtitlesTracksReply::IsInitialized() const {
+  return true;
+}
+
+void GetSubtitlesTracksReply::InternalSwap(GetSubtitlesTracksReply* other) {
+  using std::swap;
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  _impl_.subtitlestrack_.InternalSwap(&other->_impl_.subtitlestrack_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetSubtitlesTracksReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[55]);
+}
+
+// ===================================================================
+
+class Chapter::_Internal {
+ public:
+};
+
+Chapter::Chapter(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.Chapter)
+}
+Chapter::Chapter(const Chapter& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  Chapter* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.title_){}
+    , decltype(_impl_.startsecs_){}
+    , decltype(_impl_.endsecs_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  _impl_.title_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.title_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (!from._internal_title().empty()) {
+    _this->_impl_.title_.Set(from._internal_title(), 
+      _this->GetArenaForAllocation());
+  }
+  ::memcpy(&_impl_.startsecs_, &from._impl_.startsecs_,
+    static_cast<size_t>(reinterpret_cast<char*>(&_impl_.endsecs_) -
+    reinterpret_cast<char*>(&_impl_.startsecs_)) + sizeof(_impl_.endsecs_));
+  // @@protoc_insertion_point(copy_constructor:player.Chapter)
+}
+
+inline void Chapter::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.title_){}
+    , decltype(_impl_.startsecs_){0}
+    , decltype(_impl_.endsecs_){0}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+  _impl_.title_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.title_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+}
+
+Chapter::~Chapter() {
+  // @@protoc_insertion_point(destructor:player.Chapter)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void Chapter::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+  _impl_.title_.Destroy();
+}
+
+void Chapter::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void Chapter::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.Chapter)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.title_.ClearToEmpty();
+  ::memset(&_impl_.startsecs_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&_impl_.endsecs_) -
+      reinterpret_cast<char*>(&_impl_.startsecs_)) + sizeof(_impl_.endsecs_));
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* Chapter::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // string title = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 10)) {
+          auto str = _internal_mutable_title();
+          ptr = ::_pbi::InlineGreedyStringParser(str, ptr, ctx);
+          CHK_(ptr);
+          CHK_(::_pbi::VerifyUTF8(str, "player.Chapter.title"));
+        } else
+          goto handle_unusual;
+        continue;
+      // double startSecs = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 17)) {
+          _impl_.startsecs_ = ::PROTOBUF_NAMESPACE_ID::internal::UnalignedLoad<double>(ptr);
+          ptr += sizeof(double);
+        } else
+          goto handle_unusual;
+        continue;
+      // double endSecs = 3;
+      case 3:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 25)) {
+          _impl_.endsecs_ = ::PROTOBUF_NAMESPACE_ID::internal::UnalignedLoad<double>(ptr);
+          ptr += sizeof(double);
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* Chapter::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.Chapter)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // string title = 1;
+  if (!this->_internal_title().empty()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->_internal_title().data(), static_cast<int>(this->_internal_title().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "player.Chapter.title");
+    target = stream->WriteStringMaybeAliased(
+        1, this->_internal_title(), target);
+  }
+
+  // double startSecs = 2;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_startsecs = this->_internal_startsecs();
+  uint64_t raw_startsecs;
+  memcpy(&raw_startsecs, &tmp_startsecs, sizeof(tmp_startsecs));
+  if (raw_startsecs != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteDoubleToArray(2, this->_internal_startsecs(), target);
+  }
+
+  // double endSecs = 3;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_endsecs = this->_internal_endsecs();
+  uint64_t raw_endsecs;
+  memcpy(&raw_endsecs, &tmp_endsecs, sizeof(tmp_endsecs));
+  if (raw_endsecs != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteDoubleToArray(3, this->_internal_endsecs(), target);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.Chapter)
+  return target;
+}
+
+size_t Chapter::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.Chapter)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // string title = 1;
+  if (!this->_internal_title().empty()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::StringSize(
+        this->_internal_title());
+  }
+
+  // double startSecs = 2;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_startsecs = this->_internal_startsecs();
+  uint64_t raw_startsecs;
+  memcpy(&raw_startsecs, &tmp_startsecs, sizeof(tmp_startsecs));
+  if (raw_startsecs != 0) {
+    total_size += 1 + 8;
+  }
+
+  // double endSecs = 3;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_endsecs = this->_internal_endsecs();
+  uint64_t raw_endsecs;
+  memcpy(&raw_endsecs, &tmp_endsecs, sizeof(tmp_endsecs));
+  if (raw_endsecs != 0) {
+    total_size += 1 + 8;
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData Chapter::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    Chapter::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*Chapter::GetClassData() const { return &_class_data_; }
+
+
+void Chapter::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<Chapter*>(&to_msg);
+  auto& from = static_cast<const Chapter&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.Chapter)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (!from._internal_title().empty()) {
+    _this->_internal_set_title(from._internal_title());
+  }
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_startsecs = from._internal_startsecs();
+  uint64_t raw_startsecs;
+  memcpy(&raw_startsecs, &tmp_startsecs, sizeof(tmp_startsecs));
+  if (raw_startsecs != 0) {
+    _this->_internal_set_startsecs(from._internal_startsecs());
+  }
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_endsecs = from._internal_endsecs();
+  uint64_t raw_endsecs;
+  memcpy(&raw_endsecs, &tmp_endsecs, sizeof(tmp_endsecs));
+  if (raw_endsecs != 0) {
+    _this->_internal_set_endsecs(from._internal_endsecs());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void Chapter::CopyFrom(const Chapter& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.Chapter)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool Chapter::IsInitialized() const {
+  return true;
+}
+
+void Chapter::InternalSwap(Chapter* other) {
+  using std::swap;
+  auto* lhs_arena = GetArenaForAllocation();
+  auto* rhs_arena = other->GetArenaForAllocation();
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr::InternalSwap(
+      &_impl_.title_, lhs_arena,
+      &other->_impl_.title_, rhs_arena
+  );
+  ::PROTOBUF_NAMESPACE_ID::internal::memswap<
+      PROTOBUF_FIELD_OFFSET(Chapter, _impl_.endsecs_)
+      + sizeof(Chapter::_impl_.endsecs_)
+      - PROTOBUF_FIELD_OFFSET(Chapter, _impl_.startsecs_)>(
+          reinterpret_cast<char*>(&_impl_.startsecs_),
+          reinterpret_cast<char*>(&other->_impl_.startsecs_));
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata Chapter::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[56]);
+}
+
+// ===================================================================
+
+MediaInfo_TagsEntry_DoNotUse::MediaInfo_TagsEntry_DoNotUse() {}
+MediaInfo_TagsEntry_DoNotUse::MediaInfo_TagsEntry_DoNotUse(::PROTOBUF_NAMESPACE_ID::Arena* arena)
+    : SuperType(arena) {}
+void MediaInfo_TagsEntry_DoNotUse::MergeFrom(const MediaInfo_TagsEntry_DoNotUse& other) {
+  MergeFromInternal(other);
+}
+::PROTOBUF_NAMESPACE_ID::Metadata MediaInfo_TagsEntry_DoNotUse::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[57]);
+}
+
+// ===================================================================
+
+class MediaInfo::_Internal {
+ public:
+};
+
+MediaInfo::MediaInfo(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  if (arena != nullptr && !is_message_owned) {
+    arena->OwnCustomDestructor(this, &MediaInfo::ArenaDtor);
+  }
+  // @@protoc_insertion_point(arena_constructor:player.MediaInfo)
+}
+MediaInfo::MediaInfo(const MediaInfo& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  MediaInfo* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      /*decltype(_impl_.tags_)*/{}
+    , decltype(_impl_.chapters_){from._impl_.chapters_}
+    , decltype(_impl_.formatname_){}
+    , decltype(_impl_.bitrate_){}
+    , decltype(_impl_.streamcount_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  _this->_impl_.tags_.MergeFrom(from._impl_.tags_);
+  _impl_.formatname_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.formatname_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (!from._internal_formatname().empty()) {
+    _this->_impl_.formatname_.Set(from._internal_formatname(), 
+      _this->GetArenaForAllocation());
+  }
+  ::memcpy(&_impl_.bitrate_, &from._impl_.bitrate_,
+    static_cast<size_t>(reinterpret_cast<char*>(&_impl_.streamcount_) -
+    reinterpret_cast<char*>(&_impl_.bitrate_)) + sizeof(_impl_.streamcount_));
+  // @@protoc_insertion_point(copy_constructor:player.MediaInfo)
+}
+
+inline void MediaInfo::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      /*decltype(_impl_.tags_)*/{::_pbi::ArenaInitialized(), arena}
+    , decltype(_impl_.chapters_){arena}
+    , decltype(_impl_.formatname_){}
+    , decltype(_impl_.bitrate_){int64_t{0}}
+    , decltype(_impl_.streamcount_){0}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+  _impl_.formatname_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.formatname_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+}
+
+MediaInfo::~MediaInfo() {
+  // @@protoc_insertion_point(destructor:player.MediaInfo)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    ArenaDtor(this);
+    return;
+  }
+  SharedDtor();
+}
+
+inline void MediaInfo::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+  _impl_.tags_.Destruct();
+  _impl_.tags_.~MapField();
+  _impl_.chapters_.~RepeatedPtrField();
+  _impl_.formatname_.Destroy();
+}
+
+void MediaInfo::ArenaDtor(void* object) {
+  MediaInfo* _this = reinterpret_cast< MediaInfo* >(object);
+  _this->_impl_.tags_.Destruct();
+}
+void MediaInfo::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void MediaInfo::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.MediaInfo)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.tags_.Clear();
+  _impl_.chapters_.Clear();
+  _impl_.formatname_.ClearToEmpty();
+  ::memset(&_impl_.bitrate_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&_impl_.streamcount_) -
+      reinterpret_cast<char*>(&_impl_.bitrate_)) + sizeof(_impl_.streamcount_));
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* MediaInfo::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // string formatName = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 10)) {
+          auto str = _internal_mutable_formatname();
+          ptr = ::_pbi::InlineGreedyStringParser(str, ptr, ctx);
+          CHK_(ptr);
+          CHK_(::_pbi::VerifyUTF8(str, "player.MediaInfo.formatName"));
+        } else
+          goto handle_unusual;
+        continue;
+      // int64 bitrate = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 16)) {
+          _impl_.bitrate_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // int32 streamCount = 3;
+      case 3:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 24)) {
+          _impl_.streamcount_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint32(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // map<string, string> tags = 4;
+      case 4:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 34)) {
+          ptr -= 1;
+          do {
+            ptr += 1;
+            ptr = ctx->ParseMessage(&_impl_.tags_, ptr);
+            CHK_(ptr);
+            if (!ctx->DataAvailable(ptr)) break;
+          } while (::PROTOBUF_NAMESPACE_ID::internal::ExpectTag<34>(ptr));
+        } else
+          goto handle_unusual;
+        continue;
+      // repeated .player.Chapter chapters = 5;
+      case 5:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 42)) {
+          ptr -= 1;
+          do {
+            ptr += 1;
+            ptr = ctx->ParseMessage(_internal_add_chapters(), ptr);
+            CHK_(ptr);
+            if (!ctx->DataAvailable(ptr)) break;
+          } while (::PROTOBUF_NAMESPACE_ID::internal::ExpectTag<42>(ptr));
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* MediaInfo::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.MediaInfo)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // string formatName = 1;
+  if (!this->_internal_formatname().empty()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->_internal_formatname().data(), static_cast<int>(this->_internal_formatname().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "player.MediaInfo.formatName");
+    target = stream->WriteStringMaybeAliased(
+        1, this->_internal_formatname(), target);
+  }
+
+  // int64 bitrate = 2;
+  if (this->_internal_bitrate() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteInt64ToArray(2, this->_internal_bitrate(), target);
+  }
+
+  // int32 streamCount = 3;
+  if (this->_internal_streamcount() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteInt32ToArray(3, this->_internal_streamcount(), target);
+  }
+
+  // map<string, string> tags = 4;
+  if (!this->_internal_tags().empty()) {
+    using MapType = ::_pb::Map<std::string, std::string>;
+    using WireHelper = MediaInfo_TagsEntry_DoNotUse::Funcs;
+    const auto& map_field = this->_internal_tags();
+    auto check_utf8 = [](const MapType::value_type& entry) {
+      (void)entry;
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+        entry.first.data(), static_cast<int>(entry.first.length()),
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+        "player.MediaInfo.TagsEntry.key");
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+        entry.second.data(), static_cast<int>(entry.second.length()),
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+        "player.MediaInfo.TagsEntry.value");
+    };
+
+    if (stream->IsSerializationDeterministic() && map_field.size() > 1) {
+      for (const auto& entry : ::_pbi::MapSorterPtr<MapType>(map_field)) {
+        target = WireHelper::InternalSerialize(4, entry.first, entry.second, target, stream);
+        check_utf8(entry);
+      }
+    } else {
+      for (const auto& entry : map_field) {
+        target = WireHelper::InternalSerialize(4, entry.first, entry.second, target, stream);
+        check_utf8(entry);
+      }
+    }
+  }
+
+  // repeated .player.Chapter chapters = 5;
+  for (unsigned i = 0,
+      n = static_cast<unsigned>(this->_internal_chapters_size()); i < n; i++) {
+    const auto& repfield = this->_internal_chapters(i);
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+        InternalWriteMessage(5, repfield, repfield.GetCachedSize(), target, stream);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.MediaInfo)
+  return target;
+}
+
+size_t MediaInfo::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.MediaInfo)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // map<string, string> tags = 4;
+  total_size += 1 *
+      ::PROTOBUF_NAMESPACE_ID::internal::FromIntSize(this->_internal_tags_size());
+  for (::PROTOBUF_NAMESPACE_ID::Map< std::string, std::string >::const_iterator
+      it = this->_internal_tags().begin();
+      it != this->_internal_tags().end(); ++it) {
+    total_size += MediaInfo_TagsEntry_DoNotUse::Funcs::ByteSizeLong(it->first, it->second);
+  }
+
+  // repeated .player.Chapter chapters = 5;
+  total_size += 1UL * this->_internal_chapters_size();
+  for (const auto& msg : this->_impl_.chapters_) {
+    total_size +=
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(msg);
+  }
+
+  // string formatName = 1;
+  if (!this->_internal_formatname().empty()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::StringSize(
+        this->_internal_formatname());
+  }
+
+  // int64 bitrate = 2;
+  if (this->_internal_bitrate() != 0) {
+    total_size += ::_pbi::WireFormatLite::Int64SizePlusOne(this->_internal_bitrate());
+  }
+
+  // int32 streamCount = 3;
+  if (this->_internal_streamcount() != 0) {
+    total_size += ::_pbi::WireFormatLite::Int32SizePlusOne(this->_internal_streamcount());
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData MediaInfo::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    MediaInfo::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*MediaInfo::GetClassData() const { return &_class_data_; }
+
+
+void MediaInfo::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<MediaInfo*>(&to_msg);
+  auto& from = static_cast<const MediaInfo&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.MediaInfo)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  _this->_impl_.tags_.MergeFrom(from._impl_.tags_);
+  _this->_impl_.chapters_.MergeFrom(from._impl_.chapters_);
+  if (!from._internal_formatname().empty()) {
+    _this->_internal_set_formatname(from._internal_formatname());
+  }
+  if (from._internal_bitrate() != 0) {
+    _this->_internal_set_bitrate(from._internal_bitrate());
+  }
+  if (from._internal_streamcount() != 0) {
+    _this->_internal_set_streamcount(from._internal_streamcount());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void MediaInfo::CopyFrom(const MediaInfo& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.MediaInfo)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool MediaInfo::IsInitialized() const {
+  return true;
+}
+
+void MediaInfo::InternalSwap(MediaInfo* other) {
+  using std::swap;
+  auto* lhs_arena = GetArenaForAllocation();
+  auto* rhs_arena = other->GetArenaForAllocation();
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  _impl_.tags_.InternalSwap(&other->_impl_.tags_);
+  _impl_.chapters_.InternalSwap(&other->_impl_.chapters_);
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr::InternalSwap(
+      &_impl_.formatname_, lhs_arena,
+      &other->_impl_.formatname_, rhs_arena
+  );
+  ::PROTOBUF_NAMESPACE_ID::internal::memswap<
+      PROTOBUF_FIELD_OFFSET(MediaInfo, _impl_.streamcount_)
+      + sizeof(MediaInfo::_impl_.streamcount_)
+      - PROTOBUF_FIELD_OFFSET(MediaInfo, _impl_.bitrate_)>(
+          reinterpret_cast<char*>(&_impl_.bitrate_),
+          reinterpret_cast<char*>(&other->_impl_.bitrate_));
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata MediaInfo::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[58]);
+}
+
+// ===================================================================
+
+class GetMediaInfoRequest::_Internal {
+ public:
+};
+
+GetMediaInfoRequest::GetMediaInfoRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.GetMediaInfoRequest)
+}
+GetMediaInfoRequest::GetMediaInfoRequest(const GetMediaInfoRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  GetMediaInfoRequest* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.GetMediaInfoRequest)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetMediaInfoRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetMediaInfoRequest::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetMediaInfoRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[59]);
+}
+
+// ===================================================================
+
+class GetMediaInfoReply::_Internal {
+ public:
+  static const ::player::MediaInfo& mediainfo(const GetMediaInfoReply* msg);
+};
+
+const ::player::MediaInfo&
+GetMediaInfoReply::_Internal::mediainfo(const GetMediaInfoReply* msg) {
+  return *msg->_impl_.mediainfo_;
+}
+GetMediaInfoReply::GetMediaInfoReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.GetMediaInfoReply)
+}
+GetMediaInfoReply::GetMediaInfoReply(const GetMediaInfoReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  GetMediaInfoReply* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.mediainfo_){nullptr}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  if (from._internal_has_mediainfo()) {
+    _this->_impl_.mediainfo_ = new ::player::MediaInfo(*from._impl_.mediainfo_);
+  }
+  // @@protoc_insertion_point(copy_constructor:player.GetMediaInfoReply)
+}
+
+inline void GetMediaInfoReply::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.mediainfo_){nullptr}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+GetMediaInfoReply::~GetMediaInfoReply() {
+  // @@protoc_insertion_point(destructor:player.GetMediaInfoReply)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void GetMediaInfoReply::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+  if (this != internal_default_instance()) delete _impl_.mediainfo_;
+}
+
+void GetMediaInfoReply::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void GetMediaInfoReply::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.GetMediaInfoReply)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  if (GetArenaForAllocation() == nullptr && _impl_.mediainfo_ != nullptr) {
+    delete _impl_.mediainfo_;
+  }
+  _impl_.mediainfo_ = nullptr;
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* GetMediaInfoReply::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // .player.MediaInfo mediaInfo = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 10)) {
+          ptr = ctx->ParseMessage(_internal_mutable_mediainfo(), ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* GetMediaInfoReply::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.GetMediaInfoReply)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .player.MediaInfo mediaInfo = 1;
+  if (this->_internal_has_mediainfo()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessage(1, _Internal::mediainfo(this),
+        _Internal::mediainfo(this).GetCachedSize(), target, stream);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.GetMediaInfoReply)
+  return target;
+}
+
+size_t GetMediaInfoReply::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.GetMediaInfoReply)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // .player.MediaInfo mediaInfo = 1;
+  if (this->_internal_has_mediainfo()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *_impl_.mediainfo_);
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetMediaInfoReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    GetMediaInfoReply::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetMediaInfoReply::GetClassData() const { return &_class_data_; }
+
+
+void GetMediaInfoReply::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<GetMediaInfoReply*>(&to_msg);
+  auto& from = static_cast<const GetMediaInfoReply&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.GetMediaInfoReply)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from._internal_has_mediainfo()) {
+    _this->_internal_mutable_mediainfo()->::player::MediaInfo::MergeFrom(
+        from._internal_mediainfo());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void GetMediaInfoReply::CopyFrom(const GetMediaInfoReply& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.GetMediaInfoReply)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GetMediaInfoReply::IsInitialized() const {
+  return true;
+}
+
+void GetMediaInfoReply::InternalSwap(GetMediaInfoReply* other) {
+  using std::swap;
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  swap(_impl_.mediainfo_, other->_impl_.mediainfo_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetMediaInfoReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[60]);
+}
+
+// ===================================================================
+
+class GetChaptersRequest::_Internal {
+ public:
+};
+
+GetChaptersRequest::GetChaptersRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.GetChaptersRequest)
+}
+GetChaptersRequest::GetChaptersRequest(const GetChaptersRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  GetChaptersRequest* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.GetChaptersRequest)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetChaptersRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetChaptersRequest::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetChaptersRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[61]);
+}
+
+// ===================================================================
+
+class GetChaptersReply::_Internal {
+ public:
+};
+
+GetChaptersReply::GetChaptersReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.GetChaptersReply)
+}
+GetChaptersReply::GetChaptersReply(const GetChaptersReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  GetChaptersReply* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.chapters_){from._impl_.chapters_}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.GetChaptersReply)
+}
+
+inline void GetChaptersReply::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.chapters_){arena}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+GetChaptersReply::~GetChaptersReply() {
+  // @@protoc_insertion_point(destructor:player.GetChaptersReply)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void GetChaptersReply::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+  _impl_.chapters_.~RepeatedPtrField();
+}
+
+void GetChaptersReply::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void GetChaptersReply::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.GetChaptersReply)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.chapters_.Clear();
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* GetChaptersReply::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // repeated .player.Chapter chapters = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 10)) {
+          ptr -= 1;
+          do {
+            ptr += 1;
+            ptr = ctx->ParseMessage(_internal_add_chapters(), ptr);
+            CHK_(ptr);
+            if (!ctx->DataAvailable(ptr)) break;
+          } while (::PROTOBUF_NAMESPACE_ID::internal::ExpectTag<10>(ptr));
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* GetChaptersReply::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.GetChaptersReply)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // repeated .player.Chapter chapters = 1;
+  for (unsigned i = 0,
+      n = static_cast<unsigned>(this->_internal_chapters_size()); i < n; i++) {
+    const auto& repfield = this->_internal_chapters(i);
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+        InternalWriteMessage(1, repfield, repfield.GetCachedSize(), target, stream);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.GetChaptersReply)
+  return target;
+}
+
+size_t GetChaptersReply::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.GetChaptersReply)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // repeated .player.Chapter chapters = 1;
+  total_size += 1UL * this->_internal_chapters_size();
+  for (const auto& msg : this->_impl_.chapters_) {
+    total_size +=
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(msg);
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetChaptersReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    GetChaptersReply::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetChaptersReply::GetClassData() const { return &_class_data_; }
+
+
+void GetChaptersReply::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<GetChaptersReply*>(&to_msg);
+  auto& from = static_cast<const GetChaptersReply&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.GetChaptersReply)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  _this->_impl_.chapters_.MergeFrom(from._impl_.chapters_);
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void GetChaptersReply::CopyFrom(const GetChaptersReply& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.GetChaptersReply)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GetChaptersReply::IsInitialized() const {
+  return true;
+}
+
+void GetChaptersReply::InternalSwap(GetChaptersReply* other) {
+  using std::swap;
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  _impl_.chapters_.InternalSwap(&other->_impl_.chapters_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetChaptersReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[62]);
+}
+
+// ===================================================================
+
+class GetChapterRequest::_Internal {
+ public:
+};
+
+GetChapterRequest::GetChapterRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.GetChapterRequest)
+}
+GetChapterRequest::GetChapterRequest(const GetChapterRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  GetChapterRequest* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.GetChapterRequest)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetChapterRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetChapterRequest::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetChapterRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[63]);
+}
+
+// ===================================================================
+
+class GetChapterReply::_Internal {
+ public:
+};
+
+GetChapterReply::GetChapterReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.GetChapterReply)
+}
+GetChapterReply::GetChapterReply(const GetChapterReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  GetChapterReply* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.chapteridx_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  _this->_impl_.chapteridx_ = from._impl_.chapteridx_;
+  // @@protoc_insertion_point(copy_constructor:player.GetChapterReply)
+}
+
+inline void GetChapterReply::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.chapteridx_){0}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+GetChapterReply::~GetChapterReply() {
+  // @@protoc_insertion_point(destructor:player.GetChapterReply)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void GetChapterReply::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+}
+
+void GetChapterReply::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void GetChapterReply::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.GetChapterReply)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.chapteridx_ = 0;
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* GetChapterReply::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // int32 chapterIdx = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
+          _impl_.chapteridx_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint32(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* GetChapterReply::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.GetChapterReply)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // int32 chapterIdx = 1;
+  if (this->_internal_chapteridx() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteInt32ToArray(1, this->_internal_chapteridx(), target);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.GetChapterReply)
+  return target;
+}
+
+size_t GetChapterReply::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.GetChapterReply)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // int32 chapterIdx = 1;
+  if (this->_internal_chapteridx() != 0) {
+    total_size += ::_pbi::WireFormatLite::Int32SizePlusOne(this->_internal_chapteridx());
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetChapterReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    GetChapterReply::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetChapterReply::GetClassData() const { return &_class_data_; }
+
+
+void GetChapterReply::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<GetChapterReply*>(&to_msg);
+  auto& from = static_cast<const GetChapterReply&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.GetChapterReply)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from._internal_chapteridx() != 0) {
+    _this->_internal_set_chapteridx(from._internal_chapteridx());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void GetChapterReply::CopyFrom(const GetChapterReply& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.GetChapterReply)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GetChapterReply::IsInitialized() const {
+  return true;
+}
+
+void GetChapterReply::InternalSwap(GetChapterReply* other) {
+  using std::swap;
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  swap(_impl_.chapteridx_, other->_impl_.chapteridx_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata GetChapterReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[64]);
+}
+
+// ===================================================================
+
+class SetChapterRequest::_Internal {
+ public:
+};
+
+SetChapterRequest::SetChapterRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.SetChapterRequest)
+}
+SetChapterRequest::SetChapterRequest(const SetChapterRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  SetChapterRequest* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.chapteridx_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  _this->_impl_.chapteridx_ = from._impl_.chapteridx_;
+  // @@protoc_insertion_point(copy_constructor:player.SetChapterRequest)
+}
+
+inline void SetChapterRequest::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.chapteridx_){0}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+SetChapterRequest::~SetChapterRequest() {
+  // @@protoc_insertion_point(destructor:player.SetChapterRequest)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void SetChapterRequest::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+}
+
+void SetChapterRequest::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void SetChapterRequest::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.SetChapterRequest)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.chapteridx_ = 0;
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* SetChapterRequest::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // int32 chapterIdx = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
+          _impl_.chapteridx_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint32(&ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* SetChapterRequest::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.SetChapterRequest)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // int32 chapterIdx = 1;
+  if (this->_internal_chapteridx() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteInt32ToArray(1, this->_internal_chapteridx(), target);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.SetChapterRequest)
+  return target;
+}
+
+size_t SetChapterRequest::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.SetChapterRequest)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // int32 chapterIdx = 1;
+  if (this->_internal_chapteridx() != 0) {
+    total_size += ::_pbi::WireFormatLite::Int32SizePlusOne(this->_internal_chapteridx());
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetChapterRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    SetChapterRequest::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetChapterRequest::GetClassData() const { return &_class_data_; }
+
+
+void SetChapterRequest::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<SetChapterRequest*>(&to_msg);
+  auto& from = static_cast<const SetChapterRequest&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.SetChapterRequest)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from._internal_chapteridx() != 0) {
+    _this->_internal_set_chapteridx(from._internal_chapteridx());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void SetChapterRequest::CopyFrom(const SetChapterRequest& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.SetChapterRequest)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool SetChapterRequest::IsInitialized() const {
+  return true;
+}
+
+void SetChapterRequest::InternalSwap(SetChapterRequest* other) {
+  using std::swap;
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  swap(_impl_.chapteridx_, other->_impl_.chapteridx_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata SetChapterRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[65]);
+}
+
+// ===================================================================
+
+class SetChapterReply::_Internal {
+ public:
+};
+
+SetChapterReply::SetChapterReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.SetChapterReply)
+}
+SetChapterReply::SetChapterReply(const SetChapterReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  SetChapterReply* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.SetChapterReply)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SetChapterReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SetChapterReply::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata SetChapterReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[66]);
+}
+
+// ===================================================================
+
+class StopRequest::_Internal {
+ public:
+};
+
+StopRequest::StopRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.StopRequest)
+}
+StopRequest::StopRequest(const StopRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  StopRequest* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.StopRequest)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData StopRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*StopRequest::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata StopRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[67]);
+}
+
+// ===================================================================
+
+class StopReply::_Internal {
+ public:
+};
+
+StopReply::StopReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.StopReply)
+}
+StopReply::StopReply(const StopReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  StopReply* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.StopReply)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData StopReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*StopReply::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata StopReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[68]);
+}
+
+// ===================================================================
+
+class CloseRequest::_Internal {
+ public:
+};
+
+CloseRequest::CloseRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.CloseRequest)
+}
+CloseRequest::CloseRequest(const CloseRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  CloseRequest* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.CloseRequest)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData CloseRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*CloseRequest::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata CloseRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[69]);
+}
+
+// ===================================================================
+
+class CloseReply::_Internal {
+ public:
+};
+
+CloseReply::CloseReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.CloseReply)
+}
+CloseReply::CloseReply(const CloseReply& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  CloseReply* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.CloseReply)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData CloseReply::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*CloseReply::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata CloseReply::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[70]);
+}
+
+// ===================================================================
+
+class EventsRequest::_Internal {
+ public:
+};
+
+EventsRequest::EventsRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.EventsRequest)
+}
+EventsRequest::EventsRequest(const EventsRequest& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  EventsRequest* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.EventsRequest)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData EventsRequest::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*EventsRequest::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata EventsRequest::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[71]);
+}
+
+// ===================================================================
+
+class EventStateChange::_Internal {
+ public:
+};
+
+EventStateChange::EventStateChange(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.EventStateChange)
+}
+EventStateChange::EventStateChange(const EventStateChange& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  EventStateChange* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.state_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  _this->_impl_.state_ = from._impl_.state_;
+  // @@protoc_insertion_point(copy_constructor:player.EventStateChange)
+}
+
+inline void EventStateChange::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.state_){0}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+EventStateChange::~EventStateChange() {
+  // @@protoc_insertion_point(destructor:player.EventStateChange)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void EventStateChange::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+}
+
+void EventStateChange::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void EventStateChange::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.EventStateChange)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.state_ = 0;
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* EventStateChange::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // .player.PlaybackState state = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
+          uint64_t val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+          CHK_(ptr);
+          _internal_set_state(static_cast<::player::PlaybackState>(val));
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* EventStateChange::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.EventStateChange)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .player.PlaybackState state = 1;
+  if (this->_internal_state() != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteEnumToArray(
+      1, this->_internal_state(), target);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.EventStateChange)
+  return target;
+}
+
+size_t EventStateChange::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.EventStateChange)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // .player.PlaybackState state = 1;
+  if (this->_internal_state() != 0) {
+    total_size += 1 +
+      ::_pbi::WireFormatLite::EnumSize(this->_internal_state());
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData EventStateChange::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    EventStateChange::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*EventStateChange::GetClassData() const { return &_class_data_; }
+
+
+void EventStateChange::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<EventStateChange*>(&to_msg);
+  auto& from = static_cast<const EventStateChange&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.EventStateChange)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from._internal_state() != 0) {
+    _this->_internal_set_state(from._internal_state());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void EventStateChange::CopyFrom(const EventStateChange& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.EventStateChange)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool EventStateChange::IsInitialized() const {
+  return true;
+}
+
+void EventStateChange::InternalSwap(EventStateChange* other) {
+  using std::swap;
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  swap(_impl_.state_, other->_impl_.state_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata EventStateChange::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[72]);
+}
+
+// ===================================================================
+
+class EventPosition::_Internal {
+ public:
+};
+
+EventPosition::EventPosition(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.EventPosition)
+}
+EventPosition::EventPosition(const EventPosition& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  EventPosition* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.positionsecs_){}
+    , decltype(_impl_.lengthsecs_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  ::memcpy(&_impl_.positionsecs_, &from._impl_.positionsecs_,
+    static_cast<size_t>(reinterpret_cast<char*>(&_impl_.lengthsecs_) -
+    reinterpret_cast<char*>(&_impl_.positionsecs_)) + sizeof(_impl_.lengthsecs_));
+  // @@protoc_insertion_point(copy_constructor:player.EventPosition)
+}
+
+inline void EventPosition::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.positionsecs_){0}
+    , decltype(_impl_.lengthsecs_){0}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+EventPosition::~EventPosition() {
+  // @@protoc_insertion_point(destructor:player.EventPosition)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void EventPosition::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+}
+
+void EventPosition::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void EventPosition::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.EventPosition)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  ::memset(&_impl_.positionsecs_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&_impl_.lengthsecs_) -
+      reinterpret_cast<char*>(&_impl_.positionsecs_)) + sizeof(_impl_.lengthsecs_));
+  _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
+}
+
+const char* EventPosition::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    uint32_t tag;
+    ptr = ::_pbi::ReadTag(ptr, &tag);
+    switch (tag >> 3) {
+      // double positionSecs = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 9)) {
+          _impl_.positionsecs_ = ::PROTOBUF_NAMESPACE_ID::internal::UnalignedLoad<double>(ptr);
+          ptr += sizeof(double);
+        } else
+          goto handle_unusual;
+        continue;
+      // double lengthSecs = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 17)) {
+          _impl_.lengthsecs_ = ::PROTOBUF_NAMESPACE_ID::internal::UnalignedLoad<double>(ptr);
+          ptr += sizeof(double);
+        } else
+          goto handle_unusual;
+        continue;
+      default:
+        goto handle_unusual;
+    }  // switch
+  handle_unusual:
+    if ((tag == 0) || ((tag & 7) == 4)) {
+      CHK_(ptr);
+      ctx->SetLastTag(tag);
+      goto message_done;
+    }
+    ptr = UnknownFieldParse(
+        tag,
+        _internal_metadata_.mutable_unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(),
+        ptr, ctx);
+    CHK_(ptr != nullptr);
+  }  // while
+message_done:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto message_done;
+#undef CHK_
+}
+
+uint8_t* EventPosition::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.EventPosition)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // double positionSecs = 1;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_positionsecs = this->_internal_positionsecs();
+  uint64_t raw_positionsecs;
+  memcpy(&raw_positionsecs, &tmp_positionsecs, sizeof(tmp_positionsecs));
+  if (raw_positionsecs != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteDoubleToArray(1, this->_internal_positionsecs(), target);
+  }
+
+  // double lengthSecs = 2;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_lengthsecs = this->_internal_lengthsecs();
+  uint64_t raw_lengthsecs;
+  memcpy(&raw_lengthsecs, &tmp_lengthsecs, sizeof(tmp_lengthsecs));
+  if (raw_lengthsecs != 0) {
+    target = stream->EnsureSpace(target);
+    target = ::_pbi::WireFormatLite::WriteDoubleToArray(2, this->_internal_lengthsecs(), target);
+  }
+
+  if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
+    target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:player.EventPosition)
+  return target;
+}
+
+size_t EventPosition::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.EventPosition)
+  size_t total_size = 0;
+
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // double positionSecs = 1;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_positionsecs = this->_internal_positionsecs();
+  uint64_t raw_positionsecs;
+  memcpy(&raw_positionsecs, &tmp_positionsecs, sizeof(tmp_positionsecs));
+  if (raw_positionsecs != 0) {
+    total_size += 1 + 8;
+  }
+
+  // double lengthSecs = 2;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_lengthsecs = this->_internal_lengthsecs();
+  uint64_t raw_lengthsecs;
+  memcpy(&raw_lengthsecs, &tmp_lengthsecs, sizeof(tmp_lengthsecs));
+  if (raw_lengthsecs != 0) {
+    total_size += 1 + 8;
+  }
+
+  return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
+}
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData EventPosition::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
+    EventPosition::MergeImpl
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*EventPosition::GetClassData() const { return &_class_data_; }
+
+
+void EventPosition::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<EventPosition*>(&to_msg);
+  auto& from = static_cast<const EventPosition&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.EventPosition)
+  GOOGLE_DCHECK_NE(&from, _this);
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_positionsecs = from._internal_positionsecs();
+  uint64_t raw_positionsecs;
+  memcpy(&raw_positionsecs, &tmp_positionsecs, sizeof(tmp_positionsecs));
+  if (raw_positionsecs != 0) {
+    _this->_internal_set_positionsecs(from._internal_positionsecs());
+  }
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_lengthsecs = from._internal_lengthsecs();
+  uint64_t raw_lengthsecs;
+  memcpy(&raw_lengthsecs, &tmp_lengthsecs, sizeof(tmp_lengthsecs));
+  if (raw_lengthsecs != 0) {
+    _this->_internal_set_lengthsecs(from._internal_lengthsecs());
+  }
+  _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+}
+
+void EventPosition::CopyFrom(const EventPosition& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.EventPosition)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool EventPosition::IsInitialized() const {
+  return true;
+}
+
+void EventPosition::InternalSwap(EventPosition* other) {
+  using std::swap;
+  _internal_metadata_.InternalSwap(&other->_internal_metadata_);
+  ::PROTOBUF_NAMESPACE_ID::internal::memswap<
+      PROTOBUF_FIELD_OFFSET(EventPosition, _impl_.lengthsecs_)
+      + sizeof(EventPosition::_impl_.lengthsecs_)
+      - PROTOBUF_FIELD_OFFSET(EventPosition, _impl_.positionsecs_)>(
+          reinterpret_cast<char*>(&_impl_.positionsecs_),
+          reinterpret_cast<char*>(&other->_impl_.positionsecs_));
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata EventPosition::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[73]);
+}
+
+// ===================================================================
+
+class EventTracksChange::_Internal {
+ public:
+};
+
+EventTracksChange::EventTracksChange(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.EventTracksChange)
+}
+EventTracksChange::EventTracksChange(const EventTracksChange& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  EventTracksChange* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.EventTracksChange)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData EventTracksChange::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*EventTracksChange::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata EventTracksChange::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[74]);
+}
 
-  ::memset(&_impl_.id_, 0, static_cast<size_t>(
-      reinterpret_cast<char*>(&_impl_.isactive_) -
-      reinterpret_cast<char*>(&_impl_.id_)) + sizeof(_impl_.isactive_));
+// ===================================================================
+
+class EventBuffering::_Internal {
+ public:
+};
+
+EventBuffering::EventBuffering(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
+  SharedCtor(arena, is_message_owned);
+  // @@protoc_insertion_point(arena_constructor:player.EventBuffering)
+}
+EventBuffering::EventBuffering(const EventBuffering& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message() {
+  EventBuffering* const _this = this; (void)_this;
+  new (&_impl_) Impl_{
+      decltype(_impl_.percent_){}
+    , /*decltype(_impl_._cached_size_)*/{}};
+
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  _this->_impl_.percent_ = from._impl_.percent_;
+  // @@protoc_insertion_point(copy_constructor:player.EventBuffering)
+}
+
+inline void EventBuffering::SharedCtor(
+    ::_pb::Arena* arena, bool is_message_owned) {
+  (void)arena;
+  (void)is_message_owned;
+  new (&_impl_) Impl_{
+      decltype(_impl_.percent_){0}
+    , /*decltype(_impl_._cached_size_)*/{}
+  };
+}
+
+EventBuffering::~EventBuffering() {
+  // @@protoc_insertion_point(destructor:player.EventBuffering)
+  if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
+  (void)arena;
+    return;
+  }
+  SharedDtor();
+}
+
+inline void EventBuffering::SharedDtor() {
+  GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+}
+
+void EventBuffering::SetCachedSize(int size) const {
+  _impl_._cached_size_.Set(size);
+}
+
+void EventBuffering::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.EventBuffering)
+  uint32_t cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  _impl_.percent_ = 0;
   _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
 }
 
-const char* AudioTrack::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+const char* EventBuffering::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     uint32_t tag;
     ptr = ::_pbi::ReadTag(ptr, &tag);
     switch (tag >> 3) {
-      // int64 id = 1;
+      // double percent = 1;
       case 1:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
-          _impl_.id_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
-          CHK_(ptr);
-        } else
-          goto handle_unusual;
-        continue;
-      // bool isActive = 2;
-      case 2:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 16)) {
-          _impl_.isactive_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
-          CHK_(ptr);
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 9)) {
+          _impl_.percent_ = ::PROTOBUF_NAMESPACE_ID::internal::UnalignedLoad<double>(ptr);
+          ptr += sizeof(double);
         } else
           goto handle_unusual;
         continue;
@@ -5102,180 +11290,145 @@ failure:
 #undef CHK_
 }
 
-uint8_t* AudioTrack::_InternalSerialize(
+uint8_t* EventBuffering::_InternalSerialize(
     uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
-  // @@protoc_insertion_point(serialize_to_array_start:player.AudioTrack)
+  // @@protoc_insertion_point(serialize_to_array_start:player.EventBuffering)
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // int64 id = 1;
-  if (this->_internal_id() != 0) {
-    target = stream->EnsureSpace(target);
-    target = ::_pbi::WireFormatLite::WriteInt64ToArray(1, this->_internal_id(), target);
-  }
-
-  // bool isActive = 2;
-  if (this->_internal_isactive() != 0) {
+  // double percent = 1;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_percent = this->_internal_percent();
+  uint64_t raw_percent;
+  memcpy(&raw_percent, &tmp_percent, sizeof(tmp_percent));
+  if (raw_percent != 0) {
     target = stream->EnsureSpace(target);
-    target = ::_pbi::WireFormatLite::WriteBoolToArray(2, this->_internal_isactive(), target);
+    target = ::_pbi::WireFormatLite::WriteDoubleToArray(1, this->_internal_percent(), target);
   }
 
   if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
     target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:player.AudioTrack)
+  // @@protoc_insertion_point(serialize_to_array_end:player.EventBuffering)
   return target;
 }
 
-size_t AudioTrack::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:player.AudioTrack)
+size_t EventBuffering::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.EventBuffering)
   size_t total_size = 0;
 
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // int64 id = 1;
-  if (this->_internal_id() != 0) {
-    total_size += ::_pbi::WireFormatLite::Int64SizePlusOne(this->_internal_id());
-  }
-
-  // bool isActive = 2;
-  if (this->_internal_isactive() != 0) {
-    total_size += 1 + 1;
+  // double percent = 1;
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_percent = this->_internal_percent();
+  uint64_t raw_percent;
+  memcpy(&raw_percent, &tmp_percent, sizeof(tmp_percent));
+  if (raw_percent != 0) {
+    total_size += 1 + 8;
   }
 
   return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
 }
 
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData AudioTrack::_class_data_ = {
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData EventBuffering::_class_data_ = {
     ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
-    AudioTrack::MergeImpl
+    EventBuffering::MergeImpl
 };
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*AudioTrack::GetClassData() const { return &_class_data_; }
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*EventBuffering::GetClassData() const { return &_class_data_; }
 
 
-void AudioTrack::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
-  auto* const _this = static_cast<AudioTrack*>(&to_msg);
-  auto& from = static_cast<const AudioTrack&>(from_msg);
-  // @@protoc_insertion_point(class_specific_merge_from_start:player.AudioTrack)
+void EventBuffering::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<EventBuffering*>(&to_msg);
+  auto& from = static_cast<const EventBuffering&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.EventBuffering)
   GOOGLE_DCHECK_NE(&from, _this);
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from._internal_id() != 0) {
-    _this->_internal_set_id(from._internal_id());
-  }
-  if (from._internal_isactive() != 0) {
-    _this->_internal_set_isactive(from._internal_isactive());
+  static_assert(sizeof(uint64_t) == sizeof(double), "Code assumes uint64_t and double are the same size.");
+  double tmp_percent = from._internal_percent();
+  uint64_t raw_percent;
+  memcpy(&raw_percent, &tmp_percent, sizeof(tmp_percent));
+  if (raw_percent != 0) {
+    _this->_internal_set_percent(from._internal_percent());
   }
   _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
 }
 
-void AudioTrack::CopyFrom(const AudioTrack& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:player.AudioTrack)
+void EventBuffering::CopyFrom(const EventBuffering& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.EventBuffering)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool AudioTrack::IsInitialized() const {
+bool EventBuffering::IsInitialized() const {
   return true;
 }
 
-void AudioTrack::InternalSwap(AudioTrack* other) {
+void EventBuffering::InternalSwap(EventBuffering* other) {
   using std::swap;
   _internal_metadata_.InternalSwap(&other->_internal_metadata_);
-  ::PROTOBUF_NAMESPACE_ID::internal::memswap<
-      PROTOBUF_FIELD_OFFSET(AudioTrack, _impl_.isactive_)
-      + sizeof(AudioTrack::_impl_.isactive_)
-      - PROTOBUF_FIELD_OFFSET(AudioTrack, _impl_.id_)>(
-          reinterpret_cast<char*>(&_impl_.id_),
-          reinterpret_cast<char*>(&other->_impl_.id_));
-}
-
-::PROTOBUF_NAMESPACE_ID::Metadata AudioTrack::GetMetadata() const {
-  return ::_pbi::AssignDescriptors(
-      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[35]);
-}
-
-// ===================================================================
-
-class GetAudioTracksRequest::_Internal {
- public:
-};
-
-GetAudioTracksRequest::GetAudioTracksRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
-                         bool is_message_owned)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
-  // @@protoc_insertion_point(arena_constructor:player.GetAudioTracksRequest)
-}
-GetAudioTracksRequest::GetAudioTracksRequest(const GetAudioTracksRequest& from)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
-  GetAudioTracksRequest* const _this = this; (void)_this;
-  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:player.GetAudioTracksRequest)
+  swap(_impl_.percent_, other->_impl_.percent_);
 }
 
-
-
-
-
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetAudioTracksRequest::_class_data_ = {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
-};
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetAudioTracksRequest::GetClassData() const { return &_class_data_; }
-
-
-
-
-
-
-
-::PROTOBUF_NAMESPACE_ID::Metadata GetAudioTracksRequest::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata EventBuffering::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[36]);
+      file_level_metadata_player_2eproto[75]);
 }
 
 // ===================================================================
 
-class GetAudioTracksReply::_Internal {
+class EventError::_Internal {
  public:
 };
 
-GetAudioTracksReply::GetAudioTracksReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+EventError::EventError(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                          bool is_message_owned)
   : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
   SharedCtor(arena, is_message_owned);
-  // @@protoc_insertion_point(arena_constructor:player.GetAudioTracksReply)
+  // @@protoc_insertion_point(arena_constructor:player.EventError)
 }
-GetAudioTracksReply::GetAudioTracksReply(const GetAudioTracksReply& from)
+EventError::EventError(const EventError& from)
   : ::PROTOBUF_NAMESPACE_ID::Message() {
-  GetAudioTracksReply* const _this = this; (void)_this;
+  EventError* const _this = this; (void)_this;
   new (&_impl_) Impl_{
-      decltype(_impl_.audiotrack_){from._impl_.audiotrack_}
+      decltype(_impl_.error_){}
     , /*decltype(_impl_._cached_size_)*/{}};
 
   _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:player.GetAudioTracksReply)
+  _impl_.error_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.error_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (!from._internal_error().empty()) {
+    _this->_impl_.error_.Set(from._internal_error(), 
+      _this->GetArenaForAllocation());
+  }
+  // @@protoc_insertion_point(copy_constructor:player.EventError)
 }
 
-inline void GetAudioTracksReply::SharedCtor(
+inline void EventError::SharedCtor(
     ::_pb::Arena* arena, bool is_message_owned) {
   (void)arena;
   (void)is_message_owned;
   new (&_impl_) Impl_{
-      decltype(_impl_.audiotrack_){arena}
+      decltype(_impl_.error_){}
     , /*decltype(_impl_._cached_size_)*/{}
   };
+  _impl_.error_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.error_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
 }
 
-GetAudioTracksReply::~GetAudioTracksReply() {
-  // @@protoc_insertion_point(destructor:player.GetAudioTracksReply)
+EventError::~EventError() {
+  // @@protoc_insertion_point(destructor:player.EventError)
   if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
   (void)arena;
     return;
@@ -5283,41 +11436,38 @@ GetAudioTracksReply::~GetAudioTracksReply() {
   SharedDtor();
 }
 
-inline void GetAudioTracksReply::SharedDtor() {
+inline void EventError::SharedDtor() {
   GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
-  _impl_.audiotrack_.~RepeatedPtrField();
+  _impl_.error_.Destroy();
 }
 
-void GetAudioTracksReply::SetCachedSize(int size) const {
+void EventError::SetCachedSize(int size) const {
   _impl_._cached_size_.Set(size);
 }
 
-void GetAudioTracksReply::Clear() {
-// @@protoc_insertion_point(message_clear_start:player.GetAudioTracksReply)
+void EventError::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.EventError)
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  _impl_.audiotrack_.Clear();
+  _impl_.error_.ClearToEmpty();
   _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
 }
 
-const char* GetAudioTracksReply::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+const char* EventError::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     uint32_t tag;
     ptr = ::_pbi::ReadTag(ptr, &tag);
     switch (tag >> 3) {
-      // repeated .player.AudioTrack audioTrack = 1;
+      // string error = 1;
       case 1:
         if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 10)) {
-          ptr -= 1;
-          do {
-            ptr += 1;
-            ptr = ctx->ParseMessage(_internal_add_audiotrack(), ptr);
-            CHK_(ptr);
-            if (!ctx->DataAvailable(ptr)) break;
-          } while (::PROTOBUF_NAMESPACE_ID::internal::ExpectTag<10>(ptr));
+          auto str = _internal_mutable_error();
+          ptr = ::_pbi::InlineGreedyStringParser(str, ptr, ctx);
+          CHK_(ptr);
+          CHK_(::_pbi::VerifyUTF8(str, "player.EventError.error"));
         } else
           goto handle_unusual;
         continue;
@@ -5344,128 +11494,184 @@ failure:
 #undef CHK_
 }
 
-uint8_t* GetAudioTracksReply::_InternalSerialize(
+uint8_t* EventError::_InternalSerialize(
     uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
-  // @@protoc_insertion_point(serialize_to_array_start:player.GetAudioTracksReply)
+  // @@protoc_insertion_point(serialize_to_array_start:player.EventError)
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // repeated .player.AudioTrack audioTrack = 1;
-  for (unsigned i = 0,
-      n = static_cast<unsigned>(this->_internal_audiotrack_size()); i < n; i++) {
-    const auto& repfield = this->_internal_audiotrack(i);
-    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
-        InternalWriteMessage(1, repfield, repfield.GetCachedSize(), target, stream);
+  // string error = 1;
+  if (!this->_internal_error().empty()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->_internal_error().data(), static_cast<int>(this->_internal_error().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "player.EventError.error");
+    target = stream->WriteStringMaybeAliased(
+        1, this->_internal_error(), target);
   }
 
   if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
     target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:player.GetAudioTracksReply)
+  // @@protoc_insertion_point(serialize_to_array_end:player.EventError)
   return target;
 }
 
-size_t GetAudioTracksReply::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:player.GetAudioTracksReply)
+size_t EventError::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.EventError)
   size_t total_size = 0;
 
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // repeated .player.AudioTrack audioTrack = 1;
-  total_size += 1UL * this->_internal_audiotrack_size();
-  for (const auto& msg : this->_impl_.audiotrack_) {
-    total_size +=
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(msg);
+  // string error = 1;
+  if (!this->_internal_error().empty()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::StringSize(
+        this->_internal_error());
   }
 
   return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
 }
 
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetAudioTracksReply::_class_data_ = {
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData EventError::_class_data_ = {
     ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
-    GetAudioTracksReply::MergeImpl
+    EventError::MergeImpl
 };
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetAudioTracksReply::GetClassData() const { return &_class_data_; }
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*EventError::GetClassData() const { return &_class_data_; }
 
 
-void GetAudioTracksReply::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
-  auto* const _this = static_cast<GetAudioTracksReply*>(&to_msg);
-  auto& from = static_cast<const GetAudioTracksReply&>(from_msg);
-  // @@protoc_insertion_point(class_specific_merge_from_start:player.GetAudioTracksReply)
+void EventError::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<EventError*>(&to_msg);
+  auto& from = static_cast<const EventError&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.EventError)
   GOOGLE_DCHECK_NE(&from, _this);
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  _this->_impl_.audiotrack_.MergeFrom(from._impl_.audiotrack_);
+  if (!from._internal_error().empty()) {
+    _this->_internal_set_error(from._internal_error());
+  }
   _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
 }
 
-void GetAudioTracksReply::CopyFrom(const GetAudioTracksReply& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:player.GetAudioTracksReply)
+void EventError::CopyFrom(const EventError& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.EventError)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool GetAudioTracksReply::IsInitialized() const {
+bool EventError::IsInitialized() const {
   return true;
 }
 
-void GetAudioTracksReply::InternalSwap(GetAudioTracksReply* other) {
+void EventError::InternalSwap(EventError* other) {
   using std::swap;
+  auto* lhs_arena = GetArenaForAllocation();
+  auto* rhs_arena = other->GetArenaForAllocation();
   _internal_metadata_.InternalSwap(&other->_internal_metadata_);
-  _impl_.audiotrack_.InternalSwap(&other->_impl_.audiotrack_);
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr::InternalSwap(
+      &_impl_.error_, lhs_arena,
+      &other->_impl_.error_, rhs_arena
+  );
 }
 
-::PROTOBUF_NAMESPACE_ID::Metadata GetAudioTracksReply::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata EventError::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[37]);
+      file_level_metadata_player_2eproto[76]);
 }
 
 // ===================================================================
 
-class SubtitlesTrack::_Internal {
+class EventEndOfFile::_Internal {
  public:
 };
 
-SubtitlesTrack::SubtitlesTrack(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+EventEndOfFile::EventEndOfFile(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                         bool is_message_owned)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
+  // @@protoc_insertion_point(arena_constructor:player.EventEndOfFile)
+}
+EventEndOfFile::EventEndOfFile(const EventEndOfFile& from)
+  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
+  EventEndOfFile* const _this = this; (void)_this;
+  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
+  // @@protoc_insertion_point(copy_constructor:player.EventEndOfFile)
+}
+
+
+
+
+
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData EventEndOfFile::_class_data_ = {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
+};
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*EventEndOfFile::GetClassData() const { return &_class_data_; }
+
+
+
+
+
+
+
+::PROTOBUF_NAMESPACE_ID::Metadata EventEndOfFile::GetMetadata() const {
+  return ::_pbi::AssignDescriptors(
+      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
+      file_level_metadata_player_2eproto[77]);
+}
+
+// ===================================================================
+
+class EventMediaChange::_Internal {
+ public:
+};
+
+EventMediaChange::EventMediaChange(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                          bool is_message_owned)
   : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
   SharedCtor(arena, is_message_owned);
-  // @@protoc_insertion_point(arena_constructor:player.SubtitlesTrack)
+  // @@protoc_insertion_point(arena_constructor:player.EventMediaChange)
 }
-SubtitlesTrack::SubtitlesTrack(const SubtitlesTrack& from)
+EventMediaChange::EventMediaChange(const EventMediaChange& from)
   : ::PROTOBUF_NAMESPACE_ID::Message() {
-  SubtitlesTrack* const _this = this; (void)_this;
+  EventMediaChange* const _this = this; (void)_this;
   new (&_impl_) Impl_{
-      decltype(_impl_.id_){}
-    , decltype(_impl_.isactive_){}
+      decltype(_impl_.link_){}
     , /*decltype(_impl_._cached_size_)*/{}};
 
   _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  ::memcpy(&_impl_.id_, &from._impl_.id_,
-    static_cast<size_t>(reinterpret_cast<char*>(&_impl_.isactive_) -
-    reinterpret_cast<char*>(&_impl_.id_)) + sizeof(_impl_.isactive_));
-  // @@protoc_insertion_point(copy_constructor:player.SubtitlesTrack)
+  _impl_.link_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.link_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (!from._internal_link().empty()) {
+    _this->_impl_.link_.Set(from._internal_link(), 
+      _this->GetArenaForAllocation());
+  }
+  // @@protoc_insertion_point(copy_constructor:player.EventMediaChange)
 }
 
-inline void SubtitlesTrack::SharedCtor(
+inline void EventMediaChange::SharedCtor(
     ::_pb::Arena* arena, bool is_message_owned) {
   (void)arena;
   (void)is_message_owned;
   new (&_impl_) Impl_{
-      decltype(_impl_.id_){int64_t{0}}
-    , decltype(_impl_.isactive_){false}
+      decltype(_impl_.link_){}
     , /*decltype(_impl_._cached_size_)*/{}
   };
+  _impl_.link_.InitDefault();
+  #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+    _impl_.link_.Set("", GetArenaForAllocation());
+  #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
 }
 
-SubtitlesTrack::~SubtitlesTrack() {
-  // @@protoc_insertion_point(destructor:player.SubtitlesTrack)
+EventMediaChange::~EventMediaChange() {
+  // @@protoc_insertion_point(destructor:player.EventMediaChange)
   if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
   (void)arena;
     return;
@@ -5473,45 +11679,38 @@ SubtitlesTrack::~SubtitlesTrack() {
   SharedDtor();
 }
 
-inline void SubtitlesTrack::SharedDtor() {
+inline void EventMediaChange::SharedDtor() {
   GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
+  _impl_.link_.Destroy();
 }
 
-void SubtitlesTrack::SetCachedSize(int size) const {
+void EventMediaChange::SetCachedSize(int size) const {
   _impl_._cached_size_.Set(size);
 }
 
-void SubtitlesTrack::Clear() {
-// @@protoc_insertion_point(message_clear_start:player.SubtitlesTrack)
+void EventMediaChange::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.EventMediaChange)
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  ::memset(&_impl_.id_, 0, static_cast<size_t>(
-      reinterpret_cast<char*>(&_impl_.isactive_) -
-      reinterpret_cast<char*>(&_impl_.id_)) + sizeof(_impl_.isactive_));
+  _impl_.link_.ClearToEmpty();
   _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
 }
 
-const char* SubtitlesTrack::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+const char* EventMediaChange::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     uint32_t tag;
     ptr = ::_pbi::ReadTag(ptr, &tag);
     switch (tag >> 3) {
-      // int64 id = 1;
+      // string link = 1;
       case 1:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 8)) {
-          _impl_.id_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
-          CHK_(ptr);
-        } else
-          goto handle_unusual;
-        continue;
-      // bool isActive = 2;
-      case 2:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 16)) {
-          _impl_.isactive_ = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint64(&ptr);
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 10)) {
+          auto str = _internal_mutable_link();
+          ptr = ::_pbi::InlineGreedyStringParser(str, ptr, ctx);
           CHK_(ptr);
+          CHK_(::_pbi::VerifyUTF8(str, "player.EventMediaChange.link"));
         } else
           goto handle_unusual;
         continue;
@@ -5538,180 +11737,316 @@ failure:
 #undef CHK_
 }
 
-uint8_t* SubtitlesTrack::_InternalSerialize(
+uint8_t* EventMediaChange::_InternalSerialize(
     uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
-  // @@protoc_insertion_point(serialize_to_array_start:player.SubtitlesTrack)
+  // @@protoc_insertion_point(serialize_to_array_start:player.EventMediaChange)
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // int64 id = 1;
-  if (this->_internal_id() != 0) {
-    target = stream->EnsureSpace(target);
-    target = ::_pbi::WireFormatLite::WriteInt64ToArray(1, this->_internal_id(), target);
-  }
-
-  // bool isActive = 2;
-  if (this->_internal_isactive() != 0) {
-    target = stream->EnsureSpace(target);
-    target = ::_pbi::WireFormatLite::WriteBoolToArray(2, this->_internal_isactive(), target);
+  // string link = 1;
+  if (!this->_internal_link().empty()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(
+      this->_internal_link().data(), static_cast<int>(this->_internal_link().length()),
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::SERIALIZE,
+      "player.EventMediaChange.link");
+    target = stream->WriteStringMaybeAliased(
+        1, this->_internal_link(), target);
   }
 
   if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
     target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:player.SubtitlesTrack)
+  // @@protoc_insertion_point(serialize_to_array_end:player.EventMediaChange)
   return target;
 }
 
-size_t SubtitlesTrack::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:player.SubtitlesTrack)
+size_t EventMediaChange::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.EventMediaChange)
   size_t total_size = 0;
 
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // int64 id = 1;
-  if (this->_internal_id() != 0) {
-    total_size += ::_pbi::WireFormatLite::Int64SizePlusOne(this->_internal_id());
-  }
-
-  // bool isActive = 2;
-  if (this->_internal_isactive() != 0) {
-    total_size += 1 + 1;
+  // string link = 1;
+  if (!this->_internal_link().empty()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::StringSize(
+        this->_internal_link());
   }
 
   return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
 }
 
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData SubtitlesTrack::_class_data_ = {
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData EventMediaChange::_class_data_ = {
     ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
-    SubtitlesTrack::MergeImpl
+    EventMediaChange::MergeImpl
 };
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*SubtitlesTrack::GetClassData() const { return &_class_data_; }
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*EventMediaChange::GetClassData() const { return &_class_data_; }
 
 
-void SubtitlesTrack::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
-  auto* const _this = static_cast<SubtitlesTrack*>(&to_msg);
-  auto& from = static_cast<const SubtitlesTrack&>(from_msg);
-  // @@protoc_insertion_point(class_specific_merge_from_start:player.SubtitlesTrack)
+void EventMediaChange::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<EventMediaChange*>(&to_msg);
+  auto& from = static_cast<const EventMediaChange&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.EventMediaChange)
   GOOGLE_DCHECK_NE(&from, _this);
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from._internal_id() != 0) {
-    _this->_internal_set_id(from._internal_id());
-  }
-  if (from._internal_isactive() != 0) {
-    _this->_internal_set_isactive(from._internal_isactive());
+  if (!from._internal_link().empty()) {
+    _this->_internal_set_link(from._internal_link());
   }
   _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
 }
 
-void SubtitlesTrack::CopyFrom(const SubtitlesTrack& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:player.SubtitlesTrack)
+void EventMediaChange::CopyFrom(const EventMediaChange& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.EventMediaChange)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool SubtitlesTrack::IsInitialized() const {
+bool EventMediaChange::IsInitialized() const {
   return true;
 }
 
-void SubtitlesTrack::InternalSwap(SubtitlesTrack* other) {
+void EventMediaChange::InternalSwap(EventMediaChange* other) {
   using std::swap;
+  auto* lhs_arena = GetArenaForAllocation();
+  auto* rhs_arena = other->GetArenaForAllocation();
   _internal_metadata_.InternalSwap(&other->_internal_metadata_);
-  ::PROTOBUF_NAMESPACE_ID::internal::memswap<
-      PROTOBUF_FIELD_OFFSET(SubtitlesTrack, _impl_.isactive_)
-      + sizeof(SubtitlesTrack::_impl_.isactive_)
-      - PROTOBUF_FIELD_OFFSET(SubtitlesTrack, _impl_.id_)>(
-          reinterpret_cast<char*>(&_impl_.id_),
-          reinterpret_cast<char*>(&other->_impl_.id_));
+  ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr::InternalSwap(
+      &_impl_.link_, lhs_arena,
+      &other->_impl_.link_, rhs_arena
+  );
 }
 
-::PROTOBUF_NAMESPACE_ID::Metadata SubtitlesTrack::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata EventMediaChange::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[38]);
+      file_level_metadata_player_2eproto[78]);
 }
 
 // ===================================================================
 
-class GetSubtitlesTracksRequest::_Internal {
+class Event::_Internal {
  public:
-};
-
-GetSubtitlesTracksRequest::GetSubtitlesTracksRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
-                         bool is_message_owned)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
-  // @@protoc_insertion_point(arena_constructor:player.GetSubtitlesTracksRequest)
-}
-GetSubtitlesTracksRequest::GetSubtitlesTracksRequest(const GetSubtitlesTracksRequest& from)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
-  GetSubtitlesTracksRequest* const _this = this; (void)_this;
-  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:player.GetSubtitlesTracksRequest)
-}
-
-
-
-
-
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetSubtitlesTracksRequest::_class_data_ = {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
-};
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetSubtitlesTracksRequest::GetClassData() const { return &_class_data_; }
-
-
-
-
-
-
-
-::PROTOBUF_NAMESPACE_ID::Metadata GetSubtitlesTracksRequest::GetMetadata() const {
-  return ::_pbi::AssignDescriptors(
-      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[39]);
+  static const ::player::EventStateChange& statechange(const Event* msg);
+  static const ::player::EventPosition& position(const Event* msg);
+  static const ::player::EventTracksChange& trackschange(const Event* msg);
+  static const ::player::EventBuffering& buffering(const Event* msg);
+  static const ::player::EventError& error(const Event* msg);
+  static const ::player::EventEndOfFile& endoffile(const Event* msg);
+  static const ::player::EventMediaChange& mediachange(const Event* msg);
+};
+
+const ::player::EventStateChange&
+Event::_Internal::statechange(const Event* msg) {
+  return *msg->_impl_.event_.statechange_;
+}
+const ::player::EventPosition&
+Event::_Internal::position(const Event* msg) {
+  return *msg->_impl_.event_.position_;
+}
+const ::player::EventTracksChange&
+Event::_Internal::trackschange(const Event* msg) {
+  return *msg->_impl_.event_.trackschange_;
+}
+const ::player::EventBuffering&
+Event::_Internal::buffering(const Event* msg) {
+  return *msg->_impl_.event_.buffering_;
+}
+const ::player::EventError&
+Event::_Internal::error(const Event* msg) {
+  return *msg->_impl_.event_.error_;
+}
+const ::player::EventEndOfFile&
+Event::_Internal::endoffile(const Event* msg) {
+  return *msg->_impl_.event_.endoffile_;
+}
+const ::player::EventMediaChange&
+Event::_Internal::mediachange(const Event* msg) {
+  return *msg->_impl_.event_.mediachange_;
+}
+void Event::set_allocated_statechange(::player::EventStateChange* statechange) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaForAllocation();
+  clear_event();
+  if (statechange) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena =
+      ::PROTOBUF_NAMESPACE_ID::Arena::InternalGetOwningArena(statechange);
+    if (message_arena != submessage_arena) {
+      statechange = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, statechange, submessage_arena);
+    }
+    set_has_statechange();
+    _impl_.event_.statechange_ = statechange;
+  }
+  // @@protoc_insertion_point(field_set_allocated:player.Event.stateChange)
+}
+void Event::set_allocated_position(::player::EventPosition* position) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaForAllocation();
+  clear_event();
+  if (position) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena =
+      ::PROTOBUF_NAMESPACE_ID::Arena::InternalGetOwningArena(position);
+    if (message_arena != submessage_arena) {
+      position = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, position, submessage_arena);
+    }
+    set_has_position();
+    _impl_.event_.position_ = position;
+  }
+  // @@protoc_insertion_point(field_set_allocated:player.Event.position)
+}
+void Event::set_allocated_trackschange(::player::EventTracksChange* trackschange) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaForAllocation();
+  clear_event();
+  if (trackschange) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena =
+      ::PROTOBUF_NAMESPACE_ID::Arena::InternalGetOwningArena(trackschange);
+    if (message_arena != submessage_arena) {
+      trackschange = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, trackschange, submessage_arena);
+    }
+    set_has_trackschange();
+    _impl_.event_.trackschange_ = trackschange;
+  }
+  // @@protoc_insertion_point(field_set_allocated:player.Event.tracksChange)
+}
+void Event::set_allocated_buffering(::player::EventBuffering* buffering) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaForAllocation();
+  clear_event();
+  if (buffering) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena =
+      ::PROTOBUF_NAMESPACE_ID::Arena::InternalGetOwningArena(buffering);
+    if (message_arena != submessage_arena) {
+      buffering = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, buffering, submessage_arena);
+    }
+    set_has_buffering();
+    _impl_.event_.buffering_ = buffering;
+  }
+  // @@protoc_insertion_point(field_set_allocated:player.Event.buffering)
+}
+void Event::set_allocated_error(::player::EventError* error) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaForAllocation();
+  clear_event();
+  if (error) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena =
+      ::PROTOBUF_NAMESPACE_ID::Arena::InternalGetOwningArena(error);
+    if (message_arena != submessage_arena) {
+      error = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, error, submessage_arena);
+    }
+    set_has_error();
+    _impl_.event_.error_ = error;
+  }
+  // @@protoc_insertion_point(field_set_allocated:player.Event.error)
+}
+void Event::set_allocated_endoffile(::player::EventEndOfFile* endoffile) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaForAllocation();
+  clear_event();
+  if (endoffile) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena =
+      ::PROTOBUF_NAMESPACE_ID::Arena::InternalGetOwningArena(endoffile);
+    if (message_arena != submessage_arena) {
+      endoffile = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, endoffile, submessage_arena);
+    }
+    set_has_endoffile();
+    _impl_.event_.endoffile_ = endoffile;
+  }
+  // @@protoc_insertion_point(field_set_allocated:player.Event.endOfFile)
+}
+void Event::set_allocated_mediachange(::player::EventMediaChange* mediachange) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaForAllocation();
+  clear_event();
+  if (mediachange) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena =
+      ::PROTOBUF_NAMESPACE_ID::Arena::InternalGetOwningArena(mediachange);
+    if (message_arena != submessage_arena) {
+      mediachange = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, mediachange, submessage_arena);
+    }
+    set_has_mediachange();
+    _impl_.event_.mediachange_ = mediachange;
+  }
+  // @@protoc_insertion_point(field_set_allocated:player.Event.mediaChange)
 }
-
-// ===================================================================
-
-class GetSubtitlesTracksReply::_Internal {
- public:
-};
-
-GetSubtitlesTracksReply::GetSubtitlesTracksReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+Event::Event(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                          bool is_message_owned)
   : ::PROTOBUF_NAMESPACE_ID::Message(arena, is_message_owned) {
   SharedCtor(arena, is_message_owned);
-  // @@protoc_insertion_point(arena_constructor:player.GetSubtitlesTracksReply)
+  // @@protoc_insertion_point(arena_constructor:player.Event)
 }
-GetSubtitlesTracksReply::GetSubtitlesTracksReply(const GetSubtitlesTracksReply& from)
+Event::Event(const Event& from)
   : ::PROTOBUF_NAMESPACE_ID::Message() {
-  GetSubtitlesTracksReply* const _this = this; (void)_this;
+  Event* const _this = this; (void)_this;
   new (&_impl_) Impl_{
-      decltype(_impl_.subtitlestrack_){from._impl_.subtitlestrack_}
-    , /*decltype(_impl_._cached_size_)*/{}};
+      decltype(_impl_.event_){}
+    , /*decltype(_impl_._cached_size_)*/{}
+    , /*decltype(_impl_._oneof_case_)*/{}};
 
   _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:player.GetSubtitlesTracksReply)
+  clear_has_event();
+  switch (from.event_case()) {
+    case kStateChange: {
+      _this->_internal_mutable_statechange()->::player::EventStateChange::MergeFrom(
+          from._internal_statechange());
+      break;
+    }
+    case kPosition: {
+      _this->_internal_mutable_position()->::player::EventPosition::MergeFrom(
+          from._internal_position());
+      break;
+    }
+    case kTracksChange: {
+      _this->_internal_mutable_trackschange()->::player::EventTracksChange::MergeFrom(
+          from._internal_trackschange());
+      break;
+    }
+    case kBuffering: {
+      _this->_internal_mutable_buffering()->::player::EventBuffering::MergeFrom(
+          from._internal_buffering());
+      break;
+    }
+    case kError: {
+      _this->_internal_mutable_error()->::player::EventError::MergeFrom(
+          from._internal_error());
+      break;
+    }
+    case kEndOfFile: {
+      _this->_internal_mutable_endoffile()->::player::EventEndOfFile::MergeFrom(
+          from._internal_endoffile());
+      break;
+    }
+    case kMediaChange: {
+      _this->_internal_mutable_mediachange()->::player::EventMediaChange::MergeFrom(
+          from._internal_mediachange());
+      break;
+    }
+    case EVENT_NOT_SET: {
+      break;
+    }
+  }
+  // @@protoc_insertion_point(copy_constructor:player.Event)
 }
 
-inline void GetSubtitlesTracksReply::SharedCtor(
+inline void Event::SharedCtor(
     ::_pb::Arena* arena, bool is_message_owned) {
   (void)arena;
   (void)is_message_owned;
   new (&_impl_) Impl_{
-      decltype(_impl_.subtitlestrack_){arena}
+      decltype(_impl_.event_){}
     , /*decltype(_impl_._cached_size_)*/{}
+    , /*decltype(_impl_._oneof_case_)*/{}
   };
+  clear_has_event();
 }
 
-GetSubtitlesTracksReply::~GetSubtitlesTracksReply() {
-  // @@protoc_insertion_point(destructor:player.GetSubtitlesTracksReply)
+Event::~Event() {
+  // @@protoc_insertion_point(destructor:player.Event)
   if (auto *arena = _internal_metadata_.DeleteReturnArena<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>()) {
   (void)arena;
     return;
@@ -5719,41 +12054,139 @@ GetSubtitlesTracksReply::~GetSubtitlesTracksReply() {
   SharedDtor();
 }
 
-inline void GetSubtitlesTracksReply::SharedDtor() {
+inline void Event::SharedDtor() {
   GOOGLE_DCHECK(GetArenaForAllocation() == nullptr);
-  _impl_.subtitlestrack_.~RepeatedPtrField();
+  if (has_event()) {
+    clear_event();
+  }
 }
 
-void GetSubtitlesTracksReply::SetCachedSize(int size) const {
+void Event::SetCachedSize(int size) const {
   _impl_._cached_size_.Set(size);
 }
 
-void GetSubtitlesTracksReply::Clear() {
-// @@protoc_insertion_point(message_clear_start:player.GetSubtitlesTracksReply)
+void Event::clear_event() {
+// @@protoc_insertion_point(one_of_clear_start:player.Event)
+  switch (event_case()) {
+    case kStateChange: {
+      if (GetArenaForAllocation() == nullptr) {
+        delete _impl_.event_.statechange_;
+      }
+      break;
+    }
+    case kPosition: {
+      if (GetArenaForAllocation() == nullptr) {
+        delete _impl_.event_.position_;
+      }
+      break;
+    }
+    case kTracksChange: {
+      if (GetArenaForAllocation() == nullptr) {
+        delete _impl_.event_.trackschange_;
+      }
+      break;
+    }
+    case kBuffering: {
+      if (GetArenaForAllocation() == nullptr) {
+        delete _impl_.event_.buffering_;
+      }
+      break;
+    }
+    case kError: {
+      if (GetArenaForAllocation() == nullptr) {
+        delete _impl_.event_.error_;
+      }
+      break;
+    }
+    case kEndOfFile: {
+      if (GetArenaForAllocation() == nullptr) {
+        delete _impl_.event_.endoffile_;
+      }
+      break;
+    }
+    case kMediaChange: {
+      if (GetArenaForAllocation() == nullptr) {
+        delete _impl_.event_.mediachange_;
+      }
+      break;
+    }
+    case EVENT_NOT_SET: {
+      break;
+    }
+  }
+  _impl_._oneof_case_[0] = EVENT_NOT_SET;
+}
+
+
+void Event::Clear() {
+// @@protoc_insertion_point(message_clear_start:player.Event)
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  _impl_.subtitlestrack_.Clear();
+  clear_event();
   _internal_metadata_.Clear<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>();
 }
 
-const char* GetSubtitlesTracksReply::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
+const char* Event::_InternalParse(const char* ptr, ::_pbi::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     uint32_t tag;
     ptr = ::_pbi::ReadTag(ptr, &tag);
     switch (tag >> 3) {
-      // repeated .player.SubtitlesTrack subtitlesTrack = 1;
+      // .player.EventStateChange stateChange = 1;
       case 1:
         if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 10)) {
-          ptr -= 1;
-          do {
-            ptr += 1;
-            ptr = ctx->ParseMessage(_internal_add_subtitlestrack(), ptr);
-            CHK_(ptr);
-            if (!ctx->DataAvailable(ptr)) break;
-          } while (::PROTOBUF_NAMESPACE_ID::internal::ExpectTag<10>(ptr));
+          ptr = ctx->ParseMessage(_internal_mutable_statechange(), ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // .player.EventPosition position = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 18)) {
+          ptr = ctx->ParseMessage(_internal_mutable_position(), ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // .player.EventTracksChange tracksChange = 3;
+      case 3:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 26)) {
+          ptr = ctx->ParseMessage(_internal_mutable_trackschange(), ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // .player.EventBuffering buffering = 4;
+      case 4:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 34)) {
+          ptr = ctx->ParseMessage(_internal_mutable_buffering(), ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // .player.EventError error = 5;
+      case 5:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 42)) {
+          ptr = ctx->ParseMessage(_internal_mutable_error(), ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // .player.EventEndOfFile endOfFile = 6;
+      case 6:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 50)) {
+          ptr = ctx->ParseMessage(_internal_mutable_endoffile(), ptr);
+          CHK_(ptr);
+        } else
+          goto handle_unusual;
+        continue;
+      // .player.EventMediaChange mediaChange = 7;
+      case 7:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<uint8_t>(tag) == 58)) {
+          ptr = ctx->ParseMessage(_internal_mutable_mediachange(), ptr);
+          CHK_(ptr);
         } else
           goto handle_unusual;
         continue;
@@ -5780,246 +12213,214 @@ failure:
 #undef CHK_
 }
 
-uint8_t* GetSubtitlesTracksReply::_InternalSerialize(
-    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
-  // @@protoc_insertion_point(serialize_to_array_start:player.GetSubtitlesTracksReply)
-  uint32_t cached_has_bits = 0;
-  (void) cached_has_bits;
+uint8_t* Event::_InternalSerialize(
+    uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const {
+  // @@protoc_insertion_point(serialize_to_array_start:player.Event)
+  uint32_t cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .player.EventStateChange stateChange = 1;
+  if (_internal_has_statechange()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessage(1, _Internal::statechange(this),
+        _Internal::statechange(this).GetCachedSize(), target, stream);
+  }
+
+  // .player.EventPosition position = 2;
+  if (_internal_has_position()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessage(2, _Internal::position(this),
+        _Internal::position(this).GetCachedSize(), target, stream);
+  }
+
+  // .player.EventTracksChange tracksChange = 3;
+  if (_internal_has_trackschange()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessage(3, _Internal::trackschange(this),
+        _Internal::trackschange(this).GetCachedSize(), target, stream);
+  }
+
+  // .player.EventBuffering buffering = 4;
+  if (_internal_has_buffering()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessage(4, _Internal::buffering(this),
+        _Internal::buffering(this).GetCachedSize(), target, stream);
+  }
 
-  // repeated .player.SubtitlesTrack subtitlesTrack = 1;
-  for (unsigned i = 0,
-      n = static_cast<unsigned>(this->_internal_subtitlestrack_size()); i < n; i++) {
-    const auto& repfield = this->_internal_subtitlestrack(i);
+  // .player.EventError error = 5;
+  if (_internal_has_error()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
-        InternalWriteMessage(1, repfield, repfield.GetCachedSize(), target, stream);
+      InternalWriteMessage(5, _Internal::error(this),
+        _Internal::error(this).GetCachedSize(), target, stream);
+  }
+
+  // .player.EventEndOfFile endOfFile = 6;
+  if (_internal_has_endoffile()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessage(6, _Internal::endoffile(this),
+        _Internal::endoffile(this).GetCachedSize(), target, stream);
+  }
+
+  // .player.EventMediaChange mediaChange = 7;
+  if (_internal_has_mediachange()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessage(7, _Internal::mediachange(this),
+        _Internal::mediachange(this).GetCachedSize(), target, stream);
   }
 
   if (PROTOBUF_PREDICT_FALSE(_internal_metadata_.have_unknown_fields())) {
     target = ::_pbi::WireFormat::InternalSerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(::PROTOBUF_NAMESPACE_ID::UnknownFieldSet::default_instance), target, stream);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:player.GetSubtitlesTracksReply)
+  // @@protoc_insertion_point(serialize_to_array_end:player.Event)
   return target;
 }
 
-size_t GetSubtitlesTracksReply::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:player.GetSubtitlesTracksReply)
+size_t Event::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:player.Event)
   size_t total_size = 0;
 
   uint32_t cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // repeated .player.SubtitlesTrack subtitlesTrack = 1;
-  total_size += 1UL * this->_internal_subtitlestrack_size();
-  for (const auto& msg : this->_impl_.subtitlestrack_) {
-    total_size +=
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(msg);
+  switch (event_case()) {
+    // .player.EventStateChange stateChange = 1;
+    case kStateChange: {
+      total_size += 1 +
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+          *_impl_.event_.statechange_);
+      break;
+    }
+    // .player.EventPosition position = 2;
+    case kPosition: {
+      total_size += 1 +
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+          *_impl_.event_.position_);
+      break;
+    }
+    // .player.EventTracksChange tracksChange = 3;
+    case kTracksChange: {
+      total_size += 1 +
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+          *_impl_.event_.trackschange_);
+      break;
+    }
+    // .player.EventBuffering buffering = 4;
+    case kBuffering: {
+      total_size += 1 +
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+          *_impl_.event_.buffering_);
+      break;
+    }
+    // .player.EventError error = 5;
+    case kError: {
+      total_size += 1 +
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+          *_impl_.event_.error_);
+      break;
+    }
+    // .player.EventEndOfFile endOfFile = 6;
+    case kEndOfFile: {
+      total_size += 1 +
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+          *_impl_.event_.endoffile_);
+      break;
+    }
+    // .player.EventMediaChange mediaChange = 7;
+    case kMediaChange: {
+      total_size += 1 +
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+          *_impl_.event_.mediachange_);
+      break;
+    }
+    case EVENT_NOT_SET: {
+      break;
+    }
   }
-
   return MaybeComputeUnknownFieldsSize(total_size, &_impl_._cached_size_);
 }
 
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData GetSubtitlesTracksReply::_class_data_ = {
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData Event::_class_data_ = {
     ::PROTOBUF_NAMESPACE_ID::Message::CopyWithSourceCheck,
-    GetSubtitlesTracksReply::MergeImpl
+    Event::MergeImpl
 };
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetSubtitlesTracksReply::GetClassData() const { return &_class_data_; }
+const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*Event::GetClassData() const { return &_class_data_; }
 
 
-void GetSubtitlesTracksReply::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
-  auto* const _this = static_cast<GetSubtitlesTracksReply*>(&to_msg);
-  auto& from = static_cast<const GetSubtitlesTracksReply&>(from_msg);
-  // @@protoc_insertion_point(class_specific_merge_from_start:player.GetSubtitlesTracksReply)
+void Event::MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg) {
+  auto* const _this = static_cast<Event*>(&to_msg);
+  auto& from = static_cast<const Event&>(from_msg);
+  // @@protoc_insertion_point(class_specific_merge_from_start:player.Event)
   GOOGLE_DCHECK_NE(&from, _this);
   uint32_t cached_has_bits = 0;
   (void) cached_has_bits;
 
-  _this->_impl_.subtitlestrack_.MergeFrom(from._impl_.subtitlestrack_);
+  switch (from.event_case()) {
+    case kStateChange: {
+      _this->_internal_mutable_statechange()->::player::EventStateChange::MergeFrom(
+          from._internal_statechange());
+      break;
+    }
+    case kPosition: {
+      _this->_internal_mutable_position()->::player::EventPosition::MergeFrom(
+          from._internal_position());
+      break;
+    }
+    case kTracksChange: {
+      _this->_internal_mutable_trackschange()->::player::EventTracksChange::MergeFrom(
+          from._internal_trackschange());
+      break;
+    }
+    case kBuffering: {
+      _this->_internal_mutable_buffering()->::player::EventBuffering::MergeFrom(
+          from._internal_buffering());
+      break;
+    }
+    case kError: {
+      _this->_internal_mutable_error()->::player::EventError::MergeFrom(
+          from._internal_error());
+      break;
+    }
+    case kEndOfFile: {
+      _this->_internal_mutable_endoffile()->::player::EventEndOfFile::MergeFrom(
+          from._internal_endoffile());
+      break;
+    }
+    case kMediaChange: {
+      _this->_internal_mutable_mediachange()->::player::EventMediaChange::MergeFrom(
+          from._internal_mediachange());
+      break;
+    }
+    case EVENT_NOT_SET: {
+      break;
+    }
+  }
   _this->_internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
 }
 
-void GetSubtitlesTracksReply::CopyFrom(const GetSubtitlesTracksReply& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:player.GetSubtitlesTracksReply)
+void Event::CopyFrom(const Event& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:player.Event)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool GetSubtitlesTracksReply::IsInitialized() const {
+bool Event::IsInitialized() const {
   return true;
 }
 
-void GetSubtitlesTracksReply::InternalSwap(GetSubtitlesTracksReply* other) {
+void Event::InternalSwap(Event* other) {
   using std::swap;
   _internal_metadata_.InternalSwap(&other->_internal_metadata_);
-  _impl_.subtitlestrack_.InternalSwap(&other->_impl_.subtitlestrack_);
-}
-
-::PROTOBUF_NAMESPACE_ID::Metadata GetSubtitlesTracksReply::GetMetadata() const {
-  return ::_pbi::AssignDescriptors(
-      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[40]);
-}
-
-// ===================================================================
-
-class StopRequest::_Internal {
- public:
-};
-
-StopRequest::StopRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
-                         bool is_message_owned)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
-  // @@protoc_insertion_point(arena_constructor:player.StopRequest)
-}
-StopRequest::StopRequest(const StopRequest& from)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
-  StopRequest* const _this = this; (void)_this;
-  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:player.StopRequest)
-}
-
-
-
-
-
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData StopRequest::_class_data_ = {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
-};
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*StopRequest::GetClassData() const { return &_class_data_; }
-
-
-
-
-
-
-
-::PROTOBUF_NAMESPACE_ID::Metadata StopRequest::GetMetadata() const {
-  return ::_pbi::AssignDescriptors(
-      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[41]);
-}
-
-// ===================================================================
-
-class StopReply::_Internal {
- public:
-};
-
-StopReply::StopReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
-                         bool is_message_owned)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
-  // @@protoc_insertion_point(arena_constructor:player.StopReply)
-}
-StopReply::StopReply(const StopReply& from)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
-  StopReply* const _this = this; (void)_this;
-  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:player.StopReply)
-}
-
-
-
-
-
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData StopReply::_class_data_ = {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
-};
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*StopReply::GetClassData() const { return &_class_data_; }
-
-
-
-
-
-
-
-::PROTOBUF_NAMESPACE_ID::Metadata StopReply::GetMetadata() const {
-  return ::_pbi::AssignDescriptors(
-      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[42]);
-}
-
-// ===================================================================
-
-class CloseRequest::_Internal {
- public:
-};
-
-CloseRequest::CloseRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
-                         bool is_message_owned)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
-  // @@protoc_insertion_point(arena_constructor:player.CloseRequest)
-}
-CloseRequest::CloseRequest(const CloseRequest& from)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
-  CloseRequest* const _this = this; (void)_this;
-  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:player.CloseRequest)
-}
-
-
-
-
-
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData CloseRequest::_class_data_ = {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
-};
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*CloseRequest::GetClassData() const { return &_class_data_; }
-
-
-
-
-
-
-
-::PROTOBUF_NAMESPACE_ID::Metadata CloseRequest::GetMetadata() const {
-  return ::_pbi::AssignDescriptors(
-      &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[43]);
-}
-
-// ===================================================================
-
-class CloseReply::_Internal {
- public:
-};
-
-CloseReply::CloseReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
-                         bool is_message_owned)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase(arena, is_message_owned) {
-  // @@protoc_insertion_point(arena_constructor:player.CloseReply)
-}
-CloseReply::CloseReply(const CloseReply& from)
-  : ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase() {
-  CloseReply* const _this = this; (void)_this;
-  _internal_metadata_.MergeFrom<::PROTOBUF_NAMESPACE_ID::UnknownFieldSet>(from._internal_metadata_);
-  // @@protoc_insertion_point(copy_constructor:player.CloseReply)
+  swap(_impl_.event_, other->_impl_.event_);
+  swap(_impl_._oneof_case_[0], other->_impl_._oneof_case_[0]);
 }
 
-
-
-
-
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData CloseReply::_class_data_ = {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl,
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl,
-};
-const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*CloseReply::GetClassData() const { return &_class_data_; }
-
-
-
-
-
-
-
-::PROTOBUF_NAMESPACE_ID::Metadata CloseReply::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata Event::GetMetadata() const {
   return ::_pbi::AssignDescriptors(
       &descriptor_table_player_2eproto_getter, &descriptor_table_player_2eproto_once,
-      file_level_metadata_player_2eproto[44]);
+      file_level_metadata_player_2eproto[79]);
 }
 
 // @@protoc_insertion_point(namespace_scope)
@@ -6081,6 +12482,14 @@ template<> PROTOBUF_NOINLINE ::player::GetPositionReply*
 Arena::CreateMaybeMessage< ::player::GetPositionReply >(Arena* arena) {
   return Arena::CreateMessageInternal< ::player::GetPositionReply >(arena);
 }
+template<> PROTOBUF_NOINLINE ::player::GetAudioPositionRequest*
+Arena::CreateMaybeMessage< ::player::GetAudioPositionRequest >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::GetAudioPositionRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::GetAudioPositionReply*
+Arena::CreateMaybeMessage< ::player::GetAudioPositionReply >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::GetAudioPositionReply >(arena);
+}
 template<> PROTOBUF_NOINLINE ::player::GetLengthRequest*
 Arena::CreateMaybeMessage< ::player::GetLengthRequest >(Arena* arena) {
   return Arena::CreateMessageInternal< ::player::GetLengthRequest >(arena);
@@ -6121,6 +12530,38 @@ template<> PROTOBUF_NOINLINE ::player::SetPauseReply*
 Arena::CreateMaybeMessage< ::player::SetPauseReply >(Arena* arena) {
   return Arena::CreateMessageInternal< ::player::SetPauseReply >(arena);
 }
+template<> PROTOBUF_NOINLINE ::player::GetVolumeRequest*
+Arena::CreateMaybeMessage< ::player::GetVolumeRequest >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::GetVolumeRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::GetVolumeReply*
+Arena::CreateMaybeMessage< ::player::GetVolumeReply >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::GetVolumeReply >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::SetVolumeRequest*
+Arena::CreateMaybeMessage< ::player::SetVolumeRequest >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::SetVolumeRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::SetVolumeReply*
+Arena::CreateMaybeMessage< ::player::SetVolumeReply >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::SetVolumeReply >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::GetMuteRequest*
+Arena::CreateMaybeMessage< ::player::GetMuteRequest >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::GetMuteRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::GetMuteReply*
+Arena::CreateMaybeMessage< ::player::GetMuteReply >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::GetMuteReply >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::SetMuteRequest*
+Arena::CreateMaybeMessage< ::player::SetMuteRequest >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::SetMuteRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::SetMuteReply*
+Arena::CreateMaybeMessage< ::player::SetMuteReply >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::SetMuteReply >(arena);
+}
 template<> PROTOBUF_NOINLINE ::player::SetVideoTrackRequest*
 Arena::CreateMaybeMessage< ::player::SetVideoTrackRequest >(Arena* arena) {
   return Arena::CreateMessageInternal< ::player::SetVideoTrackRequest >(arena);
@@ -6145,6 +12586,10 @@ template<> PROTOBUF_NOINLINE ::player::SetSubtitlesTrackReply*
 Arena::CreateMaybeMessage< ::player::SetSubtitlesTrackReply >(Arena* arena) {
   return Arena::CreateMessageInternal< ::player::SetSubtitlesTrackReply >(arena);
 }
+template<> PROTOBUF_NOINLINE ::player::TrackInfo*
+Arena::CreateMaybeMessage< ::player::TrackInfo >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::TrackInfo >(arena);
+}
 template<> PROTOBUF_NOINLINE ::player::VideoTrack*
 Arena::CreateMaybeMessage< ::player::VideoTrack >(Arena* arena) {
   return Arena::CreateMessageInternal< ::player::VideoTrack >(arena);
@@ -6157,6 +12602,22 @@ template<> PROTOBUF_NOINLINE ::player::SeekReply*
 Arena::CreateMaybeMessage< ::player::SeekReply >(Arena* arena) {
   return Arena::CreateMessageInternal< ::player::SeekReply >(arena);
 }
+template<> PROTOBUF_NOINLINE ::player::FrameStepRequest*
+Arena::CreateMaybeMessage< ::player::FrameStepRequest >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::FrameStepRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::FrameStepReply*
+Arena::CreateMaybeMessage< ::player::FrameStepReply >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::FrameStepReply >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::ScreenshotRequest*
+Arena::CreateMaybeMessage< ::player::ScreenshotRequest >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::ScreenshotRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::ScreenshotReply*
+Arena::CreateMaybeMessage< ::player::ScreenshotReply >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::ScreenshotReply >(arena);
+}
 template<> PROTOBUF_NOINLINE ::player::GetVideoTracksRequest*
 Arena::CreateMaybeMessage< ::player::GetVideoTracksRequest >(Arena* arena) {
   return Arena::CreateMessageInternal< ::player::GetVideoTracksRequest >(arena);
@@ -6189,6 +12650,50 @@ template<> PROTOBUF_NOINLINE ::player::GetSubtitlesTracksReply*
 Arena::CreateMaybeMessage< ::player::GetSubtitlesTracksReply >(Arena* arena) {
   return Arena::CreateMessageInternal< ::player::GetSubtitlesTracksReply >(arena);
 }
+template<> PROTOBUF_NOINLINE ::player::Chapter*
+Arena::CreateMaybeMessage< ::player::Chapter >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::Chapter >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::MediaInfo_TagsEntry_DoNotUse*
+Arena::CreateMaybeMessage< ::player::MediaInfo_TagsEntry_DoNotUse >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::MediaInfo_TagsEntry_DoNotUse >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::MediaInfo*
+Arena::CreateMaybeMessage< ::player::MediaInfo >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::MediaInfo >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::GetMediaInfoRequest*
+Arena::CreateMaybeMessage< ::player::GetMediaInfoRequest >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::GetMediaInfoRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::GetMediaInfoReply*
+Arena::CreateMaybeMessage< ::player::GetMediaInfoReply >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::GetMediaInfoReply >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::GetChaptersRequest*
+Arena::CreateMaybeMessage< ::player::GetChaptersRequest >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::GetChaptersRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::GetChaptersReply*
+Arena::CreateMaybeMessage< ::player::GetChaptersReply >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::GetChaptersReply >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::GetChapterRequest*
+Arena::CreateMaybeMessage< ::player::GetChapterRequest >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::GetChapterRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::GetChapterReply*
+Arena::CreateMaybeMessage< ::player::GetChapterReply >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::GetChapterReply >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::SetChapterRequest*
+Arena::CreateMaybeMessage< ::player::SetChapterRequest >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::SetChapterRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::SetChapterReply*
+Arena::CreateMaybeMessage< ::player::SetChapterReply >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::SetChapterReply >(arena);
+}
 template<> PROTOBUF_NOINLINE ::player::StopRequest*
 Arena::CreateMaybeMessage< ::player::StopRequest >(Arena* arena) {
   return Arena::CreateMessageInternal< ::player::StopRequest >(arena);
@@ -6205,6 +12710,42 @@ template<> PROTOBUF_NOINLINE ::player::CloseReply*
 Arena::CreateMaybeMessage< ::player::CloseReply >(Arena* arena) {
   return Arena::CreateMessageInternal< ::player::CloseReply >(arena);
 }
+template<> PROTOBUF_NOINLINE ::player::EventsRequest*
+Arena::CreateMaybeMessage< ::player::EventsRequest >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::EventsRequest >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::EventStateChange*
+Arena::CreateMaybeMessage< ::player::EventStateChange >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::EventStateChange >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::EventPosition*
+Arena::CreateMaybeMessage< ::player::EventPosition >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::EventPosition >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::EventTracksChange*
+Arena::CreateMaybeMessage< ::player::EventTracksChange >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::EventTracksChange >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::EventBuffering*
+Arena::CreateMaybeMessage< ::player::EventBuffering >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::EventBuffering >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::EventError*
+Arena::CreateMaybeMessage< ::player::EventError >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::EventError >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::EventEndOfFile*
+Arena::CreateMaybeMessage< ::player::EventEndOfFile >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::EventEndOfFile >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::EventMediaChange*
+Arena::CreateMaybeMessage< ::player::EventMediaChange >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::EventMediaChange >(arena);
+}
+template<> PROTOBUF_NOINLINE ::player::Event*
+Arena::CreateMaybeMessage< ::player::Event >(Arena* arena) {
+  return Arena::CreateMessageInternal< ::player::Event >(arena);
+}
 PROTOBUF_NAMESPACE_CLOSE
 
 // @@protoc_insertion_point(global_scope)
@@ -30,6 +30,9 @@
 #include <google/protobuf/message.h>
 #include <google/protobuf/repeated_field.h>  // IWYU pragma: export
 #include <google/protobuf/extension_set.h>  // IWYU pragma: export
+#include <google/protobuf/map.h>  // IWYU pragma: export
+#include <google/protobuf/map_entry.h>
+#include <google/protobuf/map_field_inl.h>
 #include <google/protobuf/generated_enum_reflection.h>
 #include <google/protobuf/unknown_field_set.h>
 // @@protoc_insertion_point(includes)
@@ -50,6 +53,9 @@ namespace player {
 class AudioTrack;
 struct AudioTrackDefaultTypeInternal;
 extern AudioTrackDefaultTypeInternal _AudioTrack_default_instance_;
+class Chapter;
+struct ChapterDefaultTypeInternal;
+extern ChapterDefaultTypeInternal _Chapter_default_instance_;
 class CloseReply;
 struct CloseReplyDefaultTypeInternal;
 extern CloseReplyDefaultTypeInternal _CloseReply_default_instance_;
@@ -62,12 +68,63 @@ extern EndChanReplyDefaultTypeInternal _EndChanReply_default_instance_;
 class EndChanRequest;
 struct EndChanRequestDefaultTypeInternal;
 extern EndChanRequestDefaultTypeInternal _EndChanRequest_default_instance_;
+class Event;
+struct EventDefaultTypeInternal;
+extern EventDefaultTypeInternal _Event_default_instance_;
+class EventBuffering;
+struct EventBufferingDefaultTypeInternal;
+extern EventBufferingDefaultTypeInternal _EventBuffering_default_instance_;
+class EventEndOfFile;
+struct EventEndOfFileDefaultTypeInternal;
+extern EventEndOfFileDefaultTypeInternal _EventEndOfFile_default_instance_;
+class EventError;
+struct EventErrorDefaultTypeInternal;
+extern EventErrorDefaultTypeInternal _EventError_default_instance_;
+class EventMediaChange;
+struct EventMediaChangeDefaultTypeInternal;
+extern EventMediaChangeDefaultTypeInternal _EventMediaChange_default_instance_;
+class EventPosition;
+struct EventPositionDefaultTypeInternal;
+extern EventPositionDefaultTypeInternal _EventPosition_default_instance_;
+class EventStateChange;
+struct EventStateChangeDefaultTypeInternal;
+extern EventStateChangeDefaultTypeInternal _EventStateChange_default_instance_;
+class EventTracksChange;
+struct EventTracksChangeDefaultTypeInternal;
+extern EventTracksChangeDefaultTypeInternal _EventTracksChange_default_instance_;
+class EventsRequest;
+struct EventsRequestDefaultTypeInternal;
+extern EventsRequestDefaultTypeInternal _EventsRequest_default_instance_;
+class FrameStepReply;
+struct FrameStepReplyDefaultTypeInternal;
+extern FrameStepReplyDefaultTypeInternal _FrameStepReply_default_instance_;
+class FrameStepRequest;
+struct FrameStepRequestDefaultTypeInternal;
+extern FrameStepRequestDefaultTypeInternal _FrameStepRequest_default_instance_;
+class GetAudioPositionReply;
+struct GetAudioPositionReplyDefaultTypeInternal;
+extern GetAudioPositionReplyDefaultTypeInternal _GetAudioPositionReply_default_instance_;
+class GetAudioPositionRequest;
+struct GetAudioPositionRequestDefaultTypeInternal;
+extern GetAudioPositionRequestDefaultTypeInternal _GetAudioPositionRequest_default_instance_;
 class GetAudioTracksReply;
 struct GetAudioTracksReplyDefaultTypeInternal;
 extern GetAudioTracksReplyDefaultTypeInternal _GetAudioTracksReply_default_instance_;
 class GetAudioTracksRequest;
 struct GetAudioTracksRequestDefaultTypeInternal;
 extern GetAudioTracksRequestDefaultTypeInternal _GetAudioTracksRequest_default_instance_;
+class GetChapterReply;
+struct GetChapterReplyDefaultTypeInternal;
+extern GetChapterReplyDefaultTypeInternal _GetChapterReply_default_instance_;
+class GetChapterRequest;
+struct GetChapterRequestDefaultTypeInternal;
+extern GetChapterRequestDefaultTypeInternal _GetChapterRequest_default_instance_;
+class GetChaptersReply;
+struct GetChaptersReplyDefaultTypeInternal;
+extern GetChaptersReplyDefaultTypeInternal _GetChaptersReply_default_instance_;
+class GetChaptersRequest;
+struct GetChaptersRequestDefaultTypeInternal;
+extern GetChaptersRequestDefaultTypeInternal _GetChaptersRequest_default_instance_;
 class GetLengthReply;
 struct GetLengthReplyDefaultTypeInternal;
 extern GetLengthReplyDefaultTypeInternal _GetLengthReply_default_instance_;
@@ -80,6 +137,18 @@ extern GetLinkReplyDefaultTypeInternal _GetLinkReply_default_instance_;
 class GetLinkRequest;
 struct GetLinkRequestDefaultTypeInternal;
 extern GetLinkRequestDefaultTypeInternal _GetLinkRequest_default_instance_;
+class GetMediaInfoReply;
+struct GetMediaInfoReplyDefaultTypeInternal;
+extern GetMediaInfoReplyDefaultTypeInternal _GetMediaInfoReply_default_instance_;
+class GetMediaInfoRequest;
+struct GetMediaInfoRequestDefaultTypeInternal;
+extern GetMediaInfoRequestDefaultTypeInternal _GetMediaInfoRequest_default_instance_;
+class GetMuteReply;
+struct GetMuteReplyDefaultTypeInternal;
+extern GetMuteReplyDefaultTypeInternal _GetMuteReply_default_instance_;
+class GetMuteRequest;
+struct GetMuteRequestDefaultTypeInternal;
+extern GetMuteRequestDefaultTypeInternal _GetMuteRequest_default_instance_;
 class GetPauseReply;
 struct GetPauseReplyDefaultTypeInternal;
 extern GetPauseReplyDefaultTypeInternal _GetPauseReply_default_instance_;
@@ -110,12 +179,24 @@ extern GetVideoTracksReplyDefaultTypeInternal _GetVideoTracksReply_default_insta
 class GetVideoTracksRequest;
 struct GetVideoTracksRequestDefaultTypeInternal;
 extern GetVideoTracksRequestDefaultTypeInternal _GetVideoTracksRequest_default_instance_;
+class GetVolumeReply;
+struct GetVolumeReplyDefaultTypeInternal;
+extern GetVolumeReplyDefaultTypeInternal _GetVolumeReply_default_instance_;
+class GetVolumeRequest;
+struct GetVolumeRequestDefaultTypeInternal;
+extern GetVolumeRequestDefaultTypeInternal _GetVolumeRequest_default_instance_;
 class IsEndedReply;
 struct IsEndedReplyDefaultTypeInternal;
 extern IsEndedReplyDefaultTypeInternal _IsEndedReply_default_instance_;
 class IsEndedRequest;
 struct IsEndedRequestDefaultTypeInternal;
 extern IsEndedRequestDefaultTypeInternal _IsEndedRequest_default_instance_;
+class MediaInfo;
+struct MediaInfoDefaultTypeInternal;
+extern MediaInfoDefaultTypeInternal _MediaInfo_default_instance_;
+class MediaInfo_TagsEntry_DoNotUse;
+struct MediaInfo_TagsEntry_DoNotUseDefaultTypeInternal;
+extern MediaInfo_TagsEntry_DoNotUseDefaultTypeInternal _MediaInfo_TagsEntry_DoNotUse_default_instance_;
 class OpenReply;
 struct OpenReplyDefaultTypeInternal;
 extern OpenReplyDefaultTypeInternal _OpenReply_default_instance_;
@@ -128,6 +209,12 @@ extern ProcessTitleReplyDefaultTypeInternal _ProcessTitleReply_default_instance_
 class ProcessTitleRequest;
 struct ProcessTitleRequestDefaultTypeInternal;
 extern ProcessTitleRequestDefaultTypeInternal _ProcessTitleRequest_default_instance_;
+class ScreenshotReply;
+struct ScreenshotReplyDefaultTypeInternal;
+extern ScreenshotReplyDefaultTypeInternal _ScreenshotReply_default_instance_;
+class ScreenshotRequest;
+struct ScreenshotRequestDefaultTypeInternal;
+extern ScreenshotRequestDefaultTypeInternal _ScreenshotRequest_default_instance_;
 class SeekReply;
 struct SeekReplyDefaultTypeInternal;
 extern SeekReplyDefaultTypeInternal _SeekReply_default_instance_;
@@ -140,6 +227,18 @@ extern SetAudioTrackReplyDefaultTypeInternal _SetAudioTrackReply_default_instanc
 class SetAudioTrackRequest;
 struct SetAudioTrackRequestDefaultTypeInternal;
 extern SetAudioTrackRequestDefaultTypeInternal _SetAudioTrackRequest_default_instance_;
+class SetChapterReply;
+struct SetChapterReplyDefaultTypeInternal;
+extern SetChapterReplyDefaultTypeInternal _SetChapterReply_default_instance_;
+class SetChapterRequest;
+struct SetChapterRequestDefaultTypeInternal;
+extern SetChapterRequestDefaultTypeInternal _SetChapterRequest_default_instance_;
+class SetMuteReply;
+struct SetMuteReplyDefaultTypeInternal;
+extern SetMuteReplyDefaultTypeInternal _SetMuteReply_default_instance_;
+class SetMuteRequest;
+struct SetMuteRequestDefaultTypeInternal;
+extern SetMuteRequestDefaultTypeInternal _SetMuteRequest_default_instance_;
 class SetPauseReply;
 struct SetPauseReplyDefaultTypeInternal;
 extern SetPauseReplyDefaultTypeInternal _SetPauseReply_default_instance_;
@@ -164,6 +263,12 @@ extern SetVideoTrackReplyDefaultTypeInternal _SetVideoTrackReply_default_instanc
 class SetVideoTrackRequest;
 struct SetVideoTrackRequestDefaultTypeInternal;
 extern SetVideoTrackRequestDefaultTypeInternal _SetVideoTrackRequest_default_instance_;
+class SetVolumeReply;
+struct SetVolumeReplyDefaultTypeInternal;
+extern SetVolumeReplyDefaultTypeInternal _SetVolumeReply_default_instance_;
+class SetVolumeRequest;
+struct SetVolumeRequestDefaultTypeInternal;
+extern SetVolumeRequestDefaultTypeInternal _SetVolumeRequest_default_instance_;
 class SetupForStreamingReply;
 struct SetupForStreamingReplyDefaultTypeInternal;
 extern SetupForStreamingReplyDefaultTypeInternal _SetupForStreamingReply_default_instance_;
@@ -179,22 +284,47 @@ extern StopRequestDefaultTypeInternal _StopRequest_default_instance_;
 class SubtitlesTrack;
 struct SubtitlesTrackDefaultTypeInternal;
 extern SubtitlesTrackDefaultTypeInternal _SubtitlesTrack_default_instance_;
+class TrackInfo;
+struct TrackInfoDefaultTypeInternal;
+extern TrackInfoDefaultTypeInternal _TrackInfo_default_instance_;
 class VideoTrack;
 struct VideoTrackDefaultTypeInternal;
 extern VideoTrackDefaultTypeInternal _VideoTrack_default_instance_;
 }  // namespace player
 PROTOBUF_NAMESPACE_OPEN
 template<> ::player::AudioTrack* Arena::CreateMaybeMessage<::player::AudioTrack>(Arena*);
+template<> ::player::Chapter* Arena::CreateMaybeMessage<::player::Chapter>(Arena*);
 template<> ::player::CloseReply* Arena::CreateMaybeMessage<::player::CloseReply>(Arena*);
 template<> ::player::CloseRequest* Arena::CreateMaybeMessage<::player::CloseRequest>(Arena*);
 template<> ::player::EndChanReply* Arena::CreateMaybeMessage<::player::EndChanReply>(Arena*);
 template<> ::player::EndChanRequest* Arena::CreateMaybeMessage<::player::EndChanRequest>(Arena*);
+template<> ::player::Event* Arena::CreateMaybeMessage<::player::Event>(Arena*);
+template<> ::player::EventBuffering* Arena::CreateMaybeMessage<::player::EventBuffering>(Arena*);
+template<> ::player::EventEndOfFile* Arena::CreateMaybeMessage<::player::EventEndOfFile>(Arena*);
+template<> ::player::EventError* Arena::CreateMaybeMessage<::player::EventError>(Arena*);
+template<> ::player::EventMediaChange* Arena::CreateMaybeMessage<::player::EventMediaChange>(Arena*);
+template<> ::player::EventPosition* Arena::CreateMaybeMessage<::player::EventPosition>(Arena*);
+template<> ::player::EventStateChange* Arena::CreateMaybeMessage<::player::EventStateChange>(Arena*);
+template<> ::player::EventTracksChange* Arena::CreateMaybeMessage<::player::EventTracksChange>(Arena*);
+template<> ::player::EventsRequest* Arena::CreateMaybeMessage<::player::EventsRequest>(Arena*);
+template<> ::player::FrameStepReply* Arena::CreateMaybeMessage<::player::FrameStepReply>(Arena*);
+template<> ::player::FrameStepRequest* Arena::CreateMaybeMessage<::player::FrameStepRequest>(Arena*);
+template<> ::player::GetAudioPositionReply* Arena::CreateMaybeMessage<::player::GetAudioPositionReply>(Arena*);
+template<> ::player::GetAudioPositionRequest* Arena::CreateMaybeMessage<::player::GetAudioPositionRequest>(Arena*);
 template<> ::player::GetAudioTracksReply* Arena::CreateMaybeMessage<::player::GetAudioTracksReply>(Arena*);
 template<> ::player::GetAudioTracksRequest* Arena::CreateMaybeMessage<::player::GetAudioTracksRequest>(Arena*);
+template<> ::player::GetChapterReply* Arena::CreateMaybeMessage<::player::GetChapterReply>(Arena*);
+template<> ::player::GetChapterRequest* Arena::CreateMaybeMessage<::player::GetChapterRequest>(Arena*);
+template<> ::player::GetChaptersReply* Arena::CreateMaybeMessage<::player::GetChaptersReply>(Arena*);
+template<> ::player::GetChaptersRequest* Arena::CreateMaybeMessage<::player::GetChaptersRequest>(Arena*);
 template<> ::player::GetLengthReply* Arena::CreateMaybeMessage<::player::GetLengthReply>(Arena*);
 template<> ::player::GetLengthRequest* Arena::CreateMaybeMessage<::player::GetLengthRequest>(Arena*);
 template<> ::player::GetLinkReply* Arena::CreateMaybeMessage<::player::GetLinkReply>(Arena*);
 template<> ::player::GetLinkRequest* Arena::CreateMaybeMessage<::player::GetLinkRequest>(Arena*);
+template<> ::player::GetMediaInfoReply* Arena::CreateMaybeMessage<::player::GetMediaInfoReply>(Arena*);
+template<> ::player::GetMediaInfoRequest* Arena::CreateMaybeMessage<::player::GetMediaInfoRequest>(Arena*);
+template<> ::player::GetMuteReply* Arena::CreateMaybeMessage<::player::GetMuteReply>(Arena*);
+template<> ::player::GetMuteRequest* Arena::CreateMaybeMessage<::player::GetMuteRequest>(Arena*);
 template<> ::player::GetPauseReply* Arena::CreateMaybeMessage<::player::GetPauseReply>(Arena*);
 template<> ::player::GetPauseRequest* Arena::CreateMaybeMessage<::player::GetPauseRequest>(Arena*);
 template<> ::player::GetPositionReply* Arena::CreateMaybeMessage<::player::GetPositionReply>(Arena*);
@@ -205,16 +335,26 @@ template<> ::player::GetSubtitlesTracksReply* Arena::CreateMaybeMessage<::player
 template<> ::player::GetSubtitlesTracksRequest* Arena::CreateMaybeMessage<::player::GetSubtitlesTracksRequest>(Arena*);
 template<> ::player::GetVideoTracksReply* Arena::CreateMaybeMessage<::player::GetVideoTracksReply>(Arena*);
 template<> ::player::GetVideoTracksRequest* Arena::CreateMaybeMessage<::player::GetVideoTracksRequest>(Arena*);
+template<> ::player::GetVolumeReply* Arena::CreateMaybeMessage<::player::GetVolumeReply>(Arena*);
+template<> ::player::GetVolumeRequest* Arena::CreateMaybeMessage<::player::GetVolumeRequest>(Arena*);
 template<> ::player::IsEndedReply* Arena::CreateMaybeMessage<::player::IsEndedReply>(Arena*);
 template<> ::player::IsEndedRequest* Arena::CreateMaybeMessage<::player::IsEndedRequest>(Arena*);
+template<> ::player::MediaInfo* Arena::CreateMaybeMessage<::player::MediaInfo>(Arena*);
+template<> ::player::MediaInfo_TagsEntry_DoNotUse* Arena::CreateMaybeMessage<::player::MediaInfo_TagsEntry_DoNotUse>(Arena*);
 template<> ::player::OpenReply* Arena::CreateMaybeMessage<::player::OpenReply>(Arena*);
 template<> ::player::OpenRequest* Arena::CreateMaybeMessage<::player::OpenRequest>(Arena*);
 template<> ::player::ProcessTitleReply* Arena::CreateMaybeMessage<::player::ProcessTitleReply>(Arena*);
 template<> ::player::ProcessTitleRequest* Arena::CreateMaybeMessage<::player::ProcessTitleRequest>(Arena*);
+template<> ::player::ScreenshotReply* Arena::CreateMaybeMessage<::player::ScreenshotReply>(Arena*);
+template<> ::player::ScreenshotRequest* Arena::CreateMaybeMessage<::player::ScreenshotRequest>(Arena*);
 template<> ::player::SeekReply* Arena::CreateMaybeMessage<::player::SeekReply>(Arena*);
 template<> ::player::SeekRequest* Arena::CreateMaybeMessage<::player::SeekRequest>(Arena*);
 template<> ::player::SetAudioTrackReply* Arena::CreateMaybeMessage<::player::SetAudioTrackReply>(Arena*);
 template<> ::player::SetAudioTrackRequest* Arena::CreateMaybeMessage<::player::SetAudioTrackRequest>(Arena*);
+template<> ::player::SetChapterReply* Arena::CreateMaybeMessage<::player::SetChapterReply>(Arena*);
+template<> ::player::SetChapterRequest* Arena::CreateMaybeMessage<::player::SetChapterRequest>(Arena*);
+template<> ::player::SetMuteReply* Arena::CreateMaybeMessage<::player::SetMuteReply>(Arena*);
+template<> ::player::SetMuteRequest* Arena::CreateMaybeMessage<::player::SetMuteRequest>(Arena*);
 template<> ::player::SetPauseReply* Arena::CreateMaybeMessage<::player::SetPauseReply>(Arena*);
 template<> ::player::SetPauseRequest* Arena::CreateMaybeMessage<::player::SetPauseRequest>(Arena*);
 template<> ::player::SetSpeedReply* Arena::CreateMaybeMessage<::player::SetSpeedReply>(Arena*);
@@ -223,11 +363,14 @@ template<> ::player::SetSubtitlesTrackReply* Arena::CreateMaybeMessage<::player:
 template<> ::player::SetSubtitlesTrackRequest* Arena::CreateMaybeMessage<::player::SetSubtitlesTrackRequest>(Arena*);
 template<> ::player::SetVideoTrackReply* Arena::CreateMaybeMessage<::player::SetVideoTrackReply>(Arena*);
 template<> ::player::SetVideoTrackRequest* Arena::CreateMaybeMessage<::player::SetVideoTrackRequest>(Arena*);
+template<> ::player::SetVolumeReply* Arena::CreateMaybeMessage<::player::SetVolumeReply>(Arena*);
+template<> ::player::SetVolumeRequest* Arena::CreateMaybeMessage<::player::SetVolumeRequest>(Arena*);
 template<> ::player::SetupForStreamingReply* Arena::CreateMaybeMessage<::player::SetupForStreamingReply>(Arena*);
 template<> ::player::SetupForStreamingRequest* Arena::CreateMaybeMessage<::player::SetupForStreamingRequest>(Arena*);
 template<> ::player::StopReply* Arena::CreateMaybeMessage<::player::StopReply>(Arena*);
 template<> ::player::StopRequest* Arena::CreateMaybeMessage<::player::StopRequest>(Arena*);
 template<> ::player::SubtitlesTrack* Arena::CreateMaybeMessage<::player::SubtitlesTrack>(Arena*);
+template<> ::player::TrackInfo* Arena::CreateMaybeMessage<::player::TrackInfo>(Arena*);
 template<> ::player::VideoTrack* Arena::CreateMaybeMessage<::player::VideoTrack>(Arena*);
 PROTOBUF_NAMESPACE_CLOSE
 namespace player {
@@ -263,6 +406,33 @@ inline bool LoggingLevel_Parse(
   return ::PROTOBUF_NAMESPACE_ID::internal::ParseNamedEnum<LoggingLevel>(
     LoggingLevel_descriptor(), name, value);
 }
+enum PlaybackState : int {
+  PlaybackStateUndefined = 0,
+  PlaybackStateStopped = 1,
+  PlaybackStatePlaying = 2,
+  PlaybackStatePaused = 3,
+  PlaybackState_INT_MIN_SENTINEL_DO_NOT_USE_ = std::numeric_limits<int32_t>::min(),
+  PlaybackState_INT_MAX_SENTINEL_DO_NOT_USE_ = std::numeric_limits<int32_t>::max()
+};
+bool PlaybackState_IsValid(int value);
+constexpr PlaybackState PlaybackState_MIN = PlaybackStateUndefined;
+constexpr PlaybackState PlaybackState_MAX = PlaybackStatePaused;
+constexpr int PlaybackState_ARRAYSIZE = PlaybackState_MAX + 1;
+
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* PlaybackState_descriptor();
+template<typename T>
+inline const std::string& PlaybackState_Name(T enum_t_value) {
+  static_assert(::std::is_same<T, PlaybackState>::value ||
+    ::std::is_integral<T>::value,
+    "Incorrect type passed to function PlaybackState_Name.");
+  return ::PROTOBUF_NAMESPACE_ID::internal::NameOfEnum(
+    PlaybackState_descriptor(), enum_t_value);
+}
+inline bool PlaybackState_Parse(
+    ::PROTOBUF_NAMESPACE_ID::ConstStringParam name, PlaybackState* value) {
+  return ::PROTOBUF_NAMESPACE_ID::internal::ParseNamedEnum<PlaybackState>(
+    PlaybackState_descriptor(), name, value);
+}
 // ===================================================================
 
 class SetupForStreamingRequest final :
@@ -2109,6 +2279,272 @@ class GetPositionReply final :
 };
 // -------------------------------------------------------------------
 
+class GetAudioPositionRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.GetAudioPositionRequest) */ {
+ public:
+  inline GetAudioPositionRequest() : GetAudioPositionRequest(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR GetAudioPositionRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  GetAudioPositionRequest(const GetAudioPositionRequest& from);
+  GetAudioPositionRequest(GetAudioPositionRequest&& from) noexcept
+    : GetAudioPositionRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline GetAudioPositionRequest& operator=(const GetAudioPositionRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline GetAudioPositionRequest& operator=(GetAudioPositionRequest&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const GetAudioPositionRequest& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const GetAudioPositionRequest* internal_default_instance() {
+    return reinterpret_cast<const GetAudioPositionRequest*>(
+               &_GetAudioPositionRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    14;
+
+  friend void swap(GetAudioPositionRequest& a, GetAudioPositionRequest& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(GetAudioPositionRequest* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(GetAudioPositionRequest* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  GetAudioPositionRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetAudioPositionRequest>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const GetAudioPositionRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const GetAudioPositionRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  }
+  public:
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.GetAudioPositionRequest";
+  }
+  protected:
+  explicit GetAudioPositionRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:player.GetAudioPositionRequest)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+  };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GetAudioPositionReply final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.GetAudioPositionReply) */ {
+ public:
+  inline GetAudioPositionReply() : GetAudioPositionReply(nullptr) {}
+  ~GetAudioPositionReply() override;
+  explicit PROTOBUF_CONSTEXPR GetAudioPositionReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  GetAudioPositionReply(const GetAudioPositionReply& from);
+  GetAudioPositionReply(GetAudioPositionReply&& from) noexcept
+    : GetAudioPositionReply() {
+    *this = ::std::move(from);
+  }
+
+  inline GetAudioPositionReply& operator=(const GetAudioPositionReply& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline GetAudioPositionReply& operator=(GetAudioPositionReply&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const GetAudioPositionReply& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const GetAudioPositionReply* internal_default_instance() {
+    return reinterpret_cast<const GetAudioPositionReply*>(
+               &_GetAudioPositionReply_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    15;
+
+  friend void swap(GetAudioPositionReply& a, GetAudioPositionReply& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(GetAudioPositionReply* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(GetAudioPositionReply* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  GetAudioPositionReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetAudioPositionReply>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const GetAudioPositionReply& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const GetAudioPositionReply& from) {
+    GetAudioPositionReply::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(GetAudioPositionReply* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.GetAudioPositionReply";
+  }
+  protected:
+  explicit GetAudioPositionReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kPositionSecsFieldNumber = 1,
+  };
+  // double positionSecs = 1;
+  void clear_positionsecs();
+  double positionsecs() const;
+  void set_positionsecs(double value);
+  private:
+  double _internal_positionsecs() const;
+  void _internal_set_positionsecs(double value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.GetAudioPositionReply)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    double positionsecs_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
 class GetLengthRequest final :
     public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.GetLengthRequest) */ {
  public:
@@ -2156,7 +2592,7 @@ class GetLengthRequest final :
                &_GetLengthRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    14;
+    16;
 
   friend void swap(GetLengthRequest& a, GetLengthRequest& b) {
     a.Swap(&b);
@@ -2275,7 +2711,7 @@ class GetLengthReply final :
                &_GetLengthReply_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    15;
+    17;
 
   friend void swap(GetLengthReply& a, GetLengthReply& b) {
     a.Swap(&b);
@@ -2422,7 +2858,7 @@ class GetSpeedRequest final :
                &_GetSpeedRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    16;
+    18;
 
   friend void swap(GetSpeedRequest& a, GetSpeedRequest& b) {
     a.Swap(&b);
@@ -2541,7 +2977,7 @@ class GetSpeedReply final :
                &_GetSpeedReply_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    17;
+    19;
 
   friend void swap(GetSpeedReply& a, GetSpeedReply& b) {
     a.Swap(&b);
@@ -2689,7 +3125,7 @@ class SetSpeedRequest final :
                &_SetSpeedRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    18;
+    20;
 
   friend void swap(SetSpeedRequest& a, SetSpeedRequest& b) {
     a.Swap(&b);
@@ -2836,7 +3272,7 @@ class SetSpeedReply final :
                &_SetSpeedReply_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    19;
+    21;
 
   friend void swap(SetSpeedReply& a, SetSpeedReply& b) {
     a.Swap(&b);
@@ -2954,7 +3390,7 @@ class GetPauseRequest final :
                &_GetPauseRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    20;
+    22;
 
   friend void swap(GetPauseRequest& a, GetPauseRequest& b) {
     a.Swap(&b);
@@ -3073,7 +3509,7 @@ class GetPauseReply final :
                &_GetPauseReply_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    21;
+    23;
 
   friend void swap(GetPauseReply& a, GetPauseReply& b) {
     a.Swap(&b);
@@ -3221,7 +3657,7 @@ class SetPauseRequest final :
                &_SetPauseRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    22;
+    24;
 
   friend void swap(SetPauseRequest& a, SetPauseRequest& b) {
     a.Swap(&b);
@@ -3368,7 +3804,7 @@ class SetPauseReply final :
                &_SetPauseReply_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    23;
+    25;
 
   friend void swap(SetPauseReply& a, SetPauseReply& b) {
     a.Swap(&b);
@@ -3439,24 +3875,23 @@ class SetPauseReply final :
 };
 // -------------------------------------------------------------------
 
-class SetVideoTrackRequest final :
-    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.SetVideoTrackRequest) */ {
+class GetVolumeRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.GetVolumeRequest) */ {
  public:
-  inline SetVideoTrackRequest() : SetVideoTrackRequest(nullptr) {}
-  ~SetVideoTrackRequest() override;
-  explicit PROTOBUF_CONSTEXPR SetVideoTrackRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline GetVolumeRequest() : GetVolumeRequest(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR GetVolumeRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  SetVideoTrackRequest(const SetVideoTrackRequest& from);
-  SetVideoTrackRequest(SetVideoTrackRequest&& from) noexcept
-    : SetVideoTrackRequest() {
+  GetVolumeRequest(const GetVolumeRequest& from);
+  GetVolumeRequest(GetVolumeRequest&& from) noexcept
+    : GetVolumeRequest() {
     *this = ::std::move(from);
   }
 
-  inline SetVideoTrackRequest& operator=(const SetVideoTrackRequest& from) {
+  inline GetVolumeRequest& operator=(const GetVolumeRequest& from) {
     CopyFrom(from);
     return *this;
   }
-  inline SetVideoTrackRequest& operator=(SetVideoTrackRequest&& from) noexcept {
+  inline GetVolumeRequest& operator=(GetVolumeRequest&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -3479,20 +3914,20 @@ class SetVideoTrackRequest final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const SetVideoTrackRequest& default_instance() {
+  static const GetVolumeRequest& default_instance() {
     return *internal_default_instance();
   }
-  static inline const SetVideoTrackRequest* internal_default_instance() {
-    return reinterpret_cast<const SetVideoTrackRequest*>(
-               &_SetVideoTrackRequest_default_instance_);
+  static inline const GetVolumeRequest* internal_default_instance() {
+    return reinterpret_cast<const GetVolumeRequest*>(
+               &_GetVolumeRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    24;
+    26;
 
-  friend void swap(SetVideoTrackRequest& a, SetVideoTrackRequest& b) {
+  friend void swap(GetVolumeRequest& a, GetVolumeRequest& b) {
     a.Swap(&b);
   }
-  inline void Swap(SetVideoTrackRequest* other) {
+  inline void Swap(GetVolumeRequest* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -3505,7 +3940,7 @@ class SetVideoTrackRequest final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(SetVideoTrackRequest* other) {
+  void UnsafeArenaSwap(GetVolumeRequest* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -3513,40 +3948,26 @@ class SetVideoTrackRequest final :
 
   // implements Message ----------------------------------------------
 
-  SetVideoTrackRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<SetVideoTrackRequest>(arena);
+  GetVolumeRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetVolumeRequest>(arena);
   }
-  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
-  void CopyFrom(const SetVideoTrackRequest& from);
-  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
-  void MergeFrom( const SetVideoTrackRequest& from) {
-    SetVideoTrackRequest::MergeImpl(*this, from);
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const GetVolumeRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const GetVolumeRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
   }
-  private:
-  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
   public:
-  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
-  bool IsInitialized() const final;
-
-  size_t ByteSizeLong() const final;
-  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
-  uint8_t* _InternalSerialize(
-      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
-  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
-
-  private:
-  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
-  void SharedDtor();
-  void SetCachedSize(int size) const final;
-  void InternalSwap(SetVideoTrackRequest* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.SetVideoTrackRequest";
+    return "player.GetVolumeRequest";
   }
   protected:
-  explicit SetVideoTrackRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit GetVolumeRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -3559,19 +3980,7 @@ class SetVideoTrackRequest final :
 
   // accessors -------------------------------------------------------
 
-  enum : int {
-    kVideoTrackIDFieldNumber = 1,
-  };
-  // int64 videoTrackID = 1;
-  void clear_videotrackid();
-  int64_t videotrackid() const;
-  void set_videotrackid(int64_t value);
-  private:
-  int64_t _internal_videotrackid() const;
-  void _internal_set_videotrackid(int64_t value);
-  public:
-
-  // @@protoc_insertion_point(class_scope:player.SetVideoTrackRequest)
+  // @@protoc_insertion_point(class_scope:player.GetVolumeRequest)
  private:
   class _Internal;
 
@@ -3579,31 +3988,29 @@ class SetVideoTrackRequest final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
-    int64_t videotrackid_;
-    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
-  union { Impl_ _impl_; };
   friend struct ::TableStruct_player_2eproto;
 };
 // -------------------------------------------------------------------
 
-class SetVideoTrackReply final :
-    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.SetVideoTrackReply) */ {
+class GetVolumeReply final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.GetVolumeReply) */ {
  public:
-  inline SetVideoTrackReply() : SetVideoTrackReply(nullptr) {}
-  explicit PROTOBUF_CONSTEXPR SetVideoTrackReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline GetVolumeReply() : GetVolumeReply(nullptr) {}
+  ~GetVolumeReply() override;
+  explicit PROTOBUF_CONSTEXPR GetVolumeReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  SetVideoTrackReply(const SetVideoTrackReply& from);
-  SetVideoTrackReply(SetVideoTrackReply&& from) noexcept
-    : SetVideoTrackReply() {
+  GetVolumeReply(const GetVolumeReply& from);
+  GetVolumeReply(GetVolumeReply&& from) noexcept
+    : GetVolumeReply() {
     *this = ::std::move(from);
   }
 
-  inline SetVideoTrackReply& operator=(const SetVideoTrackReply& from) {
+  inline GetVolumeReply& operator=(const GetVolumeReply& from) {
     CopyFrom(from);
     return *this;
   }
-  inline SetVideoTrackReply& operator=(SetVideoTrackReply&& from) noexcept {
+  inline GetVolumeReply& operator=(GetVolumeReply&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -3626,20 +4033,20 @@ class SetVideoTrackReply final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const SetVideoTrackReply& default_instance() {
+  static const GetVolumeReply& default_instance() {
     return *internal_default_instance();
   }
-  static inline const SetVideoTrackReply* internal_default_instance() {
-    return reinterpret_cast<const SetVideoTrackReply*>(
-               &_SetVideoTrackReply_default_instance_);
+  static inline const GetVolumeReply* internal_default_instance() {
+    return reinterpret_cast<const GetVolumeReply*>(
+               &_GetVolumeReply_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    25;
+    27;
 
-  friend void swap(SetVideoTrackReply& a, SetVideoTrackReply& b) {
+  friend void swap(GetVolumeReply& a, GetVolumeReply& b) {
     a.Swap(&b);
   }
-  inline void Swap(SetVideoTrackReply* other) {
+  inline void Swap(GetVolumeReply* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -3652,7 +4059,7 @@ class SetVideoTrackReply final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(SetVideoTrackReply* other) {
+  void UnsafeArenaSwap(GetVolumeReply* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -3660,26 +4067,40 @@ class SetVideoTrackReply final :
 
   // implements Message ----------------------------------------------
 
-  SetVideoTrackReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<SetVideoTrackReply>(arena);
-  }
-  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
-  inline void CopyFrom(const SetVideoTrackReply& from) {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  GetVolumeReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetVolumeReply>(arena);
   }
-  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
-  void MergeFrom(const SetVideoTrackReply& from) {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const GetVolumeReply& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const GetVolumeReply& from) {
+    GetVolumeReply::MergeImpl(*this, from);
   }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
   public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(GetVolumeReply* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.SetVideoTrackReply";
+    return "player.GetVolumeReply";
   }
   protected:
-  explicit SetVideoTrackReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit GetVolumeReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -3692,7 +4113,19 @@ class SetVideoTrackReply final :
 
   // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:player.SetVideoTrackReply)
+  enum : int {
+    kVolumeFieldNumber = 1,
+  };
+  // double volume = 1;
+  void clear_volume();
+  double volume() const;
+  void set_volume(double value);
+  private:
+  double _internal_volume() const;
+  void _internal_set_volume(double value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.GetVolumeReply)
  private:
   class _Internal;
 
@@ -3700,29 +4133,32 @@ class SetVideoTrackReply final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
+    double volume_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
+  union { Impl_ _impl_; };
   friend struct ::TableStruct_player_2eproto;
 };
 // -------------------------------------------------------------------
 
-class SetAudioTrackRequest final :
-    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.SetAudioTrackRequest) */ {
+class SetVolumeRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.SetVolumeRequest) */ {
  public:
-  inline SetAudioTrackRequest() : SetAudioTrackRequest(nullptr) {}
-  ~SetAudioTrackRequest() override;
-  explicit PROTOBUF_CONSTEXPR SetAudioTrackRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline SetVolumeRequest() : SetVolumeRequest(nullptr) {}
+  ~SetVolumeRequest() override;
+  explicit PROTOBUF_CONSTEXPR SetVolumeRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  SetAudioTrackRequest(const SetAudioTrackRequest& from);
-  SetAudioTrackRequest(SetAudioTrackRequest&& from) noexcept
-    : SetAudioTrackRequest() {
+  SetVolumeRequest(const SetVolumeRequest& from);
+  SetVolumeRequest(SetVolumeRequest&& from) noexcept
+    : SetVolumeRequest() {
     *this = ::std::move(from);
   }
 
-  inline SetAudioTrackRequest& operator=(const SetAudioTrackRequest& from) {
+  inline SetVolumeRequest& operator=(const SetVolumeRequest& from) {
     CopyFrom(from);
     return *this;
   }
-  inline SetAudioTrackRequest& operator=(SetAudioTrackRequest&& from) noexcept {
+  inline SetVolumeRequest& operator=(SetVolumeRequest&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -3745,20 +4181,20 @@ class SetAudioTrackRequest final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const SetAudioTrackRequest& default_instance() {
+  static const SetVolumeRequest& default_instance() {
     return *internal_default_instance();
   }
-  static inline const SetAudioTrackRequest* internal_default_instance() {
-    return reinterpret_cast<const SetAudioTrackRequest*>(
-               &_SetAudioTrackRequest_default_instance_);
+  static inline const SetVolumeRequest* internal_default_instance() {
+    return reinterpret_cast<const SetVolumeRequest*>(
+               &_SetVolumeRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    26;
+    28;
 
-  friend void swap(SetAudioTrackRequest& a, SetAudioTrackRequest& b) {
+  friend void swap(SetVolumeRequest& a, SetVolumeRequest& b) {
     a.Swap(&b);
   }
-  inline void Swap(SetAudioTrackRequest* other) {
+  inline void Swap(SetVolumeRequest* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -3771,7 +4207,7 @@ class SetAudioTrackRequest final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(SetAudioTrackRequest* other) {
+  void UnsafeArenaSwap(SetVolumeRequest* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -3779,14 +4215,14 @@ class SetAudioTrackRequest final :
 
   // implements Message ----------------------------------------------
 
-  SetAudioTrackRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<SetAudioTrackRequest>(arena);
+  SetVolumeRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<SetVolumeRequest>(arena);
   }
   using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
-  void CopyFrom(const SetAudioTrackRequest& from);
+  void CopyFrom(const SetVolumeRequest& from);
   using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
-  void MergeFrom( const SetAudioTrackRequest& from) {
-    SetAudioTrackRequest::MergeImpl(*this, from);
+  void MergeFrom( const SetVolumeRequest& from) {
+    SetVolumeRequest::MergeImpl(*this, from);
   }
   private:
   static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
@@ -3804,15 +4240,15 @@ class SetAudioTrackRequest final :
   void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
   void SharedDtor();
   void SetCachedSize(int size) const final;
-  void InternalSwap(SetAudioTrackRequest* other);
+  void InternalSwap(SetVolumeRequest* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.SetAudioTrackRequest";
+    return "player.SetVolumeRequest";
   }
   protected:
-  explicit SetAudioTrackRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit SetVolumeRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -3826,18 +4262,18 @@ class SetAudioTrackRequest final :
   // accessors -------------------------------------------------------
 
   enum : int {
-    kAudioTrackIDFieldNumber = 1,
+    kVolumeFieldNumber = 1,
   };
-  // int64 audioTrackID = 1;
-  void clear_audiotrackid();
-  int64_t audiotrackid() const;
-  void set_audiotrackid(int64_t value);
+  // double volume = 1;
+  void clear_volume();
+  double volume() const;
+  void set_volume(double value);
   private:
-  int64_t _internal_audiotrackid() const;
-  void _internal_set_audiotrackid(int64_t value);
+  double _internal_volume() const;
+  void _internal_set_volume(double value);
   public:
 
-  // @@protoc_insertion_point(class_scope:player.SetAudioTrackRequest)
+  // @@protoc_insertion_point(class_scope:player.SetVolumeRequest)
  private:
   class _Internal;
 
@@ -3845,7 +4281,7 @@ class SetAudioTrackRequest final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
-    int64_t audiotrackid_;
+    double volume_;
     mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
   union { Impl_ _impl_; };
@@ -3853,23 +4289,23 @@ class SetAudioTrackRequest final :
 };
 // -------------------------------------------------------------------
 
-class SetAudioTrackReply final :
-    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.SetAudioTrackReply) */ {
+class SetVolumeReply final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.SetVolumeReply) */ {
  public:
-  inline SetAudioTrackReply() : SetAudioTrackReply(nullptr) {}
-  explicit PROTOBUF_CONSTEXPR SetAudioTrackReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline SetVolumeReply() : SetVolumeReply(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR SetVolumeReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  SetAudioTrackReply(const SetAudioTrackReply& from);
-  SetAudioTrackReply(SetAudioTrackReply&& from) noexcept
-    : SetAudioTrackReply() {
+  SetVolumeReply(const SetVolumeReply& from);
+  SetVolumeReply(SetVolumeReply&& from) noexcept
+    : SetVolumeReply() {
     *this = ::std::move(from);
   }
 
-  inline SetAudioTrackReply& operator=(const SetAudioTrackReply& from) {
+  inline SetVolumeReply& operator=(const SetVolumeReply& from) {
     CopyFrom(from);
     return *this;
   }
-  inline SetAudioTrackReply& operator=(SetAudioTrackReply&& from) noexcept {
+  inline SetVolumeReply& operator=(SetVolumeReply&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -3892,20 +4328,20 @@ class SetAudioTrackReply final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const SetAudioTrackReply& default_instance() {
+  static const SetVolumeReply& default_instance() {
     return *internal_default_instance();
   }
-  static inline const SetAudioTrackReply* internal_default_instance() {
-    return reinterpret_cast<const SetAudioTrackReply*>(
-               &_SetAudioTrackReply_default_instance_);
+  static inline const SetVolumeReply* internal_default_instance() {
+    return reinterpret_cast<const SetVolumeReply*>(
+               &_SetVolumeReply_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    27;
+    29;
 
-  friend void swap(SetAudioTrackReply& a, SetAudioTrackReply& b) {
+  friend void swap(SetVolumeReply& a, SetVolumeReply& b) {
     a.Swap(&b);
   }
-  inline void Swap(SetAudioTrackReply* other) {
+  inline void Swap(SetVolumeReply* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -3918,7 +4354,7 @@ class SetAudioTrackReply final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(SetAudioTrackReply* other) {
+  void UnsafeArenaSwap(SetVolumeReply* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -3926,15 +4362,15 @@ class SetAudioTrackReply final :
 
   // implements Message ----------------------------------------------
 
-  SetAudioTrackReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<SetAudioTrackReply>(arena);
+  SetVolumeReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<SetVolumeReply>(arena);
   }
   using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
-  inline void CopyFrom(const SetAudioTrackReply& from) {
+  inline void CopyFrom(const SetVolumeReply& from) {
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
   }
   using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
-  void MergeFrom(const SetAudioTrackReply& from) {
+  void MergeFrom(const SetVolumeReply& from) {
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
   }
   public:
@@ -3942,10 +4378,10 @@ class SetAudioTrackReply final :
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.SetAudioTrackReply";
+    return "player.SetVolumeReply";
   }
   protected:
-  explicit SetAudioTrackReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit SetVolumeReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -3958,7 +4394,7 @@ class SetAudioTrackReply final :
 
   // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:player.SetAudioTrackReply)
+  // @@protoc_insertion_point(class_scope:player.SetVolumeReply)
  private:
   class _Internal;
 
@@ -3971,24 +4407,23 @@ class SetAudioTrackReply final :
 };
 // -------------------------------------------------------------------
 
-class SetSubtitlesTrackRequest final :
-    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.SetSubtitlesTrackRequest) */ {
+class GetMuteRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.GetMuteRequest) */ {
  public:
-  inline SetSubtitlesTrackRequest() : SetSubtitlesTrackRequest(nullptr) {}
-  ~SetSubtitlesTrackRequest() override;
-  explicit PROTOBUF_CONSTEXPR SetSubtitlesTrackRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline GetMuteRequest() : GetMuteRequest(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR GetMuteRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  SetSubtitlesTrackRequest(const SetSubtitlesTrackRequest& from);
-  SetSubtitlesTrackRequest(SetSubtitlesTrackRequest&& from) noexcept
-    : SetSubtitlesTrackRequest() {
+  GetMuteRequest(const GetMuteRequest& from);
+  GetMuteRequest(GetMuteRequest&& from) noexcept
+    : GetMuteRequest() {
     *this = ::std::move(from);
   }
 
-  inline SetSubtitlesTrackRequest& operator=(const SetSubtitlesTrackRequest& from) {
+  inline GetMuteRequest& operator=(const GetMuteRequest& from) {
     CopyFrom(from);
     return *this;
   }
-  inline SetSubtitlesTrackRequest& operator=(SetSubtitlesTrackRequest&& from) noexcept {
+  inline GetMuteRequest& operator=(GetMuteRequest&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -4011,20 +4446,20 @@ class SetSubtitlesTrackRequest final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const SetSubtitlesTrackRequest& default_instance() {
+  static const GetMuteRequest& default_instance() {
     return *internal_default_instance();
   }
-  static inline const SetSubtitlesTrackRequest* internal_default_instance() {
-    return reinterpret_cast<const SetSubtitlesTrackRequest*>(
-               &_SetSubtitlesTrackRequest_default_instance_);
+  static inline const GetMuteRequest* internal_default_instance() {
+    return reinterpret_cast<const GetMuteRequest*>(
+               &_GetMuteRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    28;
+    30;
 
-  friend void swap(SetSubtitlesTrackRequest& a, SetSubtitlesTrackRequest& b) {
+  friend void swap(GetMuteRequest& a, GetMuteRequest& b) {
     a.Swap(&b);
   }
-  inline void Swap(SetSubtitlesTrackRequest* other) {
+  inline void Swap(GetMuteRequest* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -4037,7 +4472,7 @@ class SetSubtitlesTrackRequest final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(SetSubtitlesTrackRequest* other) {
+  void UnsafeArenaSwap(GetMuteRequest* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -4045,40 +4480,26 @@ class SetSubtitlesTrackRequest final :
 
   // implements Message ----------------------------------------------
 
-  SetSubtitlesTrackRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<SetSubtitlesTrackRequest>(arena);
+  GetMuteRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetMuteRequest>(arena);
   }
-  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
-  void CopyFrom(const SetSubtitlesTrackRequest& from);
-  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
-  void MergeFrom( const SetSubtitlesTrackRequest& from) {
-    SetSubtitlesTrackRequest::MergeImpl(*this, from);
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const GetMuteRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const GetMuteRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
   }
-  private:
-  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
   public:
-  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
-  bool IsInitialized() const final;
-
-  size_t ByteSizeLong() const final;
-  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
-  uint8_t* _InternalSerialize(
-      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
-  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
-
-  private:
-  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
-  void SharedDtor();
-  void SetCachedSize(int size) const final;
-  void InternalSwap(SetSubtitlesTrackRequest* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.SetSubtitlesTrackRequest";
+    return "player.GetMuteRequest";
   }
   protected:
-  explicit SetSubtitlesTrackRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit GetMuteRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -4091,19 +4512,7 @@ class SetSubtitlesTrackRequest final :
 
   // accessors -------------------------------------------------------
 
-  enum : int {
-    kSubtitlesTrackIDFieldNumber = 1,
-  };
-  // int64 subtitlesTrackID = 1;
-  void clear_subtitlestrackid();
-  int64_t subtitlestrackid() const;
-  void set_subtitlestrackid(int64_t value);
-  private:
-  int64_t _internal_subtitlestrackid() const;
-  void _internal_set_subtitlestrackid(int64_t value);
-  public:
-
-  // @@protoc_insertion_point(class_scope:player.SetSubtitlesTrackRequest)
+  // @@protoc_insertion_point(class_scope:player.GetMuteRequest)
  private:
   class _Internal;
 
@@ -4111,31 +4520,29 @@ class SetSubtitlesTrackRequest final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
-    int64_t subtitlestrackid_;
-    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
-  union { Impl_ _impl_; };
   friend struct ::TableStruct_player_2eproto;
 };
 // -------------------------------------------------------------------
 
-class SetSubtitlesTrackReply final :
-    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.SetSubtitlesTrackReply) */ {
+class GetMuteReply final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.GetMuteReply) */ {
  public:
-  inline SetSubtitlesTrackReply() : SetSubtitlesTrackReply(nullptr) {}
-  explicit PROTOBUF_CONSTEXPR SetSubtitlesTrackReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline GetMuteReply() : GetMuteReply(nullptr) {}
+  ~GetMuteReply() override;
+  explicit PROTOBUF_CONSTEXPR GetMuteReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  SetSubtitlesTrackReply(const SetSubtitlesTrackReply& from);
-  SetSubtitlesTrackReply(SetSubtitlesTrackReply&& from) noexcept
-    : SetSubtitlesTrackReply() {
+  GetMuteReply(const GetMuteReply& from);
+  GetMuteReply(GetMuteReply&& from) noexcept
+    : GetMuteReply() {
     *this = ::std::move(from);
   }
 
-  inline SetSubtitlesTrackReply& operator=(const SetSubtitlesTrackReply& from) {
+  inline GetMuteReply& operator=(const GetMuteReply& from) {
     CopyFrom(from);
     return *this;
   }
-  inline SetSubtitlesTrackReply& operator=(SetSubtitlesTrackReply&& from) noexcept {
+  inline GetMuteReply& operator=(GetMuteReply&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -4158,20 +4565,20 @@ class SetSubtitlesTrackReply final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const SetSubtitlesTrackReply& default_instance() {
+  static const GetMuteReply& default_instance() {
     return *internal_default_instance();
   }
-  static inline const SetSubtitlesTrackReply* internal_default_instance() {
-    return reinterpret_cast<const SetSubtitlesTrackReply*>(
-               &_SetSubtitlesTrackReply_default_instance_);
+  static inline const GetMuteReply* internal_default_instance() {
+    return reinterpret_cast<const GetMuteReply*>(
+               &_GetMuteReply_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    29;
+    31;
 
-  friend void swap(SetSubtitlesTrackReply& a, SetSubtitlesTrackReply& b) {
+  friend void swap(GetMuteReply& a, GetMuteReply& b) {
     a.Swap(&b);
   }
-  inline void Swap(SetSubtitlesTrackReply* other) {
+  inline void Swap(GetMuteReply* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -4184,7 +4591,7 @@ class SetSubtitlesTrackReply final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(SetSubtitlesTrackReply* other) {
+  void UnsafeArenaSwap(GetMuteReply* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -4192,26 +4599,40 @@ class SetSubtitlesTrackReply final :
 
   // implements Message ----------------------------------------------
 
-  SetSubtitlesTrackReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<SetSubtitlesTrackReply>(arena);
+  GetMuteReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetMuteReply>(arena);
   }
-  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
-  inline void CopyFrom(const SetSubtitlesTrackReply& from) {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
-  }
-  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
-  void MergeFrom(const SetSubtitlesTrackReply& from) {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const GetMuteReply& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const GetMuteReply& from) {
+    GetMuteReply::MergeImpl(*this, from);
   }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
   public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(GetMuteReply* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.SetSubtitlesTrackReply";
+    return "player.GetMuteReply";
   }
   protected:
-  explicit SetSubtitlesTrackReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit GetMuteReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -4224,7 +4645,19 @@ class SetSubtitlesTrackReply final :
 
   // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:player.SetSubtitlesTrackReply)
+  enum : int {
+    kIsMutedFieldNumber = 1,
+  };
+  // bool isMuted = 1;
+  void clear_ismuted();
+  bool ismuted() const;
+  void set_ismuted(bool value);
+  private:
+  bool _internal_ismuted() const;
+  void _internal_set_ismuted(bool value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.GetMuteReply)
  private:
   class _Internal;
 
@@ -4232,29 +4665,32 @@ class SetSubtitlesTrackReply final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
+    bool ismuted_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
+  union { Impl_ _impl_; };
   friend struct ::TableStruct_player_2eproto;
 };
 // -------------------------------------------------------------------
 
-class VideoTrack final :
-    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.VideoTrack) */ {
+class SetMuteRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.SetMuteRequest) */ {
  public:
-  inline VideoTrack() : VideoTrack(nullptr) {}
-  ~VideoTrack() override;
-  explicit PROTOBUF_CONSTEXPR VideoTrack(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline SetMuteRequest() : SetMuteRequest(nullptr) {}
+  ~SetMuteRequest() override;
+  explicit PROTOBUF_CONSTEXPR SetMuteRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  VideoTrack(const VideoTrack& from);
-  VideoTrack(VideoTrack&& from) noexcept
-    : VideoTrack() {
+  SetMuteRequest(const SetMuteRequest& from);
+  SetMuteRequest(SetMuteRequest&& from) noexcept
+    : SetMuteRequest() {
     *this = ::std::move(from);
   }
 
-  inline VideoTrack& operator=(const VideoTrack& from) {
+  inline SetMuteRequest& operator=(const SetMuteRequest& from) {
     CopyFrom(from);
     return *this;
   }
-  inline VideoTrack& operator=(VideoTrack&& from) noexcept {
+  inline SetMuteRequest& operator=(SetMuteRequest&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -4277,20 +4713,20 @@ class VideoTrack final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const VideoTrack& default_instance() {
+  static const SetMuteRequest& default_instance() {
     return *internal_default_instance();
   }
-  static inline const VideoTrack* internal_default_instance() {
-    return reinterpret_cast<const VideoTrack*>(
-               &_VideoTrack_default_instance_);
+  static inline const SetMuteRequest* internal_default_instance() {
+    return reinterpret_cast<const SetMuteRequest*>(
+               &_SetMuteRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    30;
+    32;
 
-  friend void swap(VideoTrack& a, VideoTrack& b) {
+  friend void swap(SetMuteRequest& a, SetMuteRequest& b) {
     a.Swap(&b);
   }
-  inline void Swap(VideoTrack* other) {
+  inline void Swap(SetMuteRequest* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -4303,7 +4739,7 @@ class VideoTrack final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(VideoTrack* other) {
+  void UnsafeArenaSwap(SetMuteRequest* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -4311,14 +4747,14 @@ class VideoTrack final :
 
   // implements Message ----------------------------------------------
 
-  VideoTrack* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<VideoTrack>(arena);
+  SetMuteRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<SetMuteRequest>(arena);
   }
   using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
-  void CopyFrom(const VideoTrack& from);
+  void CopyFrom(const SetMuteRequest& from);
   using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
-  void MergeFrom( const VideoTrack& from) {
-    VideoTrack::MergeImpl(*this, from);
+  void MergeFrom( const SetMuteRequest& from) {
+    SetMuteRequest::MergeImpl(*this, from);
   }
   private:
   static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
@@ -4336,15 +4772,15 @@ class VideoTrack final :
   void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
   void SharedDtor();
   void SetCachedSize(int size) const final;
-  void InternalSwap(VideoTrack* other);
+  void InternalSwap(SetMuteRequest* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.VideoTrack";
+    return "player.SetMuteRequest";
   }
   protected:
-  explicit VideoTrack(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit SetMuteRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -4358,28 +4794,18 @@ class VideoTrack final :
   // accessors -------------------------------------------------------
 
   enum : int {
-    kIdFieldNumber = 1,
-    kIsActiveFieldNumber = 2,
+    kIsMutedFieldNumber = 1,
   };
-  // int64 id = 1;
-  void clear_id();
-  int64_t id() const;
-  void set_id(int64_t value);
+  // bool isMuted = 1;
+  void clear_ismuted();
+  bool ismuted() const;
+  void set_ismuted(bool value);
   private:
-  int64_t _internal_id() const;
-  void _internal_set_id(int64_t value);
-  public:
-
-  // bool isActive = 2;
-  void clear_isactive();
-  bool isactive() const;
-  void set_isactive(bool value);
-  private:
-  bool _internal_isactive() const;
-  void _internal_set_isactive(bool value);
+  bool _internal_ismuted() const;
+  void _internal_set_ismuted(bool value);
   public:
 
-  // @@protoc_insertion_point(class_scope:player.VideoTrack)
+  // @@protoc_insertion_point(class_scope:player.SetMuteRequest)
  private:
   class _Internal;
 
@@ -4387,8 +4813,7 @@ class VideoTrack final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
-    int64_t id_;
-    bool isactive_;
+    bool ismuted_;
     mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
   union { Impl_ _impl_; };
@@ -4396,24 +4821,23 @@ class VideoTrack final :
 };
 // -------------------------------------------------------------------
 
-class SeekRequest final :
-    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.SeekRequest) */ {
+class SetMuteReply final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.SetMuteReply) */ {
  public:
-  inline SeekRequest() : SeekRequest(nullptr) {}
-  ~SeekRequest() override;
-  explicit PROTOBUF_CONSTEXPR SeekRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline SetMuteReply() : SetMuteReply(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR SetMuteReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  SeekRequest(const SeekRequest& from);
-  SeekRequest(SeekRequest&& from) noexcept
-    : SeekRequest() {
+  SetMuteReply(const SetMuteReply& from);
+  SetMuteReply(SetMuteReply&& from) noexcept
+    : SetMuteReply() {
     *this = ::std::move(from);
   }
 
-  inline SeekRequest& operator=(const SeekRequest& from) {
+  inline SetMuteReply& operator=(const SetMuteReply& from) {
     CopyFrom(from);
     return *this;
   }
-  inline SeekRequest& operator=(SeekRequest&& from) noexcept {
+  inline SetMuteReply& operator=(SetMuteReply&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -4436,20 +4860,20 @@ class SeekRequest final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const SeekRequest& default_instance() {
+  static const SetMuteReply& default_instance() {
     return *internal_default_instance();
   }
-  static inline const SeekRequest* internal_default_instance() {
-    return reinterpret_cast<const SeekRequest*>(
-               &_SeekRequest_default_instance_);
+  static inline const SetMuteReply* internal_default_instance() {
+    return reinterpret_cast<const SetMuteReply*>(
+               &_SetMuteReply_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    31;
+    33;
 
-  friend void swap(SeekRequest& a, SeekRequest& b) {
+  friend void swap(SetMuteReply& a, SetMuteReply& b) {
     a.Swap(&b);
   }
-  inline void Swap(SeekRequest* other) {
+  inline void Swap(SetMuteReply* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -4462,7 +4886,7 @@ class SeekRequest final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(SeekRequest* other) {
+  void UnsafeArenaSwap(SetMuteReply* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -4470,40 +4894,26 @@ class SeekRequest final :
 
   // implements Message ----------------------------------------------
 
-  SeekRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<SeekRequest>(arena);
+  SetMuteReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<SetMuteReply>(arena);
   }
-  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
-  void CopyFrom(const SeekRequest& from);
-  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
-  void MergeFrom( const SeekRequest& from) {
-    SeekRequest::MergeImpl(*this, from);
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const SetMuteReply& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const SetMuteReply& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
   }
-  private:
-  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
   public:
-  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
-  bool IsInitialized() const final;
-
-  size_t ByteSizeLong() const final;
-  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
-  uint8_t* _InternalSerialize(
-      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
-  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
-
-  private:
-  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
-  void SharedDtor();
-  void SetCachedSize(int size) const final;
-  void InternalSwap(SeekRequest* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.SeekRequest";
+    return "player.SetMuteReply";
   }
   protected:
-  explicit SeekRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit SetMuteReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -4516,39 +4926,7 @@ class SeekRequest final :
 
   // accessors -------------------------------------------------------
 
-  enum : int {
-    kPosFieldNumber = 1,
-    kIsRelativeFieldNumber = 2,
-    kIsQuickFieldNumber = 3,
-  };
-  // int64 pos = 1;
-  void clear_pos();
-  int64_t pos() const;
-  void set_pos(int64_t value);
-  private:
-  int64_t _internal_pos() const;
-  void _internal_set_pos(int64_t value);
-  public:
-
-  // bool isRelative = 2;
-  void clear_isrelative();
-  bool isrelative() const;
-  void set_isrelative(bool value);
-  private:
-  bool _internal_isrelative() const;
-  void _internal_set_isrelative(bool value);
-  public:
-
-  // bool isQuick = 3;
-  void clear_isquick();
-  bool isquick() const;
-  void set_isquick(bool value);
-  private:
-  bool _internal_isquick() const;
-  void _internal_set_isquick(bool value);
-  public:
-
-  // @@protoc_insertion_point(class_scope:player.SeekRequest)
+  // @@protoc_insertion_point(class_scope:player.SetMuteReply)
  private:
   class _Internal;
 
@@ -4556,33 +4934,29 @@ class SeekRequest final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
-    int64_t pos_;
-    bool isrelative_;
-    bool isquick_;
-    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
-  union { Impl_ _impl_; };
   friend struct ::TableStruct_player_2eproto;
 };
 // -------------------------------------------------------------------
 
-class SeekReply final :
-    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.SeekReply) */ {
+class SetVideoTrackRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.SetVideoTrackRequest) */ {
  public:
-  inline SeekReply() : SeekReply(nullptr) {}
-  explicit PROTOBUF_CONSTEXPR SeekReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline SetVideoTrackRequest() : SetVideoTrackRequest(nullptr) {}
+  ~SetVideoTrackRequest() override;
+  explicit PROTOBUF_CONSTEXPR SetVideoTrackRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  SeekReply(const SeekReply& from);
-  SeekReply(SeekReply&& from) noexcept
-    : SeekReply() {
+  SetVideoTrackRequest(const SetVideoTrackRequest& from);
+  SetVideoTrackRequest(SetVideoTrackRequest&& from) noexcept
+    : SetVideoTrackRequest() {
     *this = ::std::move(from);
   }
 
-  inline SeekReply& operator=(const SeekReply& from) {
+  inline SetVideoTrackRequest& operator=(const SetVideoTrackRequest& from) {
     CopyFrom(from);
     return *this;
   }
-  inline SeekReply& operator=(SeekReply&& from) noexcept {
+  inline SetVideoTrackRequest& operator=(SetVideoTrackRequest&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -4605,20 +4979,20 @@ class SeekReply final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const SeekReply& default_instance() {
+  static const SetVideoTrackRequest& default_instance() {
     return *internal_default_instance();
   }
-  static inline const SeekReply* internal_default_instance() {
-    return reinterpret_cast<const SeekReply*>(
-               &_SeekReply_default_instance_);
+  static inline const SetVideoTrackRequest* internal_default_instance() {
+    return reinterpret_cast<const SetVideoTrackRequest*>(
+               &_SetVideoTrackRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    32;
+    34;
 
-  friend void swap(SeekReply& a, SeekReply& b) {
+  friend void swap(SetVideoTrackRequest& a, SetVideoTrackRequest& b) {
     a.Swap(&b);
   }
-  inline void Swap(SeekReply* other) {
+  inline void Swap(SetVideoTrackRequest* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -4631,7 +5005,7 @@ class SeekReply final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(SeekReply* other) {
+  void UnsafeArenaSwap(SetVideoTrackRequest* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -4639,26 +5013,40 @@ class SeekReply final :
 
   // implements Message ----------------------------------------------
 
-  SeekReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<SeekReply>(arena);
-  }
-  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
-  inline void CopyFrom(const SeekReply& from) {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  SetVideoTrackRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<SetVideoTrackRequest>(arena);
   }
-  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
-  void MergeFrom(const SeekReply& from) {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const SetVideoTrackRequest& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const SetVideoTrackRequest& from) {
+    SetVideoTrackRequest::MergeImpl(*this, from);
   }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
   public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(SetVideoTrackRequest* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.SeekReply";
+    return "player.SetVideoTrackRequest";
   }
   protected:
-  explicit SeekReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit SetVideoTrackRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -4671,7 +5059,19 @@ class SeekReply final :
 
   // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:player.SeekReply)
+  enum : int {
+    kVideoTrackIDFieldNumber = 1,
+  };
+  // int64 videoTrackID = 1;
+  void clear_videotrackid();
+  int64_t videotrackid() const;
+  void set_videotrackid(int64_t value);
+  private:
+  int64_t _internal_videotrackid() const;
+  void _internal_set_videotrackid(int64_t value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.SetVideoTrackRequest)
  private:
   class _Internal;
 
@@ -4679,28 +5079,31 @@ class SeekReply final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
+    int64_t videotrackid_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
+  union { Impl_ _impl_; };
   friend struct ::TableStruct_player_2eproto;
 };
 // -------------------------------------------------------------------
 
-class GetVideoTracksRequest final :
-    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.GetVideoTracksRequest) */ {
+class SetVideoTrackReply final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.SetVideoTrackReply) */ {
  public:
-  inline GetVideoTracksRequest() : GetVideoTracksRequest(nullptr) {}
-  explicit PROTOBUF_CONSTEXPR GetVideoTracksRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline SetVideoTrackReply() : SetVideoTrackReply(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR SetVideoTrackReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  GetVideoTracksRequest(const GetVideoTracksRequest& from);
-  GetVideoTracksRequest(GetVideoTracksRequest&& from) noexcept
-    : GetVideoTracksRequest() {
+  SetVideoTrackReply(const SetVideoTrackReply& from);
+  SetVideoTrackReply(SetVideoTrackReply&& from) noexcept
+    : SetVideoTrackReply() {
     *this = ::std::move(from);
   }
 
-  inline GetVideoTracksRequest& operator=(const GetVideoTracksRequest& from) {
+  inline SetVideoTrackReply& operator=(const SetVideoTrackReply& from) {
     CopyFrom(from);
     return *this;
   }
-  inline GetVideoTracksRequest& operator=(GetVideoTracksRequest&& from) noexcept {
+  inline SetVideoTrackReply& operator=(SetVideoTrackReply&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -4723,20 +5126,20 @@ class GetVideoTracksRequest final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const GetVideoTracksRequest& default_instance() {
+  static const SetVideoTrackReply& default_instance() {
     return *internal_default_instance();
   }
-  static inline const GetVideoTracksRequest* internal_default_instance() {
-    return reinterpret_cast<const GetVideoTracksRequest*>(
-               &_GetVideoTracksRequest_default_instance_);
+  static inline const SetVideoTrackReply* internal_default_instance() {
+    return reinterpret_cast<const SetVideoTrackReply*>(
+               &_SetVideoTrackReply_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    33;
+    35;
 
-  friend void swap(GetVideoTracksRequest& a, GetVideoTracksRequest& b) {
+  friend void swap(SetVideoTrackReply& a, SetVideoTrackReply& b) {
     a.Swap(&b);
   }
-  inline void Swap(GetVideoTracksRequest* other) {
+  inline void Swap(SetVideoTrackReply* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -4749,7 +5152,7 @@ class GetVideoTracksRequest final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(GetVideoTracksRequest* other) {
+  void UnsafeArenaSwap(SetVideoTrackReply* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -4757,15 +5160,15 @@ class GetVideoTracksRequest final :
 
   // implements Message ----------------------------------------------
 
-  GetVideoTracksRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<GetVideoTracksRequest>(arena);
+  SetVideoTrackReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<SetVideoTrackReply>(arena);
   }
   using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
-  inline void CopyFrom(const GetVideoTracksRequest& from) {
+  inline void CopyFrom(const SetVideoTrackReply& from) {
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
   }
   using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
-  void MergeFrom(const GetVideoTracksRequest& from) {
+  void MergeFrom(const SetVideoTrackReply& from) {
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
   }
   public:
@@ -4773,10 +5176,10 @@ class GetVideoTracksRequest final :
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.GetVideoTracksRequest";
+    return "player.SetVideoTrackReply";
   }
   protected:
-  explicit GetVideoTracksRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit SetVideoTrackReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -4789,7 +5192,7 @@ class GetVideoTracksRequest final :
 
   // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:player.GetVideoTracksRequest)
+  // @@protoc_insertion_point(class_scope:player.SetVideoTrackReply)
  private:
   class _Internal;
 
@@ -4802,24 +5205,24 @@ class GetVideoTracksRequest final :
 };
 // -------------------------------------------------------------------
 
-class GetVideoTracksReply final :
-    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.GetVideoTracksReply) */ {
+class SetAudioTrackRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.SetAudioTrackRequest) */ {
  public:
-  inline GetVideoTracksReply() : GetVideoTracksReply(nullptr) {}
-  ~GetVideoTracksReply() override;
-  explicit PROTOBUF_CONSTEXPR GetVideoTracksReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline SetAudioTrackRequest() : SetAudioTrackRequest(nullptr) {}
+  ~SetAudioTrackRequest() override;
+  explicit PROTOBUF_CONSTEXPR SetAudioTrackRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  GetVideoTracksReply(const GetVideoTracksReply& from);
-  GetVideoTracksReply(GetVideoTracksReply&& from) noexcept
-    : GetVideoTracksReply() {
+  SetAudioTrackRequest(const SetAudioTrackRequest& from);
+  SetAudioTrackRequest(SetAudioTrackRequest&& from) noexcept
+    : SetAudioTrackRequest() {
     *this = ::std::move(from);
   }
 
-  inline GetVideoTracksReply& operator=(const GetVideoTracksReply& from) {
+  inline SetAudioTrackRequest& operator=(const SetAudioTrackRequest& from) {
     CopyFrom(from);
     return *this;
   }
-  inline GetVideoTracksReply& operator=(GetVideoTracksReply&& from) noexcept {
+  inline SetAudioTrackRequest& operator=(SetAudioTrackRequest&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -4842,20 +5245,20 @@ class GetVideoTracksReply final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const GetVideoTracksReply& default_instance() {
+  static const SetAudioTrackRequest& default_instance() {
     return *internal_default_instance();
   }
-  static inline const GetVideoTracksReply* internal_default_instance() {
-    return reinterpret_cast<const GetVideoTracksReply*>(
-               &_GetVideoTracksReply_default_instance_);
+  static inline const SetAudioTrackRequest* internal_default_instance() {
+    return reinterpret_cast<const SetAudioTrackRequest*>(
+               &_SetAudioTrackRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    34;
+    36;
 
-  friend void swap(GetVideoTracksReply& a, GetVideoTracksReply& b) {
+  friend void swap(SetAudioTrackRequest& a, SetAudioTrackRequest& b) {
     a.Swap(&b);
   }
-  inline void Swap(GetVideoTracksReply* other) {
+  inline void Swap(SetAudioTrackRequest* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -4868,7 +5271,7 @@ class GetVideoTracksReply final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(GetVideoTracksReply* other) {
+  void UnsafeArenaSwap(SetAudioTrackRequest* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -4876,14 +5279,14 @@ class GetVideoTracksReply final :
 
   // implements Message ----------------------------------------------
 
-  GetVideoTracksReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<GetVideoTracksReply>(arena);
+  SetAudioTrackRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<SetAudioTrackRequest>(arena);
   }
   using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
-  void CopyFrom(const GetVideoTracksReply& from);
+  void CopyFrom(const SetAudioTrackRequest& from);
   using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
-  void MergeFrom( const GetVideoTracksReply& from) {
-    GetVideoTracksReply::MergeImpl(*this, from);
+  void MergeFrom( const SetAudioTrackRequest& from) {
+    SetAudioTrackRequest::MergeImpl(*this, from);
   }
   private:
   static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
@@ -4901,15 +5304,15 @@ class GetVideoTracksReply final :
   void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
   void SharedDtor();
   void SetCachedSize(int size) const final;
-  void InternalSwap(GetVideoTracksReply* other);
+  void InternalSwap(SetAudioTrackRequest* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.GetVideoTracksReply";
+    return "player.SetAudioTrackRequest";
   }
   protected:
-  explicit GetVideoTracksReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit SetAudioTrackRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -4923,27 +5326,18 @@ class GetVideoTracksReply final :
   // accessors -------------------------------------------------------
 
   enum : int {
-    kVideoTrackFieldNumber = 1,
+    kAudioTrackIDFieldNumber = 1,
   };
-  // repeated .player.VideoTrack videoTrack = 1;
-  int videotrack_size() const;
-  private:
-  int _internal_videotrack_size() const;
-  public:
-  void clear_videotrack();
-  ::player::VideoTrack* mutable_videotrack(int index);
-  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::VideoTrack >*
-      mutable_videotrack();
+  // int64 audioTrackID = 1;
+  void clear_audiotrackid();
+  int64_t audiotrackid() const;
+  void set_audiotrackid(int64_t value);
   private:
-  const ::player::VideoTrack& _internal_videotrack(int index) const;
-  ::player::VideoTrack* _internal_add_videotrack();
+  int64_t _internal_audiotrackid() const;
+  void _internal_set_audiotrackid(int64_t value);
   public:
-  const ::player::VideoTrack& videotrack(int index) const;
-  ::player::VideoTrack* add_videotrack();
-  const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::VideoTrack >&
-      videotrack() const;
 
-  // @@protoc_insertion_point(class_scope:player.GetVideoTracksReply)
+  // @@protoc_insertion_point(class_scope:player.SetAudioTrackRequest)
  private:
   class _Internal;
 
@@ -4951,7 +5345,7 @@ class GetVideoTracksReply final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
-    ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::VideoTrack > videotrack_;
+    int64_t audiotrackid_;
     mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
   union { Impl_ _impl_; };
@@ -4959,24 +5353,23 @@ class GetVideoTracksReply final :
 };
 // -------------------------------------------------------------------
 
-class AudioTrack final :
-    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.AudioTrack) */ {
+class SetAudioTrackReply final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.SetAudioTrackReply) */ {
  public:
-  inline AudioTrack() : AudioTrack(nullptr) {}
-  ~AudioTrack() override;
-  explicit PROTOBUF_CONSTEXPR AudioTrack(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline SetAudioTrackReply() : SetAudioTrackReply(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR SetAudioTrackReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  AudioTrack(const AudioTrack& from);
-  AudioTrack(AudioTrack&& from) noexcept
-    : AudioTrack() {
+  SetAudioTrackReply(const SetAudioTrackReply& from);
+  SetAudioTrackReply(SetAudioTrackReply&& from) noexcept
+    : SetAudioTrackReply() {
     *this = ::std::move(from);
   }
 
-  inline AudioTrack& operator=(const AudioTrack& from) {
+  inline SetAudioTrackReply& operator=(const SetAudioTrackReply& from) {
     CopyFrom(from);
     return *this;
   }
-  inline AudioTrack& operator=(AudioTrack&& from) noexcept {
+  inline SetAudioTrackReply& operator=(SetAudioTrackReply&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -4999,20 +5392,20 @@ class AudioTrack final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const AudioTrack& default_instance() {
+  static const SetAudioTrackReply& default_instance() {
     return *internal_default_instance();
   }
-  static inline const AudioTrack* internal_default_instance() {
-    return reinterpret_cast<const AudioTrack*>(
-               &_AudioTrack_default_instance_);
+  static inline const SetAudioTrackReply* internal_default_instance() {
+    return reinterpret_cast<const SetAudioTrackReply*>(
+               &_SetAudioTrackReply_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    35;
+    37;
 
-  friend void swap(AudioTrack& a, AudioTrack& b) {
+  friend void swap(SetAudioTrackReply& a, SetAudioTrackReply& b) {
     a.Swap(&b);
   }
-  inline void Swap(AudioTrack* other) {
+  inline void Swap(SetAudioTrackReply* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -5025,7 +5418,7 @@ class AudioTrack final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(AudioTrack* other) {
+  void UnsafeArenaSwap(SetAudioTrackReply* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -5033,40 +5426,26 @@ class AudioTrack final :
 
   // implements Message ----------------------------------------------
 
-  AudioTrack* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<AudioTrack>(arena);
+  SetAudioTrackReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<SetAudioTrackReply>(arena);
   }
-  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
-  void CopyFrom(const AudioTrack& from);
-  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
-  void MergeFrom( const AudioTrack& from) {
-    AudioTrack::MergeImpl(*this, from);
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const SetAudioTrackReply& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const SetAudioTrackReply& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
   }
-  private:
-  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
   public:
-  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
-  bool IsInitialized() const final;
-
-  size_t ByteSizeLong() const final;
-  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
-  uint8_t* _InternalSerialize(
-      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
-  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
-
-  private:
-  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
-  void SharedDtor();
-  void SetCachedSize(int size) const final;
-  void InternalSwap(AudioTrack* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.AudioTrack";
+    return "player.SetAudioTrackReply";
   }
   protected:
-  explicit AudioTrack(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit SetAudioTrackReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -5079,29 +5458,7 @@ class AudioTrack final :
 
   // accessors -------------------------------------------------------
 
-  enum : int {
-    kIdFieldNumber = 1,
-    kIsActiveFieldNumber = 2,
-  };
-  // int64 id = 1;
-  void clear_id();
-  int64_t id() const;
-  void set_id(int64_t value);
-  private:
-  int64_t _internal_id() const;
-  void _internal_set_id(int64_t value);
-  public:
-
-  // bool isActive = 2;
-  void clear_isactive();
-  bool isactive() const;
-  void set_isactive(bool value);
-  private:
-  bool _internal_isactive() const;
-  void _internal_set_isactive(bool value);
-  public:
-
-  // @@protoc_insertion_point(class_scope:player.AudioTrack)
+  // @@protoc_insertion_point(class_scope:player.SetAudioTrackReply)
  private:
   class _Internal;
 
@@ -5109,32 +5466,29 @@ class AudioTrack final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
-    int64_t id_;
-    bool isactive_;
-    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
-  union { Impl_ _impl_; };
   friend struct ::TableStruct_player_2eproto;
 };
 // -------------------------------------------------------------------
 
-class GetAudioTracksRequest final :
-    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.GetAudioTracksRequest) */ {
+class SetSubtitlesTrackRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.SetSubtitlesTrackRequest) */ {
  public:
-  inline GetAudioTracksRequest() : GetAudioTracksRequest(nullptr) {}
-  explicit PROTOBUF_CONSTEXPR GetAudioTracksRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline SetSubtitlesTrackRequest() : SetSubtitlesTrackRequest(nullptr) {}
+  ~SetSubtitlesTrackRequest() override;
+  explicit PROTOBUF_CONSTEXPR SetSubtitlesTrackRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  GetAudioTracksRequest(const GetAudioTracksRequest& from);
-  GetAudioTracksRequest(GetAudioTracksRequest&& from) noexcept
-    : GetAudioTracksRequest() {
+  SetSubtitlesTrackRequest(const SetSubtitlesTrackRequest& from);
+  SetSubtitlesTrackRequest(SetSubtitlesTrackRequest&& from) noexcept
+    : SetSubtitlesTrackRequest() {
     *this = ::std::move(from);
   }
 
-  inline GetAudioTracksRequest& operator=(const GetAudioTracksRequest& from) {
+  inline SetSubtitlesTrackRequest& operator=(const SetSubtitlesTrackRequest& from) {
     CopyFrom(from);
     return *this;
   }
-  inline GetAudioTracksRequest& operator=(GetAudioTracksRequest&& from) noexcept {
+  inline SetSubtitlesTrackRequest& operator=(SetSubtitlesTrackRequest&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -5157,20 +5511,20 @@ class GetAudioTracksRequest final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const GetAudioTracksRequest& default_instance() {
+  static const SetSubtitlesTrackRequest& default_instance() {
     return *internal_default_instance();
   }
-  static inline const GetAudioTracksRequest* internal_default_instance() {
-    return reinterpret_cast<const GetAudioTracksRequest*>(
-               &_GetAudioTracksRequest_default_instance_);
+  static inline const SetSubtitlesTrackRequest* internal_default_instance() {
+    return reinterpret_cast<const SetSubtitlesTrackRequest*>(
+               &_SetSubtitlesTrackRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    36;
+    38;
 
-  friend void swap(GetAudioTracksRequest& a, GetAudioTracksRequest& b) {
+  friend void swap(SetSubtitlesTrackRequest& a, SetSubtitlesTrackRequest& b) {
     a.Swap(&b);
   }
-  inline void Swap(GetAudioTracksRequest* other) {
+  inline void Swap(SetSubtitlesTrackRequest* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -5183,7 +5537,7 @@ class GetAudioTracksRequest final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(GetAudioTracksRequest* other) {
+  void UnsafeArenaSwap(SetSubtitlesTrackRequest* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -5191,26 +5545,40 @@ class GetAudioTracksRequest final :
 
   // implements Message ----------------------------------------------
 
-  GetAudioTracksRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<GetAudioTracksRequest>(arena);
+  SetSubtitlesTrackRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<SetSubtitlesTrackRequest>(arena);
   }
-  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
-  inline void CopyFrom(const GetAudioTracksRequest& from) {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
-  }
-  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
-  void MergeFrom(const GetAudioTracksRequest& from) {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const SetSubtitlesTrackRequest& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const SetSubtitlesTrackRequest& from) {
+    SetSubtitlesTrackRequest::MergeImpl(*this, from);
   }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
   public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(SetSubtitlesTrackRequest* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.GetAudioTracksRequest";
+    return "player.SetSubtitlesTrackRequest";
   }
   protected:
-  explicit GetAudioTracksRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit SetSubtitlesTrackRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -5223,7 +5591,19 @@ class GetAudioTracksRequest final :
 
   // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:player.GetAudioTracksRequest)
+  enum : int {
+    kSubtitlesTrackIDFieldNumber = 1,
+  };
+  // int64 subtitlesTrackID = 1;
+  void clear_subtitlestrackid();
+  int64_t subtitlestrackid() const;
+  void set_subtitlestrackid(int64_t value);
+  private:
+  int64_t _internal_subtitlestrackid() const;
+  void _internal_set_subtitlestrackid(int64_t value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.SetSubtitlesTrackRequest)
  private:
   class _Internal;
 
@@ -5231,29 +5611,31 @@ class GetAudioTracksRequest final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
+    int64_t subtitlestrackid_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
+  union { Impl_ _impl_; };
   friend struct ::TableStruct_player_2eproto;
 };
 // -------------------------------------------------------------------
 
-class GetAudioTracksReply final :
-    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.GetAudioTracksReply) */ {
+class SetSubtitlesTrackReply final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.SetSubtitlesTrackReply) */ {
  public:
-  inline GetAudioTracksReply() : GetAudioTracksReply(nullptr) {}
-  ~GetAudioTracksReply() override;
-  explicit PROTOBUF_CONSTEXPR GetAudioTracksReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline SetSubtitlesTrackReply() : SetSubtitlesTrackReply(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR SetSubtitlesTrackReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  GetAudioTracksReply(const GetAudioTracksReply& from);
-  GetAudioTracksReply(GetAudioTracksReply&& from) noexcept
-    : GetAudioTracksReply() {
+  SetSubtitlesTrackReply(const SetSubtitlesTrackReply& from);
+  SetSubtitlesTrackReply(SetSubtitlesTrackReply&& from) noexcept
+    : SetSubtitlesTrackReply() {
     *this = ::std::move(from);
   }
 
-  inline GetAudioTracksReply& operator=(const GetAudioTracksReply& from) {
+  inline SetSubtitlesTrackReply& operator=(const SetSubtitlesTrackReply& from) {
     CopyFrom(from);
     return *this;
   }
-  inline GetAudioTracksReply& operator=(GetAudioTracksReply&& from) noexcept {
+  inline SetSubtitlesTrackReply& operator=(SetSubtitlesTrackReply&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -5276,20 +5658,20 @@ class GetAudioTracksReply final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const GetAudioTracksReply& default_instance() {
+  static const SetSubtitlesTrackReply& default_instance() {
     return *internal_default_instance();
   }
-  static inline const GetAudioTracksReply* internal_default_instance() {
-    return reinterpret_cast<const GetAudioTracksReply*>(
-               &_GetAudioTracksReply_default_instance_);
+  static inline const SetSubtitlesTrackReply* internal_default_instance() {
+    return reinterpret_cast<const SetSubtitlesTrackReply*>(
+               &_SetSubtitlesTrackReply_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    37;
+    39;
 
-  friend void swap(GetAudioTracksReply& a, GetAudioTracksReply& b) {
+  friend void swap(SetSubtitlesTrackReply& a, SetSubtitlesTrackReply& b) {
     a.Swap(&b);
   }
-  inline void Swap(GetAudioTracksReply* other) {
+  inline void Swap(SetSubtitlesTrackReply* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -5302,7 +5684,7 @@ class GetAudioTracksReply final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(GetAudioTracksReply* other) {
+  void UnsafeArenaSwap(SetSubtitlesTrackReply* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -5310,40 +5692,26 @@ class GetAudioTracksReply final :
 
   // implements Message ----------------------------------------------
 
-  GetAudioTracksReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<GetAudioTracksReply>(arena);
+  SetSubtitlesTrackReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<SetSubtitlesTrackReply>(arena);
   }
-  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
-  void CopyFrom(const GetAudioTracksReply& from);
-  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
-  void MergeFrom( const GetAudioTracksReply& from) {
-    GetAudioTracksReply::MergeImpl(*this, from);
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const SetSubtitlesTrackReply& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const SetSubtitlesTrackReply& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
   }
-  private:
-  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
   public:
-  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
-  bool IsInitialized() const final;
-
-  size_t ByteSizeLong() const final;
-  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
-  uint8_t* _InternalSerialize(
-      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
-  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
-
-  private:
-  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
-  void SharedDtor();
-  void SetCachedSize(int size) const final;
-  void InternalSwap(GetAudioTracksReply* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.GetAudioTracksReply";
+    return "player.SetSubtitlesTrackReply";
   }
   protected:
-  explicit GetAudioTracksReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit SetSubtitlesTrackReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -5356,28 +5724,7 @@ class GetAudioTracksReply final :
 
   // accessors -------------------------------------------------------
 
-  enum : int {
-    kAudioTrackFieldNumber = 1,
-  };
-  // repeated .player.AudioTrack audioTrack = 1;
-  int audiotrack_size() const;
-  private:
-  int _internal_audiotrack_size() const;
-  public:
-  void clear_audiotrack();
-  ::player::AudioTrack* mutable_audiotrack(int index);
-  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::AudioTrack >*
-      mutable_audiotrack();
-  private:
-  const ::player::AudioTrack& _internal_audiotrack(int index) const;
-  ::player::AudioTrack* _internal_add_audiotrack();
-  public:
-  const ::player::AudioTrack& audiotrack(int index) const;
-  ::player::AudioTrack* add_audiotrack();
-  const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::AudioTrack >&
-      audiotrack() const;
-
-  // @@protoc_insertion_point(class_scope:player.GetAudioTracksReply)
+  // @@protoc_insertion_point(class_scope:player.SetSubtitlesTrackReply)
  private:
   class _Internal;
 
@@ -5385,32 +5732,29 @@ class GetAudioTracksReply final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
-    ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::AudioTrack > audiotrack_;
-    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
-  union { Impl_ _impl_; };
   friend struct ::TableStruct_player_2eproto;
 };
 // -------------------------------------------------------------------
 
-class SubtitlesTrack final :
-    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.SubtitlesTrack) */ {
+class TrackInfo final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.TrackInfo) */ {
  public:
-  inline SubtitlesTrack() : SubtitlesTrack(nullptr) {}
-  ~SubtitlesTrack() override;
-  explicit PROTOBUF_CONSTEXPR SubtitlesTrack(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline TrackInfo() : TrackInfo(nullptr) {}
+  ~TrackInfo() override;
+  explicit PROTOBUF_CONSTEXPR TrackInfo(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  SubtitlesTrack(const SubtitlesTrack& from);
-  SubtitlesTrack(SubtitlesTrack&& from) noexcept
-    : SubtitlesTrack() {
+  TrackInfo(const TrackInfo& from);
+  TrackInfo(TrackInfo&& from) noexcept
+    : TrackInfo() {
     *this = ::std::move(from);
   }
 
-  inline SubtitlesTrack& operator=(const SubtitlesTrack& from) {
+  inline TrackInfo& operator=(const TrackInfo& from) {
     CopyFrom(from);
     return *this;
   }
-  inline SubtitlesTrack& operator=(SubtitlesTrack&& from) noexcept {
+  inline TrackInfo& operator=(TrackInfo&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -5433,20 +5777,20 @@ class SubtitlesTrack final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const SubtitlesTrack& default_instance() {
+  static const TrackInfo& default_instance() {
     return *internal_default_instance();
   }
-  static inline const SubtitlesTrack* internal_default_instance() {
-    return reinterpret_cast<const SubtitlesTrack*>(
-               &_SubtitlesTrack_default_instance_);
+  static inline const TrackInfo* internal_default_instance() {
+    return reinterpret_cast<const TrackInfo*>(
+               &_TrackInfo_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    38;
+    40;
 
-  friend void swap(SubtitlesTrack& a, SubtitlesTrack& b) {
+  friend void swap(TrackInfo& a, TrackInfo& b) {
     a.Swap(&b);
   }
-  inline void Swap(SubtitlesTrack* other) {
+  inline void Swap(TrackInfo* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -5459,7 +5803,7 @@ class SubtitlesTrack final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(SubtitlesTrack* other) {
+  void UnsafeArenaSwap(TrackInfo* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -5467,14 +5811,14 @@ class SubtitlesTrack final :
 
   // implements Message ----------------------------------------------
 
-  SubtitlesTrack* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<SubtitlesTrack>(arena);
+  TrackInfo* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<TrackInfo>(arena);
   }
   using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
-  void CopyFrom(const SubtitlesTrack& from);
+  void CopyFrom(const TrackInfo& from);
   using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
-  void MergeFrom( const SubtitlesTrack& from) {
-    SubtitlesTrack::MergeImpl(*this, from);
+  void MergeFrom( const TrackInfo& from) {
+    TrackInfo::MergeImpl(*this, from);
   }
   private:
   static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
@@ -5492,15 +5836,15 @@ class SubtitlesTrack final :
   void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
   void SharedDtor();
   void SetCachedSize(int size) const final;
-  void InternalSwap(SubtitlesTrack* other);
+  void InternalSwap(TrackInfo* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.SubtitlesTrack";
+    return "player.TrackInfo";
   }
   protected:
-  explicit SubtitlesTrack(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit TrackInfo(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -5514,28 +5858,73 @@ class SubtitlesTrack final :
   // accessors -------------------------------------------------------
 
   enum : int {
-    kIdFieldNumber = 1,
-    kIsActiveFieldNumber = 2,
+    kCodecFieldNumber = 1,
+    kLanguageFieldNumber = 2,
+    kTitleFieldNumber = 3,
+    kIsDefaultFieldNumber = 4,
+    kIsForcedFieldNumber = 5,
   };
-  // int64 id = 1;
-  void clear_id();
-  int64_t id() const;
-  void set_id(int64_t value);
+  // string codec = 1;
+  void clear_codec();
+  const std::string& codec() const;
+  template <typename ArgT0 = const std::string&, typename... ArgT>
+  void set_codec(ArgT0&& arg0, ArgT... args);
+  std::string* mutable_codec();
+  PROTOBUF_NODISCARD std::string* release_codec();
+  void set_allocated_codec(std::string* codec);
   private:
-  int64_t _internal_id() const;
-  void _internal_set_id(int64_t value);
+  const std::string& _internal_codec() const;
+  inline PROTOBUF_ALWAYS_INLINE void _internal_set_codec(const std::string& value);
+  std::string* _internal_mutable_codec();
   public:
 
-  // bool isActive = 2;
-  void clear_isactive();
-  bool isactive() const;
-  void set_isactive(bool value);
+  // string language = 2;
+  void clear_language();
+  const std::string& language() const;
+  template <typename ArgT0 = const std::string&, typename... ArgT>
+  void set_language(ArgT0&& arg0, ArgT... args);
+  std::string* mutable_language();
+  PROTOBUF_NODISCARD std::string* release_language();
+  void set_allocated_language(std::string* language);
   private:
-  bool _internal_isactive() const;
-  void _internal_set_isactive(bool value);
+  const std::string& _internal_language() const;
+  inline PROTOBUF_ALWAYS_INLINE void _internal_set_language(const std::string& value);
+  std::string* _internal_mutable_language();
   public:
 
-  // @@protoc_insertion_point(class_scope:player.SubtitlesTrack)
+  // string title = 3;
+  void clear_title();
+  const std::string& title() const;
+  template <typename ArgT0 = const std::string&, typename... ArgT>
+  void set_title(ArgT0&& arg0, ArgT... args);
+  std::string* mutable_title();
+  PROTOBUF_NODISCARD std::string* release_title();
+  void set_allocated_title(std::string* title);
+  private:
+  const std::string& _internal_title() const;
+  inline PROTOBUF_ALWAYS_INLINE void _internal_set_title(const std::string& value);
+  std::string* _internal_mutable_title();
+  public:
+
+  // bool isDefault = 4;
+  void clear_isdefault();
+  bool isdefault() const;
+  void set_isdefault(bool value);
+  private:
+  bool _internal_isdefault() const;
+  void _internal_set_isdefault(bool value);
+  public:
+
+  // bool isForced = 5;
+  void clear_isforced();
+  bool isforced() const;
+  void set_isforced(bool value);
+  private:
+  bool _internal_isforced() const;
+  void _internal_set_isforced(bool value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.TrackInfo)
  private:
   class _Internal;
 
@@ -5543,8 +5932,11 @@ class SubtitlesTrack final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
-    int64_t id_;
-    bool isactive_;
+    ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr codec_;
+    ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr language_;
+    ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr title_;
+    bool isdefault_;
+    bool isforced_;
     mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
   union { Impl_ _impl_; };
@@ -5552,23 +5944,24 @@ class SubtitlesTrack final :
 };
 // -------------------------------------------------------------------
 
-class GetSubtitlesTracksRequest final :
-    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.GetSubtitlesTracksRequest) */ {
+class VideoTrack final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.VideoTrack) */ {
  public:
-  inline GetSubtitlesTracksRequest() : GetSubtitlesTracksRequest(nullptr) {}
-  explicit PROTOBUF_CONSTEXPR GetSubtitlesTracksRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline VideoTrack() : VideoTrack(nullptr) {}
+  ~VideoTrack() override;
+  explicit PROTOBUF_CONSTEXPR VideoTrack(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  GetSubtitlesTracksRequest(const GetSubtitlesTracksRequest& from);
-  GetSubtitlesTracksRequest(GetSubtitlesTracksRequest&& from) noexcept
-    : GetSubtitlesTracksRequest() {
+  VideoTrack(const VideoTrack& from);
+  VideoTrack(VideoTrack&& from) noexcept
+    : VideoTrack() {
     *this = ::std::move(from);
   }
 
-  inline GetSubtitlesTracksRequest& operator=(const GetSubtitlesTracksRequest& from) {
+  inline VideoTrack& operator=(const VideoTrack& from) {
     CopyFrom(from);
     return *this;
   }
-  inline GetSubtitlesTracksRequest& operator=(GetSubtitlesTracksRequest&& from) noexcept {
+  inline VideoTrack& operator=(VideoTrack&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -5591,20 +5984,20 @@ class GetSubtitlesTracksRequest final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const GetSubtitlesTracksRequest& default_instance() {
+  static const VideoTrack& default_instance() {
     return *internal_default_instance();
   }
-  static inline const GetSubtitlesTracksRequest* internal_default_instance() {
-    return reinterpret_cast<const GetSubtitlesTracksRequest*>(
-               &_GetSubtitlesTracksRequest_default_instance_);
+  static inline const VideoTrack* internal_default_instance() {
+    return reinterpret_cast<const VideoTrack*>(
+               &_VideoTrack_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    39;
+    41;
 
-  friend void swap(GetSubtitlesTracksRequest& a, GetSubtitlesTracksRequest& b) {
+  friend void swap(VideoTrack& a, VideoTrack& b) {
     a.Swap(&b);
   }
-  inline void Swap(GetSubtitlesTracksRequest* other) {
+  inline void Swap(VideoTrack* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -5617,7 +6010,7 @@ class GetSubtitlesTracksRequest final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(GetSubtitlesTracksRequest* other) {
+  void UnsafeArenaSwap(VideoTrack* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -5625,26 +6018,40 @@ class GetSubtitlesTracksRequest final :
 
   // implements Message ----------------------------------------------
 
-  GetSubtitlesTracksRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<GetSubtitlesTracksRequest>(arena);
-  }
-  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
-  inline void CopyFrom(const GetSubtitlesTracksRequest& from) {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  VideoTrack* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<VideoTrack>(arena);
   }
-  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
-  void MergeFrom(const GetSubtitlesTracksRequest& from) {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const VideoTrack& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const VideoTrack& from) {
+    VideoTrack::MergeImpl(*this, from);
   }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
   public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(VideoTrack* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.GetSubtitlesTracksRequest";
+    return "player.VideoTrack";
   }
   protected:
-  explicit GetSubtitlesTracksRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit VideoTrack(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -5657,7 +6064,78 @@ class GetSubtitlesTracksRequest final :
 
   // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:player.GetSubtitlesTracksRequest)
+  enum : int {
+    kInfoFieldNumber = 3,
+    kIdFieldNumber = 1,
+    kIsActiveFieldNumber = 2,
+    kWidthFieldNumber = 4,
+    kFpsFieldNumber = 6,
+    kHeightFieldNumber = 5,
+  };
+  // .player.TrackInfo info = 3;
+  bool has_info() const;
+  private:
+  bool _internal_has_info() const;
+  public:
+  void clear_info();
+  const ::player::TrackInfo& info() const;
+  PROTOBUF_NODISCARD ::player::TrackInfo* release_info();
+  ::player::TrackInfo* mutable_info();
+  void set_allocated_info(::player::TrackInfo* info);
+  private:
+  const ::player::TrackInfo& _internal_info() const;
+  ::player::TrackInfo* _internal_mutable_info();
+  public:
+  void unsafe_arena_set_allocated_info(
+      ::player::TrackInfo* info);
+  ::player::TrackInfo* unsafe_arena_release_info();
+
+  // int64 id = 1;
+  void clear_id();
+  int64_t id() const;
+  void set_id(int64_t value);
+  private:
+  int64_t _internal_id() const;
+  void _internal_set_id(int64_t value);
+  public:
+
+  // bool isActive = 2;
+  void clear_isactive();
+  bool isactive() const;
+  void set_isactive(bool value);
+  private:
+  bool _internal_isactive() const;
+  void _internal_set_isactive(bool value);
+  public:
+
+  // int32 width = 4;
+  void clear_width();
+  int32_t width() const;
+  void set_width(int32_t value);
+  private:
+  int32_t _internal_width() const;
+  void _internal_set_width(int32_t value);
+  public:
+
+  // double fps = 6;
+  void clear_fps();
+  double fps() const;
+  void set_fps(double value);
+  private:
+  double _internal_fps() const;
+  void _internal_set_fps(double value);
+  public:
+
+  // int32 height = 5;
+  void clear_height();
+  int32_t height() const;
+  void set_height(int32_t value);
+  private:
+  int32_t _internal_height() const;
+  void _internal_set_height(int32_t value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.VideoTrack)
  private:
   class _Internal;
 
@@ -5665,29 +6143,37 @@ class GetSubtitlesTracksRequest final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
+    ::player::TrackInfo* info_;
+    int64_t id_;
+    bool isactive_;
+    int32_t width_;
+    double fps_;
+    int32_t height_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
+  union { Impl_ _impl_; };
   friend struct ::TableStruct_player_2eproto;
 };
 // -------------------------------------------------------------------
 
-class GetSubtitlesTracksReply final :
-    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.GetSubtitlesTracksReply) */ {
+class SeekRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.SeekRequest) */ {
  public:
-  inline GetSubtitlesTracksReply() : GetSubtitlesTracksReply(nullptr) {}
-  ~GetSubtitlesTracksReply() override;
-  explicit PROTOBUF_CONSTEXPR GetSubtitlesTracksReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline SeekRequest() : SeekRequest(nullptr) {}
+  ~SeekRequest() override;
+  explicit PROTOBUF_CONSTEXPR SeekRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  GetSubtitlesTracksReply(const GetSubtitlesTracksReply& from);
-  GetSubtitlesTracksReply(GetSubtitlesTracksReply&& from) noexcept
-    : GetSubtitlesTracksReply() {
+  SeekRequest(const SeekRequest& from);
+  SeekRequest(SeekRequest&& from) noexcept
+    : SeekRequest() {
     *this = ::std::move(from);
   }
 
-  inline GetSubtitlesTracksReply& operator=(const GetSubtitlesTracksReply& from) {
+  inline SeekRequest& operator=(const SeekRequest& from) {
     CopyFrom(from);
     return *this;
   }
-  inline GetSubtitlesTracksReply& operator=(GetSubtitlesTracksReply&& from) noexcept {
+  inline SeekRequest& operator=(SeekRequest&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -5710,20 +6196,20 @@ class GetSubtitlesTracksReply final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const GetSubtitlesTracksReply& default_instance() {
+  static const SeekRequest& default_instance() {
     return *internal_default_instance();
   }
-  static inline const GetSubtitlesTracksReply* internal_default_instance() {
-    return reinterpret_cast<const GetSubtitlesTracksReply*>(
-               &_GetSubtitlesTracksReply_default_instance_);
+  static inline const SeekRequest* internal_default_instance() {
+    return reinterpret_cast<const SeekRequest*>(
+               &_SeekRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    40;
+    42;
 
-  friend void swap(GetSubtitlesTracksReply& a, GetSubtitlesTracksReply& b) {
+  friend void swap(SeekRequest& a, SeekRequest& b) {
     a.Swap(&b);
   }
-  inline void Swap(GetSubtitlesTracksReply* other) {
+  inline void Swap(SeekRequest* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -5736,7 +6222,7 @@ class GetSubtitlesTracksReply final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(GetSubtitlesTracksReply* other) {
+  void UnsafeArenaSwap(SeekRequest* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -5744,14 +6230,14 @@ class GetSubtitlesTracksReply final :
 
   // implements Message ----------------------------------------------
 
-  GetSubtitlesTracksReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<GetSubtitlesTracksReply>(arena);
+  SeekRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<SeekRequest>(arena);
   }
   using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
-  void CopyFrom(const GetSubtitlesTracksReply& from);
+  void CopyFrom(const SeekRequest& from);
   using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
-  void MergeFrom( const GetSubtitlesTracksReply& from) {
-    GetSubtitlesTracksReply::MergeImpl(*this, from);
+  void MergeFrom( const SeekRequest& from) {
+    SeekRequest::MergeImpl(*this, from);
   }
   private:
   static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
@@ -5769,15 +6255,15 @@ class GetSubtitlesTracksReply final :
   void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
   void SharedDtor();
   void SetCachedSize(int size) const final;
-  void InternalSwap(GetSubtitlesTracksReply* other);
+  void InternalSwap(SeekRequest* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.GetSubtitlesTracksReply";
+    return "player.SeekRequest";
   }
   protected:
-  explicit GetSubtitlesTracksReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit SeekRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -5791,27 +6277,38 @@ class GetSubtitlesTracksReply final :
   // accessors -------------------------------------------------------
 
   enum : int {
-    kSubtitlesTrackFieldNumber = 1,
+    kPosNanosecondsFieldNumber = 1,
+    kIsRelativeFieldNumber = 2,
+    kIsQuickFieldNumber = 3,
   };
-  // repeated .player.SubtitlesTrack subtitlesTrack = 1;
-  int subtitlestrack_size() const;
+  // int64 posNanoseconds = 1;
+  void clear_posnanoseconds();
+  int64_t posnanoseconds() const;
+  void set_posnanoseconds(int64_t value);
   private:
-  int _internal_subtitlestrack_size() const;
+  int64_t _internal_posnanoseconds() const;
+  void _internal_set_posnanoseconds(int64_t value);
   public:
-  void clear_subtitlestrack();
-  ::player::SubtitlesTrack* mutable_subtitlestrack(int index);
-  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::SubtitlesTrack >*
-      mutable_subtitlestrack();
+
+  // bool isRelative = 2;
+  void clear_isrelative();
+  bool isrelative() const;
+  void set_isrelative(bool value);
   private:
-  const ::player::SubtitlesTrack& _internal_subtitlestrack(int index) const;
-  ::player::SubtitlesTrack* _internal_add_subtitlestrack();
+  bool _internal_isrelative() const;
+  void _internal_set_isrelative(bool value);
   public:
-  const ::player::SubtitlesTrack& subtitlestrack(int index) const;
-  ::player::SubtitlesTrack* add_subtitlestrack();
-  const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::SubtitlesTrack >&
-      subtitlestrack() const;
 
-  // @@protoc_insertion_point(class_scope:player.GetSubtitlesTracksReply)
+  // bool isQuick = 3;
+  void clear_isquick();
+  bool isquick() const;
+  void set_isquick(bool value);
+  private:
+  bool _internal_isquick() const;
+  void _internal_set_isquick(bool value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.SeekRequest)
  private:
   class _Internal;
 
@@ -5819,7 +6316,9 @@ class GetSubtitlesTracksReply final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
-    ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::SubtitlesTrack > subtitlestrack_;
+    int64_t posnanoseconds_;
+    bool isrelative_;
+    bool isquick_;
     mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
   union { Impl_ _impl_; };
@@ -5827,23 +6326,23 @@ class GetSubtitlesTracksReply final :
 };
 // -------------------------------------------------------------------
 
-class StopRequest final :
-    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.StopRequest) */ {
+class SeekReply final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.SeekReply) */ {
  public:
-  inline StopRequest() : StopRequest(nullptr) {}
-  explicit PROTOBUF_CONSTEXPR StopRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline SeekReply() : SeekReply(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR SeekReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  StopRequest(const StopRequest& from);
-  StopRequest(StopRequest&& from) noexcept
-    : StopRequest() {
+  SeekReply(const SeekReply& from);
+  SeekReply(SeekReply&& from) noexcept
+    : SeekReply() {
     *this = ::std::move(from);
   }
 
-  inline StopRequest& operator=(const StopRequest& from) {
+  inline SeekReply& operator=(const SeekReply& from) {
     CopyFrom(from);
     return *this;
   }
-  inline StopRequest& operator=(StopRequest&& from) noexcept {
+  inline SeekReply& operator=(SeekReply&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -5866,20 +6365,20 @@ class StopRequest final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const StopRequest& default_instance() {
+  static const SeekReply& default_instance() {
     return *internal_default_instance();
   }
-  static inline const StopRequest* internal_default_instance() {
-    return reinterpret_cast<const StopRequest*>(
-               &_StopRequest_default_instance_);
+  static inline const SeekReply* internal_default_instance() {
+    return reinterpret_cast<const SeekReply*>(
+               &_SeekReply_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    41;
+    43;
 
-  friend void swap(StopRequest& a, StopRequest& b) {
+  friend void swap(SeekReply& a, SeekReply& b) {
     a.Swap(&b);
   }
-  inline void Swap(StopRequest* other) {
+  inline void Swap(SeekReply* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -5892,7 +6391,7 @@ class StopRequest final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(StopRequest* other) {
+  void UnsafeArenaSwap(SeekReply* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -5900,15 +6399,15 @@ class StopRequest final :
 
   // implements Message ----------------------------------------------
 
-  StopRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<StopRequest>(arena);
+  SeekReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<SeekReply>(arena);
   }
   using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
-  inline void CopyFrom(const StopRequest& from) {
+  inline void CopyFrom(const SeekReply& from) {
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
   }
   using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
-  void MergeFrom(const StopRequest& from) {
+  void MergeFrom(const SeekReply& from) {
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
   }
   public:
@@ -5916,10 +6415,10 @@ class StopRequest final :
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.StopRequest";
+    return "player.SeekReply";
   }
   protected:
-  explicit StopRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit SeekReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -5932,7 +6431,7 @@ class StopRequest final :
 
   // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:player.StopRequest)
+  // @@protoc_insertion_point(class_scope:player.SeekReply)
  private:
   class _Internal;
 
@@ -5945,23 +6444,24 @@ class StopRequest final :
 };
 // -------------------------------------------------------------------
 
-class StopReply final :
-    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.StopReply) */ {
+class FrameStepRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.FrameStepRequest) */ {
  public:
-  inline StopReply() : StopReply(nullptr) {}
-  explicit PROTOBUF_CONSTEXPR StopReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline FrameStepRequest() : FrameStepRequest(nullptr) {}
+  ~FrameStepRequest() override;
+  explicit PROTOBUF_CONSTEXPR FrameStepRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  StopReply(const StopReply& from);
-  StopReply(StopReply&& from) noexcept
-    : StopReply() {
+  FrameStepRequest(const FrameStepRequest& from);
+  FrameStepRequest(FrameStepRequest&& from) noexcept
+    : FrameStepRequest() {
     *this = ::std::move(from);
   }
 
-  inline StopReply& operator=(const StopReply& from) {
+  inline FrameStepRequest& operator=(const FrameStepRequest& from) {
     CopyFrom(from);
     return *this;
   }
-  inline StopReply& operator=(StopReply&& from) noexcept {
+  inline FrameStepRequest& operator=(FrameStepRequest&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -5984,20 +6484,20 @@ class StopReply final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const StopReply& default_instance() {
+  static const FrameStepRequest& default_instance() {
     return *internal_default_instance();
   }
-  static inline const StopReply* internal_default_instance() {
-    return reinterpret_cast<const StopReply*>(
-               &_StopReply_default_instance_);
+  static inline const FrameStepRequest* internal_default_instance() {
+    return reinterpret_cast<const FrameStepRequest*>(
+               &_FrameStepRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    42;
+    44;
 
-  friend void swap(StopReply& a, StopReply& b) {
+  friend void swap(FrameStepRequest& a, FrameStepRequest& b) {
     a.Swap(&b);
   }
-  inline void Swap(StopReply* other) {
+  inline void Swap(FrameStepRequest* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -6010,7 +6510,7 @@ class StopReply final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(StopReply* other) {
+  void UnsafeArenaSwap(FrameStepRequest* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -6018,26 +6518,40 @@ class StopReply final :
 
   // implements Message ----------------------------------------------
 
-  StopReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<StopReply>(arena);
+  FrameStepRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<FrameStepRequest>(arena);
   }
-  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
-  inline void CopyFrom(const StopReply& from) {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
-  }
-  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
-  void MergeFrom(const StopReply& from) {
-    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const FrameStepRequest& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const FrameStepRequest& from) {
+    FrameStepRequest::MergeImpl(*this, from);
   }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
   public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(FrameStepRequest* other);
 
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.StopReply";
+    return "player.FrameStepRequest";
   }
   protected:
-  explicit StopReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit FrameStepRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -6050,7 +6564,19 @@ class StopReply final :
 
   // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:player.StopReply)
+  enum : int {
+    kForwardFieldNumber = 1,
+  };
+  // bool forward = 1;
+  void clear_forward();
+  bool forward() const;
+  void set_forward(bool value);
+  private:
+  bool _internal_forward() const;
+  void _internal_set_forward(bool value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.FrameStepRequest)
  private:
   class _Internal;
 
@@ -6058,28 +6584,31 @@ class StopReply final :
   typedef void InternalArenaConstructable_;
   typedef void DestructorSkippable_;
   struct Impl_ {
+    bool forward_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   };
+  union { Impl_ _impl_; };
   friend struct ::TableStruct_player_2eproto;
 };
 // -------------------------------------------------------------------
 
-class CloseRequest final :
-    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.CloseRequest) */ {
+class FrameStepReply final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.FrameStepReply) */ {
  public:
-  inline CloseRequest() : CloseRequest(nullptr) {}
-  explicit PROTOBUF_CONSTEXPR CloseRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline FrameStepReply() : FrameStepReply(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR FrameStepReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  CloseRequest(const CloseRequest& from);
-  CloseRequest(CloseRequest&& from) noexcept
-    : CloseRequest() {
+  FrameStepReply(const FrameStepReply& from);
+  FrameStepReply(FrameStepReply&& from) noexcept
+    : FrameStepReply() {
     *this = ::std::move(from);
   }
 
-  inline CloseRequest& operator=(const CloseRequest& from) {
+  inline FrameStepReply& operator=(const FrameStepReply& from) {
     CopyFrom(from);
     return *this;
   }
-  inline CloseRequest& operator=(CloseRequest&& from) noexcept {
+  inline FrameStepReply& operator=(FrameStepReply&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -6102,20 +6631,20 @@ class CloseRequest final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const CloseRequest& default_instance() {
+  static const FrameStepReply& default_instance() {
     return *internal_default_instance();
   }
-  static inline const CloseRequest* internal_default_instance() {
-    return reinterpret_cast<const CloseRequest*>(
-               &_CloseRequest_default_instance_);
+  static inline const FrameStepReply* internal_default_instance() {
+    return reinterpret_cast<const FrameStepReply*>(
+               &_FrameStepReply_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    43;
+    45;
 
-  friend void swap(CloseRequest& a, CloseRequest& b) {
+  friend void swap(FrameStepReply& a, FrameStepReply& b) {
     a.Swap(&b);
   }
-  inline void Swap(CloseRequest* other) {
+  inline void Swap(FrameStepReply* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -6128,7 +6657,7 @@ class CloseRequest final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(CloseRequest* other) {
+  void UnsafeArenaSwap(FrameStepReply* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -6136,15 +6665,15 @@ class CloseRequest final :
 
   // implements Message ----------------------------------------------
 
-  CloseRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<CloseRequest>(arena);
+  FrameStepReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<FrameStepReply>(arena);
   }
   using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
-  inline void CopyFrom(const CloseRequest& from) {
+  inline void CopyFrom(const FrameStepReply& from) {
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
   }
   using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
-  void MergeFrom(const CloseRequest& from) {
+  void MergeFrom(const FrameStepReply& from) {
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
   }
   public:
@@ -6152,10 +6681,10 @@ class CloseRequest final :
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.CloseRequest";
+    return "player.FrameStepReply";
   }
   protected:
-  explicit CloseRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit FrameStepReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -6168,7 +6697,7 @@ class CloseRequest final :
 
   // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:player.CloseRequest)
+  // @@protoc_insertion_point(class_scope:player.FrameStepReply)
  private:
   class _Internal;
 
@@ -6181,23 +6710,23 @@ class CloseRequest final :
 };
 // -------------------------------------------------------------------
 
-class CloseReply final :
-    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.CloseReply) */ {
+class ScreenshotRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.ScreenshotRequest) */ {
  public:
-  inline CloseReply() : CloseReply(nullptr) {}
-  explicit PROTOBUF_CONSTEXPR CloseReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  inline ScreenshotRequest() : ScreenshotRequest(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR ScreenshotRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-  CloseReply(const CloseReply& from);
-  CloseReply(CloseReply&& from) noexcept
-    : CloseReply() {
+  ScreenshotRequest(const ScreenshotRequest& from);
+  ScreenshotRequest(ScreenshotRequest&& from) noexcept
+    : ScreenshotRequest() {
     *this = ::std::move(from);
   }
 
-  inline CloseReply& operator=(const CloseReply& from) {
+  inline ScreenshotRequest& operator=(const ScreenshotRequest& from) {
     CopyFrom(from);
     return *this;
   }
-  inline CloseReply& operator=(CloseReply&& from) noexcept {
+  inline ScreenshotRequest& operator=(ScreenshotRequest&& from) noexcept {
     if (this == &from) return *this;
     if (GetOwningArena() == from.GetOwningArena()
   #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
@@ -6220,20 +6749,20 @@ class CloseReply final :
   static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
     return default_instance().GetMetadata().reflection;
   }
-  static const CloseReply& default_instance() {
+  static const ScreenshotRequest& default_instance() {
     return *internal_default_instance();
   }
-  static inline const CloseReply* internal_default_instance() {
-    return reinterpret_cast<const CloseReply*>(
-               &_CloseReply_default_instance_);
+  static inline const ScreenshotRequest* internal_default_instance() {
+    return reinterpret_cast<const ScreenshotRequest*>(
+               &_ScreenshotRequest_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    44;
+    46;
 
-  friend void swap(CloseReply& a, CloseReply& b) {
+  friend void swap(ScreenshotRequest& a, ScreenshotRequest& b) {
     a.Swap(&b);
   }
-  inline void Swap(CloseReply* other) {
+  inline void Swap(ScreenshotRequest* other) {
     if (other == this) return;
   #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
     if (GetOwningArena() != nullptr &&
@@ -6246,7 +6775,7 @@ class CloseReply final :
       ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
     }
   }
-  void UnsafeArenaSwap(CloseReply* other) {
+  void UnsafeArenaSwap(ScreenshotRequest* other) {
     if (other == this) return;
     GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
     InternalSwap(other);
@@ -6254,15 +6783,15 @@ class CloseReply final :
 
   // implements Message ----------------------------------------------
 
-  CloseReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
-    return CreateMaybeMessage<CloseReply>(arena);
+  ScreenshotRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<ScreenshotRequest>(arena);
   }
   using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
-  inline void CopyFrom(const CloseReply& from) {
+  inline void CopyFrom(const ScreenshotRequest& from) {
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
   }
   using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
-  void MergeFrom(const CloseReply& from) {
+  void MergeFrom(const ScreenshotRequest& from) {
     ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
   }
   public:
@@ -6270,10 +6799,10 @@ class CloseReply final :
   private:
   friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
   static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
-    return "player.CloseReply";
+    return "player.ScreenshotRequest";
   }
   protected:
-  explicit CloseReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+  explicit ScreenshotRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
                        bool is_message_owned = false);
   public:
 
@@ -6286,7 +6815,7 @@ class CloseReply final :
 
   // accessors -------------------------------------------------------
 
-  // @@protoc_insertion_point(class_scope:player.CloseReply)
+  // @@protoc_insertion_point(class_scope:player.ScreenshotRequest)
  private:
   class _Internal;
 
@@ -6297,169 +6826,6550 @@ class CloseReply final :
   };
   friend struct ::TableStruct_player_2eproto;
 };
-// ===================================================================
+// -------------------------------------------------------------------
 
+class ScreenshotReply final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.ScreenshotReply) */ {
+ public:
+  inline ScreenshotReply() : ScreenshotReply(nullptr) {}
+  ~ScreenshotReply() override;
+  explicit PROTOBUF_CONSTEXPR ScreenshotReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
 
-// ===================================================================
+  ScreenshotReply(const ScreenshotReply& from);
+  ScreenshotReply(ScreenshotReply&& from) noexcept
+    : ScreenshotReply() {
+    *this = ::std::move(from);
+  }
 
-#ifdef __GNUC__
-  #pragma GCC diagnostic push
-  #pragma GCC diagnostic ignored "-Wstrict-aliasing"
-#endif  // __GNUC__
-// SetupForStreamingRequest
+  inline ScreenshotReply& operator=(const ScreenshotReply& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline ScreenshotReply& operator=(ScreenshotReply&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
 
-// -------------------------------------------------------------------
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const ScreenshotReply& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const ScreenshotReply* internal_default_instance() {
+    return reinterpret_cast<const ScreenshotReply*>(
+               &_ScreenshotReply_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    47;
 
-// SetupForStreamingReply
+  friend void swap(ScreenshotReply& a, ScreenshotReply& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(ScreenshotReply* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(ScreenshotReply* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
 
-// -------------------------------------------------------------------
+  // implements Message ----------------------------------------------
 
-// ProcessTitleRequest
+  ScreenshotReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<ScreenshotReply>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const ScreenshotReply& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const ScreenshotReply& from) {
+    ScreenshotReply::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
 
-// -------------------------------------------------------------------
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
 
-// ProcessTitleReply
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(ScreenshotReply* other);
 
-// string title = 1;
-inline void ProcessTitleReply::clear_title() {
-  _impl_.title_.ClearToEmpty();
-}
-inline const std::string& ProcessTitleReply::title() const {
-  // @@protoc_insertion_point(field_get:player.ProcessTitleReply.title)
-  return _internal_title();
-}
-template <typename ArgT0, typename... ArgT>
-inline PROTOBUF_ALWAYS_INLINE
-void ProcessTitleReply::set_title(ArgT0&& arg0, ArgT... args) {
- 
- _impl_.title_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
-  // @@protoc_insertion_point(field_set:player.ProcessTitleReply.title)
-}
-inline std::string* ProcessTitleReply::mutable_title() {
-  std::string* _s = _internal_mutable_title();
-  // @@protoc_insertion_point(field_mutable:player.ProcessTitleReply.title)
-  return _s;
-}
-inline const std::string& ProcessTitleReply::_internal_title() const {
-  return _impl_.title_.Get();
-}
-inline void ProcessTitleReply::_internal_set_title(const std::string& value) {
-  
-  _impl_.title_.Set(value, GetArenaForAllocation());
-}
-inline std::string* ProcessTitleReply::_internal_mutable_title() {
-  
-  return _impl_.title_.Mutable(GetArenaForAllocation());
-}
-inline std::string* ProcessTitleReply::release_title() {
-  // @@protoc_insertion_point(field_release:player.ProcessTitleReply.title)
-  return _impl_.title_.Release();
-}
-inline void ProcessTitleReply::set_allocated_title(std::string* title) {
-  if (title != nullptr) {
-    
-  } else {
-    
-  }
-  _impl_.title_.SetAllocated(title, GetArenaForAllocation());
-#ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
-  if (_impl_.title_.IsDefault()) {
-    _impl_.title_.Set("", GetArenaForAllocation());
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.ScreenshotReply";
   }
-#endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
-  // @@protoc_insertion_point(field_set_allocated:player.ProcessTitleReply.title)
-}
+  protected:
+  explicit ScreenshotReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
 
-// -------------------------------------------------------------------
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
 
-// OpenRequest
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
 
-// string link = 1;
-inline void OpenRequest::clear_link() {
-  _impl_.link_.ClearToEmpty();
-}
-inline const std::string& OpenRequest::link() const {
-  // @@protoc_insertion_point(field_get:player.OpenRequest.link)
-  return _internal_link();
-}
-template <typename ArgT0, typename... ArgT>
-inline PROTOBUF_ALWAYS_INLINE
-void OpenRequest::set_link(ArgT0&& arg0, ArgT... args) {
- 
- _impl_.link_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
-  // @@protoc_insertion_point(field_set:player.OpenRequest.link)
-}
-inline std::string* OpenRequest::mutable_link() {
-  std::string* _s = _internal_mutable_link();
-  // @@protoc_insertion_point(field_mutable:player.OpenRequest.link)
-  return _s;
-}
-inline const std::string& OpenRequest::_internal_link() const {
-  return _impl_.link_.Get();
-}
-inline void OpenRequest::_internal_set_link(const std::string& value) {
-  
-  _impl_.link_.Set(value, GetArenaForAllocation());
-}
-inline std::string* OpenRequest::_internal_mutable_link() {
-  
-  return _impl_.link_.Mutable(GetArenaForAllocation());
-}
-inline std::string* OpenRequest::release_link() {
-  // @@protoc_insertion_point(field_release:player.OpenRequest.link)
-  return _impl_.link_.Release();
-}
-inline void OpenRequest::set_allocated_link(std::string* link) {
-  if (link != nullptr) {
-    
-  } else {
-    
-  }
-  _impl_.link_.SetAllocated(link, GetArenaForAllocation());
-#ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
-  if (_impl_.link_.IsDefault()) {
-    _impl_.link_.Set("", GetArenaForAllocation());
-  }
-#endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
-  // @@protoc_insertion_point(field_set_allocated:player.OpenRequest.link)
-}
+  // nested types ----------------------------------------------------
 
-// string title = 2;
-inline void OpenRequest::clear_title() {
-  _impl_.title_.ClearToEmpty();
-}
-inline const std::string& OpenRequest::title() const {
-  // @@protoc_insertion_point(field_get:player.OpenRequest.title)
-  return _internal_title();
-}
-template <typename ArgT0, typename... ArgT>
-inline PROTOBUF_ALWAYS_INLINE
-void OpenRequest::set_title(ArgT0&& arg0, ArgT... args) {
- 
- _impl_.title_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
-  // @@protoc_insertion_point(field_set:player.OpenRequest.title)
-}
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kPngFieldNumber = 1,
+  };
+  // bytes png = 1;
+  void clear_png();
+  const std::string& png() const;
+  template <typename ArgT0 = const std::string&, typename... ArgT>
+  void set_png(ArgT0&& arg0, ArgT... args);
+  std::string* mutable_png();
+  PROTOBUF_NODISCARD std::string* release_png();
+  void set_allocated_png(std::string* png);
+  private:
+  const std::string& _internal_png() const;
+  inline PROTOBUF_ALWAYS_INLINE void _internal_set_png(const std::string& value);
+  std::string* _internal_mutable_png();
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.ScreenshotReply)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr png_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GetVideoTracksRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.GetVideoTracksRequest) */ {
+ public:
+  inline GetVideoTracksRequest() : GetVideoTracksRequest(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR GetVideoTracksRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  GetVideoTracksRequest(const GetVideoTracksRequest& from);
+  GetVideoTracksRequest(GetVideoTracksRequest&& from) noexcept
+    : GetVideoTracksRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline GetVideoTracksRequest& operator=(const GetVideoTracksRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline GetVideoTracksRequest& operator=(GetVideoTracksRequest&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const GetVideoTracksRequest& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const GetVideoTracksRequest* internal_default_instance() {
+    return reinterpret_cast<const GetVideoTracksRequest*>(
+               &_GetVideoTracksRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    48;
+
+  friend void swap(GetVideoTracksRequest& a, GetVideoTracksRequest& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(GetVideoTracksRequest* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(GetVideoTracksRequest* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  GetVideoTracksRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetVideoTracksRequest>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const GetVideoTracksRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const GetVideoTracksRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  }
+  public:
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.GetVideoTracksRequest";
+  }
+  protected:
+  explicit GetVideoTracksRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:player.GetVideoTracksRequest)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+  };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GetVideoTracksReply final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.GetVideoTracksReply) */ {
+ public:
+  inline GetVideoTracksReply() : GetVideoTracksReply(nullptr) {}
+  ~GetVideoTracksReply() override;
+  explicit PROTOBUF_CONSTEXPR GetVideoTracksReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  GetVideoTracksReply(const GetVideoTracksReply& from);
+  GetVideoTracksReply(GetVideoTracksReply&& from) noexcept
+    : GetVideoTracksReply() {
+    *this = ::std::move(from);
+  }
+
+  inline GetVideoTracksReply& operator=(const GetVideoTracksReply& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline GetVideoTracksReply& operator=(GetVideoTracksReply&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const GetVideoTracksReply& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const GetVideoTracksReply* internal_default_instance() {
+    return reinterpret_cast<const GetVideoTracksReply*>(
+               &_GetVideoTracksReply_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    49;
+
+  friend void swap(GetVideoTracksReply& a, GetVideoTracksReply& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(GetVideoTracksReply* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(GetVideoTracksReply* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  GetVideoTracksReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetVideoTracksReply>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const GetVideoTracksReply& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const GetVideoTracksReply& from) {
+    GetVideoTracksReply::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(GetVideoTracksReply* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.GetVideoTracksReply";
+  }
+  protected:
+  explicit GetVideoTracksReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kVideoTrackFieldNumber = 1,
+  };
+  // repeated .player.VideoTrack videoTrack = 1;
+  int videotrack_size() const;
+  private:
+  int _internal_videotrack_size() const;
+  public:
+  void clear_videotrack();
+  ::player::VideoTrack* mutable_videotrack(int index);
+  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::VideoTrack >*
+      mutable_videotrack();
+  private:
+  const ::player::VideoTrack& _internal_videotrack(int index) const;
+  ::player::VideoTrack* _internal_add_videotrack();
+  public:
+  const ::player::VideoTrack& videotrack(int index) const;
+  ::player::VideoTrack* add_videotrack();
+  const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::VideoTrack >&
+      videotrack() const;
+
+  // @@protoc_insertion_point(class_scope:player.GetVideoTracksReply)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::VideoTrack > videotrack_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class AudioTrack final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.AudioTrack) */ {
+ public:
+  inline AudioTrack() : AudioTrack(nullptr) {}
+  ~AudioTrack() override;
+  explicit PROTOBUF_CONSTEXPR AudioTrack(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  AudioTrack(const AudioTrack& from);
+  AudioTrack(AudioTrack&& from) noexcept
+    : AudioTrack() {
+    *this = ::std::move(from);
+  }
+
+  inline AudioTrack& operator=(const AudioTrack& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline AudioTrack& operator=(AudioTrack&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const AudioTrack& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const AudioTrack* internal_default_instance() {
+    return reinterpret_cast<const AudioTrack*>(
+               &_AudioTrack_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    50;
+
+  friend void swap(AudioTrack& a, AudioTrack& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(AudioTrack* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(AudioTrack* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  AudioTrack* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<AudioTrack>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const AudioTrack& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const AudioTrack& from) {
+    AudioTrack::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(AudioTrack* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.AudioTrack";
+  }
+  protected:
+  explicit AudioTrack(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kChannelLayoutFieldNumber = 6,
+    kInfoFieldNumber = 3,
+    kIdFieldNumber = 1,
+    kIsActiveFieldNumber = 2,
+    kSampleRateFieldNumber = 4,
+    kChannelsFieldNumber = 5,
+  };
+  // string channelLayout = 6;
+  void clear_channellayout();
+  const std::string& channellayout() const;
+  template <typename ArgT0 = const std::string&, typename... ArgT>
+  void set_channellayout(ArgT0&& arg0, ArgT... args);
+  std::string* mutable_channellayout();
+  PROTOBUF_NODISCARD std::string* release_channellayout();
+  void set_allocated_channellayout(std::string* channellayout);
+  private:
+  const std::string& _internal_channellayout() const;
+  inline PROTOBUF_ALWAYS_INLINE void _internal_set_channellayout(const std::string& value);
+  std::string* _internal_mutable_channellayout();
+  public:
+
+  // .player.TrackInfo info = 3;
+  bool has_info() const;
+  private:
+  bool _internal_has_info() const;
+  public:
+  void clear_info();
+  const ::player::TrackInfo& info() const;
+  PROTOBUF_NODISCARD ::player::TrackInfo* release_info();
+  ::player::TrackInfo* mutable_info();
+  void set_allocated_info(::player::TrackInfo* info);
+  private:
+  const ::player::TrackInfo& _internal_info() const;
+  ::player::TrackInfo* _internal_mutable_info();
+  public:
+  void unsafe_arena_set_allocated_info(
+      ::player::TrackInfo* info);
+  ::player::TrackInfo* unsafe_arena_release_info();
+
+  // int64 id = 1;
+  void clear_id();
+  int64_t id() const;
+  void set_id(int64_t value);
+  private:
+  int64_t _internal_id() const;
+  void _internal_set_id(int64_t value);
+  public:
+
+  // bool isActive = 2;
+  void clear_isactive();
+  bool isactive() const;
+  void set_isactive(bool value);
+  private:
+  bool _internal_isactive() const;
+  void _internal_set_isactive(bool value);
+  public:
+
+  // int32 sampleRate = 4;
+  void clear_samplerate();
+  int32_t samplerate() const;
+  void set_samplerate(int32_t value);
+  private:
+  int32_t _internal_samplerate() const;
+  void _internal_set_samplerate(int32_t value);
+  public:
+
+  // int32 channels = 5;
+  void clear_channels();
+  int32_t channels() const;
+  void set_channels(int32_t value);
+  private:
+  int32_t _internal_channels() const;
+  void _internal_set_channels(int32_t value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.AudioTrack)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr channellayout_;
+    ::player::TrackInfo* info_;
+    int64_t id_;
+    bool isactive_;
+    int32_t samplerate_;
+    int32_t channels_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GetAudioTracksRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.GetAudioTracksRequest) */ {
+ public:
+  inline GetAudioTracksRequest() : GetAudioTracksRequest(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR GetAudioTracksRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  GetAudioTracksRequest(const GetAudioTracksRequest& from);
+  GetAudioTracksRequest(GetAudioTracksRequest&& from) noexcept
+    : GetAudioTracksRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline GetAudioTracksRequest& operator=(const GetAudioTracksRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline GetAudioTracksRequest& operator=(GetAudioTracksRequest&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const GetAudioTracksRequest& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const GetAudioTracksRequest* internal_default_instance() {
+    return reinterpret_cast<const GetAudioTracksRequest*>(
+               &_GetAudioTracksRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    51;
+
+  friend void swap(GetAudioTracksRequest& a, GetAudioTracksRequest& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(GetAudioTracksRequest* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(GetAudioTracksRequest* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  GetAudioTracksRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetAudioTracksRequest>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const GetAudioTracksRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const GetAudioTracksRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  }
+  public:
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.GetAudioTracksRequest";
+  }
+  protected:
+  explicit GetAudioTracksRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:player.GetAudioTracksRequest)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+  };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GetAudioTracksReply final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.GetAudioTracksReply) */ {
+ public:
+  inline GetAudioTracksReply() : GetAudioTracksReply(nullptr) {}
+  ~GetAudioTracksReply() override;
+  explicit PROTOBUF_CONSTEXPR GetAudioTracksReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  GetAudioTracksReply(const GetAudioTracksReply& from);
+  GetAudioTracksReply(GetAudioTracksReply&& from) noexcept
+    : GetAudioTracksReply() {
+    *this = ::std::move(from);
+  }
+
+  inline GetAudioTracksReply& operator=(const GetAudioTracksReply& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline GetAudioTracksReply& operator=(GetAudioTracksReply&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const GetAudioTracksReply& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const GetAudioTracksReply* internal_default_instance() {
+    return reinterpret_cast<const GetAudioTracksReply*>(
+               &_GetAudioTracksReply_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    52;
+
+  friend void swap(GetAudioTracksReply& a, GetAudioTracksReply& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(GetAudioTracksReply* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(GetAudioTracksReply* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  GetAudioTracksReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetAudioTracksReply>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const GetAudioTracksReply& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const GetAudioTracksReply& from) {
+    GetAudioTracksReply::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(GetAudioTracksReply* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.GetAudioTracksReply";
+  }
+  protected:
+  explicit GetAudioTracksReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kAudioTrackFieldNumber = 1,
+  };
+  // repeated .player.AudioTrack audioTrack = 1;
+  int audiotrack_size() const;
+  private:
+  int _internal_audiotrack_size() const;
+  public:
+  void clear_audiotrack();
+  ::player::AudioTrack* mutable_audiotrack(int index);
+  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::AudioTrack >*
+      mutable_audiotrack();
+  private:
+  const ::player::AudioTrack& _internal_audiotrack(int index) const;
+  ::player::AudioTrack* _internal_add_audiotrack();
+  public:
+  const ::player::AudioTrack& audiotrack(int index) const;
+  ::player::AudioTrack* add_audiotrack();
+  const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::AudioTrack >&
+      audiotrack() const;
+
+  // @@protoc_insertion_point(class_scope:player.GetAudioTracksReply)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::AudioTrack > audiotrack_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class SubtitlesTrack final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.SubtitlesTrack) */ {
+ public:
+  inline SubtitlesTrack() : SubtitlesTrack(nullptr) {}
+  ~SubtitlesTrack() override;
+  explicit PROTOBUF_CONSTEXPR SubtitlesTrack(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  SubtitlesTrack(const SubtitlesTrack& from);
+  SubtitlesTrack(SubtitlesTrack&& from) noexcept
+    : SubtitlesTrack() {
+    *this = ::std::move(from);
+  }
+
+  inline SubtitlesTrack& operator=(const SubtitlesTrack& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline SubtitlesTrack& operator=(SubtitlesTrack&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const SubtitlesTrack& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const SubtitlesTrack* internal_default_instance() {
+    return reinterpret_cast<const SubtitlesTrack*>(
+               &_SubtitlesTrack_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    53;
+
+  friend void swap(SubtitlesTrack& a, SubtitlesTrack& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(SubtitlesTrack* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(SubtitlesTrack* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  SubtitlesTrack* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<SubtitlesTrack>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const SubtitlesTrack& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const SubtitlesTrack& from) {
+    SubtitlesTrack::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(SubtitlesTrack* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.SubtitlesTrack";
+  }
+  protected:
+  explicit SubtitlesTrack(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kInfoFieldNumber = 3,
+    kIdFieldNumber = 1,
+    kIsActiveFieldNumber = 2,
+  };
+  // .player.TrackInfo info = 3;
+  bool has_info() const;
+  private:
+  bool _internal_has_info() const;
+  public:
+  void clear_info();
+  const ::player::TrackInfo& info() const;
+  PROTOBUF_NODISCARD ::player::TrackInfo* release_info();
+  ::player::TrackInfo* mutable_info();
+  void set_allocated_info(::player::TrackInfo* info);
+  private:
+  const ::player::TrackInfo& _internal_info() const;
+  ::player::TrackInfo* _internal_mutable_info();
+  public:
+  void unsafe_arena_set_allocated_info(
+      ::player::TrackInfo* info);
+  ::player::TrackInfo* unsafe_arena_release_info();
+
+  // int64 id = 1;
+  void clear_id();
+  int64_t id() const;
+  void set_id(int64_t value);
+  private:
+  int64_t _internal_id() const;
+  void _internal_set_id(int64_t value);
+  public:
+
+  // bool isActive = 2;
+  void clear_isactive();
+  bool isactive() const;
+  void set_isactive(bool value);
+  private:
+  bool _internal_isactive() const;
+  void _internal_set_isactive(bool value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.SubtitlesTrack)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    ::player::TrackInfo* info_;
+    int64_t id_;
+    bool isactive_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GetSubtitlesTracksRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.GetSubtitlesTracksRequest) */ {
+ public:
+  inline GetSubtitlesTracksRequest() : GetSubtitlesTracksRequest(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR GetSubtitlesTracksRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  GetSubtitlesTracksRequest(const GetSubtitlesTracksRequest& from);
+  GetSubtitlesTracksRequest(GetSubtitlesTracksRequest&& from) noexcept
+    : GetSubtitlesTracksRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline GetSubtitlesTracksRequest& operator=(const GetSubtitlesTracksRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline GetSubtitlesTracksRequest& operator=(GetSubtitlesTracksRequest&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const GetSubtitlesTracksRequest& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const GetSubtitlesTracksRequest* internal_default_instance() {
+    return reinterpret_cast<const GetSubtitlesTracksRequest*>(
+               &_GetSubtitlesTracksRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    54;
+
+  friend void swap(GetSubtitlesTracksRequest& a, GetSubtitlesTracksRequest& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(GetSubtitlesTracksRequest* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(GetSubtitlesTracksRequest* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  GetSubtitlesTracksRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetSubtitlesTracksRequest>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const GetSubtitlesTracksRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const GetSubtitlesTracksRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  }
+  public:
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.GetSubtitlesTracksRequest";
+  }
+  protected:
+  explicit GetSubtitlesTracksRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:player.GetSubtitlesTracksRequest)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+  };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GetSubtitlesTracksReply final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.GetSubtitlesTracksReply) */ {
+ public:
+  inline GetSubtitlesTracksReply() : GetSubtitlesTracksReply(nullptr) {}
+  ~GetSubtitlesTracksReply() override;
+  explicit PROTOBUF_CONSTEXPR GetSubtitlesTracksReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  GetSubtitlesTracksReply(const GetSubtitlesTracksReply& from);
+  GetSubtitlesTracksReply(GetSubtitlesTracksReply&& from) noexcept
+    : GetSubtitlesTracksReply() {
+    *this = ::std::move(from);
+  }
+
+  inline GetSubtitlesTracksReply& operator=(const GetSubtitlesTracksReply& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline GetSubtitlesTracksReply& operator=(GetSubtitlesTracksReply&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const GetSubtitlesTracksReply& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const GetSubtitlesTracksReply* internal_default_instance() {
+    return reinterpret_cast<const GetSubtitlesTracksReply*>(
+               &_GetSubtitlesTracksReply_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    55;
+
+  friend void swap(GetSubtitlesTracksReply& a, GetSubtitlesTracksReply& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(GetSubtitlesTracksReply* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(GetSubtitlesTracksReply* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  GetSubtitlesTracksReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetSubtitlesTracksReply>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const GetSubtitlesTracksReply& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const GetSubtitlesTracksReply& from) {
+    GetSubtitlesTracksReply::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(GetSubtitlesTracksReply* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.GetSubtitlesTracksReply";
+  }
+  protected:
+  explicit GetSubtitlesTracksReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kSubtitlesTrackFieldNumber = 1,
+  };
+  // repeated .player.SubtitlesTrack subtitlesTrack = 1;
+  int subtitlestrack_size() const;
+  private:
+  int _internal_subtitlestrack_size() const;
+  public:
+  void clear_subtitlestrack();
+  ::player::SubtitlesTrack* mutable_subtitlestrack(int index);
+  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::SubtitlesTrack >*
+      mutable_subtitlestrack();
+  private:
+  const ::player::SubtitlesTrack& _internal_subtitlestrack(int index) const;
+  ::player::SubtitlesTrack* _internal_add_subtitlestrack();
+  public:
+  const ::player::SubtitlesTrack& subtitlestrack(int index) const;
+  ::player::SubtitlesTrack* add_subtitlestrack();
+  const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::SubtitlesTrack >&
+      subtitlestrack() const;
+
+  // @@protoc_insertion_point(class_scope:player.GetSubtitlesTracksReply)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::SubtitlesTrack > subtitlestrack_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class Chapter final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.Chapter) */ {
+ public:
+  inline Chapter() : Chapter(nullptr) {}
+  ~Chapter() override;
+  explicit PROTOBUF_CONSTEXPR Chapter(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  Chapter(const Chapter& from);
+  Chapter(Chapter&& from) noexcept
+    : Chapter() {
+    *this = ::std::move(from);
+  }
+
+  inline Chapter& operator=(const Chapter& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline Chapter& operator=(Chapter&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const Chapter& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const Chapter* internal_default_instance() {
+    return reinterpret_cast<const Chapter*>(
+               &_Chapter_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    56;
+
+  friend void swap(Chapter& a, Chapter& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(Chapter* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(Chapter* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  Chapter* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<Chapter>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const Chapter& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const Chapter& from) {
+    Chapter::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(Chapter* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.Chapter";
+  }
+  protected:
+  explicit Chapter(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kTitleFieldNumber = 1,
+    kStartSecsFieldNumber = 2,
+    kEndSecsFieldNumber = 3,
+  };
+  // string title = 1;
+  void clear_title();
+  const std::string& title() const;
+  template <typename ArgT0 = const std::string&, typename... ArgT>
+  void set_title(ArgT0&& arg0, ArgT... args);
+  std::string* mutable_title();
+  PROTOBUF_NODISCARD std::string* release_title();
+  void set_allocated_title(std::string* title);
+  private:
+  const std::string& _internal_title() const;
+  inline PROTOBUF_ALWAYS_INLINE void _internal_set_title(const std::string& value);
+  std::string* _internal_mutable_title();
+  public:
+
+  // double startSecs = 2;
+  void clear_startsecs();
+  double startsecs() const;
+  void set_startsecs(double value);
+  private:
+  double _internal_startsecs() const;
+  void _internal_set_startsecs(double value);
+  public:
+
+  // double endSecs = 3;
+  void clear_endsecs();
+  double endsecs() const;
+  void set_endsecs(double value);
+  private:
+  double _internal_endsecs() const;
+  void _internal_set_endsecs(double value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.Chapter)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr title_;
+    double startsecs_;
+    double endsecs_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class MediaInfo_TagsEntry_DoNotUse : public ::PROTOBUF_NAMESPACE_ID::internal::MapEntry<MediaInfo_TagsEntry_DoNotUse, 
+    std::string, std::string,
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_STRING,
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_STRING> {
+public:
+  typedef ::PROTOBUF_NAMESPACE_ID::internal::MapEntry<MediaInfo_TagsEntry_DoNotUse, 
+    std::string, std::string,
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_STRING,
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_STRING> SuperType;
+  MediaInfo_TagsEntry_DoNotUse();
+  explicit PROTOBUF_CONSTEXPR MediaInfo_TagsEntry_DoNotUse(
+      ::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+  explicit MediaInfo_TagsEntry_DoNotUse(::PROTOBUF_NAMESPACE_ID::Arena* arena);
+  void MergeFrom(const MediaInfo_TagsEntry_DoNotUse& other);
+  static const MediaInfo_TagsEntry_DoNotUse* internal_default_instance() { return reinterpret_cast<const MediaInfo_TagsEntry_DoNotUse*>(&_MediaInfo_TagsEntry_DoNotUse_default_instance_); }
+  static bool ValidateKey(std::string* s) {
+    return ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(s->data(), static_cast<int>(s->size()), ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::PARSE, "player.MediaInfo.TagsEntry.key");
+ }
+  static bool ValidateValue(std::string* s) {
+    return ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::VerifyUtf8String(s->data(), static_cast<int>(s->size()), ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::PARSE, "player.MediaInfo.TagsEntry.value");
+ }
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+  friend struct ::TableStruct_player_2eproto;
+};
+
+// -------------------------------------------------------------------
+
+class MediaInfo final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.MediaInfo) */ {
+ public:
+  inline MediaInfo() : MediaInfo(nullptr) {}
+  ~MediaInfo() override;
+  explicit PROTOBUF_CONSTEXPR MediaInfo(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  MediaInfo(const MediaInfo& from);
+  MediaInfo(MediaInfo&& from) noexcept
+    : MediaInfo() {
+    *this = ::std::move(from);
+  }
+
+  inline MediaInfo& operator=(const MediaInfo& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline MediaInfo& operator=(MediaInfo&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const MediaInfo& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const MediaInfo* internal_default_instance() {
+    return reinterpret_cast<const MediaInfo*>(
+               &_MediaInfo_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    58;
+
+  friend void swap(MediaInfo& a, MediaInfo& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(MediaInfo* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(MediaInfo* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  MediaInfo* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<MediaInfo>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const MediaInfo& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const MediaInfo& from) {
+    MediaInfo::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(MediaInfo* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.MediaInfo";
+  }
+  protected:
+  explicit MediaInfo(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  private:
+  static void ArenaDtor(void* object);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kTagsFieldNumber = 4,
+    kChaptersFieldNumber = 5,
+    kFormatNameFieldNumber = 1,
+    kBitrateFieldNumber = 2,
+    kStreamCountFieldNumber = 3,
+  };
+  // map<string, string> tags = 4;
+  int tags_size() const;
+  private:
+  int _internal_tags_size() const;
+  public:
+  void clear_tags();
+  private:
+  const ::PROTOBUF_NAMESPACE_ID::Map< std::string, std::string >&
+      _internal_tags() const;
+  ::PROTOBUF_NAMESPACE_ID::Map< std::string, std::string >*
+      _internal_mutable_tags();
+  public:
+  const ::PROTOBUF_NAMESPACE_ID::Map< std::string, std::string >&
+      tags() const;
+  ::PROTOBUF_NAMESPACE_ID::Map< std::string, std::string >*
+      mutable_tags();
+
+  // repeated .player.Chapter chapters = 5;
+  int chapters_size() const;
+  private:
+  int _internal_chapters_size() const;
+  public:
+  void clear_chapters();
+  ::player::Chapter* mutable_chapters(int index);
+  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::Chapter >*
+      mutable_chapters();
+  private:
+  const ::player::Chapter& _internal_chapters(int index) const;
+  ::player::Chapter* _internal_add_chapters();
+  public:
+  const ::player::Chapter& chapters(int index) const;
+  ::player::Chapter* add_chapters();
+  const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::Chapter >&
+      chapters() const;
+
+  // string formatName = 1;
+  void clear_formatname();
+  const std::string& formatname() const;
+  template <typename ArgT0 = const std::string&, typename... ArgT>
+  void set_formatname(ArgT0&& arg0, ArgT... args);
+  std::string* mutable_formatname();
+  PROTOBUF_NODISCARD std::string* release_formatname();
+  void set_allocated_formatname(std::string* formatname);
+  private:
+  const std::string& _internal_formatname() const;
+  inline PROTOBUF_ALWAYS_INLINE void _internal_set_formatname(const std::string& value);
+  std::string* _internal_mutable_formatname();
+  public:
+
+  // int64 bitrate = 2;
+  void clear_bitrate();
+  int64_t bitrate() const;
+  void set_bitrate(int64_t value);
+  private:
+  int64_t _internal_bitrate() const;
+  void _internal_set_bitrate(int64_t value);
+  public:
+
+  // int32 streamCount = 3;
+  void clear_streamcount();
+  int32_t streamcount() const;
+  void set_streamcount(int32_t value);
+  private:
+  int32_t _internal_streamcount() const;
+  void _internal_set_streamcount(int32_t value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.MediaInfo)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    ::PROTOBUF_NAMESPACE_ID::internal::MapField<
+        MediaInfo_TagsEntry_DoNotUse,
+        std::string, std::string,
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_STRING,
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_STRING> tags_;
+    ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::Chapter > chapters_;
+    ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr formatname_;
+    int64_t bitrate_;
+    int32_t streamcount_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GetMediaInfoRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.GetMediaInfoRequest) */ {
+ public:
+  inline GetMediaInfoRequest() : GetMediaInfoRequest(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR GetMediaInfoRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  GetMediaInfoRequest(const GetMediaInfoRequest& from);
+  GetMediaInfoRequest(GetMediaInfoRequest&& from) noexcept
+    : GetMediaInfoRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline GetMediaInfoRequest& operator=(const GetMediaInfoRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline GetMediaInfoRequest& operator=(GetMediaInfoRequest&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const GetMediaInfoRequest& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const GetMediaInfoRequest* internal_default_instance() {
+    return reinterpret_cast<const GetMediaInfoRequest*>(
+               &_GetMediaInfoRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    59;
+
+  friend void swap(GetMediaInfoRequest& a, GetMediaInfoRequest& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(GetMediaInfoRequest* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(GetMediaInfoRequest* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  GetMediaInfoRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetMediaInfoRequest>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const GetMediaInfoRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const GetMediaInfoRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  }
+  public:
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.GetMediaInfoRequest";
+  }
+  protected:
+  explicit GetMediaInfoRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:player.GetMediaInfoRequest)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+  };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GetMediaInfoReply final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.GetMediaInfoReply) */ {
+ public:
+  inline GetMediaInfoReply() : GetMediaInfoReply(nullptr) {}
+  ~GetMediaInfoReply() override;
+  explicit PROTOBUF_CONSTEXPR GetMediaInfoReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  GetMediaInfoReply(const GetMediaInfoReply& from);
+  GetMediaInfoReply(GetMediaInfoReply&& from) noexcept
+    : GetMediaInfoReply() {
+    *this = ::std::move(from);
+  }
+
+  inline GetMediaInfoReply& operator=(const GetMediaInfoReply& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline GetMediaInfoReply& operator=(GetMediaInfoReply&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const GetMediaInfoReply& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const GetMediaInfoReply* internal_default_instance() {
+    return reinterpret_cast<const GetMediaInfoReply*>(
+               &_GetMediaInfoReply_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    60;
+
+  friend void swap(GetMediaInfoReply& a, GetMediaInfoReply& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(GetMediaInfoReply* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(GetMediaInfoReply* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  GetMediaInfoReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetMediaInfoReply>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const GetMediaInfoReply& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const GetMediaInfoReply& from) {
+    GetMediaInfoReply::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(GetMediaInfoReply* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.GetMediaInfoReply";
+  }
+  protected:
+  explicit GetMediaInfoReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kMediaInfoFieldNumber = 1,
+  };
+  // .player.MediaInfo mediaInfo = 1;
+  bool has_mediainfo() const;
+  private:
+  bool _internal_has_mediainfo() const;
+  public:
+  void clear_mediainfo();
+  const ::player::MediaInfo& mediainfo() const;
+  PROTOBUF_NODISCARD ::player::MediaInfo* release_mediainfo();
+  ::player::MediaInfo* mutable_mediainfo();
+  void set_allocated_mediainfo(::player::MediaInfo* mediainfo);
+  private:
+  const ::player::MediaInfo& _internal_mediainfo() const;
+  ::player::MediaInfo* _internal_mutable_mediainfo();
+  public:
+  void unsafe_arena_set_allocated_mediainfo(
+      ::player::MediaInfo* mediainfo);
+  ::player::MediaInfo* unsafe_arena_release_mediainfo();
+
+  // @@protoc_insertion_point(class_scope:player.GetMediaInfoReply)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    ::player::MediaInfo* mediainfo_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GetChaptersRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.GetChaptersRequest) */ {
+ public:
+  inline GetChaptersRequest() : GetChaptersRequest(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR GetChaptersRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  GetChaptersRequest(const GetChaptersRequest& from);
+  GetChaptersRequest(GetChaptersRequest&& from) noexcept
+    : GetChaptersRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline GetChaptersRequest& operator=(const GetChaptersRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline GetChaptersRequest& operator=(GetChaptersRequest&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const GetChaptersRequest& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const GetChaptersRequest* internal_default_instance() {
+    return reinterpret_cast<const GetChaptersRequest*>(
+               &_GetChaptersRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    61;
+
+  friend void swap(GetChaptersRequest& a, GetChaptersRequest& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(GetChaptersRequest* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(GetChaptersRequest* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  GetChaptersRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetChaptersRequest>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const GetChaptersRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const GetChaptersRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  }
+  public:
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.GetChaptersRequest";
+  }
+  protected:
+  explicit GetChaptersRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:player.GetChaptersRequest)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+  };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GetChaptersReply final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.GetChaptersReply) */ {
+ public:
+  inline GetChaptersReply() : GetChaptersReply(nullptr) {}
+  ~GetChaptersReply() override;
+  explicit PROTOBUF_CONSTEXPR GetChaptersReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  GetChaptersReply(const GetChaptersReply& from);
+  GetChaptersReply(GetChaptersReply&& from) noexcept
+    : GetChaptersReply() {
+    *this = ::std::move(from);
+  }
+
+  inline GetChaptersReply& operator=(const GetChaptersReply& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline GetChaptersReply& operator=(GetChaptersReply&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const GetChaptersReply& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const GetChaptersReply* internal_default_instance() {
+    return reinterpret_cast<const GetChaptersReply*>(
+               &_GetChaptersReply_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    62;
+
+  friend void swap(GetChaptersReply& a, GetChaptersReply& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(GetChaptersReply* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(GetChaptersReply* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  GetChaptersReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetChaptersReply>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const GetChaptersReply& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const GetChaptersReply& from) {
+    GetChaptersReply::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(GetChaptersReply* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.GetChaptersReply";
+  }
+  protected:
+  explicit GetChaptersReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kChaptersFieldNumber = 1,
+  };
+  // repeated .player.Chapter chapters = 1;
+  int chapters_size() const;
+  private:
+  int _internal_chapters_size() const;
+  public:
+  void clear_chapters();
+  ::player::Chapter* mutable_chapters(int index);
+  ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::Chapter >*
+      mutable_chapters();
+  private:
+  const ::player::Chapter& _internal_chapters(int index) const;
+  ::player::Chapter* _internal_add_chapters();
+  public:
+  const ::player::Chapter& chapters(int index) const;
+  ::player::Chapter* add_chapters();
+  const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::Chapter >&
+      chapters() const;
+
+  // @@protoc_insertion_point(class_scope:player.GetChaptersReply)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::Chapter > chapters_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GetChapterRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.GetChapterRequest) */ {
+ public:
+  inline GetChapterRequest() : GetChapterRequest(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR GetChapterRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  GetChapterRequest(const GetChapterRequest& from);
+  GetChapterRequest(GetChapterRequest&& from) noexcept
+    : GetChapterRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline GetChapterRequest& operator=(const GetChapterRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline GetChapterRequest& operator=(GetChapterRequest&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const GetChapterRequest& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const GetChapterRequest* internal_default_instance() {
+    return reinterpret_cast<const GetChapterRequest*>(
+               &_GetChapterRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    63;
+
+  friend void swap(GetChapterRequest& a, GetChapterRequest& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(GetChapterRequest* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(GetChapterRequest* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  GetChapterRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetChapterRequest>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const GetChapterRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const GetChapterRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  }
+  public:
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.GetChapterRequest";
+  }
+  protected:
+  explicit GetChapterRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:player.GetChapterRequest)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+  };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class GetChapterReply final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.GetChapterReply) */ {
+ public:
+  inline GetChapterReply() : GetChapterReply(nullptr) {}
+  ~GetChapterReply() override;
+  explicit PROTOBUF_CONSTEXPR GetChapterReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  GetChapterReply(const GetChapterReply& from);
+  GetChapterReply(GetChapterReply&& from) noexcept
+    : GetChapterReply() {
+    *this = ::std::move(from);
+  }
+
+  inline GetChapterReply& operator=(const GetChapterReply& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline GetChapterReply& operator=(GetChapterReply&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const GetChapterReply& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const GetChapterReply* internal_default_instance() {
+    return reinterpret_cast<const GetChapterReply*>(
+               &_GetChapterReply_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    64;
+
+  friend void swap(GetChapterReply& a, GetChapterReply& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(GetChapterReply* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(GetChapterReply* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  GetChapterReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<GetChapterReply>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const GetChapterReply& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const GetChapterReply& from) {
+    GetChapterReply::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(GetChapterReply* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.GetChapterReply";
+  }
+  protected:
+  explicit GetChapterReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kChapterIdxFieldNumber = 1,
+  };
+  // int32 chapterIdx = 1;
+  void clear_chapteridx();
+  int32_t chapteridx() const;
+  void set_chapteridx(int32_t value);
+  private:
+  int32_t _internal_chapteridx() const;
+  void _internal_set_chapteridx(int32_t value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.GetChapterReply)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    int32_t chapteridx_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class SetChapterRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.SetChapterRequest) */ {
+ public:
+  inline SetChapterRequest() : SetChapterRequest(nullptr) {}
+  ~SetChapterRequest() override;
+  explicit PROTOBUF_CONSTEXPR SetChapterRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  SetChapterRequest(const SetChapterRequest& from);
+  SetChapterRequest(SetChapterRequest&& from) noexcept
+    : SetChapterRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline SetChapterRequest& operator=(const SetChapterRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline SetChapterRequest& operator=(SetChapterRequest&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const SetChapterRequest& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const SetChapterRequest* internal_default_instance() {
+    return reinterpret_cast<const SetChapterRequest*>(
+               &_SetChapterRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    65;
+
+  friend void swap(SetChapterRequest& a, SetChapterRequest& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(SetChapterRequest* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(SetChapterRequest* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  SetChapterRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<SetChapterRequest>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const SetChapterRequest& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const SetChapterRequest& from) {
+    SetChapterRequest::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(SetChapterRequest* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.SetChapterRequest";
+  }
+  protected:
+  explicit SetChapterRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kChapterIdxFieldNumber = 1,
+  };
+  // int32 chapterIdx = 1;
+  void clear_chapteridx();
+  int32_t chapteridx() const;
+  void set_chapteridx(int32_t value);
+  private:
+  int32_t _internal_chapteridx() const;
+  void _internal_set_chapteridx(int32_t value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.SetChapterRequest)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    int32_t chapteridx_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class SetChapterReply final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.SetChapterReply) */ {
+ public:
+  inline SetChapterReply() : SetChapterReply(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR SetChapterReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  SetChapterReply(const SetChapterReply& from);
+  SetChapterReply(SetChapterReply&& from) noexcept
+    : SetChapterReply() {
+    *this = ::std::move(from);
+  }
+
+  inline SetChapterReply& operator=(const SetChapterReply& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline SetChapterReply& operator=(SetChapterReply&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const SetChapterReply& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const SetChapterReply* internal_default_instance() {
+    return reinterpret_cast<const SetChapterReply*>(
+               &_SetChapterReply_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    66;
+
+  friend void swap(SetChapterReply& a, SetChapterReply& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(SetChapterReply* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(SetChapterReply* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  SetChapterReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<SetChapterReply>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const SetChapterReply& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const SetChapterReply& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  }
+  public:
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.SetChapterReply";
+  }
+  protected:
+  explicit SetChapterReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:player.SetChapterReply)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+  };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class StopRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.StopRequest) */ {
+ public:
+  inline StopRequest() : StopRequest(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR StopRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  StopRequest(const StopRequest& from);
+  StopRequest(StopRequest&& from) noexcept
+    : StopRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline StopRequest& operator=(const StopRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline StopRequest& operator=(StopRequest&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const StopRequest& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const StopRequest* internal_default_instance() {
+    return reinterpret_cast<const StopRequest*>(
+               &_StopRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    67;
+
+  friend void swap(StopRequest& a, StopRequest& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(StopRequest* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(StopRequest* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  StopRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<StopRequest>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const StopRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const StopRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  }
+  public:
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.StopRequest";
+  }
+  protected:
+  explicit StopRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:player.StopRequest)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+  };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class StopReply final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.StopReply) */ {
+ public:
+  inline StopReply() : StopReply(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR StopReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  StopReply(const StopReply& from);
+  StopReply(StopReply&& from) noexcept
+    : StopReply() {
+    *this = ::std::move(from);
+  }
+
+  inline StopReply& operator=(const StopReply& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline StopReply& operator=(StopReply&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const StopReply& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const StopReply* internal_default_instance() {
+    return reinterpret_cast<const StopReply*>(
+               &_StopReply_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    68;
+
+  friend void swap(StopReply& a, StopReply& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(StopReply* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(StopReply* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  StopReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<StopReply>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const StopReply& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const StopReply& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  }
+  public:
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.StopReply";
+  }
+  protected:
+  explicit StopReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:player.StopReply)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+  };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class CloseRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.CloseRequest) */ {
+ public:
+  inline CloseRequest() : CloseRequest(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR CloseRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  CloseRequest(const CloseRequest& from);
+  CloseRequest(CloseRequest&& from) noexcept
+    : CloseRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline CloseRequest& operator=(const CloseRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline CloseRequest& operator=(CloseRequest&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const CloseRequest& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const CloseRequest* internal_default_instance() {
+    return reinterpret_cast<const CloseRequest*>(
+               &_CloseRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    69;
+
+  friend void swap(CloseRequest& a, CloseRequest& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(CloseRequest* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(CloseRequest* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  CloseRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<CloseRequest>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const CloseRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const CloseRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  }
+  public:
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.CloseRequest";
+  }
+  protected:
+  explicit CloseRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:player.CloseRequest)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+  };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class CloseReply final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.CloseReply) */ {
+ public:
+  inline CloseReply() : CloseReply(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR CloseReply(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  CloseReply(const CloseReply& from);
+  CloseReply(CloseReply&& from) noexcept
+    : CloseReply() {
+    *this = ::std::move(from);
+  }
+
+  inline CloseReply& operator=(const CloseReply& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline CloseReply& operator=(CloseReply&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const CloseReply& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const CloseReply* internal_default_instance() {
+    return reinterpret_cast<const CloseReply*>(
+               &_CloseReply_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    70;
+
+  friend void swap(CloseReply& a, CloseReply& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(CloseReply* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(CloseReply* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  CloseReply* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<CloseReply>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const CloseReply& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const CloseReply& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  }
+  public:
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.CloseReply";
+  }
+  protected:
+  explicit CloseReply(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:player.CloseReply)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+  };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class EventsRequest final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.EventsRequest) */ {
+ public:
+  inline EventsRequest() : EventsRequest(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR EventsRequest(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  EventsRequest(const EventsRequest& from);
+  EventsRequest(EventsRequest&& from) noexcept
+    : EventsRequest() {
+    *this = ::std::move(from);
+  }
+
+  inline EventsRequest& operator=(const EventsRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline EventsRequest& operator=(EventsRequest&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const EventsRequest& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const EventsRequest* internal_default_instance() {
+    return reinterpret_cast<const EventsRequest*>(
+               &_EventsRequest_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    71;
+
+  friend void swap(EventsRequest& a, EventsRequest& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(EventsRequest* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(EventsRequest* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  EventsRequest* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<EventsRequest>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const EventsRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const EventsRequest& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  }
+  public:
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.EventsRequest";
+  }
+  protected:
+  explicit EventsRequest(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:player.EventsRequest)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+  };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class EventStateChange final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.EventStateChange) */ {
+ public:
+  inline EventStateChange() : EventStateChange(nullptr) {}
+  ~EventStateChange() override;
+  explicit PROTOBUF_CONSTEXPR EventStateChange(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  EventStateChange(const EventStateChange& from);
+  EventStateChange(EventStateChange&& from) noexcept
+    : EventStateChange() {
+    *this = ::std::move(from);
+  }
+
+  inline EventStateChange& operator=(const EventStateChange& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline EventStateChange& operator=(EventStateChange&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const EventStateChange& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const EventStateChange* internal_default_instance() {
+    return reinterpret_cast<const EventStateChange*>(
+               &_EventStateChange_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    72;
+
+  friend void swap(EventStateChange& a, EventStateChange& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(EventStateChange* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(EventStateChange* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  EventStateChange* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<EventStateChange>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const EventStateChange& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const EventStateChange& from) {
+    EventStateChange::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(EventStateChange* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.EventStateChange";
+  }
+  protected:
+  explicit EventStateChange(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kStateFieldNumber = 1,
+  };
+  // .player.PlaybackState state = 1;
+  void clear_state();
+  ::player::PlaybackState state() const;
+  void set_state(::player::PlaybackState value);
+  private:
+  ::player::PlaybackState _internal_state() const;
+  void _internal_set_state(::player::PlaybackState value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.EventStateChange)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    int state_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class EventPosition final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.EventPosition) */ {
+ public:
+  inline EventPosition() : EventPosition(nullptr) {}
+  ~EventPosition() override;
+  explicit PROTOBUF_CONSTEXPR EventPosition(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  EventPosition(const EventPosition& from);
+  EventPosition(EventPosition&& from) noexcept
+    : EventPosition() {
+    *this = ::std::move(from);
+  }
+
+  inline EventPosition& operator=(const EventPosition& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline EventPosition& operator=(EventPosition&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const EventPosition& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const EventPosition* internal_default_instance() {
+    return reinterpret_cast<const EventPosition*>(
+               &_EventPosition_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    73;
+
+  friend void swap(EventPosition& a, EventPosition& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(EventPosition* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(EventPosition* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  EventPosition* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<EventPosition>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const EventPosition& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const EventPosition& from) {
+    EventPosition::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(EventPosition* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.EventPosition";
+  }
+  protected:
+  explicit EventPosition(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kPositionSecsFieldNumber = 1,
+    kLengthSecsFieldNumber = 2,
+  };
+  // double positionSecs = 1;
+  void clear_positionsecs();
+  double positionsecs() const;
+  void set_positionsecs(double value);
+  private:
+  double _internal_positionsecs() const;
+  void _internal_set_positionsecs(double value);
+  public:
+
+  // double lengthSecs = 2;
+  void clear_lengthsecs();
+  double lengthsecs() const;
+  void set_lengthsecs(double value);
+  private:
+  double _internal_lengthsecs() const;
+  void _internal_set_lengthsecs(double value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.EventPosition)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    double positionsecs_;
+    double lengthsecs_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class EventTracksChange final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.EventTracksChange) */ {
+ public:
+  inline EventTracksChange() : EventTracksChange(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR EventTracksChange(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  EventTracksChange(const EventTracksChange& from);
+  EventTracksChange(EventTracksChange&& from) noexcept
+    : EventTracksChange() {
+    *this = ::std::move(from);
+  }
+
+  inline EventTracksChange& operator=(const EventTracksChange& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline EventTracksChange& operator=(EventTracksChange&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const EventTracksChange& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const EventTracksChange* internal_default_instance() {
+    return reinterpret_cast<const EventTracksChange*>(
+               &_EventTracksChange_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    74;
+
+  friend void swap(EventTracksChange& a, EventTracksChange& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(EventTracksChange* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(EventTracksChange* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  EventTracksChange* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<EventTracksChange>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const EventTracksChange& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const EventTracksChange& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  }
+  public:
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.EventTracksChange";
+  }
+  protected:
+  explicit EventTracksChange(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:player.EventTracksChange)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+  };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class EventBuffering final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.EventBuffering) */ {
+ public:
+  inline EventBuffering() : EventBuffering(nullptr) {}
+  ~EventBuffering() override;
+  explicit PROTOBUF_CONSTEXPR EventBuffering(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  EventBuffering(const EventBuffering& from);
+  EventBuffering(EventBuffering&& from) noexcept
+    : EventBuffering() {
+    *this = ::std::move(from);
+  }
+
+  inline EventBuffering& operator=(const EventBuffering& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline EventBuffering& operator=(EventBuffering&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const EventBuffering& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const EventBuffering* internal_default_instance() {
+    return reinterpret_cast<const EventBuffering*>(
+               &_EventBuffering_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    75;
+
+  friend void swap(EventBuffering& a, EventBuffering& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(EventBuffering* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(EventBuffering* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  EventBuffering* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<EventBuffering>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const EventBuffering& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const EventBuffering& from) {
+    EventBuffering::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(EventBuffering* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.EventBuffering";
+  }
+  protected:
+  explicit EventBuffering(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kPercentFieldNumber = 1,
+  };
+  // double percent = 1;
+  void clear_percent();
+  double percent() const;
+  void set_percent(double value);
+  private:
+  double _internal_percent() const;
+  void _internal_set_percent(double value);
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.EventBuffering)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    double percent_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class EventError final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.EventError) */ {
+ public:
+  inline EventError() : EventError(nullptr) {}
+  ~EventError() override;
+  explicit PROTOBUF_CONSTEXPR EventError(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  EventError(const EventError& from);
+  EventError(EventError&& from) noexcept
+    : EventError() {
+    *this = ::std::move(from);
+  }
+
+  inline EventError& operator=(const EventError& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline EventError& operator=(EventError&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const EventError& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const EventError* internal_default_instance() {
+    return reinterpret_cast<const EventError*>(
+               &_EventError_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    76;
+
+  friend void swap(EventError& a, EventError& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(EventError* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(EventError* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  EventError* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<EventError>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const EventError& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const EventError& from) {
+    EventError::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(EventError* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.EventError";
+  }
+  protected:
+  explicit EventError(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kErrorFieldNumber = 1,
+  };
+  // string error = 1;
+  void clear_error();
+  const std::string& error() const;
+  template <typename ArgT0 = const std::string&, typename... ArgT>
+  void set_error(ArgT0&& arg0, ArgT... args);
+  std::string* mutable_error();
+  PROTOBUF_NODISCARD std::string* release_error();
+  void set_allocated_error(std::string* error);
+  private:
+  const std::string& _internal_error() const;
+  inline PROTOBUF_ALWAYS_INLINE void _internal_set_error(const std::string& value);
+  std::string* _internal_mutable_error();
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.EventError)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr error_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class EventEndOfFile final :
+    public ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase /* @@protoc_insertion_point(class_definition:player.EventEndOfFile) */ {
+ public:
+  inline EventEndOfFile() : EventEndOfFile(nullptr) {}
+  explicit PROTOBUF_CONSTEXPR EventEndOfFile(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  EventEndOfFile(const EventEndOfFile& from);
+  EventEndOfFile(EventEndOfFile&& from) noexcept
+    : EventEndOfFile() {
+    *this = ::std::move(from);
+  }
+
+  inline EventEndOfFile& operator=(const EventEndOfFile& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline EventEndOfFile& operator=(EventEndOfFile&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const EventEndOfFile& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const EventEndOfFile* internal_default_instance() {
+    return reinterpret_cast<const EventEndOfFile*>(
+               &_EventEndOfFile_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    77;
+
+  friend void swap(EventEndOfFile& a, EventEndOfFile& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(EventEndOfFile* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(EventEndOfFile* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  EventEndOfFile* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<EventEndOfFile>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyFrom;
+  inline void CopyFrom(const EventEndOfFile& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::CopyImpl(*this, from);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeFrom;
+  void MergeFrom(const EventEndOfFile& from) {
+    ::PROTOBUF_NAMESPACE_ID::internal::ZeroFieldsBase::MergeImpl(*this, from);
+  }
+  public:
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.EventEndOfFile";
+  }
+  protected:
+  explicit EventEndOfFile(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // @@protoc_insertion_point(class_scope:player.EventEndOfFile)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+  };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class EventMediaChange final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.EventMediaChange) */ {
+ public:
+  inline EventMediaChange() : EventMediaChange(nullptr) {}
+  ~EventMediaChange() override;
+  explicit PROTOBUF_CONSTEXPR EventMediaChange(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  EventMediaChange(const EventMediaChange& from);
+  EventMediaChange(EventMediaChange&& from) noexcept
+    : EventMediaChange() {
+    *this = ::std::move(from);
+  }
+
+  inline EventMediaChange& operator=(const EventMediaChange& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline EventMediaChange& operator=(EventMediaChange&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const EventMediaChange& default_instance() {
+    return *internal_default_instance();
+  }
+  static inline const EventMediaChange* internal_default_instance() {
+    return reinterpret_cast<const EventMediaChange*>(
+               &_EventMediaChange_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    78;
+
+  friend void swap(EventMediaChange& a, EventMediaChange& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(EventMediaChange* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(EventMediaChange* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  EventMediaChange* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<EventMediaChange>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const EventMediaChange& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const EventMediaChange& from) {
+    EventMediaChange::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(EventMediaChange* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.EventMediaChange";
+  }
+  protected:
+  explicit EventMediaChange(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kLinkFieldNumber = 1,
+  };
+  // string link = 1;
+  void clear_link();
+  const std::string& link() const;
+  template <typename ArgT0 = const std::string&, typename... ArgT>
+  void set_link(ArgT0&& arg0, ArgT... args);
+  std::string* mutable_link();
+  PROTOBUF_NODISCARD std::string* release_link();
+  void set_allocated_link(std::string* link);
+  private:
+  const std::string& _internal_link() const;
+  inline PROTOBUF_ALWAYS_INLINE void _internal_set_link(const std::string& value);
+  std::string* _internal_mutable_link();
+  public:
+
+  // @@protoc_insertion_point(class_scope:player.EventMediaChange)
+ private:
+  class _Internal;
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    ::PROTOBUF_NAMESPACE_ID::internal::ArenaStringPtr link_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// -------------------------------------------------------------------
+
+class Event final :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:player.Event) */ {
+ public:
+  inline Event() : Event(nullptr) {}
+  ~Event() override;
+  explicit PROTOBUF_CONSTEXPR Event(::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized);
+
+  Event(const Event& from);
+  Event(Event&& from) noexcept
+    : Event() {
+    *this = ::std::move(from);
+  }
+
+  inline Event& operator=(const Event& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline Event& operator=(Event&& from) noexcept {
+    if (this == &from) return *this;
+    if (GetOwningArena() == from.GetOwningArena()
+  #ifdef PROTOBUF_FORCE_COPY_IN_MOVE
+        && GetOwningArena() != nullptr
+  #endif  // !PROTOBUF_FORCE_COPY_IN_MOVE
+    ) {
+      InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return default_instance().GetMetadata().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return default_instance().GetMetadata().reflection;
+  }
+  static const Event& default_instance() {
+    return *internal_default_instance();
+  }
+  enum EventCase {
+    kStateChange = 1,
+    kPosition = 2,
+    kTracksChange = 3,
+    kBuffering = 4,
+    kError = 5,
+    kEndOfFile = 6,
+    kMediaChange = 7,
+    EVENT_NOT_SET = 0,
+  };
+
+  static inline const Event* internal_default_instance() {
+    return reinterpret_cast<const Event*>(
+               &_Event_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    79;
+
+  friend void swap(Event& a, Event& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(Event* other) {
+    if (other == this) return;
+  #ifdef PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() != nullptr &&
+        GetOwningArena() == other->GetOwningArena()) {
+   #else  // PROTOBUF_FORCE_COPY_IN_SWAP
+    if (GetOwningArena() == other->GetOwningArena()) {
+  #endif  // !PROTOBUF_FORCE_COPY_IN_SWAP
+      InternalSwap(other);
+    } else {
+      ::PROTOBUF_NAMESPACE_ID::internal::GenericSwap(this, other);
+    }
+  }
+  void UnsafeArenaSwap(Event* other) {
+    if (other == this) return;
+    GOOGLE_DCHECK(GetOwningArena() == other->GetOwningArena());
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  Event* New(::PROTOBUF_NAMESPACE_ID::Arena* arena = nullptr) const final {
+    return CreateMaybeMessage<Event>(arena);
+  }
+  using ::PROTOBUF_NAMESPACE_ID::Message::CopyFrom;
+  void CopyFrom(const Event& from);
+  using ::PROTOBUF_NAMESPACE_ID::Message::MergeFrom;
+  void MergeFrom( const Event& from) {
+    Event::MergeImpl(*this, from);
+  }
+  private:
+  static void MergeImpl(::PROTOBUF_NAMESPACE_ID::Message& to_msg, const ::PROTOBUF_NAMESPACE_ID::Message& from_msg);
+  public:
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  uint8_t* _InternalSerialize(
+      uint8_t* target, ::PROTOBUF_NAMESPACE_ID::io::EpsCopyOutputStream* stream) const final;
+  int GetCachedSize() const final { return _impl_._cached_size_.Get(); }
+
+  private:
+  void SharedCtor(::PROTOBUF_NAMESPACE_ID::Arena* arena, bool is_message_owned);
+  void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(Event* other);
+
+  private:
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "player.Event";
+  }
+  protected:
+  explicit Event(::PROTOBUF_NAMESPACE_ID::Arena* arena,
+                       bool is_message_owned = false);
+  public:
+
+  static const ClassData _class_data_;
+  const ::PROTOBUF_NAMESPACE_ID::Message::ClassData*GetClassData() const final;
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kStateChangeFieldNumber = 1,
+    kPositionFieldNumber = 2,
+    kTracksChangeFieldNumber = 3,
+    kBufferingFieldNumber = 4,
+    kErrorFieldNumber = 5,
+    kEndOfFileFieldNumber = 6,
+    kMediaChangeFieldNumber = 7,
+  };
+  // .player.EventStateChange stateChange = 1;
+  bool has_statechange() const;
+  private:
+  bool _internal_has_statechange() const;
+  public:
+  void clear_statechange();
+  const ::player::EventStateChange& statechange() const;
+  PROTOBUF_NODISCARD ::player::EventStateChange* release_statechange();
+  ::player::EventStateChange* mutable_statechange();
+  void set_allocated_statechange(::player::EventStateChange* statechange);
+  private:
+  const ::player::EventStateChange& _internal_statechange() const;
+  ::player::EventStateChange* _internal_mutable_statechange();
+  public:
+  void unsafe_arena_set_allocated_statechange(
+      ::player::EventStateChange* statechange);
+  ::player::EventStateChange* unsafe_arena_release_statechange();
+
+  // .player.EventPosition position = 2;
+  bool has_position() const;
+  private:
+  bool _internal_has_position() const;
+  public:
+  void clear_position();
+  const ::player::EventPosition& position() const;
+  PROTOBUF_NODISCARD ::player::EventPosition* release_position();
+  ::player::EventPosition* mutable_position();
+  void set_allocated_position(::player::EventPosition* position);
+  private:
+  const ::player::EventPosition& _internal_position() const;
+  ::player::EventPosition* _internal_mutable_position();
+  public:
+  void unsafe_arena_set_allocated_position(
+      ::player::EventPosition* position);
+  ::player::EventPosition* unsafe_arena_release_position();
+
+  // .player.EventTracksChange tracksChange = 3;
+  bool has_trackschange() const;
+  private:
+  bool _internal_has_trackschange() const;
+  public:
+  void clear_trackschange();
+  const ::player::EventTracksChange& trackschange() const;
+  PROTOBUF_NODISCARD ::player::EventTracksChange* release_trackschange();
+  ::player::EventTracksChange* mutable_trackschange();
+  void set_allocated_trackschange(::player::EventTracksChange* trackschange);
+  private:
+  const ::player::EventTracksChange& _internal_trackschange() const;
+  ::player::EventTracksChange* _internal_mutable_trackschange();
+  public:
+  void unsafe_arena_set_allocated_trackschange(
+      ::player::EventTracksChange* trackschange);
+  ::player::EventTracksChange* unsafe_arena_release_trackschange();
+
+  // .player.EventBuffering buffering = 4;
+  bool has_buffering() const;
+  private:
+  bool _internal_has_buffering() const;
+  public:
+  void clear_buffering();
+  const ::player::EventBuffering& buffering() const;
+  PROTOBUF_NODISCARD ::player::EventBuffering* release_buffering();
+  ::player::EventBuffering* mutable_buffering();
+  void set_allocated_buffering(::player::EventBuffering* buffering);
+  private:
+  const ::player::EventBuffering& _internal_buffering() const;
+  ::player::EventBuffering* _internal_mutable_buffering();
+  public:
+  void unsafe_arena_set_allocated_buffering(
+      ::player::EventBuffering* buffering);
+  ::player::EventBuffering* unsafe_arena_release_buffering();
+
+  // .player.EventError error = 5;
+  bool has_error() const;
+  private:
+  bool _internal_has_error() const;
+  public:
+  void clear_error();
+  const ::player::EventError& error() const;
+  PROTOBUF_NODISCARD ::player::EventError* release_error();
+  ::player::EventError* mutable_error();
+  void set_allocated_error(::player::EventError* error);
+  private:
+  const ::player::EventError& _internal_error() const;
+  ::player::EventError* _internal_mutable_error();
+  public:
+  void unsafe_arena_set_allocated_error(
+      ::player::EventError* error);
+  ::player::EventError* unsafe_arena_release_error();
+
+  // .player.EventEndOfFile endOfFile = 6;
+  bool has_endoffile() const;
+  private:
+  bool _internal_has_endoffile() const;
+  public:
+  void clear_endoffile();
+  const ::player::EventEndOfFile& endoffile() const;
+  PROTOBUF_NODISCARD ::player::EventEndOfFile* release_endoffile();
+  ::player::EventEndOfFile* mutable_endoffile();
+  void set_allocated_endoffile(::player::EventEndOfFile* endoffile);
+  private:
+  const ::player::EventEndOfFile& _internal_endoffile() const;
+  ::player::EventEndOfFile* _internal_mutable_endoffile();
+  public:
+  void unsafe_arena_set_allocated_endoffile(
+      ::player::EventEndOfFile* endoffile);
+  ::player::EventEndOfFile* unsafe_arena_release_endoffile();
+
+  // .player.EventMediaChange mediaChange = 7;
+  bool has_mediachange() const;
+  private:
+  bool _internal_has_mediachange() const;
+  public:
+  void clear_mediachange();
+  const ::player::EventMediaChange& mediachange() const;
+  PROTOBUF_NODISCARD ::player::EventMediaChange* release_mediachange();
+  ::player::EventMediaChange* mutable_mediachange();
+  void set_allocated_mediachange(::player::EventMediaChange* mediachange);
+  private:
+  const ::player::EventMediaChange& _internal_mediachange() const;
+  ::player::EventMediaChange* _internal_mutable_mediachange();
+  public:
+  void unsafe_arena_set_allocated_mediachange(
+      ::player::EventMediaChange* mediachange);
+  ::player::EventMediaChange* unsafe_arena_release_mediachange();
+
+  void clear_event();
+  EventCase event_case() const;
+  // @@protoc_insertion_point(class_scope:player.Event)
+ private:
+  class _Internal;
+  void set_has_statechange();
+  void set_has_position();
+  void set_has_trackschange();
+  void set_has_buffering();
+  void set_has_error();
+  void set_has_endoffile();
+  void set_has_mediachange();
+
+  inline bool has_event() const;
+  inline void clear_has_event();
+
+  template <typename T> friend class ::PROTOBUF_NAMESPACE_ID::Arena::InternalHelper;
+  typedef void InternalArenaConstructable_;
+  typedef void DestructorSkippable_;
+  struct Impl_ {
+    union EventUnion {
+      constexpr EventUnion() : _constinit_{} {}
+        ::PROTOBUF_NAMESPACE_ID::internal::ConstantInitialized _constinit_;
+      ::player::EventStateChange* statechange_;
+      ::player::EventPosition* position_;
+      ::player::EventTracksChange* trackschange_;
+      ::player::EventBuffering* buffering_;
+      ::player::EventError* error_;
+      ::player::EventEndOfFile* endoffile_;
+      ::player::EventMediaChange* mediachange_;
+    } event_;
+    mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+    uint32_t _oneof_case_[1];
+
+  };
+  union { Impl_ _impl_; };
+  friend struct ::TableStruct_player_2eproto;
+};
+// ===================================================================
+
+
+// ===================================================================
+
+#ifdef __GNUC__
+  #pragma GCC diagnostic push
+  #pragma GCC diagnostic ignored "-Wstrict-aliasing"
+#endif  // __GNUC__
+// SetupForStreamingRequest
+
+// -------------------------------------------------------------------
+
+// SetupForStreamingReply
+
+// -------------------------------------------------------------------
+
+// ProcessTitleRequest
+
+// -------------------------------------------------------------------
+
+// ProcessTitleReply
+
+// string title = 1;
+inline void ProcessTitleReply::clear_title() {
+  _impl_.title_.ClearToEmpty();
+}
+inline const std::string& ProcessTitleReply::title() const {
+  // @@protoc_insertion_point(field_get:player.ProcessTitleReply.title)
+  return _internal_title();
+}
+template <typename ArgT0, typename... ArgT>
+inline PROTOBUF_ALWAYS_INLINE
+void ProcessTitleReply::set_title(ArgT0&& arg0, ArgT... args) {
+ 
+ _impl_.title_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
+  // @@protoc_insertion_point(field_set:player.ProcessTitleReply.title)
+}
+inline std::string* ProcessTitleReply::mutable_title() {
+  std::string* _s = _internal_mutable_title();
+  // @@protoc_insertion_point(field_mutable:player.ProcessTitleReply.title)
+  return _s;
+}
+inline const std::string& ProcessTitleReply::_internal_title() const {
+  return _impl_.title_.Get();
+}
+inline void ProcessTitleReply::_internal_set_title(const std::string& value) {
+  
+  _impl_.title_.Set(value, GetArenaForAllocation());
+}
+inline std::string* ProcessTitleReply::_internal_mutable_title() {
+  
+  return _impl_.title_.Mutable(GetArenaForAllocation());
+}
+inline std::string* ProcessTitleReply::release_title() {
+  // @@protoc_insertion_point(field_release:player.ProcessTitleReply.title)
+  return _impl_.title_.Release();
+}
+inline void ProcessTitleReply::set_allocated_title(std::string* title) {
+  if (title != nullptr) {
+    
+  } else {
+    
+  }
+  _impl_.title_.SetAllocated(title, GetArenaForAllocation());
+#ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (_impl_.title_.IsDefault()) {
+    _impl_.title_.Set("", GetArenaForAllocation());
+  }
+#endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  // @@protoc_insertion_point(field_set_allocated:player.ProcessTitleReply.title)
+}
+
+// -------------------------------------------------------------------
+
+// OpenRequest
+
+// string link = 1;
+inline void OpenRequest::clear_link() {
+  _impl_.link_.ClearToEmpty();
+}
+inline const std::string& OpenRequest::link() const {
+  // @@protoc_insertion_point(field_get:player.OpenRequest.link)
+  return _internal_link();
+}
+template <typename ArgT0, typename... ArgT>
+inline PROTOBUF_ALWAYS_INLINE
+void OpenRequest::set_link(ArgT0&& arg0, ArgT... args) {
+ 
+ _impl_.link_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
+  // @@protoc_insertion_point(field_set:player.OpenRequest.link)
+}
+inline std::string* OpenRequest::mutable_link() {
+  std::string* _s = _internal_mutable_link();
+  // @@protoc_insertion_point(field_mutable:player.OpenRequest.link)
+  return _s;
+}
+inline const std::string& OpenRequest::_internal_link() const {
+  return _impl_.link_.Get();
+}
+inline void OpenRequest::_internal_set_link(const std::string& value) {
+  
+  _impl_.link_.Set(value, GetArenaForAllocation());
+}
+inline std::string* OpenRequest::_internal_mutable_link() {
+  
+  return _impl_.link_.Mutable(GetArenaForAllocation());
+}
+inline std::string* OpenRequest::release_link() {
+  // @@protoc_insertion_point(field_release:player.OpenRequest.link)
+  return _impl_.link_.Release();
+}
+inline void OpenRequest::set_allocated_link(std::string* link) {
+  if (link != nullptr) {
+    
+  } else {
+    
+  }
+  _impl_.link_.SetAllocated(link, GetArenaForAllocation());
+#ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (_impl_.link_.IsDefault()) {
+    _impl_.link_.Set("", GetArenaForAllocation());
+  }
+#endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  // @@protoc_insertion_point(field_set_allocated:player.OpenRequest.link)
+}
+
+// string title = 2;
+inline void OpenRequest::clear_title() {
+  _impl_.title_.ClearToEmpty();
+}
+inline const std::string& OpenRequest::title() const {
+  // @@protoc_insertion_point(field_get:player.OpenRequest.title)
+  return _internal_title();
+}
+template <typename ArgT0, typename... ArgT>
+inline PROTOBUF_ALWAYS_INLINE
+void OpenRequest::set_title(ArgT0&& arg0, ArgT... args) {
+ 
+ _impl_.title_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
+  // @@protoc_insertion_point(field_set:player.OpenRequest.title)
+}
 inline std::string* OpenRequest::mutable_title() {
   std::string* _s = _internal_mutable_title();
-  // @@protoc_insertion_point(field_mutable:player.OpenRequest.title)
+  // @@protoc_insertion_point(field_mutable:player.OpenRequest.title)
+  return _s;
+}
+inline const std::string& OpenRequest::_internal_title() const {
+  return _impl_.title_.Get();
+}
+inline void OpenRequest::_internal_set_title(const std::string& value) {
+  
+  _impl_.title_.Set(value, GetArenaForAllocation());
+}
+inline std::string* OpenRequest::_internal_mutable_title() {
+  
+  return _impl_.title_.Mutable(GetArenaForAllocation());
+}
+inline std::string* OpenRequest::release_title() {
+  // @@protoc_insertion_point(field_release:player.OpenRequest.title)
+  return _impl_.title_.Release();
+}
+inline void OpenRequest::set_allocated_title(std::string* title) {
+  if (title != nullptr) {
+    
+  } else {
+    
+  }
+  _impl_.title_.SetAllocated(title, GetArenaForAllocation());
+#ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (_impl_.title_.IsDefault()) {
+    _impl_.title_.Set("", GetArenaForAllocation());
+  }
+#endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  // @@protoc_insertion_point(field_set_allocated:player.OpenRequest.title)
+}
+
+// .player.LoggingLevel loggingLevel = 3;
+inline void OpenRequest::clear_logginglevel() {
+  _impl_.logginglevel_ = 0;
+}
+inline ::player::LoggingLevel OpenRequest::_internal_logginglevel() const {
+  return static_cast< ::player::LoggingLevel >(_impl_.logginglevel_);
+}
+inline ::player::LoggingLevel OpenRequest::logginglevel() const {
+  // @@protoc_insertion_point(field_get:player.OpenRequest.loggingLevel)
+  return _internal_logginglevel();
+}
+inline void OpenRequest::_internal_set_logginglevel(::player::LoggingLevel value) {
+  
+  _impl_.logginglevel_ = value;
+}
+inline void OpenRequest::set_logginglevel(::player::LoggingLevel value) {
+  _internal_set_logginglevel(value);
+  // @@protoc_insertion_point(field_set:player.OpenRequest.loggingLevel)
+}
+
+// -------------------------------------------------------------------
+
+// OpenReply
+
+// -------------------------------------------------------------------
+
+// GetLinkRequest
+
+// -------------------------------------------------------------------
+
+// GetLinkReply
+
+// string link = 1;
+inline void GetLinkReply::clear_link() {
+  _impl_.link_.ClearToEmpty();
+}
+inline const std::string& GetLinkReply::link() const {
+  // @@protoc_insertion_point(field_get:player.GetLinkReply.link)
+  return _internal_link();
+}
+template <typename ArgT0, typename... ArgT>
+inline PROTOBUF_ALWAYS_INLINE
+void GetLinkReply::set_link(ArgT0&& arg0, ArgT... args) {
+ 
+ _impl_.link_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
+  // @@protoc_insertion_point(field_set:player.GetLinkReply.link)
+}
+inline std::string* GetLinkReply::mutable_link() {
+  std::string* _s = _internal_mutable_link();
+  // @@protoc_insertion_point(field_mutable:player.GetLinkReply.link)
+  return _s;
+}
+inline const std::string& GetLinkReply::_internal_link() const {
+  return _impl_.link_.Get();
+}
+inline void GetLinkReply::_internal_set_link(const std::string& value) {
+  
+  _impl_.link_.Set(value, GetArenaForAllocation());
+}
+inline std::string* GetLinkReply::_internal_mutable_link() {
+  
+  return _impl_.link_.Mutable(GetArenaForAllocation());
+}
+inline std::string* GetLinkReply::release_link() {
+  // @@protoc_insertion_point(field_release:player.GetLinkReply.link)
+  return _impl_.link_.Release();
+}
+inline void GetLinkReply::set_allocated_link(std::string* link) {
+  if (link != nullptr) {
+    
+  } else {
+    
+  }
+  _impl_.link_.SetAllocated(link, GetArenaForAllocation());
+#ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (_impl_.link_.IsDefault()) {
+    _impl_.link_.Set("", GetArenaForAllocation());
+  }
+#endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  // @@protoc_insertion_point(field_set_allocated:player.GetLinkReply.link)
+}
+
+// -------------------------------------------------------------------
+
+// EndChanRequest
+
+// -------------------------------------------------------------------
+
+// EndChanReply
+
+// -------------------------------------------------------------------
+
+// IsEndedRequest
+
+// -------------------------------------------------------------------
+
+// IsEndedReply
+
+// bool isEnded = 1;
+inline void IsEndedReply::clear_isended() {
+  _impl_.isended_ = false;
+}
+inline bool IsEndedReply::_internal_isended() const {
+  return _impl_.isended_;
+}
+inline bool IsEndedReply::isended() const {
+  // @@protoc_insertion_point(field_get:player.IsEndedReply.isEnded)
+  return _internal_isended();
+}
+inline void IsEndedReply::_internal_set_isended(bool value) {
+  
+  _impl_.isended_ = value;
+}
+inline void IsEndedReply::set_isended(bool value) {
+  _internal_set_isended(value);
+  // @@protoc_insertion_point(field_set:player.IsEndedReply.isEnded)
+}
+
+// -------------------------------------------------------------------
+
+// GetPositionRequest
+
+// -------------------------------------------------------------------
+
+// GetPositionReply
+
+// double positionSecs = 1;
+inline void GetPositionReply::clear_positionsecs() {
+  _impl_.positionsecs_ = 0;
+}
+inline double GetPositionReply::_internal_positionsecs() const {
+  return _impl_.positionsecs_;
+}
+inline double GetPositionReply::positionsecs() const {
+  // @@protoc_insertion_point(field_get:player.GetPositionReply.positionSecs)
+  return _internal_positionsecs();
+}
+inline void GetPositionReply::_internal_set_positionsecs(double value) {
+  
+  _impl_.positionsecs_ = value;
+}
+inline void GetPositionReply::set_positionsecs(double value) {
+  _internal_set_positionsecs(value);
+  // @@protoc_insertion_point(field_set:player.GetPositionReply.positionSecs)
+}
+
+// -------------------------------------------------------------------
+
+// GetAudioPositionRequest
+
+// -------------------------------------------------------------------
+
+// GetAudioPositionReply
+
+// double positionSecs = 1;
+inline void GetAudioPositionReply::clear_positionsecs() {
+  _impl_.positionsecs_ = 0;
+}
+inline double GetAudioPositionReply::_internal_positionsecs() const {
+  return _impl_.positionsecs_;
+}
+inline double GetAudioPositionReply::positionsecs() const {
+  // @@protoc_insertion_point(field_get:player.GetAudioPositionReply.positionSecs)
+  return _internal_positionsecs();
+}
+inline void GetAudioPositionReply::_internal_set_positionsecs(double value) {
+  
+  _impl_.positionsecs_ = value;
+}
+inline void GetAudioPositionReply::set_positionsecs(double value) {
+  _internal_set_positionsecs(value);
+  // @@protoc_insertion_point(field_set:player.GetAudioPositionReply.positionSecs)
+}
+
+// -------------------------------------------------------------------
+
+// GetLengthRequest
+
+// -------------------------------------------------------------------
+
+// GetLengthReply
+
+// double lengthSecs = 1;
+inline void GetLengthReply::clear_lengthsecs() {
+  _impl_.lengthsecs_ = 0;
+}
+inline double GetLengthReply::_internal_lengthsecs() const {
+  return _impl_.lengthsecs_;
+}
+inline double GetLengthReply::lengthsecs() const {
+  // @@protoc_insertion_point(field_get:player.GetLengthReply.lengthSecs)
+  return _internal_lengthsecs();
+}
+inline void GetLengthReply::_internal_set_lengthsecs(double value) {
+  
+  _impl_.lengthsecs_ = value;
+}
+inline void GetLengthReply::set_lengthsecs(double value) {
+  _internal_set_lengthsecs(value);
+  // @@protoc_insertion_point(field_set:player.GetLengthReply.lengthSecs)
+}
+
+// -------------------------------------------------------------------
+
+// GetSpeedRequest
+
+// -------------------------------------------------------------------
+
+// GetSpeedReply
+
+// double speed = 1;
+inline void GetSpeedReply::clear_speed() {
+  _impl_.speed_ = 0;
+}
+inline double GetSpeedReply::_internal_speed() const {
+  return _impl_.speed_;
+}
+inline double GetSpeedReply::speed() const {
+  // @@protoc_insertion_point(field_get:player.GetSpeedReply.speed)
+  return _internal_speed();
+}
+inline void GetSpeedReply::_internal_set_speed(double value) {
+  
+  _impl_.speed_ = value;
+}
+inline void GetSpeedReply::set_speed(double value) {
+  _internal_set_speed(value);
+  // @@protoc_insertion_point(field_set:player.GetSpeedReply.speed)
+}
+
+// -------------------------------------------------------------------
+
+// SetSpeedRequest
+
+// double speed = 1;
+inline void SetSpeedRequest::clear_speed() {
+  _impl_.speed_ = 0;
+}
+inline double SetSpeedRequest::_internal_speed() const {
+  return _impl_.speed_;
+}
+inline double SetSpeedRequest::speed() const {
+  // @@protoc_insertion_point(field_get:player.SetSpeedRequest.speed)
+  return _internal_speed();
+}
+inline void SetSpeedRequest::_internal_set_speed(double value) {
+  
+  _impl_.speed_ = value;
+}
+inline void SetSpeedRequest::set_speed(double value) {
+  _internal_set_speed(value);
+  // @@protoc_insertion_point(field_set:player.SetSpeedRequest.speed)
+}
+
+// -------------------------------------------------------------------
+
+// SetSpeedReply
+
+// -------------------------------------------------------------------
+
+// GetPauseRequest
+
+// -------------------------------------------------------------------
+
+// GetPauseReply
+
+// bool isPaused = 1;
+inline void GetPauseReply::clear_ispaused() {
+  _impl_.ispaused_ = false;
+}
+inline bool GetPauseReply::_internal_ispaused() const {
+  return _impl_.ispaused_;
+}
+inline bool GetPauseReply::ispaused() const {
+  // @@protoc_insertion_point(field_get:player.GetPauseReply.isPaused)
+  return _internal_ispaused();
+}
+inline void GetPauseReply::_internal_set_ispaused(bool value) {
+  
+  _impl_.ispaused_ = value;
+}
+inline void GetPauseReply::set_ispaused(bool value) {
+  _internal_set_ispaused(value);
+  // @@protoc_insertion_point(field_set:player.GetPauseReply.isPaused)
+}
+
+// -------------------------------------------------------------------
+
+// SetPauseRequest
+
+// bool isPaused = 1;
+inline void SetPauseRequest::clear_ispaused() {
+  _impl_.ispaused_ = false;
+}
+inline bool SetPauseRequest::_internal_ispaused() const {
+  return _impl_.ispaused_;
+}
+inline bool SetPauseRequest::ispaused() const {
+  // @@protoc_insertion_point(field_get:player.SetPauseRequest.isPaused)
+  return _internal_ispaused();
+}
+inline void SetPauseRequest::_internal_set_ispaused(bool value) {
+  
+  _impl_.ispaused_ = value;
+}
+inline void SetPauseRequest::set_ispaused(bool value) {
+  _internal_set_ispaused(value);
+  // @@protoc_insertion_point(field_set:player.SetPauseRequest.isPaused)
+}
+
+// -------------------------------------------------------------------
+
+// SetPauseReply
+
+// -------------------------------------------------------------------
+
+// GetVolumeRequest
+
+// -------------------------------------------------------------------
+
+// GetVolumeReply
+
+// double volume = 1;
+inline void GetVolumeReply::clear_volume() {
+  _impl_.volume_ = 0;
+}
+inline double GetVolumeReply::_internal_volume() const {
+  return _impl_.volume_;
+}
+inline double GetVolumeReply::volume() const {
+  // @@protoc_insertion_point(field_get:player.GetVolumeReply.volume)
+  return _internal_volume();
+}
+inline void GetVolumeReply::_internal_set_volume(double value) {
+  
+  _impl_.volume_ = value;
+}
+inline void GetVolumeReply::set_volume(double value) {
+  _internal_set_volume(value);
+  // @@protoc_insertion_point(field_set:player.GetVolumeReply.volume)
+}
+
+// -------------------------------------------------------------------
+
+// SetVolumeRequest
+
+// double volume = 1;
+inline void SetVolumeRequest::clear_volume() {
+  _impl_.volume_ = 0;
+}
+inline double SetVolumeRequest::_internal_volume() const {
+  return _impl_.volume_;
+}
+inline double SetVolumeRequest::volume() const {
+  // @@protoc_insertion_point(field_get:player.SetVolumeRequest.volume)
+  return _internal_volume();
+}
+inline void SetVolumeRequest::_internal_set_volume(double value) {
+  
+  _impl_.volume_ = value;
+}
+inline void SetVolumeRequest::set_volume(double value) {
+  _internal_set_volume(value);
+  // @@protoc_insertion_point(field_set:player.SetVolumeRequest.volume)
+}
+
+// -------------------------------------------------------------------
+
+// SetVolumeReply
+
+// -------------------------------------------------------------------
+
+// GetMuteRequest
+
+// -------------------------------------------------------------------
+
+// GetMuteReply
+
+// bool isMuted = 1;
+inline void GetMuteReply::clear_ismuted() {
+  _impl_.ismuted_ = false;
+}
+inline bool GetMuteReply::_internal_ismuted() const {
+  return _impl_.ismuted_;
+}
+inline bool GetMuteReply::ismuted() const {
+  // @@protoc_insertion_point(field_get:player.GetMuteReply.isMuted)
+  return _internal_ismuted();
+}
+inline void GetMuteReply::_internal_set_ismuted(bool value) {
+  
+  _impl_.ismuted_ = value;
+}
+inline void GetMuteReply::set_ismuted(bool value) {
+  _internal_set_ismuted(value);
+  // @@protoc_insertion_point(field_set:player.GetMuteReply.isMuted)
+}
+
+// -------------------------------------------------------------------
+
+// SetMuteRequest
+
+// bool isMuted = 1;
+inline void SetMuteRequest::clear_ismuted() {
+  _impl_.ismuted_ = false;
+}
+inline bool SetMuteRequest::_internal_ismuted() const {
+  return _impl_.ismuted_;
+}
+inline bool SetMuteRequest::ismuted() const {
+  // @@protoc_insertion_point(field_get:player.SetMuteRequest.isMuted)
+  return _internal_ismuted();
+}
+inline void SetMuteRequest::_internal_set_ismuted(bool value) {
+  
+  _impl_.ismuted_ = value;
+}
+inline void SetMuteRequest::set_ismuted(bool value) {
+  _internal_set_ismuted(value);
+  // @@protoc_insertion_point(field_set:player.SetMuteRequest.isMuted)
+}
+
+// -------------------------------------------------------------------
+
+// SetMuteReply
+
+// -------------------------------------------------------------------
+
+// SetVideoTrackRequest
+
+// int64 videoTrackID = 1;
+inline void SetVideoTrackRequest::clear_videotrackid() {
+  _impl_.videotrackid_ = int64_t{0};
+}
+inline int64_t SetVideoTrackRequest::_internal_videotrackid() const {
+  return _impl_.videotrackid_;
+}
+inline int64_t SetVideoTrackRequest::videotrackid() const {
+  // @@protoc_insertion_point(field_get:player.SetVideoTrackRequest.videoTrackID)
+  return _internal_videotrackid();
+}
+inline void SetVideoTrackRequest::_internal_set_videotrackid(int64_t value) {
+  
+  _impl_.videotrackid_ = value;
+}
+inline void SetVideoTrackRequest::set_videotrackid(int64_t value) {
+  _internal_set_videotrackid(value);
+  // @@protoc_insertion_point(field_set:player.SetVideoTrackRequest.videoTrackID)
+}
+
+// -------------------------------------------------------------------
+
+// SetVideoTrackReply
+
+// -------------------------------------------------------------------
+
+// SetAudioTrackRequest
+
+// int64 audioTrackID = 1;
+inline void SetAudioTrackRequest::clear_audiotrackid() {
+  _impl_.audiotrackid_ = int64_t{0};
+}
+inline int64_t SetAudioTrackRequest::_internal_audiotrackid() const {
+  return _impl_.audiotrackid_;
+}
+inline int64_t SetAudioTrackRequest::audiotrackid() const {
+  // @@protoc_insertion_point(field_get:player.SetAudioTrackRequest.audioTrackID)
+  return _internal_audiotrackid();
+}
+inline void SetAudioTrackRequest::_internal_set_audiotrackid(int64_t value) {
+  
+  _impl_.audiotrackid_ = value;
+}
+inline void SetAudioTrackRequest::set_audiotrackid(int64_t value) {
+  _internal_set_audiotrackid(value);
+  // @@protoc_insertion_point(field_set:player.SetAudioTrackRequest.audioTrackID)
+}
+
+// -------------------------------------------------------------------
+
+// SetAudioTrackReply
+
+// -------------------------------------------------------------------
+
+// SetSubtitlesTrackRequest
+
+// int64 subtitlesTrackID = 1;
+inline void SetSubtitlesTrackRequest::clear_subtitlestrackid() {
+  _impl_.subtitlestrackid_ = int64_t{0};
+}
+inline int64_t SetSubtitlesTrackRequest::_internal_subtitlestrackid() const {
+  return _impl_.subtitlestrackid_;
+}
+inline int64_t SetSubtitlesTrackRequest::subtitlestrackid() const {
+  // @@protoc_insertion_point(field_get:player.SetSubtitlesTrackRequest.subtitlesTrackID)
+  return _internal_subtitlestrackid();
+}
+inline void SetSubtitlesTrackRequest::_internal_set_subtitlestrackid(int64_t value) {
+  
+  _impl_.subtitlestrackid_ = value;
+}
+inline void SetSubtitlesTrackRequest::set_subtitlestrackid(int64_t value) {
+  _internal_set_subtitlestrackid(value);
+  // @@protoc_insertion_point(field_set:player.SetSubtitlesTrackRequest.subtitlesTrackID)
+}
+
+// -------------------------------------------------------------------
+
+// SetSubtitlesTrackReply
+
+// -------------------------------------------------------------------
+
+// TrackInfo
+
+// string codec = 1;
+inline void TrackInfo::clear_codec() {
+  _impl_.codec_.ClearToEmpty();
+}
+inline const std::string& TrackInfo::codec() const {
+  // @@protoc_insertion_point(field_get:player.TrackInfo.codec)
+  return _internal_codec();
+}
+template <typename ArgT0, typename... ArgT>
+inline PROTOBUF_ALWAYS_INLINE
+void TrackInfo::set_codec(ArgT0&& arg0, ArgT... args) {
+ 
+ _impl_.codec_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
+  // @@protoc_insertion_point(field_set:player.TrackInfo.codec)
+}
+inline std::string* TrackInfo::mutable_codec() {
+  std::string* _s = _internal_mutable_codec();
+  // @@protoc_insertion_point(field_mutable:player.TrackInfo.codec)
+  return _s;
+}
+inline const std::string& TrackInfo::_internal_codec() const {
+  return _impl_.codec_.Get();
+}
+inline void TrackInfo::_internal_set_codec(const std::string& value) {
+  
+  _impl_.codec_.Set(value, GetArenaForAllocation());
+}
+inline std::string* TrackInfo::_internal_mutable_codec() {
+  
+  return _impl_.codec_.Mutable(GetArenaForAllocation());
+}
+inline std::string* TrackInfo::release_codec() {
+  // @@protoc_insertion_point(field_release:player.TrackInfo.codec)
+  return _impl_.codec_.Release();
+}
+inline void TrackInfo::set_allocated_codec(std::string* codec) {
+  if (codec != nullptr) {
+    
+  } else {
+    
+  }
+  _impl_.codec_.SetAllocated(codec, GetArenaForAllocation());
+#ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (_impl_.codec_.IsDefault()) {
+    _impl_.codec_.Set("", GetArenaForAllocation());
+  }
+#endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  // @@protoc_insertion_point(field_set_allocated:player.TrackInfo.codec)
+}
+
+// string language = 2;
+inline void TrackInfo::clear_language() {
+  _impl_.language_.ClearToEmpty();
+}
+inline const std::string& TrackInfo::language() const {
+  // @@protoc_insertion_point(field_get:player.TrackInfo.language)
+  return _internal_language();
+}
+template <typename ArgT0, typename... ArgT>
+inline PROTOBUF_ALWAYS_INLINE
+void TrackInfo::set_language(ArgT0&& arg0, ArgT... args) {
+ 
+ _impl_.language_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
+  // @@protoc_insertion_point(field_set:player.TrackInfo.language)
+}
+inline std::string* TrackInfo::mutable_language() {
+  std::string* _s = _internal_mutable_language();
+  // @@protoc_insertion_point(field_mutable:player.TrackInfo.language)
+  return _s;
+}
+inline const std::string& TrackInfo::_internal_language() const {
+  return _impl_.language_.Get();
+}
+inline void TrackInfo::_internal_set_language(const std::string& value) {
+  
+  _impl_.language_.Set(value, GetArenaForAllocation());
+}
+inline std::string* TrackInfo::_internal_mutable_language() {
+  
+  return _impl_.language_.Mutable(GetArenaForAllocation());
+}
+inline std::string* TrackInfo::release_language() {
+  // @@protoc_insertion_point(field_release:player.TrackInfo.language)
+  return _impl_.language_.Release();
+}
+inline void TrackInfo::set_allocated_language(std::string* language) {
+  if (language != nullptr) {
+    
+  } else {
+    
+  }
+  _impl_.language_.SetAllocated(language, GetArenaForAllocation());
+#ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (_impl_.language_.IsDefault()) {
+    _impl_.language_.Set("", GetArenaForAllocation());
+  }
+#endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  // @@protoc_insertion_point(field_set_allocated:player.TrackInfo.language)
+}
+
+// string title = 3;
+inline void TrackInfo::clear_title() {
+  _impl_.title_.ClearToEmpty();
+}
+inline const std::string& TrackInfo::title() const {
+  // @@protoc_insertion_point(field_get:player.TrackInfo.title)
+  return _internal_title();
+}
+template <typename ArgT0, typename... ArgT>
+inline PROTOBUF_ALWAYS_INLINE
+void TrackInfo::set_title(ArgT0&& arg0, ArgT... args) {
+ 
+ _impl_.title_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
+  // @@protoc_insertion_point(field_set:player.TrackInfo.title)
+}
+inline std::string* TrackInfo::mutable_title() {
+  std::string* _s = _internal_mutable_title();
+  // @@protoc_insertion_point(field_mutable:player.TrackInfo.title)
+  return _s;
+}
+inline const std::string& TrackInfo::_internal_title() const {
+  return _impl_.title_.Get();
+}
+inline void TrackInfo::_internal_set_title(const std::string& value) {
+  
+  _impl_.title_.Set(value, GetArenaForAllocation());
+}
+inline std::string* TrackInfo::_internal_mutable_title() {
+  
+  return _impl_.title_.Mutable(GetArenaForAllocation());
+}
+inline std::string* TrackInfo::release_title() {
+  // @@protoc_insertion_point(field_release:player.TrackInfo.title)
+  return _impl_.title_.Release();
+}
+inline void TrackInfo::set_allocated_title(std::string* title) {
+  if (title != nullptr) {
+    
+  } else {
+    
+  }
+  _impl_.title_.SetAllocated(title, GetArenaForAllocation());
+#ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (_impl_.title_.IsDefault()) {
+    _impl_.title_.Set("", GetArenaForAllocation());
+  }
+#endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  // @@protoc_insertion_point(field_set_allocated:player.TrackInfo.title)
+}
+
+// bool isDefault = 4;
+inline void TrackInfo::clear_isdefault() {
+  _impl_.isdefault_ = false;
+}
+inline bool TrackInfo::_internal_isdefault() const {
+  return _impl_.isdefault_;
+}
+inline bool TrackInfo::isdefault() const {
+  // @@protoc_insertion_point(field_get:player.TrackInfo.isDefault)
+  return _internal_isdefault();
+}
+inline void TrackInfo::_internal_set_isdefault(bool value) {
+  
+  _impl_.isdefault_ = value;
+}
+inline void TrackInfo::set_isdefault(bool value) {
+  _internal_set_isdefault(value);
+  // @@protoc_insertion_point(field_set:player.TrackInfo.isDefault)
+}
+
+// bool isForced = 5;
+inline void TrackInfo::clear_isforced() {
+  _impl_.isforced_ = false;
+}
+inline bool TrackInfo::_internal_isforced() const {
+  return _impl_.isforced_;
+}
+inline bool TrackInfo::isforced() const {
+  // @@protoc_insertion_point(field_get:player.TrackInfo.isForced)
+  return _internal_isforced();
+}
+inline void TrackInfo::_internal_set_isforced(bool value) {
+  
+  _impl_.isforced_ = value;
+}
+inline void TrackInfo::set_isforced(bool value) {
+  _internal_set_isforced(value);
+  // @@protoc_insertion_point(field_set:player.TrackInfo.isForced)
+}
+
+// -------------------------------------------------------------------
+
+// VideoTrack
+
+// int64 id = 1;
+inline void VideoTrack::clear_id() {
+  _impl_.id_ = int64_t{0};
+}
+inline int64_t VideoTrack::_internal_id() const {
+  return _impl_.id_;
+}
+inline int64_t VideoTrack::id() const {
+  // @@protoc_insertion_point(field_get:player.VideoTrack.id)
+  return _internal_id();
+}
+inline void VideoTrack::_internal_set_id(int64_t value) {
+  
+  _impl_.id_ = value;
+}
+inline void VideoTrack::set_id(int64_t value) {
+  _internal_set_id(value);
+  // @@protoc_insertion_point(field_set:player.VideoTrack.id)
+}
+
+// bool isActive = 2;
+inline void VideoTrack::clear_isactive() {
+  _impl_.isactive_ = false;
+}
+inline bool VideoTrack::_internal_isactive() const {
+  return _impl_.isactive_;
+}
+inline bool VideoTrack::isactive() const {
+  // @@protoc_insertion_point(field_get:player.VideoTrack.isActive)
+  return _internal_isactive();
+}
+inline void VideoTrack::_internal_set_isactive(bool value) {
+  
+  _impl_.isactive_ = value;
+}
+inline void VideoTrack::set_isactive(bool value) {
+  _internal_set_isactive(value);
+  // @@protoc_insertion_point(field_set:player.VideoTrack.isActive)
+}
+
+// .player.TrackInfo info = 3;
+inline bool VideoTrack::_internal_has_info() const {
+  return this != internal_default_instance() && _impl_.info_ != nullptr;
+}
+inline bool VideoTrack::has_info() const {
+  return _internal_has_info();
+}
+inline void VideoTrack::clear_info() {
+  if (GetArenaForAllocation() == nullptr && _impl_.info_ != nullptr) {
+    delete _impl_.info_;
+  }
+  _impl_.info_ = nullptr;
+}
+inline const ::player::TrackInfo& VideoTrack::_internal_info() const {
+  const ::player::TrackInfo* p = _impl_.info_;
+  return p != nullptr ? *p : reinterpret_cast<const ::player::TrackInfo&>(
+      ::player::_TrackInfo_default_instance_);
+}
+inline const ::player::TrackInfo& VideoTrack::info() const {
+  // @@protoc_insertion_point(field_get:player.VideoTrack.info)
+  return _internal_info();
+}
+inline void VideoTrack::unsafe_arena_set_allocated_info(
+    ::player::TrackInfo* info) {
+  if (GetArenaForAllocation() == nullptr) {
+    delete reinterpret_cast<::PROTOBUF_NAMESPACE_ID::MessageLite*>(_impl_.info_);
+  }
+  _impl_.info_ = info;
+  if (info) {
+    
+  } else {
+    
+  }
+  // @@protoc_insertion_point(field_unsafe_arena_set_allocated:player.VideoTrack.info)
+}
+inline ::player::TrackInfo* VideoTrack::release_info() {
+  
+  ::player::TrackInfo* temp = _impl_.info_;
+  _impl_.info_ = nullptr;
+#ifdef PROTOBUF_FORCE_COPY_IN_RELEASE
+  auto* old =  reinterpret_cast<::PROTOBUF_NAMESPACE_ID::MessageLite*>(temp);
+  temp = ::PROTOBUF_NAMESPACE_ID::internal::DuplicateIfNonNull(temp);
+  if (GetArenaForAllocation() == nullptr) { delete old; }
+#else  // PROTOBUF_FORCE_COPY_IN_RELEASE
+  if (GetArenaForAllocation() != nullptr) {
+    temp = ::PROTOBUF_NAMESPACE_ID::internal::DuplicateIfNonNull(temp);
+  }
+#endif  // !PROTOBUF_FORCE_COPY_IN_RELEASE
+  return temp;
+}
+inline ::player::TrackInfo* VideoTrack::unsafe_arena_release_info() {
+  // @@protoc_insertion_point(field_release:player.VideoTrack.info)
+  
+  ::player::TrackInfo* temp = _impl_.info_;
+  _impl_.info_ = nullptr;
+  return temp;
+}
+inline ::player::TrackInfo* VideoTrack::_internal_mutable_info() {
+  
+  if (_impl_.info_ == nullptr) {
+    auto* p = CreateMaybeMessage<::player::TrackInfo>(GetArenaForAllocation());
+    _impl_.info_ = p;
+  }
+  return _impl_.info_;
+}
+inline ::player::TrackInfo* VideoTrack::mutable_info() {
+  ::player::TrackInfo* _msg = _internal_mutable_info();
+  // @@protoc_insertion_point(field_mutable:player.VideoTrack.info)
+  return _msg;
+}
+inline void VideoTrack::set_allocated_info(::player::TrackInfo* info) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaForAllocation();
+  if (message_arena == nullptr) {
+    delete _impl_.info_;
+  }
+  if (info) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena =
+        ::PROTOBUF_NAMESPACE_ID::Arena::InternalGetOwningArena(info);
+    if (message_arena != submessage_arena) {
+      info = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, info, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  _impl_.info_ = info;
+  // @@protoc_insertion_point(field_set_allocated:player.VideoTrack.info)
+}
+
+// int32 width = 4;
+inline void VideoTrack::clear_width() {
+  _impl_.width_ = 0;
+}
+inline int32_t VideoTrack::_internal_width() const {
+  return _impl_.width_;
+}
+inline int32_t VideoTrack::width() const {
+  // @@protoc_insertion_point(field_get:player.VideoTrack.width)
+  return _internal_width();
+}
+inline void VideoTrack::_internal_set_width(int32_t value) {
+  
+  _impl_.width_ = value;
+}
+inline void VideoTrack::set_width(int32_t value) {
+  _internal_set_width(value);
+  // @@protoc_insertion_point(field_set:player.VideoTrack.width)
+}
+
+// int32 height = 5;
+inline void VideoTrack::clear_height() {
+  _impl_.height_ = 0;
+}
+inline int32_t VideoTrack::_internal_height() const {
+  return _impl_.height_;
+}
+inline int32_t VideoTrack::height() const {
+  // @@protoc_insertion_point(field_get:player.VideoTrack.height)
+  return _internal_height();
+}
+inline void VideoTrack::_internal_set_height(int32_t value) {
+  
+  _impl_.height_ = value;
+}
+inline void VideoTrack::set_height(int32_t value) {
+  _internal_set_height(value);
+  // @@protoc_insertion_point(field_set:player.VideoTrack.height)
+}
+
+// double fps = 6;
+inline void VideoTrack::clear_fps() {
+  _impl_.fps_ = 0;
+}
+inline double VideoTrack::_internal_fps() const {
+  return _impl_.fps_;
+}
+inline double VideoTrack::fps() const {
+  // @@protoc_insertion_point(field_get:player.VideoTrack.fps)
+  return _internal_fps();
+}
+inline void VideoTrack::_internal_set_fps(double value) {
+  
+  _impl_.fps_ = value;
+}
+inline void VideoTrack::set_fps(double value) {
+  _internal_set_fps(value);
+  // @@protoc_insertion_point(field_set:player.VideoTrack.fps)
+}
+
+// -------------------------------------------------------------------
+
+// SeekRequest
+
+// int64 posNanoseconds = 1;
+inline void SeekRequest::clear_posnanoseconds() {
+  _impl_.posnanoseconds_ = int64_t{0};
+}
+inline int64_t SeekRequest::_internal_posnanoseconds() const {
+  return _impl_.posnanoseconds_;
+}
+inline int64_t SeekRequest::posnanoseconds() const {
+  // @@protoc_insertion_point(field_get:player.SeekRequest.posNanoseconds)
+  return _internal_posnanoseconds();
+}
+inline void SeekRequest::_internal_set_posnanoseconds(int64_t value) {
+  
+  _impl_.posnanoseconds_ = value;
+}
+inline void SeekRequest::set_posnanoseconds(int64_t value) {
+  _internal_set_posnanoseconds(value);
+  // @@protoc_insertion_point(field_set:player.SeekRequest.posNanoseconds)
+}
+
+// bool isRelative = 2;
+inline void SeekRequest::clear_isrelative() {
+  _impl_.isrelative_ = false;
+}
+inline bool SeekRequest::_internal_isrelative() const {
+  return _impl_.isrelative_;
+}
+inline bool SeekRequest::isrelative() const {
+  // @@protoc_insertion_point(field_get:player.SeekRequest.isRelative)
+  return _internal_isrelative();
+}
+inline void SeekRequest::_internal_set_isrelative(bool value) {
+  
+  _impl_.isrelative_ = value;
+}
+inline void SeekRequest::set_isrelative(bool value) {
+  _internal_set_isrelative(value);
+  // @@protoc_insertion_point(field_set:player.SeekRequest.isRelative)
+}
+
+// bool isQuick = 3;
+inline void SeekRequest::clear_isquick() {
+  _impl_.isquick_ = false;
+}
+inline bool SeekRequest::_internal_isquick() const {
+  return _impl_.isquick_;
+}
+inline bool SeekRequest::isquick() const {
+  // @@protoc_insertion_point(field_get:player.SeekRequest.isQuick)
+  return _internal_isquick();
+}
+inline void SeekRequest::_internal_set_isquick(bool value) {
+  
+  _impl_.isquick_ = value;
+}
+inline void SeekRequest::set_isquick(bool value) {
+  _internal_set_isquick(value);
+  // @@protoc_insertion_point(field_set:player.SeekRequest.isQuick)
+}
+
+// -------------------------------------------------------------------
+
+// SeekReply
+
+// -------------------------------------------------------------------
+
+// FrameStepRequest
+
+// bool forward = 1;
+inline void FrameStepRequest::clear_forward() {
+  _impl_.forward_ = false;
+}
+inline bool FrameStepRequest::_internal_forward() const {
+  return _impl_.forward_;
+}
+inline bool FrameStepRequest::forward() const {
+  // @@protoc_insertion_point(field_get:player.FrameStepRequest.forward)
+  return _internal_forward();
+}
+inline void FrameStepRequest::_internal_set_forward(bool value) {
+  
+  _impl_.forward_ = value;
+}
+inline void FrameStepRequest::set_forward(bool value) {
+  _internal_set_forward(value);
+  // @@protoc_insertion_point(field_set:player.FrameStepRequest.forward)
+}
+
+// -------------------------------------------------------------------
+
+// FrameStepReply
+
+// -------------------------------------------------------------------
+
+// ScreenshotRequest
+
+// -------------------------------------------------------------------
+
+// ScreenshotReply
+
+// bytes png = 1;
+inline void ScreenshotReply::clear_png() {
+  _impl_.png_.ClearToEmpty();
+}
+inline const std::string& ScreenshotReply::png() const {
+  // @@protoc_insertion_point(field_get:player.ScreenshotReply.png)
+  return _internal_png();
+}
+template <typename ArgT0, typename... ArgT>
+inline PROTOBUF_ALWAYS_INLINE
+void ScreenshotReply::set_png(ArgT0&& arg0, ArgT... args) {
+ 
+ _impl_.png_.SetBytes(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
+  // @@protoc_insertion_point(field_set:player.ScreenshotReply.png)
+}
+inline std::string* ScreenshotReply::mutable_png() {
+  std::string* _s = _internal_mutable_png();
+  // @@protoc_insertion_point(field_mutable:player.ScreenshotReply.png)
+  return _s;
+}
+inline const std::string& ScreenshotReply::_internal_png() const {
+  return _impl_.png_.Get();
+}
+inline void ScreenshotReply::_internal_set_png(const std::string& value) {
+  
+  _impl_.png_.Set(value, GetArenaForAllocation());
+}
+inline std::string* ScreenshotReply::_internal_mutable_png() {
+  
+  return _impl_.png_.Mutable(GetArenaForAllocation());
+}
+inline std::string* ScreenshotReply::release_png() {
+  // @@protoc_insertion_point(field_release:player.ScreenshotReply.png)
+  return _impl_.png_.Release();
+}
+inline void ScreenshotReply::set_allocated_png(std::string* png) {
+  if (png != nullptr) {
+    
+  } else {
+    
+  }
+  _impl_.png_.SetAllocated(png, GetArenaForAllocation());
+#ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (_impl_.png_.IsDefault()) {
+    _impl_.png_.Set("", GetArenaForAllocation());
+  }
+#endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  // @@protoc_insertion_point(field_set_allocated:player.ScreenshotReply.png)
+}
+
+// -------------------------------------------------------------------
+
+// GetVideoTracksRequest
+
+// -------------------------------------------------------------------
+
+// GetVideoTracksReply
+
+// repeated .player.VideoTrack videoTrack = 1;
+inline int GetVideoTracksReply::_internal_videotrack_size() const {
+  return _impl_.videotrack_.size();
+}
+inline int GetVideoTracksReply::videotrack_size() const {
+  return _internal_videotrack_size();
+}
+inline void GetVideoTracksReply::clear_videotrack() {
+  _impl_.videotrack_.Clear();
+}
+inline ::player::VideoTrack* GetVideoTracksReply::mutable_videotrack(int index) {
+  // @@protoc_insertion_point(field_mutable:player.GetVideoTracksReply.videoTrack)
+  return _impl_.videotrack_.Mutable(index);
+}
+inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::VideoTrack >*
+GetVideoTracksReply::mutable_videotrack() {
+  // @@protoc_insertion_point(field_mutable_list:player.GetVideoTracksReply.videoTrack)
+  return &_impl_.videotrack_;
+}
+inline const ::player::VideoTrack& GetVideoTracksReply::_internal_videotrack(int index) const {
+  return _impl_.videotrack_.Get(index);
+}
+inline const ::player::VideoTrack& GetVideoTracksReply::videotrack(int index) const {
+  // @@protoc_insertion_point(field_get:player.GetVideoTracksReply.videoTrack)
+  return _internal_videotrack(index);
+}
+inline ::player::VideoTrack* GetVideoTracksReply::_internal_add_videotrack() {
+  return _impl_.videotrack_.Add();
+}
+inline ::player::VideoTrack* GetVideoTracksReply::add_videotrack() {
+  ::player::VideoTrack* _add = _internal_add_videotrack();
+  // @@protoc_insertion_point(field_add:player.GetVideoTracksReply.videoTrack)
+  return _add;
+}
+inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::VideoTrack >&
+GetVideoTracksReply::videotrack() const {
+  // @@protoc_insertion_point(field_list:player.GetVideoTracksReply.videoTrack)
+  return _impl_.videotrack_;
+}
+
+// -------------------------------------------------------------------
+
+// AudioTrack
+
+// int64 id = 1;
+inline void AudioTrack::clear_id() {
+  _impl_.id_ = int64_t{0};
+}
+inline int64_t AudioTrack::_internal_id() const {
+  return _impl_.id_;
+}
+inline int64_t AudioTrack::id() const {
+  // @@protoc_insertion_point(field_get:player.AudioTrack.id)
+  return _internal_id();
+}
+inline void AudioTrack::_internal_set_id(int64_t value) {
+  
+  _impl_.id_ = value;
+}
+inline void AudioTrack::set_id(int64_t value) {
+  _internal_set_id(value);
+  // @@protoc_insertion_point(field_set:player.AudioTrack.id)
+}
+
+// bool isActive = 2;
+inline void AudioTrack::clear_isactive() {
+  _impl_.isactive_ = false;
+}
+inline bool AudioTrack::_internal_isactive() const {
+  return _impl_.isactive_;
+}
+inline bool AudioTrack::isactive() const {
+  // @@protoc_insertion_point(field_get:player.AudioTrack.isActive)
+  return _internal_isactive();
+}
+inline void AudioTrack::_internal_set_isactive(bool value) {
+  
+  _impl_.isactive_ = value;
+}
+inline void AudioTrack::set_isactive(bool value) {
+  _internal_set_isactive(value);
+  // @@protoc_insertion_point(field_set:player.AudioTrack.isActive)
+}
+
+// .player.TrackInfo info = 3;
+inline bool AudioTrack::_internal_has_info() const {
+  return this != internal_default_instance() && _impl_.info_ != nullptr;
+}
+inline bool AudioTrack::has_info() const {
+  return _internal_has_info();
+}
+inline void AudioTrack::clear_info() {
+  if (GetArenaForAllocation() == nullptr && _impl_.info_ != nullptr) {
+    delete _impl_.info_;
+  }
+  _impl_.info_ = nullptr;
+}
+inline const ::player::TrackInfo& AudioTrack::_internal_info() const {
+  const ::player::TrackInfo* p = _impl_.info_;
+  return p != nullptr ? *p : reinterpret_cast<const ::player::TrackInfo&>(
+      ::player::_TrackInfo_default_instance_);
+}
+inline const ::player::TrackInfo& AudioTrack::info() const {
+  // @@protoc_insertion_point(field_get:player.AudioTrack.info)
+  return _internal_info();
+}
+inline void AudioTrack::unsafe_arena_set_allocated_info(
+    ::player::TrackInfo* info) {
+  if (GetArenaForAllocation() == nullptr) {
+    delete reinterpret_cast<::PROTOBUF_NAMESPACE_ID::MessageLite*>(_impl_.info_);
+  }
+  _impl_.info_ = info;
+  if (info) {
+    
+  } else {
+    
+  }
+  // @@protoc_insertion_point(field_unsafe_arena_set_allocated:player.AudioTrack.info)
+}
+inline ::player::TrackInfo* AudioTrack::release_info() {
+  
+  ::player::TrackInfo* temp = _impl_.info_;
+  _impl_.info_ = nullptr;
+#ifdef PROTOBUF_FORCE_COPY_IN_RELEASE
+  auto* old =  reinterpret_cast<::PROTOBUF_NAMESPACE_ID::MessageLite*>(temp);
+  temp = ::PROTOBUF_NAMESPACE_ID::internal::DuplicateIfNonNull(temp);
+  if (GetArenaForAllocation() == nullptr) { delete old; }
+#else  // PROTOBUF_FORCE_COPY_IN_RELEASE
+  if (GetArenaForAllocation() != nullptr) {
+    temp = ::PROTOBUF_NAMESPACE_ID::internal::DuplicateIfNonNull(temp);
+  }
+#endif  // !PROTOBUF_FORCE_COPY_IN_RELEASE
+  return temp;
+}
+inline ::player::TrackInfo* AudioTrack::unsafe_arena_release_info() {
+  // @@protoc_insertion_point(field_release:player.AudioTrack.info)
+  
+  ::player::TrackInfo* temp = _impl_.info_;
+  _impl_.info_ = nullptr;
+  return temp;
+}
+inline ::player::TrackInfo* AudioTrack::_internal_mutable_info() {
+  
+  if (_impl_.info_ == nullptr) {
+    auto* p = CreateMaybeMessage<::player::TrackInfo>(GetArenaForAllocation());
+    _impl_.info_ = p;
+  }
+  return _impl_.info_;
+}
+inline ::player::TrackInfo* AudioTrack::mutable_info() {
+  ::player::TrackInfo* _msg = _internal_mutable_info();
+  // @@protoc_insertion_point(field_mutable:player.AudioTrack.info)
+  return _msg;
+}
+inline void AudioTrack::set_allocated_info(::player::TrackInfo* info) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaForAllocation();
+  if (message_arena == nullptr) {
+    delete _impl_.info_;
+  }
+  if (info) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena =
+        ::PROTOBUF_NAMESPACE_ID::Arena::InternalGetOwningArena(info);
+    if (message_arena != submessage_arena) {
+      info = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, info, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  _impl_.info_ = info;
+  // @@protoc_insertion_point(field_set_allocated:player.AudioTrack.info)
+}
+
+// int32 sampleRate = 4;
+inline void AudioTrack::clear_samplerate() {
+  _impl_.samplerate_ = 0;
+}
+inline int32_t AudioTrack::_internal_samplerate() const {
+  return _impl_.samplerate_;
+}
+inline int32_t AudioTrack::samplerate() const {
+  // @@protoc_insertion_point(field_get:player.AudioTrack.sampleRate)
+  return _internal_samplerate();
+}
+inline void AudioTrack::_internal_set_samplerate(int32_t value) {
+  
+  _impl_.samplerate_ = value;
+}
+inline void AudioTrack::set_samplerate(int32_t value) {
+  _internal_set_samplerate(value);
+  // @@protoc_insertion_point(field_set:player.AudioTrack.sampleRate)
+}
+
+// int32 channels = 5;
+inline void AudioTrack::clear_channels() {
+  _impl_.channels_ = 0;
+}
+inline int32_t AudioTrack::_internal_channels() const {
+  return _impl_.channels_;
+}
+inline int32_t AudioTrack::channels() const {
+  // @@protoc_insertion_point(field_get:player.AudioTrack.channels)
+  return _internal_channels();
+}
+inline void AudioTrack::_internal_set_channels(int32_t value) {
+  
+  _impl_.channels_ = value;
+}
+inline void AudioTrack::set_channels(int32_t value) {
+  _internal_set_channels(value);
+  // @@protoc_insertion_point(field_set:player.AudioTrack.channels)
+}
+
+// string channelLayout = 6;
+inline void AudioTrack::clear_channellayout() {
+  _impl_.channellayout_.ClearToEmpty();
+}
+inline const std::string& AudioTrack::channellayout() const {
+  // @@protoc_insertion_point(field_get:player.AudioTrack.channelLayout)
+  return _internal_channellayout();
+}
+template <typename ArgT0, typename... ArgT>
+inline PROTOBUF_ALWAYS_INLINE
+void AudioTrack::set_channellayout(ArgT0&& arg0, ArgT... args) {
+ 
+ _impl_.channellayout_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
+  // @@protoc_insertion_point(field_set:player.AudioTrack.channelLayout)
+}
+inline std::string* AudioTrack::mutable_channellayout() {
+  std::string* _s = _internal_mutable_channellayout();
+  // @@protoc_insertion_point(field_mutable:player.AudioTrack.channelLayout)
+  return _s;
+}
+inline const std::string& AudioTrack::_internal_channellayout() const {
+  return _impl_.channellayout_.Get();
+}
+inline void AudioTrack::_internal_set_channellayout(const std::string& value) {
+  
+  _impl_.channellayout_.Set(value, GetArenaForAllocation());
+}
+inline std::string* AudioTrack::_internal_mutable_channellayout() {
+  
+  return _impl_.channellayout_.Mutable(GetArenaForAllocation());
+}
+inline std::string* AudioTrack::release_channellayout() {
+  // @@protoc_insertion_point(field_release:player.AudioTrack.channelLayout)
+  return _impl_.channellayout_.Release();
+}
+inline void AudioTrack::set_allocated_channellayout(std::string* channellayout) {
+  if (channellayout != nullptr) {
+    
+  } else {
+    
+  }
+  _impl_.channellayout_.SetAllocated(channellayout, GetArenaForAllocation());
+#ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (_impl_.channellayout_.IsDefault()) {
+    _impl_.channellayout_.Set("", GetArenaForAllocation());
+  }
+#endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  // @@protoc_insertion_point(field_set_allocated:player.AudioTrack.channelLayout)
+}
+
+// -------------------------------------------------------------------
+
+// GetAudioTracksRequest
+
+// -------------------------------------------------------------------
+
+// GetAudioTracksReply
+
+// repeated .player.AudioTrack audioTrack = 1;
+inline int GetAudioTracksReply::_internal_audiotrack_size() const {
+  return _impl_.audiotrack_.size();
+}
+inline int GetAudioTracksReply::audiotrack_size() const {
+  return _internal_audiotrack_size();
+}
+inline void GetAudioTracksReply::clear_audiotrack() {
+  _impl_.audiotrack_.Clear();
+}
+inline ::player::AudioTrack* GetAudioTracksReply::mutable_audiotrack(int index) {
+  // @@protoc_insertion_point(field_mutable:player.GetAudioTracksReply.audioTrack)
+  return _impl_.audiotrack_.Mutable(index);
+}
+inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::AudioTrack >*
+GetAudioTracksReply::mutable_audiotrack() {
+  // @@protoc_insertion_point(field_mutable_list:player.GetAudioTracksReply.audioTrack)
+  return &_impl_.audiotrack_;
+}
+inline const ::player::AudioTrack& GetAudioTracksReply::_internal_audiotrack(int index) const {
+  return _impl_.audiotrack_.Get(index);
+}
+inline const ::player::AudioTrack& GetAudioTracksReply::audiotrack(int index) const {
+  // @@protoc_insertion_point(field_get:player.GetAudioTracksReply.audioTrack)
+  return _internal_audiotrack(index);
+}
+inline ::player::AudioTrack* GetAudioTracksReply::_internal_add_audiotrack() {
+  return _impl_.audiotrack_.Add();
+}
+inline ::player::AudioTrack* GetAudioTracksReply::add_audiotrack() {
+  ::player::AudioTrack* _add = _internal_add_audiotrack();
+  // @@protoc_insertion_point(field_add:player.GetAudioTracksReply.audioTrack)
+  return _add;
+}
+inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::AudioTrack >&
+GetAudioTracksReply::audiotrack() const {
+  // @@protoc_insertion_point(field_list:player.GetAudioTracksReply.audioTrack)
+  return _impl_.audiotrack_;
+}
+
+// -------------------------------------------------------------------
+
+// SubtitlesTrack
+
+// int64 id = 1;
+inline void SubtitlesTrack::clear_id() {
+  _impl_.id_ = int64_t{0};
+}
+inline int64_t SubtitlesTrack::_internal_id() const {
+  return _impl_.id_;
+}
+inline int64_t SubtitlesTrack::id() const {
+  // @@protoc_insertion_point(field_get:player.SubtitlesTrack.id)
+  return _internal_id();
+}
+inline void SubtitlesTrack::_internal_set_id(int64_t value) {
+  
+  _impl_.id_ = value;
+}
+inline void SubtitlesTrack::set_id(int64_t value) {
+  _internal_set_id(value);
+  // @@protoc_insertion_point(field_set:player.SubtitlesTrack.id)
+}
+
+// bool isActive = 2;
+inline void SubtitlesTrack::clear_isactive() {
+  _impl_.isactive_ = false;
+}
+inline bool SubtitlesTrack::_internal_isactive() const {
+  return _impl_.isactive_;
+}
+inline bool SubtitlesTrack::isactive() const {
+  // @@protoc_insertion_point(field_get:player.SubtitlesTrack.isActive)
+  return _internal_isactive();
+}
+inline void SubtitlesTrack::_internal_set_isactive(bool value) {
+  
+  _impl_.isactive_ = value;
+}
+inline void SubtitlesTrack::set_isactive(bool value) {
+  _internal_set_isactive(value);
+  // @@protoc_insertion_point(field_set:player.SubtitlesTrack.isActive)
+}
+
+// .player.TrackInfo info = 3;
+inline bool SubtitlesTrack::_internal_has_info() const {
+  return this != internal_default_instance() && _impl_.info_ != nullptr;
+}
+inline bool SubtitlesTrack::has_info() const {
+  return _internal_has_info();
+}
+inline void SubtitlesTrack::clear_info() {
+  if (GetArenaForAllocation() == nullptr && _impl_.info_ != nullptr) {
+    delete _impl_.info_;
+  }
+  _impl_.info_ = nullptr;
+}
+inline const ::player::TrackInfo& SubtitlesTrack::_internal_info() const {
+  const ::player::TrackInfo* p = _impl_.info_;
+  return p != nullptr ? *p : reinterpret_cast<const ::player::TrackInfo&>(
+      ::player::_TrackInfo_default_instance_);
+}
+inline const ::player::TrackInfo& SubtitlesTrack::info() const {
+  // @@protoc_insertion_point(field_get:player.SubtitlesTrack.info)
+  return _internal_info();
+}
+inline void SubtitlesTrack::unsafe_arena_set_allocated_info(
+    ::player::TrackInfo* info) {
+  if (GetArenaForAllocation() == nullptr) {
+    delete reinterpret_cast<::PROTOBUF_NAMESPACE_ID::MessageLite*>(_impl_.info_);
+  }
+  _impl_.info_ = info;
+  if (info) {
+    
+  } else {
+    
+  }
+  // @@protoc_insertion_point(field_unsafe_arena_set_allocated:player.SubtitlesTrack.info)
+}
+inline ::player::TrackInfo* SubtitlesTrack::release_info() {
+  
+  ::player::TrackInfo* temp = _impl_.info_;
+  _impl_.info_ = nullptr;
+#ifdef PROTOBUF_FORCE_COPY_IN_RELEASE
+  auto* old =  reinterpret_cast<::PROTOBUF_NAMESPACE_ID::MessageLite*>(temp);
+  temp = ::PROTOBUF_NAMESPACE_ID::internal::DuplicateIfNonNull(temp);
+  if (GetArenaForAllocation() == nullptr) { delete old; }
+#else  // PROTOBUF_FORCE_COPY_IN_RELEASE
+  if (GetArenaForAllocation() != nullptr) {
+    temp = ::PROTOBUF_NAMESPACE_ID::internal::DuplicateIfNonNull(temp);
+  }
+#endif  // !PROTOBUF_FORCE_COPY_IN_RELEASE
+  return temp;
+}
+inline ::player::TrackInfo* SubtitlesTrack::unsafe_arena_release_info() {
+  // @@protoc_insertion_point(field_release:player.SubtitlesTrack.info)
+  
+  ::player::TrackInfo* temp = _impl_.info_;
+  _impl_.info_ = nullptr;
+  return temp;
+}
+inline ::player::TrackInfo* SubtitlesTrack::_internal_mutable_info() {
+  
+  if (_impl_.info_ == nullptr) {
+    auto* p = CreateMaybeMessage<::player::TrackInfo>(GetArenaForAllocation());
+    _impl_.info_ = p;
+  }
+  return _impl_.info_;
+}
+inline ::player::TrackInfo* SubtitlesTrack::mutable_info() {
+  ::player::TrackInfo* _msg = _internal_mutable_info();
+  // @@protoc_insertion_point(field_mutable:player.SubtitlesTrack.info)
+  return _msg;
+}
+inline void SubtitlesTrack::set_allocated_info(::player::TrackInfo* info) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaForAllocation();
+  if (message_arena == nullptr) {
+    delete _impl_.info_;
+  }
+  if (info) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena =
+        ::PROTOBUF_NAMESPACE_ID::Arena::InternalGetOwningArena(info);
+    if (message_arena != submessage_arena) {
+      info = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, info, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  _impl_.info_ = info;
+  // @@protoc_insertion_point(field_set_allocated:player.SubtitlesTrack.info)
+}
+
+// -------------------------------------------------------------------
+
+// GetSubtitlesTracksRequest
+
+// -------------------------------------------------------------------
+
+// GetSubtitlesTracksReply
+
+// repeated .player.SubtitlesTrack subtitlesTrack = 1;
+inline int GetSubtitlesTracksReply::_internal_subtitlestrack_size() const {
+  return _impl_.subtitlestrack_.size();
+}
+inline int GetSubtitlesTracksReply::subtitlestrack_size() const {
+  return _internal_subtitlestrack_size();
+}
+inline void GetSubtitlesTracksReply::clear_subtitlestrack() {
+  _impl_.subtitlestrack_.Clear();
+}
+inline ::player::SubtitlesTrack* GetSubtitlesTracksReply::mutable_subtitlestrack(int index) {
+  // @@protoc_insertion_point(field_mutable:player.GetSubtitlesTracksReply.subtitlesTrack)
+  return _impl_.subtitlestrack_.Mutable(index);
+}
+inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::SubtitlesTrack >*
+GetSubtitlesTracksReply::mutable_subtitlestrack() {
+  // @@protoc_insertion_point(field_mutable_list:player.GetSubtitlesTracksReply.subtitlesTrack)
+  return &_impl_.subtitlestrack_;
+}
+inline const ::player::SubtitlesTrack& GetSubtitlesTracksReply::_internal_subtitlestrack(int index) const {
+  return _impl_.subtitlestrack_.Get(index);
+}
+inline const ::player::SubtitlesTrack& GetSubtitlesTracksReply::subtitlestrack(int index) const {
+  // @@protoc_insertion_point(field_get:player.GetSubtitlesTracksReply.subtitlesTrack)
+  return _internal_subtitlestrack(index);
+}
+inline ::player::SubtitlesTrack* GetSubtitlesTracksReply::_internal_add_subtitlestrack() {
+  return _impl_.subtitlestrack_.Add();
+}
+inline ::player::SubtitlesTrack* GetSubtitlesTracksReply::add_subtitlestrack() {
+  ::player::SubtitlesTrack* _add = _internal_add_subtitlestrack();
+  // @@protoc_insertion_point(field_add:player.GetSubtitlesTracksReply.subtitlesTrack)
+  return _add;
+}
+inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::SubtitlesTrack >&
+GetSubtitlesTracksReply::subtitlestrack() const {
+  // @@protoc_insertion_point(field_list:player.GetSubtitlesTracksReply.subtitlesTrack)
+  return _impl_.subtitlestrack_;
+}
+
+// -------------------------------------------------------------------
+
+// Chapter
+
+// string title = 1;
+inline void Chapter::clear_title() {
+  _impl_.title_.ClearToEmpty();
+}
+inline const std::string& Chapter::title() const {
+  // @@protoc_insertion_point(field_get:player.Chapter.title)
+  return _internal_title();
+}
+template <typename ArgT0, typename... ArgT>
+inline PROTOBUF_ALWAYS_INLINE
+void Chapter::set_title(ArgT0&& arg0, ArgT... args) {
+ 
+ _impl_.title_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
+  // @@protoc_insertion_point(field_set:player.Chapter.title)
+}
+inline std::string* Chapter::mutable_title() {
+  std::string* _s = _internal_mutable_title();
+  // @@protoc_insertion_point(field_mutable:player.Chapter.title)
   return _s;
 }
-inline const std::string& OpenRequest::_internal_title() const {
+inline const std::string& Chapter::_internal_title() const {
   return _impl_.title_.Get();
 }
-inline void OpenRequest::_internal_set_title(const std::string& value) {
+inline void Chapter::_internal_set_title(const std::string& value) {
   
   _impl_.title_.Set(value, GetArenaForAllocation());
 }
-inline std::string* OpenRequest::_internal_mutable_title() {
+inline std::string* Chapter::_internal_mutable_title() {
   
   return _impl_.title_.Mutable(GetArenaForAllocation());
 }
-inline std::string* OpenRequest::release_title() {
-  // @@protoc_insertion_point(field_release:player.OpenRequest.title)
+inline std::string* Chapter::release_title() {
+  // @@protoc_insertion_point(field_release:player.Chapter.title)
   return _impl_.title_.Release();
 }
-inline void OpenRequest::set_allocated_title(std::string* title) {
+inline void Chapter::set_allocated_title(std::string* title) {
   if (title != nullptr) {
     
   } else {
@@ -6471,742 +13381,1248 @@ inline void OpenRequest::set_allocated_title(std::string* title) {
     _impl_.title_.Set("", GetArenaForAllocation());
   }
 #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
-  // @@protoc_insertion_point(field_set_allocated:player.OpenRequest.title)
+  // @@protoc_insertion_point(field_set_allocated:player.Chapter.title)
 }
 
-// .player.LoggingLevel loggingLevel = 3;
-inline void OpenRequest::clear_logginglevel() {
-  _impl_.logginglevel_ = 0;
+// double startSecs = 2;
+inline void Chapter::clear_startsecs() {
+  _impl_.startsecs_ = 0;
 }
-inline ::player::LoggingLevel OpenRequest::_internal_logginglevel() const {
-  return static_cast< ::player::LoggingLevel >(_impl_.logginglevel_);
+inline double Chapter::_internal_startsecs() const {
+  return _impl_.startsecs_;
 }
-inline ::player::LoggingLevel OpenRequest::logginglevel() const {
-  // @@protoc_insertion_point(field_get:player.OpenRequest.loggingLevel)
-  return _internal_logginglevel();
+inline double Chapter::startsecs() const {
+  // @@protoc_insertion_point(field_get:player.Chapter.startSecs)
+  return _internal_startsecs();
 }
-inline void OpenRequest::_internal_set_logginglevel(::player::LoggingLevel value) {
+inline void Chapter::_internal_set_startsecs(double value) {
   
-  _impl_.logginglevel_ = value;
+  _impl_.startsecs_ = value;
 }
-inline void OpenRequest::set_logginglevel(::player::LoggingLevel value) {
-  _internal_set_logginglevel(value);
-  // @@protoc_insertion_point(field_set:player.OpenRequest.loggingLevel)
+inline void Chapter::set_startsecs(double value) {
+  _internal_set_startsecs(value);
+  // @@protoc_insertion_point(field_set:player.Chapter.startSecs)
 }
 
-// -------------------------------------------------------------------
-
-// OpenReply
+// double endSecs = 3;
+inline void Chapter::clear_endsecs() {
+  _impl_.endsecs_ = 0;
+}
+inline double Chapter::_internal_endsecs() const {
+  return _impl_.endsecs_;
+}
+inline double Chapter::endsecs() const {
+  // @@protoc_insertion_point(field_get:player.Chapter.endSecs)
+  return _internal_endsecs();
+}
+inline void Chapter::_internal_set_endsecs(double value) {
+  
+  _impl_.endsecs_ = value;
+}
+inline void Chapter::set_endsecs(double value) {
+  _internal_set_endsecs(value);
+  // @@protoc_insertion_point(field_set:player.Chapter.endSecs)
+}
 
 // -------------------------------------------------------------------
 
-// GetLinkRequest
-
 // -------------------------------------------------------------------
 
-// GetLinkReply
+// MediaInfo
 
-// string link = 1;
-inline void GetLinkReply::clear_link() {
-  _impl_.link_.ClearToEmpty();
+// string formatName = 1;
+inline void MediaInfo::clear_formatname() {
+  _impl_.formatname_.ClearToEmpty();
 }
-inline const std::string& GetLinkReply::link() const {
-  // @@protoc_insertion_point(field_get:player.GetLinkReply.link)
-  return _internal_link();
+inline const std::string& MediaInfo::formatname() const {
+  // @@protoc_insertion_point(field_get:player.MediaInfo.formatName)
+  return _internal_formatname();
 }
 template <typename ArgT0, typename... ArgT>
 inline PROTOBUF_ALWAYS_INLINE
-void GetLinkReply::set_link(ArgT0&& arg0, ArgT... args) {
+void MediaInfo::set_formatname(ArgT0&& arg0, ArgT... args) {
  
- _impl_.link_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
-  // @@protoc_insertion_point(field_set:player.GetLinkReply.link)
+ _impl_.formatname_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
+  // @@protoc_insertion_point(field_set:player.MediaInfo.formatName)
 }
-inline std::string* GetLinkReply::mutable_link() {
-  std::string* _s = _internal_mutable_link();
-  // @@protoc_insertion_point(field_mutable:player.GetLinkReply.link)
+inline std::string* MediaInfo::mutable_formatname() {
+  std::string* _s = _internal_mutable_formatname();
+  // @@protoc_insertion_point(field_mutable:player.MediaInfo.formatName)
   return _s;
 }
-inline const std::string& GetLinkReply::_internal_link() const {
-  return _impl_.link_.Get();
+inline const std::string& MediaInfo::_internal_formatname() const {
+  return _impl_.formatname_.Get();
 }
-inline void GetLinkReply::_internal_set_link(const std::string& value) {
+inline void MediaInfo::_internal_set_formatname(const std::string& value) {
   
-  _impl_.link_.Set(value, GetArenaForAllocation());
+  _impl_.formatname_.Set(value, GetArenaForAllocation());
 }
-inline std::string* GetLinkReply::_internal_mutable_link() {
+inline std::string* MediaInfo::_internal_mutable_formatname() {
   
-  return _impl_.link_.Mutable(GetArenaForAllocation());
+  return _impl_.formatname_.Mutable(GetArenaForAllocation());
 }
-inline std::string* GetLinkReply::release_link() {
-  // @@protoc_insertion_point(field_release:player.GetLinkReply.link)
-  return _impl_.link_.Release();
+inline std::string* MediaInfo::release_formatname() {
+  // @@protoc_insertion_point(field_release:player.MediaInfo.formatName)
+  return _impl_.formatname_.Release();
 }
-inline void GetLinkReply::set_allocated_link(std::string* link) {
-  if (link != nullptr) {
+inline void MediaInfo::set_allocated_formatname(std::string* formatname) {
+  if (formatname != nullptr) {
     
   } else {
     
   }
-  _impl_.link_.SetAllocated(link, GetArenaForAllocation());
+  _impl_.formatname_.SetAllocated(formatname, GetArenaForAllocation());
 #ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
-  if (_impl_.link_.IsDefault()) {
-    _impl_.link_.Set("", GetArenaForAllocation());
+  if (_impl_.formatname_.IsDefault()) {
+    _impl_.formatname_.Set("", GetArenaForAllocation());
   }
 #endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
-  // @@protoc_insertion_point(field_set_allocated:player.GetLinkReply.link)
+  // @@protoc_insertion_point(field_set_allocated:player.MediaInfo.formatName)
 }
 
-// -------------------------------------------------------------------
-
-// EndChanRequest
-
-// -------------------------------------------------------------------
-
-// EndChanReply
-
-// -------------------------------------------------------------------
-
-// IsEndedRequest
+// int64 bitrate = 2;
+inline void MediaInfo::clear_bitrate() {
+  _impl_.bitrate_ = int64_t{0};
+}
+inline int64_t MediaInfo::_internal_bitrate() const {
+  return _impl_.bitrate_;
+}
+inline int64_t MediaInfo::bitrate() const {
+  // @@protoc_insertion_point(field_get:player.MediaInfo.bitrate)
+  return _internal_bitrate();
+}
+inline void MediaInfo::_internal_set_bitrate(int64_t value) {
+  
+  _impl_.bitrate_ = value;
+}
+inline void MediaInfo::set_bitrate(int64_t value) {
+  _internal_set_bitrate(value);
+  // @@protoc_insertion_point(field_set:player.MediaInfo.bitrate)
+}
 
-// -------------------------------------------------------------------
+// int32 streamCount = 3;
+inline void MediaInfo::clear_streamcount() {
+  _impl_.streamcount_ = 0;
+}
+inline int32_t MediaInfo::_internal_streamcount() const {
+  return _impl_.streamcount_;
+}
+inline int32_t MediaInfo::streamcount() const {
+  // @@protoc_insertion_point(field_get:player.MediaInfo.streamCount)
+  return _internal_streamcount();
+}
+inline void MediaInfo::_internal_set_streamcount(int32_t value) {
+  
+  _impl_.streamcount_ = value;
+}
+inline void MediaInfo::set_streamcount(int32_t value) {
+  _internal_set_streamcount(value);
+  // @@protoc_insertion_point(field_set:player.MediaInfo.streamCount)
+}
 
-// IsEndedReply
+// map<string, string> tags = 4;
+inline int MediaInfo::_internal_tags_size() const {
+  return _impl_.tags_.size();
+}
+inline int MediaInfo::tags_size() const {
+  return _internal_tags_size();
+}
+inline void MediaInfo::clear_tags() {
+  _impl_.tags_.Clear();
+}
+inline const ::PROTOBUF_NAMESPACE_ID::Map< std::string, std::string >&
+MediaInfo::_internal_tags() const {
+  return _impl_.tags_.GetMap();
+}
+inline const ::PROTOBUF_NAMESPACE_ID::Map< std::string, std::string >&
+MediaInfo::tags() const {
+  // @@protoc_insertion_point(field_map:player.MediaInfo.tags)
+  return _internal_tags();
+}
+inline ::PROTOBUF_NAMESPACE_ID::Map< std::string, std::string >*
+MediaInfo::_internal_mutable_tags() {
+  return _impl_.tags_.MutableMap();
+}
+inline ::PROTOBUF_NAMESPACE_ID::Map< std::string, std::string >*
+MediaInfo::mutable_tags() {
+  // @@protoc_insertion_point(field_mutable_map:player.MediaInfo.tags)
+  return _internal_mutable_tags();
+}
 
-// bool isEnded = 1;
-inline void IsEndedReply::clear_isended() {
-  _impl_.isended_ = false;
+// repeated .player.Chapter chapters = 5;
+inline int MediaInfo::_internal_chapters_size() const {
+  return _impl_.chapters_.size();
 }
-inline bool IsEndedReply::_internal_isended() const {
-  return _impl_.isended_;
+inline int MediaInfo::chapters_size() const {
+  return _internal_chapters_size();
 }
-inline bool IsEndedReply::isended() const {
-  // @@protoc_insertion_point(field_get:player.IsEndedReply.isEnded)
-  return _internal_isended();
+inline void MediaInfo::clear_chapters() {
+  _impl_.chapters_.Clear();
 }
-inline void IsEndedReply::_internal_set_isended(bool value) {
-  
-  _impl_.isended_ = value;
+inline ::player::Chapter* MediaInfo::mutable_chapters(int index) {
+  // @@protoc_insertion_point(field_mutable:player.MediaInfo.chapters)
+  return _impl_.chapters_.Mutable(index);
 }
-inline void IsEndedReply::set_isended(bool value) {
-  _internal_set_isended(value);
-  // @@protoc_insertion_point(field_set:player.IsEndedReply.isEnded)
+inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::Chapter >*
+MediaInfo::mutable_chapters() {
+  // @@protoc_insertion_point(field_mutable_list:player.MediaInfo.chapters)
+  return &_impl_.chapters_;
+}
+inline const ::player::Chapter& MediaInfo::_internal_chapters(int index) const {
+  return _impl_.chapters_.Get(index);
+}
+inline const ::player::Chapter& MediaInfo::chapters(int index) const {
+  // @@protoc_insertion_point(field_get:player.MediaInfo.chapters)
+  return _internal_chapters(index);
+}
+inline ::player::Chapter* MediaInfo::_internal_add_chapters() {
+  return _impl_.chapters_.Add();
+}
+inline ::player::Chapter* MediaInfo::add_chapters() {
+  ::player::Chapter* _add = _internal_add_chapters();
+  // @@protoc_insertion_point(field_add:player.MediaInfo.chapters)
+  return _add;
+}
+inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::Chapter >&
+MediaInfo::chapters() const {
+  // @@protoc_insertion_point(field_list:player.MediaInfo.chapters)
+  return _impl_.chapters_;
 }
 
 // -------------------------------------------------------------------
 
-// GetPositionRequest
+// GetMediaInfoRequest
 
 // -------------------------------------------------------------------
 
-// GetPositionReply
+// GetMediaInfoReply
 
-// double positionSecs = 1;
-inline void GetPositionReply::clear_positionsecs() {
-  _impl_.positionsecs_ = 0;
+// .player.MediaInfo mediaInfo = 1;
+inline bool GetMediaInfoReply::_internal_has_mediainfo() const {
+  return this != internal_default_instance() && _impl_.mediainfo_ != nullptr;
 }
-inline double GetPositionReply::_internal_positionsecs() const {
-  return _impl_.positionsecs_;
+inline bool GetMediaInfoReply::has_mediainfo() const {
+  return _internal_has_mediainfo();
 }
-inline double GetPositionReply::positionsecs() const {
-  // @@protoc_insertion_point(field_get:player.GetPositionReply.positionSecs)
-  return _internal_positionsecs();
+inline void GetMediaInfoReply::clear_mediainfo() {
+  if (GetArenaForAllocation() == nullptr && _impl_.mediainfo_ != nullptr) {
+    delete _impl_.mediainfo_;
+  }
+  _impl_.mediainfo_ = nullptr;
 }
-inline void GetPositionReply::_internal_set_positionsecs(double value) {
+inline const ::player::MediaInfo& GetMediaInfoReply::_internal_mediainfo() const {
+  const ::player::MediaInfo* p = _impl_.mediainfo_;
+  return p != nullptr ? *p : reinterpret_cast<const ::player::MediaInfo&>(
+      ::player::_MediaInfo_default_instance_);
+}
+inline const ::player::MediaInfo& GetMediaInfoReply::mediainfo() const {
+  // @@protoc_insertion_point(field_get:player.GetMediaInfoReply.mediaInfo)
+  return _internal_mediainfo();
+}
+inline void GetMediaInfoReply::unsafe_arena_set_allocated_mediainfo(
+    ::player::MediaInfo* mediainfo) {
+  if (GetArenaForAllocation() == nullptr) {
+    delete reinterpret_cast<::PROTOBUF_NAMESPACE_ID::MessageLite*>(_impl_.mediainfo_);
+  }
+  _impl_.mediainfo_ = mediainfo;
+  if (mediainfo) {
+    
+  } else {
+    
+  }
+  // @@protoc_insertion_point(field_unsafe_arena_set_allocated:player.GetMediaInfoReply.mediaInfo)
+}
+inline ::player::MediaInfo* GetMediaInfoReply::release_mediainfo() {
   
-  _impl_.positionsecs_ = value;
+  ::player::MediaInfo* temp = _impl_.mediainfo_;
+  _impl_.mediainfo_ = nullptr;
+#ifdef PROTOBUF_FORCE_COPY_IN_RELEASE
+  auto* old =  reinterpret_cast<::PROTOBUF_NAMESPACE_ID::MessageLite*>(temp);
+  temp = ::PROTOBUF_NAMESPACE_ID::internal::DuplicateIfNonNull(temp);
+  if (GetArenaForAllocation() == nullptr) { delete old; }
+#else  // PROTOBUF_FORCE_COPY_IN_RELEASE
+  if (GetArenaForAllocation() != nullptr) {
+    temp = ::PROTOBUF_NAMESPACE_ID::internal::DuplicateIfNonNull(temp);
+  }
+#endif  // !PROTOBUF_FORCE_COPY_IN_RELEASE
+  return temp;
+}
+inline ::player::MediaInfo* GetMediaInfoReply::unsafe_arena_release_mediainfo() {
+  // @@protoc_insertion_point(field_release:player.GetMediaInfoReply.mediaInfo)
+  
+  ::player::MediaInfo* temp = _impl_.mediainfo_;
+  _impl_.mediainfo_ = nullptr;
+  return temp;
 }
-inline void GetPositionReply::set_positionsecs(double value) {
-  _internal_set_positionsecs(value);
-  // @@protoc_insertion_point(field_set:player.GetPositionReply.positionSecs)
+inline ::player::MediaInfo* GetMediaInfoReply::_internal_mutable_mediainfo() {
+  
+  if (_impl_.mediainfo_ == nullptr) {
+    auto* p = CreateMaybeMessage<::player::MediaInfo>(GetArenaForAllocation());
+    _impl_.mediainfo_ = p;
+  }
+  return _impl_.mediainfo_;
+}
+inline ::player::MediaInfo* GetMediaInfoReply::mutable_mediainfo() {
+  ::player::MediaInfo* _msg = _internal_mutable_mediainfo();
+  // @@protoc_insertion_point(field_mutable:player.GetMediaInfoReply.mediaInfo)
+  return _msg;
+}
+inline void GetMediaInfoReply::set_allocated_mediainfo(::player::MediaInfo* mediainfo) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaForAllocation();
+  if (message_arena == nullptr) {
+    delete _impl_.mediainfo_;
+  }
+  if (mediainfo) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena =
+        ::PROTOBUF_NAMESPACE_ID::Arena::InternalGetOwningArena(mediainfo);
+    if (message_arena != submessage_arena) {
+      mediainfo = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, mediainfo, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  _impl_.mediainfo_ = mediainfo;
+  // @@protoc_insertion_point(field_set_allocated:player.GetMediaInfoReply.mediaInfo)
 }
 
 // -------------------------------------------------------------------
 
-// GetLengthRequest
+// GetChaptersRequest
 
 // -------------------------------------------------------------------
 
-// GetLengthReply
+// GetChaptersReply
 
-// double lengthSecs = 1;
-inline void GetLengthReply::clear_lengthsecs() {
-  _impl_.lengthsecs_ = 0;
+// repeated .player.Chapter chapters = 1;
+inline int GetChaptersReply::_internal_chapters_size() const {
+  return _impl_.chapters_.size();
 }
-inline double GetLengthReply::_internal_lengthsecs() const {
-  return _impl_.lengthsecs_;
+inline int GetChaptersReply::chapters_size() const {
+  return _internal_chapters_size();
 }
-inline double GetLengthReply::lengthsecs() const {
-  // @@protoc_insertion_point(field_get:player.GetLengthReply.lengthSecs)
-  return _internal_lengthsecs();
+inline void GetChaptersReply::clear_chapters() {
+  _impl_.chapters_.Clear();
 }
-inline void GetLengthReply::_internal_set_lengthsecs(double value) {
-  
-  _impl_.lengthsecs_ = value;
+inline ::player::Chapter* GetChaptersReply::mutable_chapters(int index) {
+  // @@protoc_insertion_point(field_mutable:player.GetChaptersReply.chapters)
+  return _impl_.chapters_.Mutable(index);
 }
-inline void GetLengthReply::set_lengthsecs(double value) {
-  _internal_set_lengthsecs(value);
-  // @@protoc_insertion_point(field_set:player.GetLengthReply.lengthSecs)
+inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::Chapter >*
+GetChaptersReply::mutable_chapters() {
+  // @@protoc_insertion_point(field_mutable_list:player.GetChaptersReply.chapters)
+  return &_impl_.chapters_;
+}
+inline const ::player::Chapter& GetChaptersReply::_internal_chapters(int index) const {
+  return _impl_.chapters_.Get(index);
+}
+inline const ::player::Chapter& GetChaptersReply::chapters(int index) const {
+  // @@protoc_insertion_point(field_get:player.GetChaptersReply.chapters)
+  return _internal_chapters(index);
+}
+inline ::player::Chapter* GetChaptersReply::_internal_add_chapters() {
+  return _impl_.chapters_.Add();
+}
+inline ::player::Chapter* GetChaptersReply::add_chapters() {
+  ::player::Chapter* _add = _internal_add_chapters();
+  // @@protoc_insertion_point(field_add:player.GetChaptersReply.chapters)
+  return _add;
+}
+inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::Chapter >&
+GetChaptersReply::chapters() const {
+  // @@protoc_insertion_point(field_list:player.GetChaptersReply.chapters)
+  return _impl_.chapters_;
 }
 
 // -------------------------------------------------------------------
 
-// GetSpeedRequest
+// GetChapterRequest
 
 // -------------------------------------------------------------------
 
-// GetSpeedReply
+// GetChapterReply
 
-// double speed = 1;
-inline void GetSpeedReply::clear_speed() {
-  _impl_.speed_ = 0;
+// int32 chapterIdx = 1;
+inline void GetChapterReply::clear_chapteridx() {
+  _impl_.chapteridx_ = 0;
 }
-inline double GetSpeedReply::_internal_speed() const {
-  return _impl_.speed_;
+inline int32_t GetChapterReply::_internal_chapteridx() const {
+  return _impl_.chapteridx_;
 }
-inline double GetSpeedReply::speed() const {
-  // @@protoc_insertion_point(field_get:player.GetSpeedReply.speed)
-  return _internal_speed();
+inline int32_t GetChapterReply::chapteridx() const {
+  // @@protoc_insertion_point(field_get:player.GetChapterReply.chapterIdx)
+  return _internal_chapteridx();
 }
-inline void GetSpeedReply::_internal_set_speed(double value) {
+inline void GetChapterReply::_internal_set_chapteridx(int32_t value) {
   
-  _impl_.speed_ = value;
+  _impl_.chapteridx_ = value;
 }
-inline void GetSpeedReply::set_speed(double value) {
-  _internal_set_speed(value);
-  // @@protoc_insertion_point(field_set:player.GetSpeedReply.speed)
+inline void GetChapterReply::set_chapteridx(int32_t value) {
+  _internal_set_chapteridx(value);
+  // @@protoc_insertion_point(field_set:player.GetChapterReply.chapterIdx)
 }
 
 // -------------------------------------------------------------------
 
-// SetSpeedRequest
+// SetChapterRequest
 
-// double speed = 1;
-inline void SetSpeedRequest::clear_speed() {
-  _impl_.speed_ = 0;
+// int32 chapterIdx = 1;
+inline void SetChapterRequest::clear_chapteridx() {
+  _impl_.chapteridx_ = 0;
 }
-inline double SetSpeedRequest::_internal_speed() const {
-  return _impl_.speed_;
+inline int32_t SetChapterRequest::_internal_chapteridx() const {
+  return _impl_.chapteridx_;
 }
-inline double SetSpeedRequest::speed() const {
-  // @@protoc_insertion_point(field_get:player.SetSpeedRequest.speed)
-  return _internal_speed();
+inline int32_t SetChapterRequest::chapteridx() const {
+  // @@protoc_insertion_point(field_get:player.SetChapterRequest.chapterIdx)
+  return _internal_chapteridx();
 }
-inline void SetSpeedRequest::_internal_set_speed(double value) {
+inline void SetChapterRequest::_internal_set_chapteridx(int32_t value) {
   
-  _impl_.speed_ = value;
+  _impl_.chapteridx_ = value;
 }
-inline void SetSpeedRequest::set_speed(double value) {
-  _internal_set_speed(value);
-  // @@protoc_insertion_point(field_set:player.SetSpeedRequest.speed)
+inline void SetChapterRequest::set_chapteridx(int32_t value) {
+  _internal_set_chapteridx(value);
+  // @@protoc_insertion_point(field_set:player.SetChapterRequest.chapterIdx)
 }
 
 // -------------------------------------------------------------------
 
-// SetSpeedReply
+// SetChapterReply
 
 // -------------------------------------------------------------------
 
-// GetPauseRequest
+// StopRequest
 
 // -------------------------------------------------------------------
 
-// GetPauseReply
+// StopReply
 
-// bool isPaused = 1;
-inline void GetPauseReply::clear_ispaused() {
-  _impl_.ispaused_ = false;
-}
-inline bool GetPauseReply::_internal_ispaused() const {
-  return _impl_.ispaused_;
-}
-inline bool GetPauseReply::ispaused() const {
-  // @@protoc_insertion_point(field_get:player.GetPauseReply.isPaused)
-  return _internal_ispaused();
-}
-inline void GetPauseReply::_internal_set_ispaused(bool value) {
-  
-  _impl_.ispaused_ = value;
-}
-inline void GetPauseReply::set_ispaused(bool value) {
-  _internal_set_ispaused(value);
-  // @@protoc_insertion_point(field_set:player.GetPauseReply.isPaused)
-}
+// -------------------------------------------------------------------
+
+// CloseRequest
 
 // -------------------------------------------------------------------
 
-// SetPauseRequest
+// CloseReply
 
-// bool isPaused = 1;
-inline void SetPauseRequest::clear_ispaused() {
-  _impl_.ispaused_ = false;
-}
-inline bool SetPauseRequest::_internal_ispaused() const {
-  return _impl_.ispaused_;
+// -------------------------------------------------------------------
+
+// EventsRequest
+
+// -------------------------------------------------------------------
+
+// EventStateChange
+
+// .player.PlaybackState state = 1;
+inline void EventStateChange::clear_state() {
+  _impl_.state_ = 0;
 }
-inline bool SetPauseRequest::ispaused() const {
-  // @@protoc_insertion_point(field_get:player.SetPauseRequest.isPaused)
-  return _internal_ispaused();
+inline ::player::PlaybackState EventStateChange::_internal_state() const {
+  return static_cast< ::player::PlaybackState >(_impl_.state_);
 }
-inline void SetPauseRequest::_internal_set_ispaused(bool value) {
+inline ::player::PlaybackState EventStateChange::state() const {
+  // @@protoc_insertion_point(field_get:player.EventStateChange.state)
+  return _internal_state();
+}
+inline void EventStateChange::_internal_set_state(::player::PlaybackState value) {
   
-  _impl_.ispaused_ = value;
+  _impl_.state_ = value;
 }
-inline void SetPauseRequest::set_ispaused(bool value) {
-  _internal_set_ispaused(value);
-  // @@protoc_insertion_point(field_set:player.SetPauseRequest.isPaused)
+inline void EventStateChange::set_state(::player::PlaybackState value) {
+  _internal_set_state(value);
+  // @@protoc_insertion_point(field_set:player.EventStateChange.state)
 }
 
 // -------------------------------------------------------------------
 
-// SetPauseReply
-
-// -------------------------------------------------------------------
+// EventPosition
 
-// SetVideoTrackRequest
+// double positionSecs = 1;
+inline void EventPosition::clear_positionsecs() {
+  _impl_.positionsecs_ = 0;
+}
+inline double EventPosition::_internal_positionsecs() const {
+  return _impl_.positionsecs_;
+}
+inline double EventPosition::positionsecs() const {
+  // @@protoc_insertion_point(field_get:player.EventPosition.positionSecs)
+  return _internal_positionsecs();
+}
+inline void EventPosition::_internal_set_positionsecs(double value) {
+  
+  _impl_.positionsecs_ = value;
+}
+inline void EventPosition::set_positionsecs(double value) {
+  _internal_set_positionsecs(value);
+  // @@protoc_insertion_point(field_set:player.EventPosition.positionSecs)
+}
 
-// int64 videoTrackID = 1;
-inline void SetVideoTrackRequest::clear_videotrackid() {
-  _impl_.videotrackid_ = int64_t{0};
+// double lengthSecs = 2;
+inline void EventPosition::clear_lengthsecs() {
+  _impl_.lengthsecs_ = 0;
 }
-inline int64_t SetVideoTrackRequest::_internal_videotrackid() const {
-  return _impl_.videotrackid_;
+inline double EventPosition::_internal_lengthsecs() const {
+  return _impl_.lengthsecs_;
 }
-inline int64_t SetVideoTrackRequest::videotrackid() const {
-  // @@protoc_insertion_point(field_get:player.SetVideoTrackRequest.videoTrackID)
-  return _internal_videotrackid();
+inline double EventPosition::lengthsecs() const {
+  // @@protoc_insertion_point(field_get:player.EventPosition.lengthSecs)
+  return _internal_lengthsecs();
 }
-inline void SetVideoTrackRequest::_internal_set_videotrackid(int64_t value) {
+inline void EventPosition::_internal_set_lengthsecs(double value) {
   
-  _impl_.videotrackid_ = value;
+  _impl_.lengthsecs_ = value;
 }
-inline void SetVideoTrackRequest::set_videotrackid(int64_t value) {
-  _internal_set_videotrackid(value);
-  // @@protoc_insertion_point(field_set:player.SetVideoTrackRequest.videoTrackID)
+inline void EventPosition::set_lengthsecs(double value) {
+  _internal_set_lengthsecs(value);
+  // @@protoc_insertion_point(field_set:player.EventPosition.lengthSecs)
 }
 
 // -------------------------------------------------------------------
 
-// SetVideoTrackReply
+// EventTracksChange
 
 // -------------------------------------------------------------------
 
-// SetAudioTrackRequest
+// EventBuffering
 
-// int64 audioTrackID = 1;
-inline void SetAudioTrackRequest::clear_audiotrackid() {
-  _impl_.audiotrackid_ = int64_t{0};
+// double percent = 1;
+inline void EventBuffering::clear_percent() {
+  _impl_.percent_ = 0;
 }
-inline int64_t SetAudioTrackRequest::_internal_audiotrackid() const {
-  return _impl_.audiotrackid_;
+inline double EventBuffering::_internal_percent() const {
+  return _impl_.percent_;
 }
-inline int64_t SetAudioTrackRequest::audiotrackid() const {
-  // @@protoc_insertion_point(field_get:player.SetAudioTrackRequest.audioTrackID)
-  return _internal_audiotrackid();
+inline double EventBuffering::percent() const {
+  // @@protoc_insertion_point(field_get:player.EventBuffering.percent)
+  return _internal_percent();
 }
-inline void SetAudioTrackRequest::_internal_set_audiotrackid(int64_t value) {
+inline void EventBuffering::_internal_set_percent(double value) {
   
-  _impl_.audiotrackid_ = value;
+  _impl_.percent_ = value;
 }
-inline void SetAudioTrackRequest::set_audiotrackid(int64_t value) {
-  _internal_set_audiotrackid(value);
-  // @@protoc_insertion_point(field_set:player.SetAudioTrackRequest.audioTrackID)
+inline void EventBuffering::set_percent(double value) {
+  _internal_set_percent(value);
+  // @@protoc_insertion_point(field_set:player.EventBuffering.percent)
 }
 
 // -------------------------------------------------------------------
 
-// SetAudioTrackReply
-
-// -------------------------------------------------------------------
-
-// SetSubtitlesTrackRequest
+// EventError
 
-// int64 subtitlesTrackID = 1;
-inline void SetSubtitlesTrackRequest::clear_subtitlestrackid() {
-  _impl_.subtitlestrackid_ = int64_t{0};
+// string error = 1;
+inline void EventError::clear_error() {
+  _impl_.error_.ClearToEmpty();
 }
-inline int64_t SetSubtitlesTrackRequest::_internal_subtitlestrackid() const {
-  return _impl_.subtitlestrackid_;
+inline const std::string& EventError::error() const {
+  // @@protoc_insertion_point(field_get:player.EventError.error)
+  return _internal_error();
 }
-inline int64_t SetSubtitlesTrackRequest::subtitlestrackid() const {
-  // @@protoc_insertion_point(field_get:player.SetSubtitlesTrackRequest.subtitlesTrackID)
-  return _internal_subtitlestrackid();
+template <typename ArgT0, typename... ArgT>
+inline PROTOBUF_ALWAYS_INLINE
+void EventError::set_error(ArgT0&& arg0, ArgT... args) {
+ 
+ _impl_.error_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
+  // @@protoc_insertion_point(field_set:player.EventError.error)
 }
-inline void SetSubtitlesTrackRequest::_internal_set_subtitlestrackid(int64_t value) {
+inline std::string* EventError::mutable_error() {
+  std::string* _s = _internal_mutable_error();
+  // @@protoc_insertion_point(field_mutable:player.EventError.error)
+  return _s;
+}
+inline const std::string& EventError::_internal_error() const {
+  return _impl_.error_.Get();
+}
+inline void EventError::_internal_set_error(const std::string& value) {
   
-  _impl_.subtitlestrackid_ = value;
+  _impl_.error_.Set(value, GetArenaForAllocation());
 }
-inline void SetSubtitlesTrackRequest::set_subtitlestrackid(int64_t value) {
-  _internal_set_subtitlestrackid(value);
-  // @@protoc_insertion_point(field_set:player.SetSubtitlesTrackRequest.subtitlesTrackID)
+inline std::string* EventError::_internal_mutable_error() {
+  
+  return _impl_.error_.Mutable(GetArenaForAllocation());
+}
+inline std::string* EventError::release_error() {
+  // @@protoc_insertion_point(field_release:player.EventError.error)
+  return _impl_.error_.Release();
+}
+inline void EventError::set_allocated_error(std::string* error) {
+  if (error != nullptr) {
+    
+  } else {
+    
+  }
+  _impl_.error_.SetAllocated(error, GetArenaForAllocation());
+#ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (_impl_.error_.IsDefault()) {
+    _impl_.error_.Set("", GetArenaForAllocation());
+  }
+#endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  // @@protoc_insertion_point(field_set_allocated:player.EventError.error)
 }
 
 // -------------------------------------------------------------------
 
-// SetSubtitlesTrackReply
+// EventEndOfFile
 
 // -------------------------------------------------------------------
 
-// VideoTrack
+// EventMediaChange
 
-// int64 id = 1;
-inline void VideoTrack::clear_id() {
-  _impl_.id_ = int64_t{0};
-}
-inline int64_t VideoTrack::_internal_id() const {
-  return _impl_.id_;
-}
-inline int64_t VideoTrack::id() const {
-  // @@protoc_insertion_point(field_get:player.VideoTrack.id)
-  return _internal_id();
+// string link = 1;
+inline void EventMediaChange::clear_link() {
+  _impl_.link_.ClearToEmpty();
 }
-inline void VideoTrack::_internal_set_id(int64_t value) {
-  
-  _impl_.id_ = value;
+inline const std::string& EventMediaChange::link() const {
+  // @@protoc_insertion_point(field_get:player.EventMediaChange.link)
+  return _internal_link();
 }
-inline void VideoTrack::set_id(int64_t value) {
-  _internal_set_id(value);
-  // @@protoc_insertion_point(field_set:player.VideoTrack.id)
+template <typename ArgT0, typename... ArgT>
+inline PROTOBUF_ALWAYS_INLINE
+void EventMediaChange::set_link(ArgT0&& arg0, ArgT... args) {
+ 
+ _impl_.link_.Set(static_cast<ArgT0 &&>(arg0), args..., GetArenaForAllocation());
+  // @@protoc_insertion_point(field_set:player.EventMediaChange.link)
 }
-
-// bool isActive = 2;
-inline void VideoTrack::clear_isactive() {
-  _impl_.isactive_ = false;
+inline std::string* EventMediaChange::mutable_link() {
+  std::string* _s = _internal_mutable_link();
+  // @@protoc_insertion_point(field_mutable:player.EventMediaChange.link)
+  return _s;
 }
-inline bool VideoTrack::_internal_isactive() const {
-  return _impl_.isactive_;
+inline const std::string& EventMediaChange::_internal_link() const {
+  return _impl_.link_.Get();
 }
-inline bool VideoTrack::isactive() const {
-  // @@protoc_insertion_point(field_get:player.VideoTrack.isActive)
-  return _internal_isactive();
+inline void EventMediaChange::_internal_set_link(const std::string& value) {
+  
+  _impl_.link_.Set(value, GetArenaForAllocation());
 }
-inline void VideoTrack::_internal_set_isactive(bool value) {
+inline std::string* EventMediaChange::_internal_mutable_link() {
   
-  _impl_.isactive_ = value;
+  return _impl_.link_.Mutable(GetArenaForAllocation());
 }
-inline void VideoTrack::set_isactive(bool value) {
-  _internal_set_isactive(value);
-  // @@protoc_insertion_point(field_set:player.VideoTrack.isActive)
+inline std::string* EventMediaChange::release_link() {
+  // @@protoc_insertion_point(field_release:player.EventMediaChange.link)
+  return _impl_.link_.Release();
+}
+inline void EventMediaChange::set_allocated_link(std::string* link) {
+  if (link != nullptr) {
+    
+  } else {
+    
+  }
+  _impl_.link_.SetAllocated(link, GetArenaForAllocation());
+#ifdef PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  if (_impl_.link_.IsDefault()) {
+    _impl_.link_.Set("", GetArenaForAllocation());
+  }
+#endif // PROTOBUF_FORCE_COPY_DEFAULT_STRING
+  // @@protoc_insertion_point(field_set_allocated:player.EventMediaChange.link)
 }
 
 // -------------------------------------------------------------------
 
-// SeekRequest
+// Event
 
-// int64 pos = 1;
-inline void SeekRequest::clear_pos() {
-  _impl_.pos_ = int64_t{0};
+// .player.EventStateChange stateChange = 1;
+inline bool Event::_internal_has_statechange() const {
+  return event_case() == kStateChange;
 }
-inline int64_t SeekRequest::_internal_pos() const {
-  return _impl_.pos_;
+inline bool Event::has_statechange() const {
+  return _internal_has_statechange();
 }
-inline int64_t SeekRequest::pos() const {
-  // @@protoc_insertion_point(field_get:player.SeekRequest.pos)
-  return _internal_pos();
+inline void Event::set_has_statechange() {
+  _impl_._oneof_case_[0] = kStateChange;
 }
-inline void SeekRequest::_internal_set_pos(int64_t value) {
-  
-  _impl_.pos_ = value;
+inline void Event::clear_statechange() {
+  if (_internal_has_statechange()) {
+    if (GetArenaForAllocation() == nullptr) {
+      delete _impl_.event_.statechange_;
+    }
+    clear_has_event();
+  }
 }
-inline void SeekRequest::set_pos(int64_t value) {
-  _internal_set_pos(value);
-  // @@protoc_insertion_point(field_set:player.SeekRequest.pos)
+inline ::player::EventStateChange* Event::release_statechange() {
+  // @@protoc_insertion_point(field_release:player.Event.stateChange)
+  if (_internal_has_statechange()) {
+    clear_has_event();
+    ::player::EventStateChange* temp = _impl_.event_.statechange_;
+    if (GetArenaForAllocation() != nullptr) {
+      temp = ::PROTOBUF_NAMESPACE_ID::internal::DuplicateIfNonNull(temp);
+    }
+    _impl_.event_.statechange_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
 }
-
-// bool isRelative = 2;
-inline void SeekRequest::clear_isrelative() {
-  _impl_.isrelative_ = false;
+inline const ::player::EventStateChange& Event::_internal_statechange() const {
+  return _internal_has_statechange()
+      ? *_impl_.event_.statechange_
+      : reinterpret_cast< ::player::EventStateChange&>(::player::_EventStateChange_default_instance_);
 }
-inline bool SeekRequest::_internal_isrelative() const {
-  return _impl_.isrelative_;
+inline const ::player::EventStateChange& Event::statechange() const {
+  // @@protoc_insertion_point(field_get:player.Event.stateChange)
+  return _internal_statechange();
 }
-inline bool SeekRequest::isrelative() const {
-  // @@protoc_insertion_point(field_get:player.SeekRequest.isRelative)
-  return _internal_isrelative();
+inline ::player::EventStateChange* Event::unsafe_arena_release_statechange() {
+  // @@protoc_insertion_point(field_unsafe_arena_release:player.Event.stateChange)
+  if (_internal_has_statechange()) {
+    clear_has_event();
+    ::player::EventStateChange* temp = _impl_.event_.statechange_;
+    _impl_.event_.statechange_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
 }
-inline void SeekRequest::_internal_set_isrelative(bool value) {
-  
-  _impl_.isrelative_ = value;
+inline void Event::unsafe_arena_set_allocated_statechange(::player::EventStateChange* statechange) {
+  clear_event();
+  if (statechange) {
+    set_has_statechange();
+    _impl_.event_.statechange_ = statechange;
+  }
+  // @@protoc_insertion_point(field_unsafe_arena_set_allocated:player.Event.stateChange)
 }
-inline void SeekRequest::set_isrelative(bool value) {
-  _internal_set_isrelative(value);
-  // @@protoc_insertion_point(field_set:player.SeekRequest.isRelative)
+inline ::player::EventStateChange* Event::_internal_mutable_statechange() {
+  if (!_internal_has_statechange()) {
+    clear_event();
+    set_has_statechange();
+    _impl_.event_.statechange_ = CreateMaybeMessage< ::player::EventStateChange >(GetArenaForAllocation());
+  }
+  return _impl_.event_.statechange_;
+}
+inline ::player::EventStateChange* Event::mutable_statechange() {
+  ::player::EventStateChange* _msg = _internal_mutable_statechange();
+  // @@protoc_insertion_point(field_mutable:player.Event.stateChange)
+  return _msg;
 }
 
-// bool isQuick = 3;
-inline void SeekRequest::clear_isquick() {
-  _impl_.isquick_ = false;
+// .player.EventPosition position = 2;
+inline bool Event::_internal_has_position() const {
+  return event_case() == kPosition;
 }
-inline bool SeekRequest::_internal_isquick() const {
-  return _impl_.isquick_;
+inline bool Event::has_position() const {
+  return _internal_has_position();
 }
-inline bool SeekRequest::isquick() const {
-  // @@protoc_insertion_point(field_get:player.SeekRequest.isQuick)
-  return _internal_isquick();
+inline void Event::set_has_position() {
+  _impl_._oneof_case_[0] = kPosition;
 }
-inline void SeekRequest::_internal_set_isquick(bool value) {
-  
-  _impl_.isquick_ = value;
+inline void Event::clear_position() {
+  if (_internal_has_position()) {
+    if (GetArenaForAllocation() == nullptr) {
+      delete _impl_.event_.position_;
+    }
+    clear_has_event();
+  }
 }
-inline void SeekRequest::set_isquick(bool value) {
-  _internal_set_isquick(value);
-  // @@protoc_insertion_point(field_set:player.SeekRequest.isQuick)
+inline ::player::EventPosition* Event::release_position() {
+  // @@protoc_insertion_point(field_release:player.Event.position)
+  if (_internal_has_position()) {
+    clear_has_event();
+    ::player::EventPosition* temp = _impl_.event_.position_;
+    if (GetArenaForAllocation() != nullptr) {
+      temp = ::PROTOBUF_NAMESPACE_ID::internal::DuplicateIfNonNull(temp);
+    }
+    _impl_.event_.position_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
 }
-
-// -------------------------------------------------------------------
-
-// SeekReply
-
-// -------------------------------------------------------------------
-
-// GetVideoTracksRequest
-
-// -------------------------------------------------------------------
-
-// GetVideoTracksReply
-
-// repeated .player.VideoTrack videoTrack = 1;
-inline int GetVideoTracksReply::_internal_videotrack_size() const {
-  return _impl_.videotrack_.size();
+inline const ::player::EventPosition& Event::_internal_position() const {
+  return _internal_has_position()
+      ? *_impl_.event_.position_
+      : reinterpret_cast< ::player::EventPosition&>(::player::_EventPosition_default_instance_);
 }
-inline int GetVideoTracksReply::videotrack_size() const {
-  return _internal_videotrack_size();
+inline const ::player::EventPosition& Event::position() const {
+  // @@protoc_insertion_point(field_get:player.Event.position)
+  return _internal_position();
 }
-inline void GetVideoTracksReply::clear_videotrack() {
-  _impl_.videotrack_.Clear();
+inline ::player::EventPosition* Event::unsafe_arena_release_position() {
+  // @@protoc_insertion_point(field_unsafe_arena_release:player.Event.position)
+  if (_internal_has_position()) {
+    clear_has_event();
+    ::player::EventPosition* temp = _impl_.event_.position_;
+    _impl_.event_.position_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
 }
-inline ::player::VideoTrack* GetVideoTracksReply::mutable_videotrack(int index) {
-  // @@protoc_insertion_point(field_mutable:player.GetVideoTracksReply.videoTrack)
-  return _impl_.videotrack_.Mutable(index);
+inline void Event::unsafe_arena_set_allocated_position(::player::EventPosition* position) {
+  clear_event();
+  if (position) {
+    set_has_position();
+    _impl_.event_.position_ = position;
+  }
+  // @@protoc_insertion_point(field_unsafe_arena_set_allocated:player.Event.position)
 }
-inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::VideoTrack >*
-GetVideoTracksReply::mutable_videotrack() {
-  // @@protoc_insertion_point(field_mutable_list:player.GetVideoTracksReply.videoTrack)
-  return &_impl_.videotrack_;
+inline ::player::EventPosition* Event::_internal_mutable_position() {
+  if (!_internal_has_position()) {
+    clear_event();
+    set_has_position();
+    _impl_.event_.position_ = CreateMaybeMessage< ::player::EventPosition >(GetArenaForAllocation());
+  }
+  return _impl_.event_.position_;
 }
-inline const ::player::VideoTrack& GetVideoTracksReply::_internal_videotrack(int index) const {
-  return _impl_.videotrack_.Get(index);
+inline ::player::EventPosition* Event::mutable_position() {
+  ::player::EventPosition* _msg = _internal_mutable_position();
+  // @@protoc_insertion_point(field_mutable:player.Event.position)
+  return _msg;
 }
-inline const ::player::VideoTrack& GetVideoTracksReply::videotrack(int index) const {
-  // @@protoc_insertion_point(field_get:player.GetVideoTracksReply.videoTrack)
-  return _internal_videotrack(index);
+
+// .player.EventTracksChange tracksChange = 3;
+inline bool Event::_internal_has_trackschange() const {
+  return event_case() == kTracksChange;
 }
-inline ::player::VideoTrack* GetVideoTracksReply::_internal_add_videotrack() {
-  return _impl_.videotrack_.Add();
+inline bool Event::has_trackschange() const {
+  return _internal_has_trackschange();
 }
-inline ::player::VideoTrack* GetVideoTracksReply::add_videotrack() {
-  ::player::VideoTrack* _add = _internal_add_videotrack();
-  // @@protoc_insertion_point(field_add:player.GetVideoTracksReply.videoTrack)
-  return _add;
+inline void Event::set_has_trackschange() {
+  _impl_._oneof_case_[0] = kTracksChange;
 }
-inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::VideoTrack >&
-GetVideoTracksReply::videotrack() const {
-  // @@protoc_insertion_point(field_list:player.GetVideoTracksReply.videoTrack)
-  return _impl_.videotrack_;
+inline void Event::clear_trackschange() {
+  if (_internal_has_trackschange()) {
+    if (GetArenaForAllocation() == nullptr) {
+      delete _impl_.event_.trackschange_;
+    }
+    clear_has_event();
+  }
+}
+inline ::player::EventTracksChange* Event::release_trackschange() {
+  // @@protoc_insertion_point(field_release:player.Event.tracksChange)
+  if (_internal_has_trackschange()) {
+    clear_has_event();
+    ::player::EventTracksChange* temp = _impl_.event_.trackschange_;
+    if (GetArenaForAllocation() != nullptr) {
+      temp = ::PROTOBUF_NAMESPACE_ID::internal::DuplicateIfNonNull(temp);
+    }
+    _impl_.event_.trackschange_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
+}
+inline const ::player::EventTracksChange& Event::_internal_trackschange() const {
+  return _internal_has_trackschange()
+      ? *_impl_.event_.trackschange_
+      : reinterpret_cast< ::player::EventTracksChange&>(::player::_EventTracksChange_default_instance_);
+}
+inline const ::player::EventTracksChange& Event::trackschange() const {
+  // @@protoc_insertion_point(field_get:player.Event.tracksChange)
+  return _internal_trackschange();
+}
+inline ::player::EventTracksChange* Event::unsafe_arena_release_trackschange() {
+  // @@protoc_insertion_point(field_unsafe_arena_release:player.Event.tracksChange)
+  if (_internal_has_trackschange()) {
+    clear_has_event();
+    ::player::EventTracksChange* temp = _impl_.event_.trackschange_;
+    _impl_.event_.trackschange_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
+}
+inline void Event::unsafe_arena_set_allocated_trackschange(::player::EventTracksChange* trackschange) {
+  clear_event();
+  if (trackschange) {
+    set_has_trackschange();
+    _impl_.event_.trackschange_ = trackschange;
+  }
+  // @@protoc_insertion_point(field_unsafe_arena_set_allocated:player.Event.tracksChange)
+}
+inline ::player::EventTracksChange* Event::_internal_mutable_trackschange() {
+  if (!_internal_has_trackschange()) {
+    clear_event();
+    set_has_trackschange();
+    _impl_.event_.trackschange_ = CreateMaybeMessage< ::player::EventTracksChange >(GetArenaForAllocation());
+  }
+  return _impl_.event_.trackschange_;
+}
+inline ::player::EventTracksChange* Event::mutable_trackschange() {
+  ::player::EventTracksChange* _msg = _internal_mutable_trackschange();
+  // @@protoc_insertion_point(field_mutable:player.Event.tracksChange)
+  return _msg;
 }
 
-// -------------------------------------------------------------------
-
-// AudioTrack
-
-// int64 id = 1;
-inline void AudioTrack::clear_id() {
-  _impl_.id_ = int64_t{0};
+// .player.EventBuffering buffering = 4;
+inline bool Event::_internal_has_buffering() const {
+  return event_case() == kBuffering;
 }
-inline int64_t AudioTrack::_internal_id() const {
-  return _impl_.id_;
+inline bool Event::has_buffering() const {
+  return _internal_has_buffering();
 }
-inline int64_t AudioTrack::id() const {
-  // @@protoc_insertion_point(field_get:player.AudioTrack.id)
-  return _internal_id();
+inline void Event::set_has_buffering() {
+  _impl_._oneof_case_[0] = kBuffering;
 }
-inline void AudioTrack::_internal_set_id(int64_t value) {
-  
-  _impl_.id_ = value;
+inline void Event::clear_buffering() {
+  if (_internal_has_buffering()) {
+    if (GetArenaForAllocation() == nullptr) {
+      delete _impl_.event_.buffering_;
+    }
+    clear_has_event();
+  }
 }
-inline void AudioTrack::set_id(int64_t value) {
-  _internal_set_id(value);
-  // @@protoc_insertion_point(field_set:player.AudioTrack.id)
+inline ::player::EventBuffering* Event::release_buffering() {
+  // @@protoc_insertion_point(field_release:player.Event.buffering)
+  if (_internal_has_buffering()) {
+    clear_has_event();
+    ::player::EventBuffering* temp = _impl_.event_.buffering_;
+    if (GetArenaForAllocation() != nullptr) {
+      temp = ::PROTOBUF_NAMESPACE_ID::internal::DuplicateIfNonNull(temp);
+    }
+    _impl_.event_.buffering_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
 }
-
-// bool isActive = 2;
-inline void AudioTrack::clear_isactive() {
-  _impl_.isactive_ = false;
+inline const ::player::EventBuffering& Event::_internal_buffering() const {
+  return _internal_has_buffering()
+      ? *_impl_.event_.buffering_
+      : reinterpret_cast< ::player::EventBuffering&>(::player::_EventBuffering_default_instance_);
 }
-inline bool AudioTrack::_internal_isactive() const {
-  return _impl_.isactive_;
+inline const ::player::EventBuffering& Event::buffering() const {
+  // @@protoc_insertion_point(field_get:player.Event.buffering)
+  return _internal_buffering();
 }
-inline bool AudioTrack::isactive() const {
-  // @@protoc_insertion_point(field_get:player.AudioTrack.isActive)
-  return _internal_isactive();
+inline ::player::EventBuffering* Event::unsafe_arena_release_buffering() {
+  // @@protoc_insertion_point(field_unsafe_arena_release:player.Event.buffering)
+  if (_internal_has_buffering()) {
+    clear_has_event();
+    ::player::EventBuffering* temp = _impl_.event_.buffering_;
+    _impl_.event_.buffering_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
 }
-inline void AudioTrack::_internal_set_isactive(bool value) {
-  
-  _impl_.isactive_ = value;
+inline void Event::unsafe_arena_set_allocated_buffering(::player::EventBuffering* buffering) {
+  clear_event();
+  if (buffering) {
+    set_has_buffering();
+    _impl_.event_.buffering_ = buffering;
+  }
+  // @@protoc_insertion_point(field_unsafe_arena_set_allocated:player.Event.buffering)
 }
-inline void AudioTrack::set_isactive(bool value) {
-  _internal_set_isactive(value);
-  // @@protoc_insertion_point(field_set:player.AudioTrack.isActive)
+inline ::player::EventBuffering* Event::_internal_mutable_buffering() {
+  if (!_internal_has_buffering()) {
+    clear_event();
+    set_has_buffering();
+    _impl_.event_.buffering_ = CreateMaybeMessage< ::player::EventBuffering >(GetArenaForAllocation());
+  }
+  return _impl_.event_.buffering_;
+}
+inline ::player::EventBuffering* Event::mutable_buffering() {
+  ::player::EventBuffering* _msg = _internal_mutable_buffering();
+  // @@protoc_insertion_point(field_mutable:player.Event.buffering)
+  return _msg;
 }
 
-// -------------------------------------------------------------------
-
-// GetAudioTracksRequest
-
-// -------------------------------------------------------------------
-
-// GetAudioTracksReply
-
-// repeated .player.AudioTrack audioTrack = 1;
-inline int GetAudioTracksReply::_internal_audiotrack_size() const {
-  return _impl_.audiotrack_.size();
+// .player.EventError error = 5;
+inline bool Event::_internal_has_error() const {
+  return event_case() == kError;
 }
-inline int GetAudioTracksReply::audiotrack_size() const {
-  return _internal_audiotrack_size();
+inline bool Event::has_error() const {
+  return _internal_has_error();
 }
-inline void GetAudioTracksReply::clear_audiotrack() {
-  _impl_.audiotrack_.Clear();
+inline void Event::set_has_error() {
+  _impl_._oneof_case_[0] = kError;
 }
-inline ::player::AudioTrack* GetAudioTracksReply::mutable_audiotrack(int index) {
-  // @@protoc_insertion_point(field_mutable:player.GetAudioTracksReply.audioTrack)
-  return _impl_.audiotrack_.Mutable(index);
+inline void Event::clear_error() {
+  if (_internal_has_error()) {
+    if (GetArenaForAllocation() == nullptr) {
+      delete _impl_.event_.error_;
+    }
+    clear_has_event();
+  }
 }
-inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::AudioTrack >*
-GetAudioTracksReply::mutable_audiotrack() {
-  // @@protoc_insertion_point(field_mutable_list:player.GetAudioTracksReply.audioTrack)
-  return &_impl_.audiotrack_;
+inline ::player::EventError* Event::release_error() {
+  // @@protoc_insertion_point(field_release:player.Event.error)
+  if (_internal_has_error()) {
+    clear_has_event();
+    ::player::EventError* temp = _impl_.event_.error_;
+    if (GetArenaForAllocation() != nullptr) {
+      temp = ::PROTOBUF_NAMESPACE_ID::internal::DuplicateIfNonNull(temp);
+    }
+    _impl_.event_.error_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
 }
-inline const ::player::AudioTrack& GetAudioTracksReply::_internal_audiotrack(int index) const {
-  return _impl_.audiotrack_.Get(index);
+inline const ::player::EventError& Event::_internal_error() const {
+  return _internal_has_error()
+      ? *_impl_.event_.error_
+      : reinterpret_cast< ::player::EventError&>(::player::_EventError_default_instance_);
 }
-inline const ::player::AudioTrack& GetAudioTracksReply::audiotrack(int index) const {
-  // @@protoc_insertion_point(field_get:player.GetAudioTracksReply.audioTrack)
-  return _internal_audiotrack(index);
+inline const ::player::EventError& Event::error() const {
+  // @@protoc_insertion_point(field_get:player.Event.error)
+  return _internal_error();
 }
-inline ::player::AudioTrack* GetAudioTracksReply::_internal_add_audiotrack() {
-  return _impl_.audiotrack_.Add();
+inline ::player::EventError* Event::unsafe_arena_release_error() {
+  // @@protoc_insertion_point(field_unsafe_arena_release:player.Event.error)
+  if (_internal_has_error()) {
+    clear_has_event();
+    ::player::EventError* temp = _impl_.event_.error_;
+    _impl_.event_.error_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
 }
-inline ::player::AudioTrack* GetAudioTracksReply::add_audiotrack() {
-  ::player::AudioTrack* _add = _internal_add_audiotrack();
-  // @@protoc_insertion_point(field_add:player.GetAudioTracksReply.audioTrack)
-  return _add;
+inline void Event::unsafe_arena_set_allocated_error(::player::EventError* error) {
+  clear_event();
+  if (error) {
+    set_has_error();
+    _impl_.event_.error_ = error;
+  }
+  // @@protoc_insertion_point(field_unsafe_arena_set_allocated:player.Event.error)
 }
-inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::AudioTrack >&
-GetAudioTracksReply::audiotrack() const {
-  // @@protoc_insertion_point(field_list:player.GetAudioTracksReply.audioTrack)
-  return _impl_.audiotrack_;
+inline ::player::EventError* Event::_internal_mutable_error() {
+  if (!_internal_has_error()) {
+    clear_event();
+    set_has_error();
+    _impl_.event_.error_ = CreateMaybeMessage< ::player::EventError >(GetArenaForAllocation());
+  }
+  return _impl_.event_.error_;
+}
+inline ::player::EventError* Event::mutable_error() {
+  ::player::EventError* _msg = _internal_mutable_error();
+  // @@protoc_insertion_point(field_mutable:player.Event.error)
+  return _msg;
 }
 
-// -------------------------------------------------------------------
-
-// SubtitlesTrack
-
-// int64 id = 1;
-inline void SubtitlesTrack::clear_id() {
-  _impl_.id_ = int64_t{0};
+// .player.EventEndOfFile endOfFile = 6;
+inline bool Event::_internal_has_endoffile() const {
+  return event_case() == kEndOfFile;
 }
-inline int64_t SubtitlesTrack::_internal_id() const {
-  return _impl_.id_;
+inline bool Event::has_endoffile() const {
+  return _internal_has_endoffile();
 }
-inline int64_t SubtitlesTrack::id() const {
-  // @@protoc_insertion_point(field_get:player.SubtitlesTrack.id)
-  return _internal_id();
+inline void Event::set_has_endoffile() {
+  _impl_._oneof_case_[0] = kEndOfFile;
 }
-inline void SubtitlesTrack::_internal_set_id(int64_t value) {
-  
-  _impl_.id_ = value;
+inline void Event::clear_endoffile() {
+  if (_internal_has_endoffile()) {
+    if (GetArenaForAllocation() == nullptr) {
+      delete _impl_.event_.endoffile_;
+    }
+    clear_has_event();
+  }
 }
-inline void SubtitlesTrack::set_id(int64_t value) {
-  _internal_set_id(value);
-  // @@protoc_insertion_point(field_set:player.SubtitlesTrack.id)
+inline ::player::EventEndOfFile* Event::release_endoffile() {
+  // @@protoc_insertion_point(field_release:player.Event.endOfFile)
+  if (_internal_has_endoffile()) {
+    clear_has_event();
+    ::player::EventEndOfFile* temp = _impl_.event_.endoffile_;
+    if (GetArenaForAllocation() != nullptr) {
+      temp = ::PROTOBUF_NAMESPACE_ID::internal::DuplicateIfNonNull(temp);
+    }
+    _impl_.event_.endoffile_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
 }
-
-// bool isActive = 2;
-inline void SubtitlesTrack::clear_isactive() {
-  _impl_.isactive_ = false;
+inline const ::player::EventEndOfFile& Event::_internal_endoffile() const {
+  return _internal_has_endoffile()
+      ? *_impl_.event_.endoffile_
+      : reinterpret_cast< ::player::EventEndOfFile&>(::player::_EventEndOfFile_default_instance_);
 }
-inline bool SubtitlesTrack::_internal_isactive() const {
-  return _impl_.isactive_;
+inline const ::player::EventEndOfFile& Event::endoffile() const {
+  // @@protoc_insertion_point(field_get:player.Event.endOfFile)
+  return _internal_endoffile();
 }
-inline bool SubtitlesTrack::isactive() const {
-  // @@protoc_insertion_point(field_get:player.SubtitlesTrack.isActive)
-  return _internal_isactive();
+inline ::player::EventEndOfFile* Event::unsafe_arena_release_endoffile() {
+  // @@protoc_insertion_point(field_unsafe_arena_release:player.Event.endOfFile)
+  if (_internal_has_endoffile()) {
+    clear_has_event();
+    ::player::EventEndOfFile* temp = _impl_.event_.endoffile_;
+    _impl_.event_.endoffile_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
 }
-inline void SubtitlesTrack::_internal_set_isactive(bool value) {
-  
-  _impl_.isactive_ = value;
+inline void Event::unsafe_arena_set_allocated_endoffile(::player::EventEndOfFile* endoffile) {
+  clear_event();
+  if (endoffile) {
+    set_has_endoffile();
+    _impl_.event_.endoffile_ = endoffile;
+  }
+  // @@protoc_insertion_point(field_unsafe_arena_set_allocated:player.Event.endOfFile)
 }
-inline void SubtitlesTrack::set_isactive(bool value) {
-  _internal_set_isactive(value);
-  // @@protoc_insertion_point(field_set:player.SubtitlesTrack.isActive)
+inline ::player::EventEndOfFile* Event::_internal_mutable_endoffile() {
+  if (!_internal_has_endoffile()) {
+    clear_event();
+    set_has_endoffile();
+    _impl_.event_.endoffile_ = CreateMaybeMessage< ::player::EventEndOfFile >(GetArenaForAllocation());
+  }
+  return _impl_.event_.endoffile_;
+}
+inline ::player::EventEndOfFile* Event::mutable_endoffile() {
+  ::player::EventEndOfFile* _msg = _internal_mutable_endoffile();
+  // @@protoc_insertion_point(field_mutable:player.Event.endOfFile)
+  return _msg;
 }
 
-// -------------------------------------------------------------------
-
-// GetSubtitlesTracksRequest
-
-// -------------------------------------------------------------------
-
-// GetSubtitlesTracksReply
-
-// repeated .player.SubtitlesTrack subtitlesTrack = 1;
-inline int GetSubtitlesTracksReply::_internal_subtitlestrack_size() const {
-  return _impl_.subtitlestrack_.size();
+// .player.EventMediaChange mediaChange = 7;
+inline bool Event::_internal_has_mediachange() const {
+  return event_case() == kMediaChange;
 }
-inline int GetSubtitlesTracksReply::subtitlestrack_size() const {
-  return _internal_subtitlestrack_size();
+inline bool Event::has_mediachange() const {
+  return _internal_has_mediachange();
 }
-inline void GetSubtitlesTracksReply::clear_subtitlestrack() {
-  _impl_.subtitlestrack_.Clear();
+inline void Event::set_has_mediachange() {
+  _impl_._oneof_case_[0] = kMediaChange;
 }
-inline ::player::SubtitlesTrack* GetSubtitlesTracksReply::mutable_subtitlestrack(int index) {
-  // @@protoc_insertion_point(field_mutable:player.GetSubtitlesTracksReply.subtitlesTrack)
-  return _impl_.subtitlestrack_.Mutable(index);
+inline void Event::clear_mediachange() {
+  if (_internal_has_mediachange()) {
+    if (GetArenaForAllocation() == nullptr) {
+      delete _impl_.event_.mediachange_;
+    }
+    clear_has_event();
+  }
 }
-inline ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::SubtitlesTrack >*
-GetSubtitlesTracksReply::mutable_subtitlestrack() {
-  // @@protoc_insertion_point(field_mutable_list:player.GetSubtitlesTracksReply.subtitlesTrack)
-  return &_impl_.subtitlestrack_;
+inline ::player::EventMediaChange* Event::release_mediachange() {
+  // @@protoc_insertion_point(field_release:player.Event.mediaChange)
+  if (_internal_has_mediachange()) {
+    clear_has_event();
+    ::player::EventMediaChange* temp = _impl_.event_.mediachange_;
+    if (GetArenaForAllocation() != nullptr) {
+      temp = ::PROTOBUF_NAMESPACE_ID::internal::DuplicateIfNonNull(temp);
+    }
+    _impl_.event_.mediachange_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
 }
-inline const ::player::SubtitlesTrack& GetSubtitlesTracksReply::_internal_subtitlestrack(int index) const {
-  return _impl_.subtitlestrack_.Get(index);
+inline const ::player::EventMediaChange& Event::_internal_mediachange() const {
+  return _internal_has_mediachange()
+      ? *_impl_.event_.mediachange_
+      : reinterpret_cast< ::player::EventMediaChange&>(::player::_EventMediaChange_default_instance_);
 }
-inline const ::player::SubtitlesTrack& GetSubtitlesTracksReply::subtitlestrack(int index) const {
-  // @@protoc_insertion_point(field_get:player.GetSubtitlesTracksReply.subtitlesTrack)
-  return _internal_subtitlestrack(index);
+inline const ::player::EventMediaChange& Event::mediachange() const {
+  // @@protoc_insertion_point(field_get:player.Event.mediaChange)
+  return _internal_mediachange();
 }
-inline ::player::SubtitlesTrack* GetSubtitlesTracksReply::_internal_add_subtitlestrack() {
-  return _impl_.subtitlestrack_.Add();
+inline ::player::EventMediaChange* Event::unsafe_arena_release_mediachange() {
+  // @@protoc_insertion_point(field_unsafe_arena_release:player.Event.mediaChange)
+  if (_internal_has_mediachange()) {
+    clear_has_event();
+    ::player::EventMediaChange* temp = _impl_.event_.mediachange_;
+    _impl_.event_.mediachange_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
 }
-inline ::player::SubtitlesTrack* GetSubtitlesTracksReply::add_subtitlestrack() {
-  ::player::SubtitlesTrack* _add = _internal_add_subtitlestrack();
-  // @@protoc_insertion_point(field_add:player.GetSubtitlesTracksReply.subtitlesTrack)
-  return _add;
+inline void Event::unsafe_arena_set_allocated_mediachange(::player::EventMediaChange* mediachange) {
+  clear_event();
+  if (mediachange) {
+    set_has_mediachange();
+    _impl_.event_.mediachange_ = mediachange;
+  }
+  // @@protoc_insertion_point(field_unsafe_arena_set_allocated:player.Event.mediaChange)
 }
-inline const ::PROTOBUF_NAMESPACE_ID::RepeatedPtrField< ::player::SubtitlesTrack >&
-GetSubtitlesTracksReply::subtitlestrack() const {
-  // @@protoc_insertion_point(field_list:player.GetSubtitlesTracksReply.subtitlesTrack)
-  return _impl_.subtitlestrack_;
+inline ::player::EventMediaChange* Event::_internal_mutable_mediachange() {
+  if (!_internal_has_mediachange()) {
+    clear_event();
+    set_has_mediachange();
+    _impl_.event_.mediachange_ = CreateMaybeMessage< ::player::EventMediaChange >(GetArenaForAllocation());
+  }
+  return _impl_.event_.mediachange_;
+}
+inline ::player::EventMediaChange* Event::mutable_mediachange() {
+  ::player::EventMediaChange* _msg = _internal_mutable_mediachange();
+  // @@protoc_insertion_point(field_mutable:player.Event.mediaChange)
+  return _msg;
 }
 
+inline bool Event::has_event() const {
+  return event_case() != EVENT_NOT_SET;
+}
+inline void Event::clear_has_event() {
+  _impl_._oneof_case_[0] = EVENT_NOT_SET;
+}
+inline Event::EventCase Event::event_case() const {
+  return Event::EventCase(_impl_._oneof_case_[0]);
+}
+#ifdef __GNUC__
+  #pragma GCC diagnostic pop
+#endif  // __GNUC__
 // -------------------------------------------------------------------
 
-// StopRequest
+// -------------------------------------------------------------------
 
 // -------------------------------------------------------------------
 
-// StopReply
+// -------------------------------------------------------------------
 
 // -------------------------------------------------------------------
 
-// CloseRequest
+// -------------------------------------------------------------------
 
 // -------------------------------------------------------------------
 
-// CloseReply
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
 
-#ifdef __GNUC__
-  #pragma GCC diagnostic pop
-#endif  // __GNUC__
 // -------------------------------------------------------------------
 
 // -------------------------------------------------------------------
@@ -7307,6 +14723,11 @@ template <>
 inline const EnumDescriptor* GetEnumDescriptor< ::player::LoggingLevel>() {
   return ::player::LoggingLevel_descriptor();
 }
+template <> struct is_proto_enum< ::player::PlaybackState> : ::std::true_type {};
+template <>
+inline const EnumDescriptor* GetEnumDescriptor< ::player::PlaybackState>() {
+  return ::player::PlaybackState_descriptor();
+}
 
 PROTOBUF_NAMESPACE_CLOSE
 
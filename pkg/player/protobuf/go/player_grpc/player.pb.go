@@ -85,6 +85,58 @@ func (LoggingLevel) EnumDescriptor() ([]byte, []int) {
 	return file_player_proto_rawDescGZIP(), []int{0}
 }
 
+type PlaybackState int32
+
+const (
+	PlaybackState_PlaybackStateUndefined PlaybackState = 0
+	PlaybackState_PlaybackStateStopped   PlaybackState = 1
+	PlaybackState_PlaybackStatePlaying   PlaybackState = 2
+	PlaybackState_PlaybackStatePaused    PlaybackState = 3
+)
+
+// Enum value maps for PlaybackState.
+var (
+	PlaybackState_name = map[int32]string{
+		0: "PlaybackStateUndefined",
+		1: "PlaybackStateStopped",
+		2: "PlaybackStatePlaying",
+		3: "PlaybackStatePaused",
+	}
+	PlaybackState_value = map[string]int32{
+		"PlaybackStateUndefined": 0,
+		"PlaybackStateStopped":   1,
+		"PlaybackStatePlaying":   2,
+		"PlaybackStatePaused":    3,
+	}
+)
+
+func (x PlaybackState) Enum() *PlaybackState {
+	p := new(PlaybackState)
+	*p = x
+	return p
+}
+
+func (x PlaybackState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlaybackState) Descriptor() protoreflect.EnumDescriptor {
+	return file_player_proto_enumTypes[1].Descriptor()
+}
+
+func (PlaybackState) Type() protoreflect.EnumType {
+	return &file_player_proto_enumTypes[1]
+}
+
+func (x PlaybackState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlaybackState.Descriptor instead.
+func (PlaybackState) EnumDescriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{1}
+}
+
 type SetupForStreamingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1125,27 +1177,26 @@ func (*SetPauseReply) Descriptor() ([]byte, []int) {
 	return file_player_proto_rawDescGZIP(), []int{25}
 }
 
-type SetVideoTrackRequest struct {
+type GetVolumeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VideoTrackID  int64                  `protobuf:"varint,1,opt,name=videoTrackID,proto3" json:"videoTrackID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetVideoTrackRequest) Reset() {
-	*x = SetVideoTrackRequest{}
+func (x *GetVolumeRequest) Reset() {
+	*x = GetVolumeRequest{}
 	mi := &file_player_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetVideoTrackRequest) String() string {
+func (x *GetVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetVideoTrackRequest) ProtoMessage() {}
+func (*GetVolumeRequest) ProtoMessage() {}
 
-func (x *SetVideoTrackRequest) ProtoReflect() protoreflect.Message {
+func (x *GetVolumeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_player_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1157,38 +1208,32 @@ func (x *SetVideoTrackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetVideoTrackRequest.ProtoReflect.Descriptor instead.
-func (*SetVideoTrackRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVolumeRequest.ProtoReflect.Descriptor instead.
+func (*GetVolumeRequest) Descriptor() ([]byte, []int) {
 	return file_player_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *SetVideoTrackRequest) GetVideoTrackID() int64 {
-	if x != nil {
-		return x.VideoTrackID
-	}
-	return 0
-}
-
-type SetVideoTrackReply struct {
+type GetVolumeReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        float64                `protobuf:"fixed64,1,opt,name=volume,proto3" json:"volume,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetVideoTrackReply) Reset() {
-	*x = SetVideoTrackReply{}
+func (x *GetVolumeReply) Reset() {
+	*x = GetVolumeReply{}
 	mi := &file_player_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetVideoTrackReply) String() string {
+func (x *GetVolumeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetVideoTrackReply) ProtoMessage() {}
+func (*GetVolumeReply) ProtoMessage() {}
 
-func (x *SetVideoTrackReply) ProtoReflect() protoreflect.Message {
+func (x *GetVolumeReply) ProtoReflect() protoreflect.Message {
 	mi := &file_player_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1200,32 +1245,39 @@ func (x *SetVideoTrackReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetVideoTrackReply.ProtoReflect.Descriptor instead.
-func (*SetVideoTrackReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVolumeReply.ProtoReflect.Descriptor instead.
+func (*GetVolumeReply) Descriptor() ([]byte, []int) {
 	return file_player_proto_rawDescGZIP(), []int{27}
 }
 
-type SetAudioTrackRequest struct {
+func (x *GetVolumeReply) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+type SetVolumeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AudioTrackID  int64                  `protobuf:"varint,1,opt,name=audioTrackID,proto3" json:"audioTrackID,omitempty"`
+	Volume        float64                `protobuf:"fixed64,1,opt,name=volume,proto3" json:"volume,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetAudioTrackRequest) Reset() {
-	*x = SetAudioTrackRequest{}
+func (x *SetVolumeRequest) Reset() {
+	*x = SetVolumeRequest{}
 	mi := &file_player_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetAudioTrackRequest) String() string {
+func (x *SetVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetAudioTrackRequest) ProtoMessage() {}
+func (*SetVolumeRequest) ProtoMessage() {}
 
-func (x *SetAudioTrackRequest) ProtoReflect() protoreflect.Message {
+func (x *SetVolumeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_player_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1237,38 +1289,38 @@ func (x *SetAudioTrackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetAudioTrackRequest.ProtoReflect.Descriptor instead.
-func (*SetAudioTrackRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetVolumeRequest.ProtoReflect.Descriptor instead.
+func (*SetVolumeRequest) Descriptor() ([]byte, []int) {
 	return file_player_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SetAudioTrackRequest) GetAudioTrackID() int64 {
+func (x *SetVolumeRequest) GetVolume() float64 {
 	if x != nil {
-		return x.AudioTrackID
+		return x.Volume
 	}
 	return 0
 }
 
-type SetAudioTrackReply struct {
+type SetVolumeReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetAudioTrackReply) Reset() {
-	*x = SetAudioTrackReply{}
+func (x *SetVolumeReply) Reset() {
+	*x = SetVolumeReply{}
 	mi := &file_player_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetAudioTrackReply) String() string {
+func (x *SetVolumeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetAudioTrackReply) ProtoMessage() {}
+func (*SetVolumeReply) ProtoMessage() {}
 
-func (x *SetAudioTrackReply) ProtoReflect() protoreflect.Message {
+func (x *SetVolumeReply) ProtoReflect() protoreflect.Message {
 	mi := &file_player_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1280,32 +1332,31 @@ func (x *SetAudioTrackReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetAudioTrackReply.ProtoReflect.Descriptor instead.
-func (*SetAudioTrackReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetVolumeReply.ProtoReflect.Descriptor instead.
+func (*SetVolumeReply) Descriptor() ([]byte, []int) {
 	return file_player_proto_rawDescGZIP(), []int{29}
 }
 
-type SetSubtitlesTrackRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	SubtitlesTrackID int64                  `protobuf:"varint,1,opt,name=subtitlesTrackID,proto3" json:"subtitlesTrackID,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type GetMuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetSubtitlesTrackRequest) Reset() {
-	*x = SetSubtitlesTrackRequest{}
+func (x *GetMuteRequest) Reset() {
+	*x = GetMuteRequest{}
 	mi := &file_player_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetSubtitlesTrackRequest) String() string {
+func (x *GetMuteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSubtitlesTrackRequest) ProtoMessage() {}
+func (*GetMuteRequest) ProtoMessage() {}
 
-func (x *SetSubtitlesTrackRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMuteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_player_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1317,38 +1368,32 @@ func (x *SetSubtitlesTrackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSubtitlesTrackRequest.ProtoReflect.Descriptor instead.
-func (*SetSubtitlesTrackRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMuteRequest.ProtoReflect.Descriptor instead.
+func (*GetMuteRequest) Descriptor() ([]byte, []int) {
 	return file_player_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SetSubtitlesTrackRequest) GetSubtitlesTrackID() int64 {
-	if x != nil {
-		return x.SubtitlesTrackID
-	}
-	return 0
-}
-
-type SetSubtitlesTrackReply struct {
+type GetMuteReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsMuted       bool                   `protobuf:"varint,1,opt,name=isMuted,proto3" json:"isMuted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetSubtitlesTrackReply) Reset() {
-	*x = SetSubtitlesTrackReply{}
+func (x *GetMuteReply) Reset() {
+	*x = GetMuteReply{}
 	mi := &file_player_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetSubtitlesTrackReply) String() string {
+func (x *GetMuteReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSubtitlesTrackReply) ProtoMessage() {}
+func (*GetMuteReply) ProtoMessage() {}
 
-func (x *SetSubtitlesTrackReply) ProtoReflect() protoreflect.Message {
+func (x *GetMuteReply) ProtoReflect() protoreflect.Message {
 	mi := &file_player_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1360,33 +1405,39 @@ func (x *SetSubtitlesTrackReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSubtitlesTrackReply.ProtoReflect.Descriptor instead.
-func (*SetSubtitlesTrackReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMuteReply.ProtoReflect.Descriptor instead.
+func (*GetMuteReply) Descriptor() ([]byte, []int) {
 	return file_player_proto_rawDescGZIP(), []int{31}
 }
 
-type VideoTrack struct {
+func (x *GetMuteReply) GetIsMuted() bool {
+	if x != nil {
+		return x.IsMuted
+	}
+	return false
+}
+
+type SetMuteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	IsActive      bool                   `protobuf:"varint,2,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	IsMuted       bool                   `protobuf:"varint,1,opt,name=isMuted,proto3" json:"isMuted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VideoTrack) Reset() {
-	*x = VideoTrack{}
+func (x *SetMuteRequest) Reset() {
+	*x = SetMuteRequest{}
 	mi := &file_player_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VideoTrack) String() string {
+func (x *SetMuteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoTrack) ProtoMessage() {}
+func (*SetMuteRequest) ProtoMessage() {}
 
-func (x *VideoTrack) ProtoReflect() protoreflect.Message {
+func (x *SetMuteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_player_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1398,48 +1449,38 @@ func (x *VideoTrack) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoTrack.ProtoReflect.Descriptor instead.
-func (*VideoTrack) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetMuteRequest.ProtoReflect.Descriptor instead.
+func (*SetMuteRequest) Descriptor() ([]byte, []int) {
 	return file_player_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *VideoTrack) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *VideoTrack) GetIsActive() bool {
+func (x *SetMuteRequest) GetIsMuted() bool {
 	if x != nil {
-		return x.IsActive
+		return x.IsMuted
 	}
 	return false
 }
 
-type SeekRequest struct {
+type SetMuteReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pos           int64                  `protobuf:"varint,1,opt,name=pos,proto3" json:"pos,omitempty"`
-	IsRelative    bool                   `protobuf:"varint,2,opt,name=isRelative,proto3" json:"isRelative,omitempty"`
-	IsQuick       bool                   `protobuf:"varint,3,opt,name=isQuick,proto3" json:"isQuick,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SeekRequest) Reset() {
-	*x = SeekRequest{}
+func (x *SetMuteReply) Reset() {
+	*x = SetMuteReply{}
 	mi := &file_player_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeekRequest) String() string {
+func (x *SetMuteReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeekRequest) ProtoMessage() {}
+func (*SetMuteReply) ProtoMessage() {}
 
-func (x *SeekRequest) ProtoReflect() protoreflect.Message {
+func (x *SetMuteReply) ProtoReflect() protoreflect.Message {
 	mi := &file_player_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1451,52 +1492,32 @@ func (x *SeekRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeekRequest.ProtoReflect.Descriptor instead.
-func (*SeekRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetMuteReply.ProtoReflect.Descriptor instead.
+func (*SetMuteReply) Descriptor() ([]byte, []int) {
 	return file_player_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *SeekRequest) GetPos() int64 {
-	if x != nil {
-		return x.Pos
-	}
-	return 0
-}
-
-func (x *SeekRequest) GetIsRelative() bool {
-	if x != nil {
-		return x.IsRelative
-	}
-	return false
-}
-
-func (x *SeekRequest) GetIsQuick() bool {
-	if x != nil {
-		return x.IsQuick
-	}
-	return false
-}
-
-type SeekReply struct {
+type SetVideoTrackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoTrackID  int64                  `protobuf:"varint,1,opt,name=videoTrackID,proto3" json:"videoTrackID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SeekReply) Reset() {
-	*x = SeekReply{}
+func (x *SetVideoTrackRequest) Reset() {
+	*x = SetVideoTrackRequest{}
 	mi := &file_player_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeekReply) String() string {
+func (x *SetVideoTrackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeekReply) ProtoMessage() {}
+func (*SetVideoTrackRequest) ProtoMessage() {}
 
-func (x *SeekReply) ProtoReflect() protoreflect.Message {
+func (x *SetVideoTrackRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_player_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1508,31 +1529,38 @@ func (x *SeekReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeekReply.ProtoReflect.Descriptor instead.
-func (*SeekReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetVideoTrackRequest.ProtoReflect.Descriptor instead.
+func (*SetVideoTrackRequest) Descriptor() ([]byte, []int) {
 	return file_player_proto_rawDescGZIP(), []int{34}
 }
 
-type GetVideoTracksRequest struct {
+func (x *SetVideoTrackRequest) GetVideoTrackID() int64 {
+	if x != nil {
+		return x.VideoTrackID
+	}
+	return 0
+}
+
+type SetVideoTrackReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideoTracksRequest) Reset() {
-	*x = GetVideoTracksRequest{}
+func (x *SetVideoTrackReply) Reset() {
+	*x = SetVideoTrackReply{}
 	mi := &file_player_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideoTracksRequest) String() string {
+func (x *SetVideoTrackReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideoTracksRequest) ProtoMessage() {}
+func (*SetVideoTrackReply) ProtoMessage() {}
 
-func (x *GetVideoTracksRequest) ProtoReflect() protoreflect.Message {
+func (x *SetVideoTrackReply) ProtoReflect() protoreflect.Message {
 	mi := &file_player_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1544,32 +1572,32 @@ func (x *GetVideoTracksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideoTracksRequest.ProtoReflect.Descriptor instead.
-func (*GetVideoTracksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetVideoTrackReply.ProtoReflect.Descriptor instead.
+func (*SetVideoTrackReply) Descriptor() ([]byte, []int) {
 	return file_player_proto_rawDescGZIP(), []int{35}
 }
 
-type GetVideoTracksReply struct {
+type SetAudioTrackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VideoTrack    []*VideoTrack          `protobuf:"bytes,1,rep,name=videoTrack,proto3" json:"videoTrack,omitempty"`
+	AudioTrackID  int64                  `protobuf:"varint,1,opt,name=audioTrackID,proto3" json:"audioTrackID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideoTracksReply) Reset() {
-	*x = GetVideoTracksReply{}
+func (x *SetAudioTrackRequest) Reset() {
+	*x = SetAudioTrackRequest{}
 	mi := &file_player_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideoTracksReply) String() string {
+func (x *SetAudioTrackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideoTracksReply) ProtoMessage() {}
+func (*SetAudioTrackRequest) ProtoMessage() {}
 
-func (x *GetVideoTracksReply) ProtoReflect() protoreflect.Message {
+func (x *SetAudioTrackRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_player_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1581,40 +1609,38 @@ func (x *GetVideoTracksReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideoTracksReply.ProtoReflect.Descriptor instead.
-func (*GetVideoTracksReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAudioTrackRequest.ProtoReflect.Descriptor instead.
+func (*SetAudioTrackRequest) Descriptor() ([]byte, []int) {
 	return file_player_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetVideoTracksReply) GetVideoTrack() []*VideoTrack {
+func (x *SetAudioTrackRequest) GetAudioTrackID() int64 {
 	if x != nil {
-		return x.VideoTrack
+		return x.AudioTrackID
 	}
-	return nil
+	return 0
 }
 
-type AudioTrack struct {
+type SetAudioTrackReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	IsActive      bool                   `protobuf:"varint,2,opt,name=isActive,proto3" json:"isActive,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AudioTrack) Reset() {
-	*x = AudioTrack{}
+func (x *SetAudioTrackReply) Reset() {
+	*x = SetAudioTrackReply{}
 	mi := &file_player_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AudioTrack) String() string {
+func (x *SetAudioTrackReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AudioTrack) ProtoMessage() {}
+func (*SetAudioTrackReply) ProtoMessage() {}
 
-func (x *AudioTrack) ProtoReflect() protoreflect.Message {
+func (x *SetAudioTrackReply) ProtoReflect() protoreflect.Message {
 	mi := &file_player_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1626,46 +1652,1478 @@ func (x *AudioTrack) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AudioTrack.ProtoReflect.Descriptor instead.
-func (*AudioTrack) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAudioTrackReply.ProtoReflect.Descriptor instead.
+func (*SetAudioTrackReply) Descriptor() ([]byte, []int) {
 	return file_player_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *AudioTrack) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *AudioTrack) GetIsActive() bool {
-	if x != nil {
-		return x.IsActive
-	}
-	return false
+type SetSubtitlesTrackRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SubtitlesTrackID int64                  `protobuf:"varint,1,opt,name=subtitlesTrackID,proto3" json:"subtitlesTrackID,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-type GetAudioTracksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *SetSubtitlesTrackRequest) Reset() {
+	*x = SetSubtitlesTrackRequest{}
+	mi := &file_player_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSubtitlesTrackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSubtitlesTrackRequest) ProtoMessage() {}
+
+func (x *SetSubtitlesTrackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSubtitlesTrackRequest.ProtoReflect.Descriptor instead.
+func (*SetSubtitlesTrackRequest) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetSubtitlesTrackRequest) GetSubtitlesTrackID() int64 {
+	if x != nil {
+		return x.SubtitlesTrackID
+	}
+	return 0
+}
+
+type SetSubtitlesTrackReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSubtitlesTrackReply) Reset() {
+	*x = SetSubtitlesTrackReply{}
+	mi := &file_player_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSubtitlesTrackReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSubtitlesTrackReply) ProtoMessage() {}
+
+func (x *SetSubtitlesTrackReply) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSubtitlesTrackReply.ProtoReflect.Descriptor instead.
+func (*SetSubtitlesTrackReply) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{39}
+}
+
+type TrackInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Codec         string                 `protobuf:"bytes,1,opt,name=codec,proto3" json:"codec,omitempty"`
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,4,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	IsForced      bool                   `protobuf:"varint,5,opt,name=isForced,proto3" json:"isForced,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrackInfo) Reset() {
+	*x = TrackInfo{}
+	mi := &file_player_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrackInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackInfo) ProtoMessage() {}
+
+func (x *TrackInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackInfo.ProtoReflect.Descriptor instead.
+func (*TrackInfo) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TrackInfo) GetCodec() string {
+	if x != nil {
+		return x.Codec
+	}
+	return ""
+}
+
+func (x *TrackInfo) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *TrackInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TrackInfo) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *TrackInfo) GetIsForced() bool {
+	if x != nil {
+		return x.IsForced
+	}
+	return false
+}
+
+type VideoTrack struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	Info          *TrackInfo             `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	Width         int32                  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Fps           float64                `protobuf:"fixed64,6,opt,name=fps,proto3" json:"fps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoTrack) Reset() {
+	*x = VideoTrack{}
+	mi := &file_player_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoTrack) ProtoMessage() {}
+
+func (x *VideoTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoTrack.ProtoReflect.Descriptor instead.
+func (*VideoTrack) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VideoTrack) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VideoTrack) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *VideoTrack) GetInfo() *TrackInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *VideoTrack) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *VideoTrack) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *VideoTrack) GetFps() float64 {
+	if x != nil {
+		return x.Fps
+	}
+	return 0
+}
+
+type SeekRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the position to seek to (or the offset from the current position,
+	// if isRelative) in nanoseconds, as in Go's time.Duration
+	PosNanoseconds int64 `protobuf:"varint,1,opt,name=posNanoseconds,proto3" json:"posNanoseconds,omitempty"`
+	IsRelative     bool  `protobuf:"varint,2,opt,name=isRelative,proto3" json:"isRelative,omitempty"`
+	IsQuick        bool  `protobuf:"varint,3,opt,name=isQuick,proto3" json:"isQuick,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SeekRequest) Reset() {
+	*x = SeekRequest{}
+	mi := &file_player_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeekRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeekRequest) ProtoMessage() {}
+
+func (x *SeekRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeekRequest.ProtoReflect.Descriptor instead.
+func (*SeekRequest) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SeekRequest) GetPosNanoseconds() int64 {
+	if x != nil {
+		return x.PosNanoseconds
+	}
+	return 0
+}
+
+func (x *SeekRequest) GetIsRelative() bool {
+	if x != nil {
+		return x.IsRelative
+	}
+	return false
+}
+
+func (x *SeekRequest) GetIsQuick() bool {
+	if x != nil {
+		return x.IsQuick
+	}
+	return false
+}
+
+type SeekReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeekReply) Reset() {
+	*x = SeekReply{}
+	mi := &file_player_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeekReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeekReply) ProtoMessage() {}
+
+func (x *SeekReply) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeekReply.ProtoReflect.Descriptor instead.
+func (*SeekReply) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{43}
+}
+
+type FrameStepRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Forward       bool                   `protobuf:"varint,1,opt,name=forward,proto3" json:"forward,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrameStepRequest) Reset() {
+	*x = FrameStepRequest{}
+	mi := &file_player_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrameStepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameStepRequest) ProtoMessage() {}
+
+func (x *FrameStepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameStepRequest.ProtoReflect.Descriptor instead.
+func (*FrameStepRequest) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FrameStepRequest) GetForward() bool {
+	if x != nil {
+		return x.Forward
+	}
+	return false
+}
+
+type FrameStepReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrameStepReply) Reset() {
+	*x = FrameStepReply{}
+	mi := &file_player_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrameStepReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameStepReply) ProtoMessage() {}
+
+func (x *FrameStepReply) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameStepReply.ProtoReflect.Descriptor instead.
+func (*FrameStepReply) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{45}
+}
+
+type ScreenshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScreenshotRequest) Reset() {
+	*x = ScreenshotRequest{}
+	mi := &file_player_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScreenshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreenshotRequest) ProtoMessage() {}
+
+func (x *ScreenshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreenshotRequest.ProtoReflect.Descriptor instead.
+func (*ScreenshotRequest) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{46}
+}
+
+type ScreenshotReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Png           []byte                 `protobuf:"bytes,1,opt,name=png,proto3" json:"png,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScreenshotReply) Reset() {
+	*x = ScreenshotReply{}
+	mi := &file_player_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScreenshotReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreenshotReply) ProtoMessage() {}
+
+func (x *ScreenshotReply) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreenshotReply.ProtoReflect.Descriptor instead.
+func (*ScreenshotReply) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ScreenshotReply) GetPng() []byte {
+	if x != nil {
+		return x.Png
+	}
+	return nil
+}
+
+type GetVideoTracksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideoTracksRequest) Reset() {
+	*x = GetVideoTracksRequest{}
+	mi := &file_player_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideoTracksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideoTracksRequest) ProtoMessage() {}
+
+func (x *GetVideoTracksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideoTracksRequest.ProtoReflect.Descriptor instead.
+func (*GetVideoTracksRequest) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{48}
+}
+
+type GetVideoTracksReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoTrack    []*VideoTrack          `protobuf:"bytes,1,rep,name=videoTrack,proto3" json:"videoTrack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideoTracksReply) Reset() {
+	*x = GetVideoTracksReply{}
+	mi := &file_player_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideoTracksReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideoTracksReply) ProtoMessage() {}
+
+func (x *GetVideoTracksReply) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideoTracksReply.ProtoReflect.Descriptor instead.
+func (*GetVideoTracksReply) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetVideoTracksReply) GetVideoTrack() []*VideoTrack {
+	if x != nil {
+		return x.VideoTrack
+	}
+	return nil
+}
+
+type AudioTrack struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	Info          *TrackInfo             `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	SampleRate    int32                  `protobuf:"varint,4,opt,name=sampleRate,proto3" json:"sampleRate,omitempty"`
+	Channels      int32                  `protobuf:"varint,5,opt,name=channels,proto3" json:"channels,omitempty"`
+	ChannelLayout string                 `protobuf:"bytes,6,opt,name=channelLayout,proto3" json:"channelLayout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AudioTrack) Reset() {
+	*x = AudioTrack{}
+	mi := &file_player_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudioTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudioTrack) ProtoMessage() {}
+
+func (x *AudioTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudioTrack.ProtoReflect.Descriptor instead.
+func (*AudioTrack) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AudioTrack) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AudioTrack) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *AudioTrack) GetInfo() *TrackInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *AudioTrack) GetSampleRate() int32 {
+	if x != nil {
+		return x.SampleRate
+	}
+	return 0
+}
+
+func (x *AudioTrack) GetChannels() int32 {
+	if x != nil {
+		return x.Channels
+	}
+	return 0
+}
+
+func (x *AudioTrack) GetChannelLayout() string {
+	if x != nil {
+		return x.ChannelLayout
+	}
+	return ""
+}
+
+type GetAudioTracksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAudioTracksRequest) Reset() {
+	*x = GetAudioTracksRequest{}
+	mi := &file_player_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAudioTracksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAudioTracksRequest) ProtoMessage() {}
+
+func (x *GetAudioTracksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAudioTracksRequest.ProtoReflect.Descriptor instead.
+func (*GetAudioTracksRequest) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{51}
+}
+
+type GetAudioTracksReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AudioTrack    []*AudioTrack          `protobuf:"bytes,1,rep,name=audioTrack,proto3" json:"audioTrack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAudioTracksReply) Reset() {
+	*x = GetAudioTracksReply{}
+	mi := &file_player_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAudioTracksReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAudioTracksReply) ProtoMessage() {}
+
+func (x *GetAudioTracksReply) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAudioTracksReply.ProtoReflect.Descriptor instead.
+func (*GetAudioTracksReply) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetAudioTracksReply) GetAudioTrack() []*AudioTrack {
+	if x != nil {
+		return x.AudioTrack
+	}
+	return nil
+}
+
+type SubtitlesTrack struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	Info          *TrackInfo             `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubtitlesTrack) Reset() {
+	*x = SubtitlesTrack{}
+	mi := &file_player_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubtitlesTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubtitlesTrack) ProtoMessage() {}
+
+func (x *SubtitlesTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubtitlesTrack.ProtoReflect.Descriptor instead.
+func (*SubtitlesTrack) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SubtitlesTrack) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubtitlesTrack) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SubtitlesTrack) GetInfo() *TrackInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type GetSubtitlesTracksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubtitlesTracksRequest) Reset() {
+	*x = GetSubtitlesTracksRequest{}
+	mi := &file_player_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubtitlesTracksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubtitlesTracksRequest) ProtoMessage() {}
+
+func (x *GetSubtitlesTracksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubtitlesTracksRequest.ProtoReflect.Descriptor instead.
+func (*GetSubtitlesTracksRequest) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{54}
+}
+
+type GetSubtitlesTracksReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubtitlesTrack []*SubtitlesTrack      `protobuf:"bytes,1,rep,name=subtitlesTrack,proto3" json:"subtitlesTrack,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSubtitlesTracksReply) Reset() {
+	*x = GetSubtitlesTracksReply{}
+	mi := &file_player_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubtitlesTracksReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubtitlesTracksReply) ProtoMessage() {}
+
+func (x *GetSubtitlesTracksReply) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubtitlesTracksReply.ProtoReflect.Descriptor instead.
+func (*GetSubtitlesTracksReply) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetSubtitlesTracksReply) GetSubtitlesTrack() []*SubtitlesTrack {
+	if x != nil {
+		return x.SubtitlesTrack
+	}
+	return nil
+}
+
+type Chapter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	StartSecs     float64                `protobuf:"fixed64,2,opt,name=startSecs,proto3" json:"startSecs,omitempty"`
+	EndSecs       float64                `protobuf:"fixed64,3,opt,name=endSecs,proto3" json:"endSecs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Chapter) Reset() {
+	*x = Chapter{}
+	mi := &file_player_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chapter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chapter) ProtoMessage() {}
+
+func (x *Chapter) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chapter.ProtoReflect.Descriptor instead.
+func (*Chapter) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Chapter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Chapter) GetStartSecs() float64 {
+	if x != nil {
+		return x.StartSecs
+	}
+	return 0
+}
+
+func (x *Chapter) GetEndSecs() float64 {
+	if x != nil {
+		return x.EndSecs
+	}
+	return 0
+}
+
+type MediaInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FormatName    string                 `protobuf:"bytes,1,opt,name=formatName,proto3" json:"formatName,omitempty"`
+	Bitrate       int64                  `protobuf:"varint,2,opt,name=bitrate,proto3" json:"bitrate,omitempty"`
+	StreamCount   int32                  `protobuf:"varint,3,opt,name=streamCount,proto3" json:"streamCount,omitempty"`
+	Tags          map[string]string      `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Chapters      []*Chapter             `protobuf:"bytes,5,rep,name=chapters,proto3" json:"chapters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaInfo) Reset() {
+	*x = MediaInfo{}
+	mi := &file_player_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaInfo) ProtoMessage() {}
+
+func (x *MediaInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaInfo.ProtoReflect.Descriptor instead.
+func (*MediaInfo) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MediaInfo) GetFormatName() string {
+	if x != nil {
+		return x.FormatName
+	}
+	return ""
+}
+
+func (x *MediaInfo) GetBitrate() int64 {
+	if x != nil {
+		return x.Bitrate
+	}
+	return 0
+}
+
+func (x *MediaInfo) GetStreamCount() int32 {
+	if x != nil {
+		return x.StreamCount
+	}
+	return 0
+}
+
+func (x *MediaInfo) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MediaInfo) GetChapters() []*Chapter {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+type GetMediaInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMediaInfoRequest) Reset() {
+	*x = GetMediaInfoRequest{}
+	mi := &file_player_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMediaInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMediaInfoRequest) ProtoMessage() {}
+
+func (x *GetMediaInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMediaInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetMediaInfoRequest) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{58}
+}
+
+type GetMediaInfoReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MediaInfo     *MediaInfo             `protobuf:"bytes,1,opt,name=mediaInfo,proto3" json:"mediaInfo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMediaInfoReply) Reset() {
+	*x = GetMediaInfoReply{}
+	mi := &file_player_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMediaInfoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMediaInfoReply) ProtoMessage() {}
+
+func (x *GetMediaInfoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMediaInfoReply.ProtoReflect.Descriptor instead.
+func (*GetMediaInfoReply) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetMediaInfoReply) GetMediaInfo() *MediaInfo {
+	if x != nil {
+		return x.MediaInfo
+	}
+	return nil
+}
+
+type GetChaptersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChaptersRequest) Reset() {
+	*x = GetChaptersRequest{}
+	mi := &file_player_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChaptersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChaptersRequest) ProtoMessage() {}
+
+func (x *GetChaptersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChaptersRequest.ProtoReflect.Descriptor instead.
+func (*GetChaptersRequest) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{60}
+}
+
+type GetChaptersReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chapters      []*Chapter             `protobuf:"bytes,1,rep,name=chapters,proto3" json:"chapters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChaptersReply) Reset() {
+	*x = GetChaptersReply{}
+	mi := &file_player_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChaptersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChaptersReply) ProtoMessage() {}
+
+func (x *GetChaptersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChaptersReply.ProtoReflect.Descriptor instead.
+func (*GetChaptersReply) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetChaptersReply) GetChapters() []*Chapter {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+type GetChapterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChapterRequest) Reset() {
+	*x = GetChapterRequest{}
+	mi := &file_player_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChapterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChapterRequest) ProtoMessage() {}
+
+func (x *GetChapterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChapterRequest.ProtoReflect.Descriptor instead.
+func (*GetChapterRequest) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{62}
+}
+
+type GetChapterReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChapterIdx    int32                  `protobuf:"varint,1,opt,name=chapterIdx,proto3" json:"chapterIdx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChapterReply) Reset() {
+	*x = GetChapterReply{}
+	mi := &file_player_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChapterReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChapterReply) ProtoMessage() {}
+
+func (x *GetChapterReply) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChapterReply.ProtoReflect.Descriptor instead.
+func (*GetChapterReply) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetChapterReply) GetChapterIdx() int32 {
+	if x != nil {
+		return x.ChapterIdx
+	}
+	return 0
+}
+
+type SetChapterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChapterIdx    int32                  `protobuf:"varint,1,opt,name=chapterIdx,proto3" json:"chapterIdx,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChapterRequest) Reset() {
+	*x = SetChapterRequest{}
+	mi := &file_player_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChapterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChapterRequest) ProtoMessage() {}
+
+func (x *SetChapterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChapterRequest.ProtoReflect.Descriptor instead.
+func (*SetChapterRequest) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SetChapterRequest) GetChapterIdx() int32 {
+	if x != nil {
+		return x.ChapterIdx
+	}
+	return 0
+}
+
+type SetChapterReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChapterReply) Reset() {
+	*x = SetChapterReply{}
+	mi := &file_player_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChapterReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChapterReply) ProtoMessage() {}
+
+func (x *SetChapterReply) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChapterReply.ProtoReflect.Descriptor instead.
+func (*SetChapterReply) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{65}
+}
+
+type StopRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopRequest) Reset() {
+	*x = StopRequest{}
+	mi := &file_player_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopRequest) ProtoMessage() {}
+
+func (x *StopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
+func (*StopRequest) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{66}
+}
+
+type StopReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopReply) Reset() {
+	*x = StopReply{}
+	mi := &file_player_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopReply) ProtoMessage() {}
+
+func (x *StopReply) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopReply.ProtoReflect.Descriptor instead.
+func (*StopReply) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{67}
+}
+
+type CloseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseRequest) Reset() {
+	*x = CloseRequest{}
+	mi := &file_player_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseRequest) ProtoMessage() {}
+
+func (x *CloseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseRequest.ProtoReflect.Descriptor instead.
+func (*CloseRequest) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{68}
+}
+
+type CloseReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAudioTracksRequest) Reset() {
-	*x = GetAudioTracksRequest{}
-	mi := &file_player_proto_msgTypes[38]
+func (x *CloseReply) Reset() {
+	*x = CloseReply{}
+	mi := &file_player_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAudioTracksRequest) String() string {
+func (x *CloseReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAudioTracksRequest) ProtoMessage() {}
+func (*CloseReply) ProtoMessage() {}
 
-func (x *GetAudioTracksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_proto_msgTypes[38]
+func (x *CloseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1676,33 +3134,32 @@ func (x *GetAudioTracksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAudioTracksRequest.ProtoReflect.Descriptor instead.
-func (*GetAudioTracksRequest) Descriptor() ([]byte, []int) {
-	return file_player_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use CloseReply.ProtoReflect.Descriptor instead.
+func (*CloseReply) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{69}
 }
 
-type GetAudioTracksReply struct {
+type EventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AudioTrack    []*AudioTrack          `protobuf:"bytes,1,rep,name=audioTrack,proto3" json:"audioTrack,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAudioTracksReply) Reset() {
-	*x = GetAudioTracksReply{}
-	mi := &file_player_proto_msgTypes[39]
+func (x *EventsRequest) Reset() {
+	*x = EventsRequest{}
+	mi := &file_player_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAudioTracksReply) String() string {
+func (x *EventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAudioTracksReply) ProtoMessage() {}
+func (*EventsRequest) ProtoMessage() {}
 
-func (x *GetAudioTracksReply) ProtoReflect() protoreflect.Message {
-	mi := &file_player_proto_msgTypes[39]
+func (x *EventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1713,41 +3170,78 @@ func (x *GetAudioTracksReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAudioTracksReply.ProtoReflect.Descriptor instead.
-func (*GetAudioTracksReply) Descriptor() ([]byte, []int) {
-	return file_player_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use EventsRequest.ProtoReflect.Descriptor instead.
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetAudioTracksReply) GetAudioTrack() []*AudioTrack {
+type EventStateChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         PlaybackState          `protobuf:"varint,1,opt,name=state,proto3,enum=player.PlaybackState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventStateChange) Reset() {
+	*x = EventStateChange{}
+	mi := &file_player_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventStateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStateChange) ProtoMessage() {}
+
+func (x *EventStateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[71]
 	if x != nil {
-		return x.AudioTrack
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type SubtitlesTrack struct {
+// Deprecated: Use EventStateChange.ProtoReflect.Descriptor instead.
+func (*EventStateChange) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *EventStateChange) GetState() PlaybackState {
+	if x != nil {
+		return x.State
+	}
+	return PlaybackState_PlaybackStateUndefined
+}
+
+type EventPosition struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	IsActive      bool                   `protobuf:"varint,2,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	PositionSecs  float64                `protobuf:"fixed64,1,opt,name=positionSecs,proto3" json:"positionSecs,omitempty"`
+	LengthSecs    float64                `protobuf:"fixed64,2,opt,name=lengthSecs,proto3" json:"lengthSecs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubtitlesTrack) Reset() {
-	*x = SubtitlesTrack{}
-	mi := &file_player_proto_msgTypes[40]
+func (x *EventPosition) Reset() {
+	*x = EventPosition{}
+	mi := &file_player_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubtitlesTrack) String() string {
+func (x *EventPosition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubtitlesTrack) ProtoMessage() {}
+func (*EventPosition) ProtoMessage() {}
 
-func (x *SubtitlesTrack) ProtoReflect() protoreflect.Message {
-	mi := &file_player_proto_msgTypes[40]
+func (x *EventPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1758,46 +3252,46 @@ func (x *SubtitlesTrack) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubtitlesTrack.ProtoReflect.Descriptor instead.
-func (*SubtitlesTrack) Descriptor() ([]byte, []int) {
-	return file_player_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use EventPosition.ProtoReflect.Descriptor instead.
+func (*EventPosition) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *SubtitlesTrack) GetId() int64 {
+func (x *EventPosition) GetPositionSecs() float64 {
 	if x != nil {
-		return x.Id
+		return x.PositionSecs
 	}
 	return 0
 }
 
-func (x *SubtitlesTrack) GetIsActive() bool {
+func (x *EventPosition) GetLengthSecs() float64 {
 	if x != nil {
-		return x.IsActive
+		return x.LengthSecs
 	}
-	return false
+	return 0
 }
 
-type GetSubtitlesTracksRequest struct {
+type EventTracksChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSubtitlesTracksRequest) Reset() {
-	*x = GetSubtitlesTracksRequest{}
-	mi := &file_player_proto_msgTypes[41]
+func (x *EventTracksChange) Reset() {
+	*x = EventTracksChange{}
+	mi := &file_player_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSubtitlesTracksRequest) String() string {
+func (x *EventTracksChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubtitlesTracksRequest) ProtoMessage() {}
+func (*EventTracksChange) ProtoMessage() {}
 
-func (x *GetSubtitlesTracksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_proto_msgTypes[41]
+func (x *EventTracksChange) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1808,33 +3302,33 @@ func (x *GetSubtitlesTracksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubtitlesTracksRequest.ProtoReflect.Descriptor instead.
-func (*GetSubtitlesTracksRequest) Descriptor() ([]byte, []int) {
-	return file_player_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use EventTracksChange.ProtoReflect.Descriptor instead.
+func (*EventTracksChange) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{73}
 }
 
-type GetSubtitlesTracksReply struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SubtitlesTrack []*SubtitlesTrack      `protobuf:"bytes,1,rep,name=subtitlesTrack,proto3" json:"subtitlesTrack,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type EventBuffering struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Percent       float64                `protobuf:"fixed64,1,opt,name=percent,proto3" json:"percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSubtitlesTracksReply) Reset() {
-	*x = GetSubtitlesTracksReply{}
-	mi := &file_player_proto_msgTypes[42]
+func (x *EventBuffering) Reset() {
+	*x = EventBuffering{}
+	mi := &file_player_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSubtitlesTracksReply) String() string {
+func (x *EventBuffering) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubtitlesTracksReply) ProtoMessage() {}
+func (*EventBuffering) ProtoMessage() {}
 
-func (x *GetSubtitlesTracksReply) ProtoReflect() protoreflect.Message {
-	mi := &file_player_proto_msgTypes[42]
+func (x *EventBuffering) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1845,39 +3339,40 @@ func (x *GetSubtitlesTracksReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubtitlesTracksReply.ProtoReflect.Descriptor instead.
-func (*GetSubtitlesTracksReply) Descriptor() ([]byte, []int) {
-	return file_player_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use EventBuffering.ProtoReflect.Descriptor instead.
+func (*EventBuffering) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GetSubtitlesTracksReply) GetSubtitlesTrack() []*SubtitlesTrack {
+func (x *EventBuffering) GetPercent() float64 {
 	if x != nil {
-		return x.SubtitlesTrack
+		return x.Percent
 	}
-	return nil
+	return 0
 }
 
-type StopRequest struct {
+type EventError struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopRequest) Reset() {
-	*x = StopRequest{}
-	mi := &file_player_proto_msgTypes[43]
+func (x *EventError) Reset() {
+	*x = EventError{}
+	mi := &file_player_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopRequest) String() string {
+func (x *EventError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopRequest) ProtoMessage() {}
+func (*EventError) ProtoMessage() {}
 
-func (x *StopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_proto_msgTypes[43]
+func (x *EventError) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1888,32 +3383,39 @@ func (x *StopRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
-func (*StopRequest) Descriptor() ([]byte, []int) {
-	return file_player_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use EventError.ProtoReflect.Descriptor instead.
+func (*EventError) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{75}
 }
 
-type StopReply struct {
+func (x *EventError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type EventEndOfFile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopReply) Reset() {
-	*x = StopReply{}
-	mi := &file_player_proto_msgTypes[44]
+func (x *EventEndOfFile) Reset() {
+	*x = EventEndOfFile{}
+	mi := &file_player_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopReply) String() string {
+func (x *EventEndOfFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopReply) ProtoMessage() {}
+func (*EventEndOfFile) ProtoMessage() {}
 
-func (x *StopReply) ProtoReflect() protoreflect.Message {
-	mi := &file_player_proto_msgTypes[44]
+func (x *EventEndOfFile) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1924,32 +3426,33 @@ func (x *StopReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopReply.ProtoReflect.Descriptor instead.
-func (*StopReply) Descriptor() ([]byte, []int) {
-	return file_player_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use EventEndOfFile.ProtoReflect.Descriptor instead.
+func (*EventEndOfFile) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{76}
 }
 
-type CloseRequest struct {
+type EventMediaChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          string                 `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CloseRequest) Reset() {
-	*x = CloseRequest{}
-	mi := &file_player_proto_msgTypes[45]
+func (x *EventMediaChange) Reset() {
+	*x = EventMediaChange{}
+	mi := &file_player_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloseRequest) String() string {
+func (x *EventMediaChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseRequest) ProtoMessage() {}
+func (*EventMediaChange) ProtoMessage() {}
 
-func (x *CloseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_player_proto_msgTypes[45]
+func (x *EventMediaChange) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1960,32 +3463,49 @@ func (x *CloseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseRequest.ProtoReflect.Descriptor instead.
-func (*CloseRequest) Descriptor() ([]byte, []int) {
-	return file_player_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use EventMediaChange.ProtoReflect.Descriptor instead.
+func (*EventMediaChange) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{77}
 }
 
-type CloseReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *EventMediaChange) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+type Event struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*Event_StateChange
+	//	*Event_Position
+	//	*Event_TracksChange
+	//	*Event_Buffering
+	//	*Event_Error
+	//	*Event_EndOfFile
+	//	*Event_MediaChange
+	Event         isEvent_Event `protobuf_oneof:"event"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CloseReply) Reset() {
-	*x = CloseReply{}
-	mi := &file_player_proto_msgTypes[46]
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_player_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloseReply) String() string {
+func (x *Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseReply) ProtoMessage() {}
+func (*Event) ProtoMessage() {}
 
-func (x *CloseReply) ProtoReflect() protoreflect.Message {
-	mi := &file_player_proto_msgTypes[46]
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_player_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1996,11 +3516,127 @@ func (x *CloseReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseReply.ProtoReflect.Descriptor instead.
-func (*CloseReply) Descriptor() ([]byte, []int) {
-	return file_player_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_player_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Event) GetEvent() isEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *Event) GetStateChange() *EventStateChange {
+	if x != nil {
+		if x, ok := x.Event.(*Event_StateChange); ok {
+			return x.StateChange
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetPosition() *EventPosition {
+	if x != nil {
+		if x, ok := x.Event.(*Event_Position); ok {
+			return x.Position
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetTracksChange() *EventTracksChange {
+	if x != nil {
+		if x, ok := x.Event.(*Event_TracksChange); ok {
+			return x.TracksChange
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetBuffering() *EventBuffering {
+	if x != nil {
+		if x, ok := x.Event.(*Event_Buffering); ok {
+			return x.Buffering
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetError() *EventError {
+	if x != nil {
+		if x, ok := x.Event.(*Event_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetEndOfFile() *EventEndOfFile {
+	if x != nil {
+		if x, ok := x.Event.(*Event_EndOfFile); ok {
+			return x.EndOfFile
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetMediaChange() *EventMediaChange {
+	if x != nil {
+		if x, ok := x.Event.(*Event_MediaChange); ok {
+			return x.MediaChange
+		}
+	}
+	return nil
+}
+
+type isEvent_Event interface {
+	isEvent_Event()
+}
+
+type Event_StateChange struct {
+	StateChange *EventStateChange `protobuf:"bytes,1,opt,name=stateChange,proto3,oneof"`
+}
+
+type Event_Position struct {
+	Position *EventPosition `protobuf:"bytes,2,opt,name=position,proto3,oneof"`
+}
+
+type Event_TracksChange struct {
+	TracksChange *EventTracksChange `protobuf:"bytes,3,opt,name=tracksChange,proto3,oneof"`
+}
+
+type Event_Buffering struct {
+	Buffering *EventBuffering `protobuf:"bytes,4,opt,name=buffering,proto3,oneof"`
+}
+
+type Event_Error struct {
+	Error *EventError `protobuf:"bytes,5,opt,name=error,proto3,oneof"`
+}
+
+type Event_EndOfFile struct {
+	EndOfFile *EventEndOfFile `protobuf:"bytes,6,opt,name=endOfFile,proto3,oneof"`
+}
+
+type Event_MediaChange struct {
+	MediaChange *EventMediaChange `protobuf:"bytes,7,opt,name=mediaChange,proto3,oneof"`
 }
 
+func (*Event_StateChange) isEvent_Event() {}
+
+func (*Event_Position) isEvent_Event() {}
+
+func (*Event_TracksChange) isEvent_Event() {}
+
+func (*Event_Buffering) isEvent_Event() {}
+
+func (*Event_Error) isEvent_Event() {}
+
+func (*Event_EndOfFile) isEvent_Event() {}
+
+func (*Event_MediaChange) isEvent_Event() {}
+
 var File_player_proto protoreflect.FileDescriptor
 
 var file_player_proto_rawDesc = string([]byte{
@@ -2060,176 +3696,368 @@ var file_player_proto_rawDesc = string([]byte{
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x50, 0x61, 0x75, 0x73, 0x65,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x61, 0x75, 0x73, 0x65,
 	0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x3a, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x22, 0x14,
-	0x0a, 0x12, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x3a, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c,
-	0x61, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44,
-	0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x46, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x53, 0x75, 0x62,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x75,
-	0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x22, 0x18,
-	0x0a, 0x16, 0x53, 0x65, 0x74, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x38, 0x0a, 0x0a, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x22, 0x59, 0x0a, 0x0b, 0x53, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
-	0x70, 0x6f, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x6c, 0x61, 0x74,
-	0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x22, 0x0b, 0x0a,
-	0x09, 0x53, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x0a, 0x76, 0x69,
-	0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x52, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x22, 0x38,
-	0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41,
-	0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x49, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x0a, 0x61, 0x75, 0x64, 0x69,
-	0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x52, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x22, 0x3c, 0x0a, 0x0e,
-	0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65,
-	0x74, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x59, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x75,
-	0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x22, 0x0d, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x0b, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x0e,
-	0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0c,
-	0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2a, 0xc3, 0x01, 0x0a,
-	0x0c, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a,
-	0x10, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4e, 0x6f, 0x6e,
-	0x65, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65,
-	0x76, 0x65, 0x6c, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x6f,
-	0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x50, 0x61, 0x6e, 0x69, 0x63, 0x10,
-	0x02, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x67,
-	0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x57, 0x61, 0x72, 0x6e, 0x10, 0x04, 0x12, 0x14,
-	0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x10, 0x06, 0x12, 0x15, 0x0a, 0x11, 0x4c,
-	0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65,
-	0x10, 0x07, 0x32, 0xee, 0x0b, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x30, 0x0a,
-	0x04, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x13, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x57, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x75, 0x70, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65,
-	0x74, 0x75, 0x70, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e,
-	0x53, 0x65, 0x74, 0x75, 0x70, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x39, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x2e,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47,
-	0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a,
-	0x07, 0x45, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x12, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x2e, 0x45, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x64, 0x43, 0x68, 0x61,
-	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x07, 0x49, 0x73,
-	0x45, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x49,
-	0x73, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x49, 0x73, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64,
-	0x69, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75,
-	0x64, 0x69, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x3f, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12,
-	0x18, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67,
-	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12,
-	0x17, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x3c, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x17, 0x2e,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e,
-	0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x3c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x6c, 0x79, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x22, 0x2a, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x10, 0x0a, 0x0e,
+	0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x10,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x28, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x69, 0x73, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x22, 0x2a, 0x0a, 0x0e, 0x53, 0x65,
+	0x74, 0x4d, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x69, 0x73, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69,
+	0x73, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3a, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x49, 0x44, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x3a, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x49, 0x44, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x46, 0x0a, 0x18, 0x53, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x49, 0x44, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x8d, 0x01, 0x0a,
+	0x09, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x64, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x22, 0x9f, 0x01, 0x0a,
+	0x0a, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14,
+	0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x66, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x66, 0x70, 0x73, 0x22, 0x6f,
+	0x0a, 0x0b, 0x53, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x70, 0x6f, 0x73, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x51, 0x75, 0x69, 0x63, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x22,
+	0x0b, 0x0a, 0x09, 0x53, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2c, 0x0a, 0x10,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x13, 0x0a, 0x11,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x23, 0x0a, 0x0f, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x70, 0x6e, 0x67, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x49, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x0a,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x22, 0xc1, 0x01, 0x0a, 0x0a, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x22, 0x17,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32,
+	0x0a, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x0a, 0x61, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x22, 0x63, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x25, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x59, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x3e, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x2e, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52,
+	0x0e, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x22,
+	0x57, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x63, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x63, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x09, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x43,
+	0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73,
+	0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x44, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x2b, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x43, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x22, 0x13, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x31, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x78, 0x22, 0x33, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x49, 0x64, 0x78, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x0d, 0x0a,
+	0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0b, 0x0a, 0x09,
+	0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x0e, 0x0a, 0x0c, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x0c, 0x0a, 0x0a, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x53, 0x0a, 0x0d, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x53, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x53, 0x65, 0x63, 0x73, 0x22, 0x13,
+	0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x22, 0x2a, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22,
+	0x22, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x10, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x64, 0x4f,
+	0x66, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x9e, 0x03,
+	0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x09, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x36, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x45, 0x6e, 0x64, 0x4f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x6e,
+	0x64, 0x4f, 0x66, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2a, 0xc3,
+	0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x14, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4e,
+	0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
+	0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x50, 0x61, 0x6e, 0x69,
+	0x63, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x57, 0x61, 0x72, 0x6e, 0x10, 0x04,
+	0x12, 0x14, 0x0a, 0x10, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x10, 0x06, 0x12, 0x15, 0x0a,
+	0x11, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x10, 0x07, 0x2a, 0x78, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x10,
+	0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x50,
+	0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79,
+	0x69, 0x6e, 0x67, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x10, 0x03, 0x32, 0xb8,
+	0x11, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x04, 0x4f, 0x70, 0x65,
+	0x6e, 0x12, 0x13, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x11, 0x53,
+	0x65, 0x74, 0x75, 0x70, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
+	0x12, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x46,
+	0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x75,
+	0x70, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x07, 0x45, 0x6e, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x12, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x45, 0x6e,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x07, 0x49, 0x73, 0x45, 0x6e, 0x64, 0x65,
+	0x64, 0x12, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x49, 0x73, 0x45, 0x6e, 0x64,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x2e, 0x49, 0x73, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x45, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3f,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x18, 0x2e, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a,
-	0x08, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x04, 0x53,
-	0x65, 0x65, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x65,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x2e, 0x53, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12,
-	0x1d, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65,
-	0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12,
-	0x1d, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69,
-	0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0d, 0x53, 0x65, 0x74,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x1c, 0x2e, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x41, 0x75, 0x64,
-	0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x1c, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53,
-	0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x04,
-	0x53, 0x74, 0x6f, 0x70, 0x12, 0x13, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x33,
-	0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x78, 0x61, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x6f, 0x2d, 0x67, 0x6f, 0x2f, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a,
+	0x08, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x53,
+	0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x08, 0x53, 0x65, 0x74,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x07, 0x47, 0x65, 0x74,
+	0x4d, 0x75, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x12,
+	0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x30, 0x0a, 0x04, 0x53, 0x65, 0x65, 0x6b, 0x12, 0x13, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x65, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x3f, 0x0a, 0x09, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x18,
+	0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x65,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x19, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x21, 0x2e, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x12, 0x1c, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x4b, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x12, 0x1c, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x11,
+	0x53, 0x65, 0x74, 0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x12, 0x20, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74,
+	0x53, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x45, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1a,
+	0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x70, 0x74, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x53, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x30,
+	0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x13, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x33, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x12, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x15, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x61, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x6f,
+	0x2d, 0x67, 0x6f, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67,
+	0x6f, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -2244,112 +4072,183 @@ func file_player_proto_rawDescGZIP() []byte {
 	return file_player_proto_rawDescData
 }
 
-var file_player_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_player_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_player_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_player_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
 var file_player_proto_goTypes = []any{
 	(LoggingLevel)(0),                 // 0: player.LoggingLevel
-	(*SetupForStreamingRequest)(nil),  // 1: player.SetupForStreamingRequest
-	(*SetupForStreamingReply)(nil),    // 2: player.SetupForStreamingReply
-	(*ProcessTitleRequest)(nil),       // 3: player.ProcessTitleRequest
-	(*ProcessTitleReply)(nil),         // 4: player.ProcessTitleReply
-	(*OpenRequest)(nil),               // 5: player.OpenRequest
-	(*OpenReply)(nil),                 // 6: player.OpenReply
-	(*GetLinkRequest)(nil),            // 7: player.GetLinkRequest
-	(*GetLinkReply)(nil),              // 8: player.GetLinkReply
-	(*EndChanRequest)(nil),            // 9: player.EndChanRequest
-	(*EndChanReply)(nil),              // 10: player.EndChanReply
-	(*IsEndedRequest)(nil),            // 11: player.IsEndedRequest
-	(*IsEndedReply)(nil),              // 12: player.IsEndedReply
-	(*GetPositionRequest)(nil),        // 13: player.GetPositionRequest
-	(*GetPositionReply)(nil),          // 14: player.GetPositionReply
-	(*GetAudioPositionRequest)(nil),   // 15: player.GetAudioPositionRequest
-	(*GetAudioPositionReply)(nil),     // 16: player.GetAudioPositionReply
-	(*GetLengthRequest)(nil),          // 17: player.GetLengthRequest
-	(*GetLengthReply)(nil),            // 18: player.GetLengthReply
-	(*GetSpeedRequest)(nil),           // 19: player.GetSpeedRequest
-	(*GetSpeedReply)(nil),             // 20: player.GetSpeedReply
-	(*SetSpeedRequest)(nil),           // 21: player.SetSpeedRequest
-	(*SetSpeedReply)(nil),             // 22: player.SetSpeedReply
-	(*GetPauseRequest)(nil),           // 23: player.GetPauseRequest
-	(*GetPauseReply)(nil),             // 24: player.GetPauseReply
-	(*SetPauseRequest)(nil),           // 25: player.SetPauseRequest
-	(*SetPauseReply)(nil),             // 26: player.SetPauseReply
-	(*SetVideoTrackRequest)(nil),      // 27: player.SetVideoTrackRequest
-	(*SetVideoTrackReply)(nil),        // 28: player.SetVideoTrackReply
-	(*SetAudioTrackRequest)(nil),      // 29: player.SetAudioTrackRequest
-	(*SetAudioTrackReply)(nil),        // 30: player.SetAudioTrackReply
-	(*SetSubtitlesTrackRequest)(nil),  // 31: player.SetSubtitlesTrackRequest
-	(*SetSubtitlesTrackReply)(nil),    // 32: player.SetSubtitlesTrackReply
-	(*VideoTrack)(nil),                // 33: player.VideoTrack
-	(*SeekRequest)(nil),               // 34: player.SeekRequest
-	(*SeekReply)(nil),                 // 35: player.SeekReply
-	(*GetVideoTracksRequest)(nil),     // 36: player.GetVideoTracksRequest
-	(*GetVideoTracksReply)(nil),       // 37: player.GetVideoTracksReply
-	(*AudioTrack)(nil),                // 38: player.AudioTrack
-	(*GetAudioTracksRequest)(nil),     // 39: player.GetAudioTracksRequest
-	(*GetAudioTracksReply)(nil),       // 40: player.GetAudioTracksReply
-	(*SubtitlesTrack)(nil),            // 41: player.SubtitlesTrack
-	(*GetSubtitlesTracksRequest)(nil), // 42: player.GetSubtitlesTracksRequest
-	(*GetSubtitlesTracksReply)(nil),   // 43: player.GetSubtitlesTracksReply
-	(*StopRequest)(nil),               // 44: player.StopRequest
-	(*StopReply)(nil),                 // 45: player.StopReply
-	(*CloseRequest)(nil),              // 46: player.CloseRequest
-	(*CloseReply)(nil),                // 47: player.CloseReply
+	(PlaybackState)(0),                // 1: player.PlaybackState
+	(*SetupForStreamingRequest)(nil),  // 2: player.SetupForStreamingRequest
+	(*SetupForStreamingReply)(nil),    // 3: player.SetupForStreamingReply
+	(*ProcessTitleRequest)(nil),       // 4: player.ProcessTitleRequest
+	(*ProcessTitleReply)(nil),         // 5: player.ProcessTitleReply
+	(*OpenRequest)(nil),               // 6: player.OpenRequest
+	(*OpenReply)(nil),                 // 7: player.OpenReply
+	(*GetLinkRequest)(nil),            // 8: player.GetLinkRequest
+	(*GetLinkReply)(nil),              // 9: player.GetLinkReply
+	(*EndChanRequest)(nil),            // 10: player.EndChanRequest
+	(*EndChanReply)(nil),              // 11: player.EndChanReply
+	(*IsEndedRequest)(nil),            // 12: player.IsEndedRequest
+	(*IsEndedReply)(nil),              // 13: player.IsEndedReply
+	(*GetPositionRequest)(nil),        // 14: player.GetPositionRequest
+	(*GetPositionReply)(nil),          // 15: player.GetPositionReply
+	(*GetAudioPositionRequest)(nil),   // 16: player.GetAudioPositionRequest
+	(*GetAudioPositionReply)(nil),     // 17: player.GetAudioPositionReply
+	(*GetLengthRequest)(nil),          // 18: player.GetLengthRequest
+	(*GetLengthReply)(nil),            // 19: player.GetLengthReply
+	(*GetSpeedRequest)(nil),           // 20: player.GetSpeedRequest
+	(*GetSpeedReply)(nil),             // 21: player.GetSpeedReply
+	(*SetSpeedRequest)(nil),           // 22: player.SetSpeedRequest
+	(*SetSpeedReply)(nil),             // 23: player.SetSpeedReply
+	(*GetPauseRequest)(nil),           // 24: player.GetPauseRequest
+	(*GetPauseReply)(nil),             // 25: player.GetPauseReply
+	(*SetPauseRequest)(nil),           // 26: player.SetPauseRequest
+	(*SetPauseReply)(nil),             // 27: player.SetPauseReply
+	(*GetVolumeRequest)(nil),          // 28: player.GetVolumeRequest
+	(*GetVolumeReply)(nil),            // 29: player.GetVolumeReply
+	(*SetVolumeRequest)(nil),          // 30: player.SetVolumeRequest
+	(*SetVolumeReply)(nil),            // 31: player.SetVolumeReply
+	(*GetMuteRequest)(nil),            // 32: player.GetMuteRequest
+	(*GetMuteReply)(nil),              // 33: player.GetMuteReply
+	(*SetMuteRequest)(nil),            // 34: player.SetMuteRequest
+	(*SetMuteReply)(nil),              // 35: player.SetMuteReply
+	(*SetVideoTrackRequest)(nil),      // 36: player.SetVideoTrackRequest
+	(*SetVideoTrackReply)(nil),        // 37: player.SetVideoTrackReply
+	(*SetAudioTrackRequest)(nil),      // 38: player.SetAudioTrackRequest
+	(*SetAudioTrackReply)(nil),        // 39: player.SetAudioTrackReply
+	(*SetSubtitlesTrackRequest)(nil),  // 40: player.SetSubtitlesTrackRequest
+	(*SetSubtitlesTrackReply)(nil),    // 41: player.SetSubtitlesTrackReply
+	(*TrackInfo)(nil),                 // 42: player.TrackInfo
+	(*VideoTrack)(nil),                // 43: player.VideoTrack
+	(*SeekRequest)(nil),               // 44: player.SeekRequest
+	(*SeekReply)(nil),                 // 45: player.SeekReply
+	(*FrameStepRequest)(nil),          // 46: player.FrameStepRequest
+	(*FrameStepReply)(nil),            // 47: player.FrameStepReply
+	(*ScreenshotRequest)(nil),         // 48: player.ScreenshotRequest
+	(*ScreenshotReply)(nil),           // 49: player.ScreenshotReply
+	(*GetVideoTracksRequest)(nil),     // 50: player.GetVideoTracksRequest
+	(*GetVideoTracksReply)(nil),       // 51: player.GetVideoTracksReply
+	(*AudioTrack)(nil),                // 52: player.AudioTrack
+	(*GetAudioTracksRequest)(nil),     // 53: player.GetAudioTracksRequest
+	(*GetAudioTracksReply)(nil),       // 54: player.GetAudioTracksReply
+	(*SubtitlesTrack)(nil),            // 55: player.SubtitlesTrack
+	(*GetSubtitlesTracksRequest)(nil), // 56: player.GetSubtitlesTracksRequest
+	(*GetSubtitlesTracksReply)(nil),   // 57: player.GetSubtitlesTracksReply
+	(*Chapter)(nil),                   // 58: player.Chapter
+	(*MediaInfo)(nil),                 // 59: player.MediaInfo
+	(*GetMediaInfoRequest)(nil),       // 60: player.GetMediaInfoRequest
+	(*GetMediaInfoReply)(nil),         // 61: player.GetMediaInfoReply
+	(*GetChaptersRequest)(nil),        // 62: player.GetChaptersRequest
+	(*GetChaptersReply)(nil),          // 63: player.GetChaptersReply
+	(*GetChapterRequest)(nil),         // 64: player.GetChapterRequest
+	(*GetChapterReply)(nil),           // 65: player.GetChapterReply
+	(*SetChapterRequest)(nil),         // 66: player.SetChapterRequest
+	(*SetChapterReply)(nil),           // 67: player.SetChapterReply
+	(*StopRequest)(nil),               // 68: player.StopRequest
+	(*StopReply)(nil),                 // 69: player.StopReply
+	(*CloseRequest)(nil),              // 70: player.CloseRequest
+	(*CloseReply)(nil),                // 71: player.CloseReply
+	(*EventsRequest)(nil),             // 72: player.EventsRequest
+	(*EventStateChange)(nil),          // 73: player.EventStateChange
+	(*EventPosition)(nil),             // 74: player.EventPosition
+	(*EventTracksChange)(nil),         // 75: player.EventTracksChange
+	(*EventBuffering)(nil),            // 76: player.EventBuffering
+	(*EventError)(nil),                // 77: player.EventError
+	(*EventEndOfFile)(nil),            // 78: player.EventEndOfFile
+	(*EventMediaChange)(nil),          // 79: player.EventMediaChange
+	(*Event)(nil),                     // 80: player.Event
+	nil,                               // 81: player.MediaInfo.TagsEntry
 }
 var file_player_proto_depIdxs = []int32{
 	0,  // 0: player.OpenRequest.loggingLevel:type_name -> player.LoggingLevel
-	33, // 1: player.GetVideoTracksReply.videoTrack:type_name -> player.VideoTrack
-	38, // 2: player.GetAudioTracksReply.audioTrack:type_name -> player.AudioTrack
-	41, // 3: player.GetSubtitlesTracksReply.subtitlesTrack:type_name -> player.SubtitlesTrack
-	5,  // 4: player.Player.Open:input_type -> player.OpenRequest
-	1,  // 5: player.Player.SetupForStreaming:input_type -> player.SetupForStreamingRequest
-	3,  // 6: player.Player.ProcessTitle:input_type -> player.ProcessTitleRequest
-	7,  // 7: player.Player.GetLink:input_type -> player.GetLinkRequest
-	9,  // 8: player.Player.EndChan:input_type -> player.EndChanRequest
-	11, // 9: player.Player.IsEnded:input_type -> player.IsEndedRequest
-	13, // 10: player.Player.GetPosition:input_type -> player.GetPositionRequest
-	15, // 11: player.Player.GetAudioPosition:input_type -> player.GetAudioPositionRequest
-	17, // 12: player.Player.GetLength:input_type -> player.GetLengthRequest
-	19, // 13: player.Player.GetSpeed:input_type -> player.GetSpeedRequest
-	21, // 14: player.Player.SetSpeed:input_type -> player.SetSpeedRequest
-	23, // 15: player.Player.GetPause:input_type -> player.GetPauseRequest
-	25, // 16: player.Player.SetPause:input_type -> player.SetPauseRequest
-	34, // 17: player.Player.Seek:input_type -> player.SeekRequest
-	36, // 18: player.Player.GetVideoTracks:input_type -> player.GetVideoTracksRequest
-	39, // 19: player.Player.GetAudioTracks:input_type -> player.GetAudioTracksRequest
-	42, // 20: player.Player.GetSubtitlesTracks:input_type -> player.GetSubtitlesTracksRequest
-	27, // 21: player.Player.SetVideoTrack:input_type -> player.SetVideoTrackRequest
-	29, // 22: player.Player.SetAudioTrack:input_type -> player.SetAudioTrackRequest
-	31, // 23: player.Player.SetSubtitlesTrack:input_type -> player.SetSubtitlesTrackRequest
-	44, // 24: player.Player.Stop:input_type -> player.StopRequest
-	46, // 25: player.Player.Close:input_type -> player.CloseRequest
-	6,  // 26: player.Player.Open:output_type -> player.OpenReply
-	2,  // 27: player.Player.SetupForStreaming:output_type -> player.SetupForStreamingReply
-	4,  // 28: player.Player.ProcessTitle:output_type -> player.ProcessTitleReply
-	8,  // 29: player.Player.GetLink:output_type -> player.GetLinkReply
-	10, // 30: player.Player.EndChan:output_type -> player.EndChanReply
-	12, // 31: player.Player.IsEnded:output_type -> player.IsEndedReply
-	14, // 32: player.Player.GetPosition:output_type -> player.GetPositionReply
-	16, // 33: player.Player.GetAudioPosition:output_type -> player.GetAudioPositionReply
-	18, // 34: player.Player.GetLength:output_type -> player.GetLengthReply
-	20, // 35: player.Player.GetSpeed:output_type -> player.GetSpeedReply
-	22, // 36: player.Player.SetSpeed:output_type -> player.SetSpeedReply
-	24, // 37: player.Player.GetPause:output_type -> player.GetPauseReply
-	26, // 38: player.Player.SetPause:output_type -> player.SetPauseReply
-	35, // 39: player.Player.Seek:output_type -> player.SeekReply
-	37, // 40: player.Player.GetVideoTracks:output_type -> player.GetVideoTracksReply
-	40, // 41: player.Player.GetAudioTracks:output_type -> player.GetAudioTracksReply
-	43, // 42: player.Player.GetSubtitlesTracks:output_type -> player.GetSubtitlesTracksReply
-	28, // 43: player.Player.SetVideoTrack:output_type -> player.SetVideoTrackReply
-	30, // 44: player.Player.SetAudioTrack:output_type -> player.SetAudioTrackReply
-	32, // 45: player.Player.SetSubtitlesTrack:output_type -> player.SetSubtitlesTrackReply
-	45, // 46: player.Player.Stop:output_type -> player.StopReply
-	47, // 47: player.Player.Close:output_type -> player.CloseReply
-	26, // [26:48] is the sub-list for method output_type
-	4,  // [4:26] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	42, // 1: player.VideoTrack.info:type_name -> player.TrackInfo
+	43, // 2: player.GetVideoTracksReply.videoTrack:type_name -> player.VideoTrack
+	42, // 3: player.AudioTrack.info:type_name -> player.TrackInfo
+	52, // 4: player.GetAudioTracksReply.audioTrack:type_name -> player.AudioTrack
+	42, // 5: player.SubtitlesTrack.info:type_name -> player.TrackInfo
+	55, // 6: player.GetSubtitlesTracksReply.subtitlesTrack:type_name -> player.SubtitlesTrack
+	81, // 7: player.MediaInfo.tags:type_name -> player.MediaInfo.TagsEntry
+	58, // 8: player.MediaInfo.chapters:type_name -> player.Chapter
+	59, // 9: player.GetMediaInfoReply.mediaInfo:type_name -> player.MediaInfo
+	58, // 10: player.GetChaptersReply.chapters:type_name -> player.Chapter
+	1,  // 11: player.EventStateChange.state:type_name -> player.PlaybackState
+	73, // 12: player.Event.stateChange:type_name -> player.EventStateChange
+	74, // 13: player.Event.position:type_name -> player.EventPosition
+	75, // 14: player.Event.tracksChange:type_name -> player.EventTracksChange
+	76, // 15: player.Event.buffering:type_name -> player.EventBuffering
+	77, // 16: player.Event.error:type_name -> player.EventError
+	78, // 17: player.Event.endOfFile:type_name -> player.EventEndOfFile
+	79, // 18: player.Event.mediaChange:type_name -> player.EventMediaChange
+	6,  // 19: player.Player.Open:input_type -> player.OpenRequest
+	2,  // 20: player.Player.SetupForStreaming:input_type -> player.SetupForStreamingRequest
+	4,  // 21: player.Player.ProcessTitle:input_type -> player.ProcessTitleRequest
+	8,  // 22: player.Player.GetLink:input_type -> player.GetLinkRequest
+	10, // 23: player.Player.EndChan:input_type -> player.EndChanRequest
+	12, // 24: player.Player.IsEnded:input_type -> player.IsEndedRequest
+	14, // 25: player.Player.GetPosition:input_type -> player.GetPositionRequest
+	16, // 26: player.Player.GetAudioPosition:input_type -> player.GetAudioPositionRequest
+	18, // 27: player.Player.GetLength:input_type -> player.GetLengthRequest
+	20, // 28: player.Player.GetSpeed:input_type -> player.GetSpeedRequest
+	22, // 29: player.Player.SetSpeed:input_type -> player.SetSpeedRequest
+	24, // 30: player.Player.GetPause:input_type -> player.GetPauseRequest
+	26, // 31: player.Player.SetPause:input_type -> player.SetPauseRequest
+	28, // 32: player.Player.GetVolume:input_type -> player.GetVolumeRequest
+	30, // 33: player.Player.SetVolume:input_type -> player.SetVolumeRequest
+	32, // 34: player.Player.GetMute:input_type -> player.GetMuteRequest
+	34, // 35: player.Player.SetMute:input_type -> player.SetMuteRequest
+	44, // 36: player.Player.Seek:input_type -> player.SeekRequest
+	46, // 37: player.Player.FrameStep:input_type -> player.FrameStepRequest
+	48, // 38: player.Player.Screenshot:input_type -> player.ScreenshotRequest
+	50, // 39: player.Player.GetVideoTracks:input_type -> player.GetVideoTracksRequest
+	53, // 40: player.Player.GetAudioTracks:input_type -> player.GetAudioTracksRequest
+	56, // 41: player.Player.GetSubtitlesTracks:input_type -> player.GetSubtitlesTracksRequest
+	36, // 42: player.Player.SetVideoTrack:input_type -> player.SetVideoTrackRequest
+	38, // 43: player.Player.SetAudioTrack:input_type -> player.SetAudioTrackRequest
+	40, // 44: player.Player.SetSubtitlesTrack:input_type -> player.SetSubtitlesTrackRequest
+	60, // 45: player.Player.GetMediaInfo:input_type -> player.GetMediaInfoRequest
+	62, // 46: player.Player.GetChapters:input_type -> player.GetChaptersRequest
+	64, // 47: player.Player.GetChapter:input_type -> player.GetChapterRequest
+	66, // 48: player.Player.SetChapter:input_type -> player.SetChapterRequest
+	68, // 49: player.Player.Stop:input_type -> player.StopRequest
+	70, // 50: player.Player.Close:input_type -> player.CloseRequest
+	72, // 51: player.Player.Events:input_type -> player.EventsRequest
+	7,  // 52: player.Player.Open:output_type -> player.OpenReply
+	3,  // 53: player.Player.SetupForStreaming:output_type -> player.SetupForStreamingReply
+	5,  // 54: player.Player.ProcessTitle:output_type -> player.ProcessTitleReply
+	9,  // 55: player.Player.GetLink:output_type -> player.GetLinkReply
+	11, // 56: player.Player.EndChan:output_type -> player.EndChanReply
+	13, // 57: player.Player.IsEnded:output_type -> player.IsEndedReply
+	15, // 58: player.Player.GetPosition:output_type -> player.GetPositionReply
+	17, // 59: player.Player.GetAudioPosition:output_type -> player.GetAudioPositionReply
+	19, // 60: player.Player.GetLength:output_type -> player.GetLengthReply
+	21, // 61: player.Player.GetSpeed:output_type -> player.GetSpeedReply
+	23, // 62: player.Player.SetSpeed:output_type -> player.SetSpeedReply
+	25, // 63: player.Player.GetPause:output_type -> player.GetPauseReply
+	27, // 64: player.Player.SetPause:output_type -> player.SetPauseReply
+	29, // 65: player.Player.GetVolume:output_type -> player.GetVolumeReply
+	31, // 66: player.Player.SetVolume:output_type -> player.SetVolumeReply
+	33, // 67: player.Player.GetMute:output_type -> player.GetMuteReply
+	35, // 68: player.Player.SetMute:output_type -> player.SetMuteReply
+	45, // 69: player.Player.Seek:output_type -> player.SeekReply
+	47, // 70: player.Player.FrameStep:output_type -> player.FrameStepReply
+	49, // 71: player.Player.Screenshot:output_type -> player.ScreenshotReply
+	51, // 72: player.Player.GetVideoTracks:output_type -> player.GetVideoTracksReply
+	54, // 73: player.Player.GetAudioTracks:output_type -> player.GetAudioTracksReply
+	57, // 74: player.Player.GetSubtitlesTracks:output_type -> player.GetSubtitlesTracksReply
+	37, // 75: player.Player.SetVideoTrack:output_type -> player.SetVideoTrackReply
+	39, // 76: player.Player.SetAudioTrack:output_type -> player.SetAudioTrackReply
+	41, // 77: player.Player.SetSubtitlesTrack:output_type -> player.SetSubtitlesTrackReply
+	61, // 78: player.Player.GetMediaInfo:output_type -> player.GetMediaInfoReply
+	63, // 79: player.Player.GetChapters:output_type -> player.GetChaptersReply
+	65, // 80: player.Player.GetChapter:output_type -> player.GetChapterReply
+	67, // 81: player.Player.SetChapter:output_type -> player.SetChapterReply
+	69, // 82: player.Player.Stop:output_type -> player.StopReply
+	71, // 83: player.Player.Close:output_type -> player.CloseReply
+	80, // 84: player.Player.Events:output_type -> player.Event
+	52, // [52:85] is the sub-list for method output_type
+	19, // [19:52] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_player_proto_init() }
@@ -2357,13 +4256,22 @@ func file_player_proto_init() {
 	if File_player_proto != nil {
 		return
 	}
+	file_player_proto_msgTypes[78].OneofWrappers = []any{
+		(*Event_StateChange)(nil),
+		(*Event_Position)(nil),
+		(*Event_TracksChange)(nil),
+		(*Event_Buffering)(nil),
+		(*Event_Error)(nil),
+		(*Event_EndOfFile)(nil),
+		(*Event_MediaChange)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_player_proto_rawDesc), len(file_player_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   47,
+			NumEnums:      2,
+			NumMessages:   80,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -32,15 +32,26 @@ const (
 	Player_SetSpeed_FullMethodName           = "/player.Player/SetSpeed"
 	Player_GetPause_FullMethodName           = "/player.Player/GetPause"
 	Player_SetPause_FullMethodName           = "/player.Player/SetPause"
+	Player_GetVolume_FullMethodName          = "/player.Player/GetVolume"
+	Player_SetVolume_FullMethodName          = "/player.Player/SetVolume"
+	Player_GetMute_FullMethodName            = "/player.Player/GetMute"
+	Player_SetMute_FullMethodName            = "/player.Player/SetMute"
 	Player_Seek_FullMethodName               = "/player.Player/Seek"
+	Player_FrameStep_FullMethodName          = "/player.Player/FrameStep"
+	Player_Screenshot_FullMethodName         = "/player.Player/Screenshot"
 	Player_GetVideoTracks_FullMethodName     = "/player.Player/GetVideoTracks"
 	Player_GetAudioTracks_FullMethodName     = "/player.Player/GetAudioTracks"
 	Player_GetSubtitlesTracks_FullMethodName = "/player.Player/GetSubtitlesTracks"
 	Player_SetVideoTrack_FullMethodName      = "/player.Player/SetVideoTrack"
 	Player_SetAudioTrack_FullMethodName      = "/player.Player/SetAudioTrack"
 	Player_SetSubtitlesTrack_FullMethodName  = "/player.Player/SetSubtitlesTrack"
+	Player_GetMediaInfo_FullMethodName       = "/player.Player/GetMediaInfo"
+	Player_GetChapters_FullMethodName        = "/player.Player/GetChapters"
+	Player_GetChapter_FullMethodName         = "/player.Player/GetChapter"
+	Player_SetChapter_FullMethodName         = "/player.Player/SetChapter"
 	Player_Stop_FullMethodName               = "/player.Player/Stop"
 	Player_Close_FullMethodName              = "/player.Player/Close"
+	Player_Events_FullMethodName             = "/player.Player/Events"
 )
 
 // PlayerClient is the client API for Player service.
@@ -60,15 +71,26 @@ type PlayerClient interface {
 	SetSpeed(ctx context.Context, in *SetSpeedRequest, opts ...grpc.CallOption) (*SetSpeedReply, error)
 	GetPause(ctx context.Context, in *GetPauseRequest, opts ...grpc.CallOption) (*GetPauseReply, error)
 	SetPause(ctx context.Context, in *SetPauseRequest, opts ...grpc.CallOption) (*SetPauseReply, error)
+	GetVolume(ctx context.Context, in *GetVolumeRequest, opts ...grpc.CallOption) (*GetVolumeReply, error)
+	SetVolume(ctx context.Context, in *SetVolumeRequest, opts ...grpc.CallOption) (*SetVolumeReply, error)
+	GetMute(ctx context.Context, in *GetMuteRequest, opts ...grpc.CallOption) (*GetMuteReply, error)
+	SetMute(ctx context.Context, in *SetMuteRequest, opts ...grpc.CallOption) (*SetMuteReply, error)
 	Seek(ctx context.Context, in *SeekRequest, opts ...grpc.CallOption) (*SeekReply, error)
+	FrameStep(ctx context.Context, in *FrameStepRequest, opts ...grpc.CallOption) (*FrameStepReply, error)
+	Screenshot(ctx context.Context, in *ScreenshotRequest, opts ...grpc.CallOption) (*ScreenshotReply, error)
 	GetVideoTracks(ctx context.Context, in *GetVideoTracksRequest, opts ...grpc.CallOption) (*GetVideoTracksReply, error)
 	GetAudioTracks(ctx context.Context, in *GetAudioTracksRequest, opts ...grpc.CallOption) (*GetAudioTracksReply, error)
 	GetSubtitlesTracks(ctx context.Context, in *GetSubtitlesTracksRequest, opts ...grpc.CallOption) (*GetSubtitlesTracksReply, error)
 	SetVideoTrack(ctx context.Context, in *SetVideoTrackRequest, opts ...grpc.CallOption) (*SetVideoTrackReply, error)
 	SetAudioTrack(ctx context.Context, in *SetAudioTrackRequest, opts ...grpc.CallOption) (*SetAudioTrackReply, error)
 	SetSubtitlesTrack(ctx context.Context, in *SetSubtitlesTrackRequest, opts ...grpc.CallOption) (*SetSubtitlesTrackReply, error)
+	GetMediaInfo(ctx context.Context, in *GetMediaInfoRequest, opts ...grpc.CallOption) (*GetMediaInfoReply, error)
+	GetChapters(ctx context.Context, in *GetChaptersRequest, opts ...grpc.CallOption) (*GetChaptersReply, error)
+	GetChapter(ctx context.Context, in *GetChapterRequest, opts ...grpc.CallOption) (*GetChapterReply, error)
+	SetChapter(ctx context.Context, in *SetChapterRequest, opts ...grpc.CallOption) (*SetChapterReply, error)
 	Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopReply, error)
 	Close(ctx context.Context, in *CloseRequest, opts ...grpc.CallOption) (*CloseReply, error)
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Player_EventsClient, error)
 }
 
 type playerClient struct {
@@ -232,6 +254,46 @@ func (c *playerClient) SetPause(ctx context.Context, in *SetPauseRequest, opts .
 	return out, nil
 }
 
+func (c *playerClient) GetVolume(ctx context.Context, in *GetVolumeRequest, opts ...grpc.CallOption) (*GetVolumeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVolumeReply)
+	err := c.cc.Invoke(ctx, Player_GetVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerClient) SetVolume(ctx context.Context, in *SetVolumeRequest, opts ...grpc.CallOption) (*SetVolumeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetVolumeReply)
+	err := c.cc.Invoke(ctx, Player_SetVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerClient) GetMute(ctx context.Context, in *GetMuteRequest, opts ...grpc.CallOption) (*GetMuteReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMuteReply)
+	err := c.cc.Invoke(ctx, Player_GetMute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerClient) SetMute(ctx context.Context, in *SetMuteRequest, opts ...grpc.CallOption) (*SetMuteReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMuteReply)
+	err := c.cc.Invoke(ctx, Player_SetMute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *playerClient) Seek(ctx context.Context, in *SeekRequest, opts ...grpc.CallOption) (*SeekReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SeekReply)
@@ -242,6 +304,26 @@ func (c *playerClient) Seek(ctx context.Context, in *SeekRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *playerClient) FrameStep(ctx context.Context, in *FrameStepRequest, opts ...grpc.CallOption) (*FrameStepReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FrameStepReply)
+	err := c.cc.Invoke(ctx, Player_FrameStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerClient) Screenshot(ctx context.Context, in *ScreenshotRequest, opts ...grpc.CallOption) (*ScreenshotReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScreenshotReply)
+	err := c.cc.Invoke(ctx, Player_Screenshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *playerClient) GetVideoTracks(ctx context.Context, in *GetVideoTracksRequest, opts ...grpc.CallOption) (*GetVideoTracksReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetVideoTracksReply)
@@ -302,6 +384,46 @@ func (c *playerClient) SetSubtitlesTrack(ctx context.Context, in *SetSubtitlesTr
 	return out, nil
 }
 
+func (c *playerClient) GetMediaInfo(ctx context.Context, in *GetMediaInfoRequest, opts ...grpc.CallOption) (*GetMediaInfoReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMediaInfoReply)
+	err := c.cc.Invoke(ctx, Player_GetMediaInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerClient) GetChapters(ctx context.Context, in *GetChaptersRequest, opts ...grpc.CallOption) (*GetChaptersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChaptersReply)
+	err := c.cc.Invoke(ctx, Player_GetChapters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerClient) GetChapter(ctx context.Context, in *GetChapterRequest, opts ...grpc.CallOption) (*GetChapterReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChapterReply)
+	err := c.cc.Invoke(ctx, Player_GetChapter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerClient) SetChapter(ctx context.Context, in *SetChapterRequest, opts ...grpc.CallOption) (*SetChapterReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChapterReply)
+	err := c.cc.Invoke(ctx, Player_SetChapter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *playerClient) Stop(ctx context.Context, in *StopRequest, opts ...grpc.CallOption) (*StopReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StopReply)
@@ -322,6 +444,39 @@ func (c *playerClient) Close(ctx context.Context, in *CloseRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *playerClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Player_EventsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Player_ServiceDesc.Streams[1], Player_Events_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &playerEventsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Player_EventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type playerEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *playerEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PlayerServer is the server API for Player service.
 // All implementations must embed UnimplementedPlayerServer
 // for forward compatibility
@@ -339,15 +494,26 @@ type PlayerServer interface {
 	SetSpeed(context.Context, *SetSpeedRequest) (*SetSpeedReply, error)
 	GetPause(context.Context, *GetPauseRequest) (*GetPauseReply, error)
 	SetPause(context.Context, *SetPauseRequest) (*SetPauseReply, error)
+	GetVolume(context.Context, *GetVolumeRequest) (*GetVolumeReply, error)
+	SetVolume(context.Context, *SetVolumeRequest) (*SetVolumeReply, error)
+	GetMute(context.Context, *GetMuteRequest) (*GetMuteReply, error)
+	SetMute(context.Context, *SetMuteRequest) (*SetMuteReply, error)
 	Seek(context.Context, *SeekRequest) (*SeekReply, error)
+	FrameStep(context.Context, *FrameStepRequest) (*FrameStepReply, error)
+	Screenshot(context.Context, *ScreenshotRequest) (*ScreenshotReply, error)
 	GetVideoTracks(context.Context, *GetVideoTracksRequest) (*GetVideoTracksReply, error)
 	GetAudioTracks(context.Context, *GetAudioTracksRequest) (*GetAudioTracksReply, error)
 	GetSubtitlesTracks(context.Context, *GetSubtitlesTracksRequest) (*GetSubtitlesTracksReply, error)
 	SetVideoTrack(context.Context, *SetVideoTrackRequest) (*SetVideoTrackReply, error)
 	SetAudioTrack(context.Context, *SetAudioTrackRequest) (*SetAudioTrackReply, error)
 	SetSubtitlesTrack(context.Context, *SetSubtitlesTrackRequest) (*SetSubtitlesTrackReply, error)
+	GetMediaInfo(context.Context, *GetMediaInfoRequest) (*GetMediaInfoReply, error)
+	GetChapters(context.Context, *GetChaptersRequest) (*GetChaptersReply, error)
+	GetChapter(context.Context, *GetChapterRequest) (*GetChapterReply, error)
+	SetChapter(context.Context, *SetChapterRequest) (*SetChapterReply, error)
 	Stop(context.Context, *StopRequest) (*StopReply, error)
 	Close(context.Context, *CloseRequest) (*CloseReply, error)
+	Events(*EventsRequest, Player_EventsServer) error
 	mustEmbedUnimplementedPlayerServer()
 }
 
@@ -394,9 +560,27 @@ func (UnimplementedPlayerServer) GetPause(context.Context, *GetPauseRequest) (*G
 func (UnimplementedPlayerServer) SetPause(context.Context, *SetPauseRequest) (*SetPauseReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPause not implemented")
 }
+func (UnimplementedPlayerServer) GetVolume(context.Context, *GetVolumeRequest) (*GetVolumeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolume not implemented")
+}
+func (UnimplementedPlayerServer) SetVolume(context.Context, *SetVolumeRequest) (*SetVolumeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVolume not implemented")
+}
+func (UnimplementedPlayerServer) GetMute(context.Context, *GetMuteRequest) (*GetMuteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMute not implemented")
+}
+func (UnimplementedPlayerServer) SetMute(context.Context, *SetMuteRequest) (*SetMuteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMute not implemented")
+}
 func (UnimplementedPlayerServer) Seek(context.Context, *SeekRequest) (*SeekReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Seek not implemented")
 }
+func (UnimplementedPlayerServer) FrameStep(context.Context, *FrameStepRequest) (*FrameStepReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrameStep not implemented")
+}
+func (UnimplementedPlayerServer) Screenshot(context.Context, *ScreenshotRequest) (*ScreenshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Screenshot not implemented")
+}
 func (UnimplementedPlayerServer) GetVideoTracks(context.Context, *GetVideoTracksRequest) (*GetVideoTracksReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVideoTracks not implemented")
 }
@@ -415,12 +599,27 @@ func (UnimplementedPlayerServer) SetAudioTrack(context.Context, *SetAudioTrackRe
 func (UnimplementedPlayerServer) SetSubtitlesTrack(context.Context, *SetSubtitlesTrackRequest) (*SetSubtitlesTrackReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSubtitlesTrack not implemented")
 }
+func (UnimplementedPlayerServer) GetMediaInfo(context.Context, *GetMediaInfoRequest) (*GetMediaInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMediaInfo not implemented")
+}
+func (UnimplementedPlayerServer) GetChapters(context.Context, *GetChaptersRequest) (*GetChaptersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChapters not implemented")
+}
+func (UnimplementedPlayerServer) GetChapter(context.Context, *GetChapterRequest) (*GetChapterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChapter not implemented")
+}
+func (UnimplementedPlayerServer) SetChapter(context.Context, *SetChapterRequest) (*SetChapterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChapter not implemented")
+}
 func (UnimplementedPlayerServer) Stop(context.Context, *StopRequest) (*StopReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
 }
 func (UnimplementedPlayerServer) Close(context.Context, *CloseRequest) (*CloseReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
 }
+func (UnimplementedPlayerServer) Events(*EventsRequest, Player_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
 func (UnimplementedPlayerServer) mustEmbedUnimplementedPlayerServer() {}
 
 // UnsafePlayerServer may be embedded to opt out of forward compatibility for this service.
@@ -671,6 +870,78 @@ func _Player_SetPause_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Player_GetVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServer).GetVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Player_GetVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServer).GetVolume(ctx, req.(*GetVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Player_SetVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServer).SetVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Player_SetVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServer).SetVolume(ctx, req.(*SetVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Player_GetMute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServer).GetMute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Player_GetMute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServer).GetMute(ctx, req.(*GetMuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Player_SetMute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServer).SetMute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Player_SetMute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServer).SetMute(ctx, req.(*SetMuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Player_Seek_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SeekRequest)
 	if err := dec(in); err != nil {
@@ -689,6 +960,42 @@ func _Player_Seek_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Player_FrameStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FrameStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServer).FrameStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Player_FrameStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServer).FrameStep(ctx, req.(*FrameStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Player_Screenshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScreenshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServer).Screenshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Player_Screenshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServer).Screenshot(ctx, req.(*ScreenshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Player_GetVideoTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetVideoTracksRequest)
 	if err := dec(in); err != nil {
@@ -797,6 +1104,78 @@ func _Player_SetSubtitlesTrack_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Player_GetMediaInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMediaInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServer).GetMediaInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Player_GetMediaInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServer).GetMediaInfo(ctx, req.(*GetMediaInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Player_GetChapters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChaptersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServer).GetChapters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Player_GetChapters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServer).GetChapters(ctx, req.(*GetChaptersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Player_GetChapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServer).GetChapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Player_GetChapter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServer).GetChapter(ctx, req.(*GetChapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Player_SetChapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerServer).SetChapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Player_SetChapter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerServer).SetChapter(ctx, req.(*SetChapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Player_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StopRequest)
 	if err := dec(in); err != nil {
@@ -833,6 +1212,27 @@ func _Player_Close_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Player_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlayerServer).Events(m, &playerEventsServer{ServerStream: stream})
+}
+
+type Player_EventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type playerEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *playerEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Player_ServiceDesc is the grpc.ServiceDesc for Player service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -888,10 +1288,34 @@ var Player_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetPause",
 			Handler:    _Player_SetPause_Handler,
 		},
+		{
+			MethodName: "GetVolume",
+			Handler:    _Player_GetVolume_Handler,
+		},
+		{
+			MethodName: "SetVolume",
+			Handler:    _Player_SetVolume_Handler,
+		},
+		{
+			MethodName: "GetMute",
+			Handler:    _Player_GetMute_Handler,
+		},
+		{
+			MethodName: "SetMute",
+			Handler:    _Player_SetMute_Handler,
+		},
 		{
 			MethodName: "Seek",
 			Handler:    _Player_Seek_Handler,
 		},
+		{
+			MethodName: "FrameStep",
+			Handler:    _Player_FrameStep_Handler,
+		},
+		{
+			MethodName: "Screenshot",
+			Handler:    _Player_Screenshot_Handler,
+		},
 		{
 			MethodName: "GetVideoTracks",
 			Handler:    _Player_GetVideoTracks_Handler,
@@ -916,6 +1340,22 @@ var Player_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSubtitlesTrack",
 			Handler:    _Player_SetSubtitlesTrack_Handler,
 		},
+		{
+			MethodName: "GetMediaInfo",
+			Handler:    _Player_GetMediaInfo_Handler,
+		},
+		{
+			MethodName: "GetChapters",
+			Handler:    _Player_GetChapters_Handler,
+		},
+		{
+			MethodName: "GetChapter",
+			Handler:    _Player_GetChapter_Handler,
+		},
+		{
+			MethodName: "SetChapter",
+			Handler:    _Player_SetChapter_Handler,
+		},
 		{
 			MethodName: "Stop",
 			Handler:    _Player_Stop_Handler,
@@ -931,6 +1371,11 @@ var Player_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Player_EndChan_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Events",
+			Handler:       _Player_Events_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "player.proto",
 }
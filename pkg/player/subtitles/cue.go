@@ -0,0 +1,130 @@
+package subtitles
+
+import (
+	"context"
+	"image"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/xaionaro-go/xsync"
+)
+
+// CueEndUnknown is the End of a Cue which is shown until the next Cue.
+const CueEndUnknown = time.Duration(math.MaxInt64)
+
+// Cue is a single subtitle shown in the interval [Start, End).
+//
+// A cue is either a text (Text is set) or a set of bitmaps (Bitmaps
+// is set); a cue without both just clears the screen.
+type Cue struct {
+	Start time.Duration
+	End   time.Duration
+
+	// Text is the plain text of the cue; lines are separated by "\n".
+	Text string
+
+	// Bitmaps are positioned within CanvasSize; they are scaled to
+	// the actual size of the picture on rendering.
+	Bitmaps    []Bitmap
+	CanvasSize image.Point
+}
+
+type Bitmap struct {
+	Position image.Point
+	Image    *image.RGBA
+}
+
+func (c *Cue) IsEmpty() bool {
+	return c.Text == "" && len(c.Bitmaps) == 0
+}
+
+func (c *Cue) IsActiveAt(pos time.Duration) bool {
+	return pos >= c.Start && pos < c.End
+}
+
+// Track is a time-ordered set of cues.
+type Track struct {
+	// Exclusive means that each cue replaces the previous one (like
+	// in bitmap subtitles), instead of being shown together with it.
+	Exclusive bool
+
+	// EvictPast makes At to drop the cues ended before the position, so
+	// that they do not accumulate on a long playback; it is only for
+	// the tracks which are reset on a seek backwards (like the subtitles
+	// decoded on the fly).
+	EvictPast bool
+
+	locker xsync.Mutex
+	cues   []Cue
+}
+
+func NewTrack(cues []Cue) *Track {
+	t := &Track{}
+	for _, cue := range cues {
+		t.add(cue)
+	}
+	return t
+}
+
+func (t *Track) Add(cue Cue) {
+	t.locker.Do(context.Background(), func() {
+		t.add(cue)
+	})
+}
+
+func (t *Track) add(cue Cue) {
+	idx := sort.Search(len(t.cues), func(idx int) bool {
+		return t.cues[idx].Start > cue.Start
+	})
+	if idx > 0 {
+		prev := &t.cues[idx-1]
+		if prev.End == CueEndUnknown || (t.Exclusive && prev.End > cue.Start) {
+			prev.End = cue.Start
+		}
+	}
+	if idx < len(t.cues) {
+		next := &t.cues[idx]
+		if cue.End == CueEndUnknown || (t.Exclusive && cue.End > next.Start) {
+			cue.End = next.Start
+		}
+	}
+	t.cues = append(t.cues, Cue{})
+	copy(t.cues[idx+1:], t.cues[idx:])
+	t.cues[idx] = cue
+}
+
+// At returns the non-empty cues active at the given position.
+func (t *Track) At(pos time.Duration) []Cue {
+	return xsync.DoR1(context.Background(), &t.locker, func() []Cue {
+		if t.EvictPast {
+			t.evictBefore(pos)
+		}
+		var result []Cue
+		for idx := range t.cues {
+			cue := &t.cues[idx]
+			if cue.Start > pos {
+				break
+			}
+			if !cue.IsActiveAt(pos) || cue.IsEmpty() {
+				continue
+			}
+			result = append(result, *cue)
+		}
+		return result
+	})
+}
+
+func (t *Track) evictBefore(pos time.Duration) {
+	t.cues = slices.DeleteFunc(t.cues, func(cue Cue) bool {
+		return cue.End <= pos
+	})
+}
+
+// Reset removes all the cues.
+func (t *Track) Reset() {
+	t.locker.Do(context.Background(), func() {
+		t.cues = t.cues[:0]
+	})
+}
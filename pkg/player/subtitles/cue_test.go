@@ -0,0 +1,69 @@
+package subtitles
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTrackAt(t *testing.T) {
+	track := NewTrack([]Cue{
+		{Start: 3 * time.Second, End: 5 * time.Second, Text: "b"},
+		{Start: time.Second, End: 4 * time.Second, Text: "a"},
+		{Start: 6 * time.Second, End: 7 * time.Second},
+	})
+	for pos, expected := range map[time.Duration][]string{
+		0:                       nil,
+		time.Second:             {"a"},
+		3500 * time.Millisecond: {"a", "b"},
+		4 * time.Second:         {"b"},
+		6 * time.Second:         nil,
+	} {
+		var texts []string
+		for _, cue := range track.At(pos) {
+			texts = append(texts, cue.Text)
+		}
+		if !reflect.DeepEqual(texts, expected) {
+			t.Errorf("%v: expected %v, got %v", pos, expected, texts)
+		}
+	}
+}
+
+func TestTrackExclusive(t *testing.T) {
+	track := &Track{Exclusive: true}
+	track.Add(Cue{Start: time.Second, End: CueEndUnknown, Text: "a"})
+	track.Add(Cue{Start: 3 * time.Second, End: CueEndUnknown, Text: "b"})
+	track.Add(Cue{Start: 2 * time.Second, End: 10 * time.Second, Text: "c"})
+	for pos, expected := range map[time.Duration]string{
+		1500 * time.Millisecond: "a",
+		2500 * time.Millisecond: "c",
+		time.Hour:               "b",
+	} {
+		cues := track.At(pos)
+		if len(cues) != 1 || cues[0].Text != expected {
+			t.Errorf("%v: expected '%s', got %#+v", pos, expected, cues)
+		}
+	}
+}
+
+func TestTrackEvictPast(t *testing.T) {
+	for _, evictPast := range []bool{false, true} {
+		track := &Track{EvictPast: evictPast}
+		track.Add(Cue{Start: time.Second, End: 2 * time.Second, Text: "a"})
+		track.Add(Cue{Start: 3 * time.Second, End: CueEndUnknown, Text: "b"})
+		track.At(5 * time.Second)
+		cues := track.At(time.Second)
+		if evictPast {
+			if len(cues) != 0 {
+				t.Errorf("expected the ended cue to be evicted, got %#+v", cues)
+			}
+		} else {
+			if len(cues) != 1 {
+				t.Errorf("expected the ended cue to be kept, got %#+v", cues)
+			}
+		}
+		if len(track.cues) != map[bool]int{false: 2, true: 1}[evictPast] {
+			t.Errorf("EvictPast:%t: unexpected cues left: %#+v", evictPast, track.cues)
+		}
+	}
+}
@@ -0,0 +1,569 @@
+package subtitles
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"time"
+)
+
+const (
+	dvbSegmentPage              = 0x10
+	dvbSegmentRegion            = 0x11
+	dvbSegmentCLUT              = 0x12
+	dvbSegmentObject            = 0x13
+	dvbSegmentDisplayDefinition = 0x14
+	dvbSegmentEndOfDisplaySet   = 0x80
+
+	dvbDefaultDisplayWidth  = 720
+	dvbDefaultDisplayHeight = 576
+)
+
+// dvbDecoder decodes DVB subtitles (ETSI EN 300 743).
+type dvbDecoder struct {
+	displaySize image.Point
+	cluts       map[uint8]*dvbCLUT
+	regions     map[uint8]*dvbRegion
+	page        *dvbPage
+	pagePTS     time.Duration
+}
+
+type dvbCLUT struct {
+	Colors2 [4]color.RGBA
+	Colors4 [16]color.RGBA
+	Colors8 [256]color.RGBA
+}
+
+type dvbPage struct {
+	Timeout time.Duration
+	Regions []dvbPageRegion
+}
+
+type dvbPageRegion struct {
+	RegionID uint8
+	X        int
+	Y        int
+}
+
+type dvbRegion struct {
+	Width   int
+	Height  int
+	Depth   int
+	CLUTID  uint8
+	Pixels  []uint8
+	Objects []dvbRegionObject
+}
+
+type dvbRegionObject struct {
+	ObjectID uint16
+	X        int
+	Y        int
+}
+
+var _ StreamDecoder = (*dvbDecoder)(nil)
+
+func newDVBDecoder() *dvbDecoder {
+	return &dvbDecoder{
+		displaySize: image.Pt(dvbDefaultDisplayWidth, dvbDefaultDisplayHeight),
+		cluts:       map[uint8]*dvbCLUT{},
+		regions:     map[uint8]*dvbRegion{},
+	}
+}
+
+func (d *dvbDecoder) Decode(
+	data []byte,
+	pts time.Duration,
+	_ time.Duration,
+) ([]Cue, error) {
+	// data_identifier and subtitle_stream_id
+	if len(data) >= 2 && data[0] == 0x20 && data[1] == 0x00 {
+		data = data[2:]
+	}
+
+	var result []Cue
+	for len(data) >= 6 && data[0] == 0x0F {
+		segmentType := data[1]
+		segmentSize := int(binary.BigEndian.Uint16(data[4:]))
+		if 6+segmentSize > len(data) {
+			return result, fmt.Errorf("truncated segment 0x%02X: %d > %d", segmentType, 6+segmentSize, len(data))
+		}
+		segment := data[6 : 6+segmentSize]
+		data = data[6+segmentSize:]
+
+		var err error
+		switch segmentType {
+		case dvbSegmentPage:
+			err = d.parsePage(segment)
+			d.pagePTS = pts
+		case dvbSegmentRegion:
+			err = d.parseRegion(segment)
+		case dvbSegmentCLUT:
+			err = d.parseCLUT(segment)
+		case dvbSegmentObject:
+			err = d.parseObject(segment)
+		case dvbSegmentDisplayDefinition:
+			err = d.parseDisplayDefinition(segment)
+		case dvbSegmentEndOfDisplaySet:
+			if cue := d.compose(); cue != nil {
+				result = append(result, *cue)
+			}
+		}
+		if err != nil {
+			return result, fmt.Errorf("unable to parse segment 0x%02X: %w", segmentType, err)
+		}
+	}
+	return result, nil
+}
+
+func (d *dvbDecoder) parseDisplayDefinition(b []byte) error {
+	if len(b) < 5 {
+		return fmt.Errorf("too short: %d", len(b))
+	}
+	d.displaySize = image.Pt(
+		int(binary.BigEndian.Uint16(b[1:]))+1,
+		int(binary.BigEndian.Uint16(b[3:]))+1,
+	)
+	return nil
+}
+
+func (d *dvbDecoder) parsePage(b []byte) error {
+	if len(b) < 2 {
+		return fmt.Errorf("too short: %d", len(b))
+	}
+	page := &dvbPage{
+		Timeout: time.Duration(b[0]) * time.Second,
+	}
+	pageState := (b[1] >> 2) & 0x03
+	if pageState != 0 {
+		// "acquisition point" or "mode change": the previous state is invalidated
+		d.regions = map[uint8]*dvbRegion{}
+	}
+	for b = b[2:]; len(b) >= 6; b = b[6:] {
+		page.Regions = append(page.Regions, dvbPageRegion{
+			RegionID: b[0],
+			X:        int(binary.BigEndian.Uint16(b[2:])),
+			Y:        int(binary.BigEndian.Uint16(b[4:])),
+		})
+	}
+	d.page = page
+	return nil
+}
+
+func (d *dvbDecoder) parseRegion(b []byte) error {
+	if len(b) < 10 {
+		return fmt.Errorf("too short: %d", len(b))
+	}
+	regionID := b[0]
+	isFill := b[1]&0x08 != 0
+	width := int(binary.BigEndian.Uint16(b[2:]))
+	height := int(binary.BigEndian.Uint16(b[4:]))
+	var depth int
+	switch (b[6] >> 2) & 0x07 {
+	case 1:
+		depth = 2
+	case 2:
+		depth = 4
+	default:
+		depth = 8
+	}
+	var fillCode uint8
+	switch depth {
+	case 8:
+		fillCode = b[8]
+	case 4:
+		fillCode = b[9] >> 4
+	case 2:
+		fillCode = (b[9] >> 2) & 0x03
+	}
+
+	region := d.regions[regionID]
+	if region == nil || region.Width != width || region.Height != height || region.Depth != depth {
+		region = &dvbRegion{
+			Width:  width,
+			Height: height,
+			Depth:  depth,
+			Pixels: make([]uint8, width*height),
+		}
+		d.regions[regionID] = region
+		isFill = true
+	}
+	region.CLUTID = b[7]
+	if isFill {
+		for idx := range region.Pixels {
+			region.Pixels[idx] = fillCode
+		}
+	}
+
+	region.Objects = region.Objects[:0]
+	for b = b[10:]; len(b) >= 6; {
+		objectType := b[2] >> 6
+		region.Objects = append(region.Objects, dvbRegionObject{
+			ObjectID: binary.BigEndian.Uint16(b[0:]),
+			X:        int(binary.BigEndian.Uint16(b[2:]) & 0x0FFF),
+			Y:        int(binary.BigEndian.Uint16(b[4:]) & 0x0FFF),
+		})
+		b = b[6:]
+		if objectType == 1 || objectType == 2 {
+			if len(b) < 2 {
+				break
+			}
+			b = b[2:]
+		}
+	}
+	return nil
+}
+
+func (d *dvbDecoder) getCLUT(clutID uint8) *dvbCLUT {
+	clut := d.cluts[clutID]
+	if clut == nil {
+		clut = newDefaultDVBCLUT()
+		d.cluts[clutID] = clut
+	}
+	return clut
+}
+
+func (d *dvbDecoder) parseCLUT(b []byte) error {
+	if len(b) < 2 {
+		return fmt.Errorf("too short: %d", len(b))
+	}
+	clut := d.getCLUT(b[0])
+	for b = b[2:]; len(b) >= 2; {
+		entryID := b[0]
+		flags := b[1]
+		var y, cr, cb, t uint8
+		if flags&0x01 != 0 {
+			if len(b) < 6 {
+				return fmt.Errorf("truncated full range CLUT entry")
+			}
+			y, cr, cb, t = b[2], b[3], b[4], b[5]
+			b = b[6:]
+		} else {
+			if len(b) < 4 {
+				return fmt.Errorf("truncated CLUT entry")
+			}
+			v := binary.BigEndian.Uint16(b[2:])
+			y = uint8(v>>10) << 2
+			cr = uint8((v>>6)&0x0F) << 4
+			cb = uint8((v>>2)&0x0F) << 4
+			t = uint8(v&0x03) << 6
+			b = b[4:]
+		}
+		var c color.RGBA
+		if y != 0 {
+			// Y == 0 means a fully transparent color
+			c = ycbcrToRGBA(y, cb, cr, 255-t)
+		}
+		if flags&0x80 != 0 && entryID < 4 {
+			clut.Colors2[entryID] = c
+		}
+		if flags&0x40 != 0 && entryID < 16 {
+			clut.Colors4[entryID] = c
+		}
+		if flags&0x20 != 0 {
+			clut.Colors8[entryID] = c
+		}
+	}
+	return nil
+}
+
+func (d *dvbDecoder) parseObject(b []byte) error {
+	if len(b) < 3 {
+		return fmt.Errorf("too short: %d", len(b))
+	}
+	objectID := binary.BigEndian.Uint16(b[0:])
+	codingMethod := (b[2] >> 2) & 0x03
+	if codingMethod != 0 {
+		// character-coded objects are not used in practice
+		return nil
+	}
+	if len(b) < 7 {
+		return fmt.Errorf("too short: %d", len(b))
+	}
+	topLength := int(binary.BigEndian.Uint16(b[3:]))
+	bottomLength := int(binary.BigEndian.Uint16(b[5:]))
+	b = b[7:]
+	if topLength+bottomLength > len(b) {
+		return fmt.Errorf("truncated pixel data: %d+%d > %d", topLength, bottomLength, len(b))
+	}
+	top := b[:topLength]
+	bottom := b[topLength : topLength+bottomLength]
+	if bottomLength == 0 {
+		bottom = top
+	}
+
+	for _, region := range d.regions {
+		for _, obj := range region.Objects {
+			if obj.ObjectID != objectID {
+				continue
+			}
+			region.drawField(top, obj.X, obj.Y)
+			region.drawField(bottom, obj.X, obj.Y+1)
+		}
+	}
+	return nil
+}
+
+func (d *dvbDecoder) compose() *Cue {
+	page := d.page
+	if page == nil {
+		return nil
+	}
+	d.page = nil
+
+	end := CueEndUnknown
+	if page.Timeout > 0 {
+		end = d.pagePTS + page.Timeout
+	}
+	cue := &Cue{
+		Start:      d.pagePTS,
+		End:        end,
+		CanvasSize: d.displaySize,
+	}
+	for _, pageRegion := range page.Regions {
+		region := d.regions[pageRegion.RegionID]
+		if region == nil {
+			continue
+		}
+		clut := d.getCLUT(region.CLUTID)
+		img := image.NewRGBA(image.Rect(0, 0, region.Width, region.Height))
+		isEmpty := true
+		for idx, code := range region.Pixels {
+			var c color.RGBA
+			switch region.Depth {
+			case 2:
+				c = clut.Colors2[code&0x03]
+			case 4:
+				c = clut.Colors4[code&0x0F]
+			default:
+				c = clut.Colors8[code]
+			}
+			if c.A != 0 {
+				isEmpty = false
+			}
+			img.SetRGBA(idx%region.Width, idx/region.Width, c)
+		}
+		if isEmpty {
+			continue
+		}
+		cue.Bitmaps = append(cue.Bitmaps, Bitmap{
+			Position: image.Pt(pageRegion.X, pageRegion.Y),
+			Image:    img,
+		})
+	}
+	return cue
+}
+
+// drawField decodes the pixel data of a single field of an object
+// into the region; lines are interleaved (each second line).
+func (r *dvbRegion) drawField(data []byte, x0, y0 int) {
+	var (
+		map2to4 = [4]uint8{0x0, 0x7, 0x8, 0xF}
+		map2to8 = [4]uint8{0x00, 0x77, 0x88, 0xFF}
+		map4to8 = [16]uint8{
+			0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77,
+			0x88, 0x99, 0xAA, 0xBB, 0xCC, 0xDD, 0xEE, 0xFF,
+		}
+	)
+
+	x, y := x0, y0
+	put := func(length int, code uint8, bitsPerPixel int) {
+		switch {
+		case bitsPerPixel == 2 && r.Depth == 4:
+			code = map2to4[code]
+		case bitsPerPixel == 2 && r.Depth == 8:
+			code = map2to8[code]
+		case bitsPerPixel == 4 && r.Depth == 8:
+			code = map4to8[code]
+		case bitsPerPixel > r.Depth:
+			code >>= bitsPerPixel - r.Depth
+		}
+		for ; length > 0; length-- {
+			if x >= 0 && x < r.Width && y >= 0 && y < r.Height {
+				r.Pixels[y*r.Width+x] = code
+			}
+			x++
+		}
+	}
+
+	for idx := 0; idx < len(data); {
+		dataType := data[idx]
+		idx++
+		br := &bitReader{data: data[idx:]}
+		switch dataType {
+		case 0x10:
+			decodeDVB2BitString(br, func(length int, code uint8) { put(length, code, 2) })
+		case 0x11:
+			decodeDVB4BitString(br, func(length int, code uint8) { put(length, code, 4) })
+		case 0x12:
+			decodeDVB8BitString(br, func(length int, code uint8) { put(length, code, 8) })
+		case 0x20:
+			for i := range map2to4 {
+				map2to4[i] = uint8(br.read(4))
+			}
+		case 0x21:
+			for i := range map2to8 {
+				map2to8[i] = uint8(br.read(8))
+			}
+		case 0x22:
+			for i := range map4to8 {
+				map4to8[i] = uint8(br.read(8))
+			}
+		case 0xF0:
+			x = x0
+			y += 2
+		default:
+			// unknown data type; the rest is not parsable
+			return
+		}
+		idx += br.bytesConsumed()
+	}
+}
+
+func decodeDVB2BitString(br *bitReader, put func(length int, code uint8)) {
+	for !br.isEOF() {
+		if code := br.read(2); code != 0 {
+			put(1, uint8(code))
+			continue
+		}
+		if br.read(1) == 1 {
+			length := int(br.read(3)) + 3
+			put(length, uint8(br.read(2)))
+			continue
+		}
+		if br.read(1) == 1 {
+			put(1, 0)
+			continue
+		}
+		switch br.read(2) {
+		case 0:
+			return
+		case 1:
+			put(2, 0)
+		case 2:
+			length := int(br.read(4)) + 12
+			put(length, uint8(br.read(2)))
+		case 3:
+			length := int(br.read(8)) + 29
+			put(length, uint8(br.read(2)))
+		}
+	}
+}
+
+func decodeDVB4BitString(br *bitReader, put func(length int, code uint8)) {
+	for !br.isEOF() {
+		if code := br.read(4); code != 0 {
+			put(1, uint8(code))
+			continue
+		}
+		if br.read(1) == 0 {
+			length := int(br.read(3))
+			if length == 0 {
+				return
+			}
+			put(length+2, 0)
+			continue
+		}
+		if br.read(1) == 0 {
+			length := int(br.read(2)) + 4
+			put(length, uint8(br.read(4)))
+			continue
+		}
+		switch br.read(2) {
+		case 0:
+			put(1, 0)
+		case 1:
+			put(2, 0)
+		case 2:
+			length := int(br.read(4)) + 9
+			put(length, uint8(br.read(4)))
+		case 3:
+			length := int(br.read(8)) + 25
+			put(length, uint8(br.read(4)))
+		}
+	}
+}
+
+func decodeDVB8BitString(br *bitReader, put func(length int, code uint8)) {
+	for !br.isEOF() {
+		if code := br.read(8); code != 0 {
+			put(1, uint8(code))
+			continue
+		}
+		if br.read(1) == 0 {
+			length := int(br.read(7))
+			if length == 0 {
+				return
+			}
+			put(length, 0)
+			continue
+		}
+		length := int(br.read(7))
+		put(length, uint8(br.read(8)))
+	}
+}
+
+// newDefaultDVBCLUT returns the default CLUT defined in
+// ETSI EN 300 743, section 10.
+func newDefaultDVBCLUT() *dvbCLUT {
+	clut := &dvbCLUT{}
+	clut.Colors2 = [4]color.RGBA{
+		{},
+		{R: 255, G: 255, B: 255, A: 255},
+		{R: 0, G: 0, B: 0, A: 255},
+		{R: 127, G: 127, B: 127, A: 255},
+	}
+	for idx := range clut.Colors4 {
+		if idx == 0 {
+			continue
+		}
+		v := uint8(255)
+		if idx&0x08 != 0 {
+			v = 127
+		}
+		clut.Colors4[idx] = color.RGBA{
+			R: v * uint8(idx&0x01),
+			G: v * uint8((idx>>1)&0x01),
+			B: v * uint8((idx>>2)&0x01),
+			A: 255,
+		}
+	}
+	for idx := range clut.Colors8 {
+		if idx == 0 {
+			continue
+		}
+		r := uint8(idx&0x01)*0x55 + uint8((idx>>4)&0x01)*0xAA
+		g := uint8((idx>>1)&0x01)*0x55 + uint8((idx>>5)&0x01)*0xAA
+		b := uint8((idx>>2)&0x01)*0x55 + uint8((idx>>6)&0x01)*0xAA
+		clut.Colors8[idx] = color.RGBA{R: r, G: g, B: b, A: 255}
+	}
+	return clut
+}
+
+type bitReader struct {
+	data   []byte
+	bitPos int
+}
+
+func (r *bitReader) isEOF() bool {
+	return r.bitPos >= len(r.data)*8
+}
+
+func (r *bitReader) read(bits int) uint32 {
+	var v uint32
+	for range bits {
+		v <<= 1
+		byteIdx := r.bitPos / 8
+		if byteIdx < len(r.data) {
+			v |= uint32(r.data[byteIdx]>>(7-r.bitPos%8)) & 0x01
+		}
+		r.bitPos++
+	}
+	return v
+}
+
+// bytesConsumed returns the amount of bytes read (including
+// the partially read ones).
+func (r *bitReader) bytesConsumed() int {
+	return (r.bitPos + 7) / 8
+}
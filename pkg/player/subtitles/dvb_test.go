@@ -0,0 +1,233 @@
+package subtitles
+
+import (
+	"image"
+	"image/color"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// bits converts a string like "0001 01" into bytes (padded with zeros).
+func bits(s string) []byte {
+	s = strings.ReplaceAll(s, " ", "")
+	result := make([]byte, (len(s)+7)/8)
+	for idx, c := range s {
+		if c == '1' {
+			result[idx/8] |= 0x80 >> (idx % 8)
+		}
+	}
+	return result
+}
+
+type dvbRun struct {
+	Length int
+	Code   uint8
+}
+
+func TestDecodeDVBPixelStrings(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Decode   func(*bitReader, func(int, uint8))
+		Bits     string
+		Expected []dvbRun
+	}{
+		{
+			Name:     "2bit_pixel",
+			Decode:   decodeDVB2BitString,
+			Bits:     "01 11  00 0 0 00",
+			Expected: []dvbRun{{1, 1}, {1, 3}},
+		},
+		{
+			Name:     "2bit_runs",
+			Decode:   decodeDVB2BitString,
+			Bits:     "00 1 011 10  00 0 1  00 0 0 01  00 0 0 10 0011 01  00 0 0 11 00000001 11  00 0 0 00",
+			Expected: []dvbRun{{6, 2}, {1, 0}, {2, 0}, {15, 1}, {30, 3}},
+		},
+		{
+			Name:     "4bit_pixel",
+			Decode:   decodeDVB4BitString,
+			Bits:     "0001 1111  0000 0 000",
+			Expected: []dvbRun{{1, 1}, {1, 15}},
+		},
+		{
+			Name:     "4bit_runs",
+			Decode:   decodeDVB4BitString,
+			Bits:     "0000 0 011  0000 1 0 01 0101  0000 1 1 00  0000 1 1 01  0000 1 1 10 0001 0011  0000 1 1 11 00000001 0100  0000 0 000",
+			Expected: []dvbRun{{5, 0}, {5, 5}, {1, 0}, {2, 0}, {10, 3}, {26, 4}},
+		},
+		{
+			Name:     "8bit_pixel",
+			Decode:   decodeDVB8BitString,
+			Bits:     "00000101  00000000 0 0000000",
+			Expected: []dvbRun{{1, 5}},
+		},
+		{
+			Name:     "8bit_runs",
+			Decode:   decodeDVB8BitString,
+			Bits:     "00000000 0 0000011  00000000 1 0000100 00001001  00000000 0 0000000",
+			Expected: []dvbRun{{3, 0}, {4, 9}},
+		},
+		{
+			Name:     "truncated",
+			Decode:   decodeDVB4BitString,
+			Bits:     "0010 0000 1",
+			Expected: []dvbRun{{1, 2}, {4, 0}},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			var result []dvbRun
+			tc.Decode(&bitReader{data: bits(tc.Bits)}, func(length int, code uint8) {
+				result = append(result, dvbRun{Length: length, Code: code})
+			})
+			if !reflect.DeepEqual(result, tc.Expected) {
+				t.Fatalf("expected %v, got %v", tc.Expected, result)
+			}
+		})
+	}
+}
+
+func dvbSegment(segmentType uint8, payload ...byte) []byte {
+	return append([]byte{
+		0x0F, segmentType,
+		0x00, 0x01, // page ID
+		byte(len(payload) >> 8), byte(len(payload)),
+	}, payload...)
+}
+
+// dvbDisplaySet is a display set with a single 4x2 region (of depth 4)
+// at (100, 200), each line of which is two white and two green pixels.
+func dvbDisplaySet() []byte {
+	b := []byte{0x20, 0x00}
+	b = append(b, dvbSegment(dvbSegmentPage,
+		0x05,       // timeout
+		0x08,       // version and state (mode change)
+		0x01, 0x00, // region ID
+		0x00, 0x64, // X
+		0x00, 0xC8, // Y
+	)...)
+	b = append(b, dvbSegment(dvbSegmentRegion,
+		0x01,       // region ID
+		0x08,       // version and fill flag
+		0x00, 0x04, // width
+		0x00, 0x02, // height
+		0x08,       // depth: 4 bits
+		0x00,       // CLUT ID
+		0x00, 0x00, // fill codes
+		0x00, 0x02, // object ID
+		0x00, 0x00, // type and X
+		0x00, 0x00, // Y
+	)...)
+	b = append(b, dvbSegment(dvbSegmentCLUT,
+		0x00,                               // CLUT ID
+		0x00,                               // version
+		0x01, 0x41, 0xFF, 0x80, 0x80, 0x00, // 4-bit entry 1: white (full range)
+	)...)
+	b = append(b, dvbSegment(dvbSegmentObject,
+		0x00, 0x02, // object ID
+		0x00,       // version and coding method
+		0x00, 0x05, // top field length
+		0x00, 0x00, // bottom field length (the same as the top)
+		0x11, 0x11, 0x22, 0x00, 0xF0,
+	)...)
+	b = append(b, dvbSegment(dvbSegmentEndOfDisplaySet)...)
+	return append(b, 0xFF)
+}
+
+func TestDVBDecoder(t *testing.T) {
+	cues, err := newDVBDecoder().Decode(dvbDisplaySet(), time.Second, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cues) != 1 {
+		t.Fatalf("expected 1 cue, got %d", len(cues))
+	}
+	cue := cues[0]
+	if cue.Start != time.Second || cue.End != 6*time.Second {
+		t.Errorf("unexpected interval: [%v, %v)", cue.Start, cue.End)
+	}
+	if cue.CanvasSize != image.Pt(dvbDefaultDisplayWidth, dvbDefaultDisplayHeight) {
+		t.Errorf("unexpected canvas size: %v", cue.CanvasSize)
+	}
+	if len(cue.Bitmaps) != 1 {
+		t.Fatalf("expected 1 bitmap, got %d", len(cue.Bitmaps))
+	}
+	bitmap := cue.Bitmaps[0]
+	if bitmap.Position != image.Pt(100, 200) {
+		t.Errorf("unexpected position: %v", bitmap.Position)
+	}
+	if bitmap.Image.Bounds() != image.Rect(0, 0, 4, 2) {
+		t.Fatalf("unexpected bounds: %v", bitmap.Image.Bounds())
+	}
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	green := color.RGBA{G: 255, A: 255}
+	for y := range 2 {
+		for x, expected := range []color.RGBA{white, white, green, green} {
+			if c := bitmap.Image.RGBAAt(x, y); c != expected {
+				t.Errorf("(%d, %d): expected %v, got %v", x, y, expected, c)
+			}
+		}
+	}
+}
+
+func TestDVBDecoderDisplayDefinition(t *testing.T) {
+	d := newDVBDecoder()
+	data := append(dvbSegment(dvbSegmentDisplayDefinition,
+		0x00,       // version
+		0x07, 0x7F, // width-1
+		0x04, 0x37, // height-1
+	), dvbSegment(dvbSegmentPage, 0x00, 0x08)...)
+	data = append(data, dvbSegment(dvbSegmentEndOfDisplaySet)...)
+	cues, err := d.Decode(data, time.Second, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cues) != 1 {
+		t.Fatalf("expected 1 cue, got %d", len(cues))
+	}
+	if cues[0].CanvasSize != image.Pt(1920, 1080) {
+		t.Errorf("unexpected canvas size: %v", cues[0].CanvasSize)
+	}
+	if cues[0].End != CueEndUnknown || !cues[0].IsEmpty() {
+		t.Errorf("expected an empty cue without a timeout, got %#+v", cues[0])
+	}
+}
+
+func TestDVBDecoderMalformed(t *testing.T) {
+	for _, tc := range []struct {
+		Name string
+		Data []byte
+	}{
+		{Name: "truncated_segment", Data: []byte{0x0F, dvbSegmentPage, 0x00, 0x01, 0x00, 0x10, 0x00}},
+		{Name: "short_page", Data: dvbSegment(dvbSegmentPage, 0x00)},
+		{Name: "short_region", Data: dvbSegment(dvbSegmentRegion, 0x00, 0x00)},
+		{Name: "short_clut", Data: dvbSegment(dvbSegmentCLUT, 0x00)},
+		{Name: "truncated_clut_entry", Data: dvbSegment(dvbSegmentCLUT, 0x00, 0x00, 0x01, 0x41, 0xFF)},
+		{Name: "short_object", Data: dvbSegment(dvbSegmentObject, 0x00, 0x00, 0x00, 0x00)},
+		{Name: "truncated_object", Data: dvbSegment(dvbSegmentObject, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0x00)},
+		{Name: "short_display_definition", Data: dvbSegment(dvbSegmentDisplayDefinition, 0x00)},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if _, err := newDVBDecoder().Decode(tc.Data, 0, 0); err == nil {
+				t.Fatalf("expected an error")
+			}
+		})
+	}
+}
+
+// TestDVBDecoderCorrupted checks that truncated or corrupted display sets
+// never cause a panic.
+func TestDVBDecoderCorrupted(t *testing.T) {
+	data := dvbDisplaySet()
+	for size := range len(data) {
+		newDVBDecoder().Decode(data[:size], 0, 0)
+	}
+	for idx := range data {
+		for _, v := range []byte{0x00, 0x7F, 0xFF} {
+			corrupted := append([]byte{}, data...)
+			corrupted[idx] = v
+			newDVBDecoder().Decode(corrupted, 0, 0)
+		}
+	}
+}
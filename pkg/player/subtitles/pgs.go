@@ -0,0 +1,274 @@
+package subtitles
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"time"
+)
+
+const (
+	pgsSegmentPalette      = 0x14
+	pgsSegmentObject       = 0x15
+	pgsSegmentPresentation = 0x16
+	pgsSegmentWindow       = 0x17
+	pgsSegmentEnd          = 0x80
+)
+
+// pgsDecoder decodes HDMV Presentation Graphic Stream subtitles (Blu-ray).
+type pgsDecoder struct {
+	palettes map[uint8][256]color.RGBA
+	objects  map[uint16]*pgsObject
+
+	presentation *pgsPresentation
+	startPTS     time.Duration
+}
+
+type pgsObject struct {
+	Width  int
+	Height int
+	Data   []byte
+}
+
+type pgsPresentation struct {
+	Width     int
+	Height    int
+	PaletteID uint8
+	Objects   []pgsCompositionObject
+}
+
+type pgsCompositionObject struct {
+	ObjectID uint16
+	X        int
+	Y        int
+	Crop     *image.Rectangle
+}
+
+var _ StreamDecoder = (*pgsDecoder)(nil)
+
+func newPGSDecoder() *pgsDecoder {
+	return &pgsDecoder{
+		palettes: map[uint8][256]color.RGBA{},
+		objects:  map[uint16]*pgsObject{},
+	}
+}
+
+func (d *pgsDecoder) Decode(
+	data []byte,
+	pts time.Duration,
+	_ time.Duration,
+) ([]Cue, error) {
+	var result []Cue
+	for len(data) > 0 {
+		if len(data) < 3 {
+			return result, fmt.Errorf("truncated segment header")
+		}
+		segmentType := data[0]
+		segmentSize := int(binary.BigEndian.Uint16(data[1:]))
+		if 3+segmentSize > len(data) {
+			return result, fmt.Errorf("truncated segment 0x%02X: %d > %d", segmentType, 3+segmentSize, len(data))
+		}
+		segment := data[3 : 3+segmentSize]
+		data = data[3+segmentSize:]
+
+		var err error
+		switch segmentType {
+		case pgsSegmentPalette:
+			err = d.parsePalette(segment)
+		case pgsSegmentObject:
+			err = d.parseObject(segment)
+		case pgsSegmentPresentation:
+			err = d.parsePresentation(segment)
+			d.startPTS = pts
+		case pgsSegmentWindow:
+		case pgsSegmentEnd:
+			var cue *Cue
+			cue, err = d.compose()
+			if cue != nil {
+				result = append(result, *cue)
+			}
+		default:
+			err = fmt.Errorf("unknown segment type 0x%02X", segmentType)
+		}
+		if err != nil {
+			return result, fmt.Errorf("unable to parse segment 0x%02X: %w", segmentType, err)
+		}
+	}
+	return result, nil
+}
+
+func (d *pgsDecoder) parsePresentation(b []byte) error {
+	if len(b) < 11 {
+		return fmt.Errorf("too short: %d", len(b))
+	}
+	p := &pgsPresentation{
+		Width:     int(binary.BigEndian.Uint16(b[0:])),
+		Height:    int(binary.BigEndian.Uint16(b[2:])),
+		PaletteID: b[9],
+	}
+	objectsCount := int(b[10])
+	b = b[11:]
+	for range objectsCount {
+		if len(b) < 8 {
+			return fmt.Errorf("truncated composition object")
+		}
+		obj := pgsCompositionObject{
+			ObjectID: binary.BigEndian.Uint16(b[0:]),
+			X:        int(binary.BigEndian.Uint16(b[4:])),
+			Y:        int(binary.BigEndian.Uint16(b[6:])),
+		}
+		isCropped := b[3]&0x40 != 0
+		b = b[8:]
+		if isCropped {
+			if len(b) < 8 {
+				return fmt.Errorf("truncated cropping of a composition object")
+			}
+			x := int(binary.BigEndian.Uint16(b[0:]))
+			y := int(binary.BigEndian.Uint16(b[2:]))
+			w := int(binary.BigEndian.Uint16(b[4:]))
+			h := int(binary.BigEndian.Uint16(b[6:]))
+			obj.Crop = &image.Rectangle{Min: image.Pt(x, y), Max: image.Pt(x+w, y+h)}
+			b = b[8:]
+		}
+		p.Objects = append(p.Objects, obj)
+	}
+	d.presentation = p
+	return nil
+}
+
+func (d *pgsDecoder) parsePalette(b []byte) error {
+	if len(b) < 2 {
+		return fmt.Errorf("too short: %d", len(b))
+	}
+	paletteID := b[0]
+	palette := d.palettes[paletteID]
+	for b = b[2:]; len(b) >= 5; b = b[5:] {
+		palette[b[0]] = ycbcrToRGBA(b[1], b[3], b[2], b[4])
+	}
+	d.palettes[paletteID] = palette
+	return nil
+}
+
+func (d *pgsDecoder) parseObject(b []byte) error {
+	if len(b) < 4 {
+		return fmt.Errorf("too short: %d", len(b))
+	}
+	objectID := binary.BigEndian.Uint16(b[0:])
+	isFirstFragment := b[3]&0x80 != 0
+	b = b[4:]
+	if isFirstFragment {
+		if len(b) < 7 {
+			return fmt.Errorf("the first fragment is too short: %d", len(b))
+		}
+		d.objects[objectID] = &pgsObject{
+			Width:  int(binary.BigEndian.Uint16(b[3:])),
+			Height: int(binary.BigEndian.Uint16(b[5:])),
+			Data:   append([]byte{}, b[7:]...),
+		}
+		return nil
+	}
+	obj := d.objects[objectID]
+	if obj == nil {
+		return fmt.Errorf("a continuation of an unknown object %d", objectID)
+	}
+	obj.Data = append(obj.Data, b...)
+	return nil
+}
+
+func (d *pgsDecoder) compose() (*Cue, error) {
+	p := d.presentation
+	if p == nil {
+		return nil, nil
+	}
+	d.presentation = nil
+
+	cue := &Cue{
+		Start:      d.startPTS,
+		End:        CueEndUnknown,
+		CanvasSize: image.Pt(p.Width, p.Height),
+	}
+	palette := d.palettes[p.PaletteID]
+	for _, compObj := range p.Objects {
+		obj := d.objects[compObj.ObjectID]
+		if obj == nil {
+			return nil, fmt.Errorf("unknown object %d", compObj.ObjectID)
+		}
+		img, err := decodePGSRLE(obj, &palette)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode object %d: %w", compObj.ObjectID, err)
+		}
+		pos := image.Pt(compObj.X, compObj.Y)
+		if compObj.Crop != nil {
+			img = img.SubImage(*compObj.Crop).(*image.RGBA)
+		}
+		cue.Bitmaps = append(cue.Bitmaps, Bitmap{
+			Position: pos,
+			Image:    img,
+		})
+	}
+	return cue, nil
+}
+
+func decodePGSRLE(
+	obj *pgsObject,
+	palette *[256]color.RGBA,
+) (*image.RGBA, error) {
+	img := image.NewRGBA(image.Rect(0, 0, obj.Width, obj.Height))
+	b := obj.Data
+	x, y := 0, 0
+	put := func(length int, colorIdx uint8) {
+		c := palette[colorIdx]
+		for ; length > 0 && x < obj.Width; length-- {
+			img.SetRGBA(x, y, c)
+			x++
+		}
+	}
+	for idx := 0; idx < len(b) && y < obj.Height; {
+		v := b[idx]
+		idx++
+		if v != 0 {
+			put(1, v)
+			continue
+		}
+		if idx >= len(b) {
+			break
+		}
+		flags := b[idx]
+		idx++
+		if flags == 0 {
+			x = 0
+			y++
+			continue
+		}
+		length := int(flags & 0x3F)
+		if flags&0x40 != 0 {
+			if idx >= len(b) {
+				break
+			}
+			length = length<<8 | int(b[idx])
+			idx++
+		}
+		var colorIdx uint8
+		if flags&0x80 != 0 {
+			if idx >= len(b) {
+				break
+			}
+			colorIdx = b[idx]
+			idx++
+		}
+		put(length, colorIdx)
+	}
+	return img, nil
+}
+
+// ycbcrToRGBA converts a palette entry to a premultiplied color.
+func ycbcrToRGBA(y, cb, cr, alpha uint8) color.RGBA {
+	r, g, b := color.YCbCrToRGB(y, cb, cr)
+	return color.RGBA{
+		R: uint8(uint16(r) * uint16(alpha) / 255),
+		G: uint8(uint16(g) * uint16(alpha) / 255),
+		B: uint8(uint16(b) * uint16(alpha) / 255),
+		A: alpha,
+	}
+}
@@ -0,0 +1,261 @@
+package subtitles
+
+import (
+	"image"
+	"image/color"
+	"testing"
+	"time"
+)
+
+func pgsSegment(segmentType uint8, payload ...byte) []byte {
+	return append([]byte{segmentType, byte(len(payload) >> 8), byte(len(payload))}, payload...)
+}
+
+// pgsDisplaySet is a 1920x1080 display set with a single 4x2 object at
+// (10, 20): the first line is two white and two black pixels, and
+// the second line is transparent.
+func pgsDisplaySet() []byte {
+	var b []byte
+	b = append(b, pgsSegment(pgsSegmentPresentation,
+		0x07, 0x80, // width
+		0x04, 0x38, // height
+		0x10,       // frame rate
+		0x00, 0x01, // composition number
+		0x80,       // composition state
+		0x00,       // palette update flag
+		0x03,       // palette ID
+		0x01,       // objects count
+		0x00, 0x05, // object ID
+		0x00,       // window ID
+		0x00,       // cropped flag
+		0x00, 0x0A, // X
+		0x00, 0x14, // Y
+	)...)
+	b = append(b, pgsSegment(pgsSegmentWindow,
+		0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	)...)
+	b = append(b, pgsSegment(pgsSegmentPalette,
+		0x03,                         // palette ID
+		0x00,                         // version
+		0x01, 0xFF, 0x80, 0x80, 0xFF, // white
+		0x02, 0x00, 0x80, 0x80, 0xFF, // black
+	)...)
+	b = append(b, pgsSegment(pgsSegmentObject,
+		0x00, 0x05, // object ID
+		0x00,             // version
+		0xC0,             // the first and the last fragment
+		0x00, 0x00, 0x0F, // data length
+		0x00, 0x04, // width
+		0x00, 0x02, // height
+		0x01, 0x01, 0x00, 0x82, 0x02, 0x00, 0x00,
+		0x00, 0x04, 0x00, 0x00,
+	)...)
+	b = append(b, pgsSegment(pgsSegmentEnd)...)
+	return b
+}
+
+func TestPGSDecoder(t *testing.T) {
+	d := newPGSDecoder()
+	cues, err := d.Decode(pgsDisplaySet(), time.Second, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cues) != 1 {
+		t.Fatalf("expected 1 cue, got %d", len(cues))
+	}
+	cue := cues[0]
+	if cue.Start != time.Second || cue.End != CueEndUnknown {
+		t.Errorf("unexpected interval: [%v, %v)", cue.Start, cue.End)
+	}
+	if cue.CanvasSize != image.Pt(1920, 1080) {
+		t.Errorf("unexpected canvas size: %v", cue.CanvasSize)
+	}
+	if len(cue.Bitmaps) != 1 {
+		t.Fatalf("expected 1 bitmap, got %d", len(cue.Bitmaps))
+	}
+	bitmap := cue.Bitmaps[0]
+	if bitmap.Position != image.Pt(10, 20) {
+		t.Errorf("unexpected position: %v", bitmap.Position)
+	}
+	if bitmap.Image.Bounds() != image.Rect(0, 0, 4, 2) {
+		t.Fatalf("unexpected bounds: %v", bitmap.Image.Bounds())
+	}
+	white := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	black := color.RGBA{A: 255}
+	transparent := color.RGBA{}
+	for y, line := range [][]color.RGBA{
+		{white, white, black, black},
+		{transparent, transparent, transparent, transparent},
+	} {
+		for x, expected := range line {
+			if c := bitmap.Image.RGBAAt(x, y); c != expected {
+				t.Errorf("(%d, %d): expected %v, got %v", x, y, expected, c)
+			}
+		}
+	}
+
+	// an empty presentation clears the screen
+	cues, err = d.Decode(append(pgsSegment(pgsSegmentPresentation,
+		0x07, 0x80, 0x04, 0x38, 0x10, 0x00, 0x02, 0x00, 0x00, 0x03, 0x00,
+	), pgsSegment(pgsSegmentEnd)...), 3*time.Second, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cues) != 1 || cues[0].Start != 3*time.Second || !cues[0].IsEmpty() {
+		t.Fatalf("expected an empty cue at 3s, got %#+v", cues)
+	}
+}
+
+func TestPGSDecoderMalformed(t *testing.T) {
+	for _, tc := range []struct {
+		Name string
+		Data []byte
+	}{
+		{Name: "truncated_header", Data: []byte{pgsSegmentEnd, 0x00}},
+		{Name: "truncated_segment", Data: []byte{pgsSegmentPalette, 0x00, 0x10, 0x00}},
+		{Name: "unknown_segment", Data: pgsSegment(0x42)},
+		{Name: "short_presentation", Data: pgsSegment(pgsSegmentPresentation, 0x00)},
+		{Name: "short_palette", Data: pgsSegment(pgsSegmentPalette, 0x00)},
+		{Name: "short_object", Data: pgsSegment(pgsSegmentObject, 0x00, 0x01, 0x00, 0x80, 0x00)},
+		{Name: "unknown_continuation", Data: pgsSegment(pgsSegmentObject, 0x00, 0x01, 0x00, 0x40)},
+		{
+			Name: "unknown_object",
+			Data: append(pgsSegment(pgsSegmentPresentation,
+				0x00, 0x10, 0x00, 0x10, 0x10, 0x00, 0x01, 0x80, 0x00, 0x00, 0x01,
+				0x00, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			), pgsSegment(pgsSegmentEnd)...),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if _, err := newPGSDecoder().Decode(tc.Data, 0, 0); err == nil {
+				t.Fatalf("expected an error")
+			}
+		})
+	}
+}
+
+// TestPGSDecoderCorrupted checks that truncated or corrupted display sets
+// never cause a panic.
+func TestPGSDecoderCorrupted(t *testing.T) {
+	data := pgsDisplaySet()
+	for size := range len(data) {
+		newPGSDecoder().Decode(data[:size], 0, 0)
+	}
+	for idx := range data {
+		for _, v := range []byte{0x00, 0x7F, 0xFF} {
+			corrupted := append([]byte{}, data...)
+			corrupted[idx] = v
+			newPGSDecoder().Decode(corrupted, 0, 0)
+		}
+	}
+}
+
+func TestDecodePGSRLE(t *testing.T) {
+	var palette [256]color.RGBA
+	for idx := 1; idx < len(palette); idx++ {
+		palette[idx] = color.RGBA{R: uint8(idx), A: 255}
+	}
+	for _, tc := range []struct {
+		Name     string
+		Width    int
+		Height   int
+		Data     []byte
+		Expected [][]uint8
+	}{
+		{
+			Name:     "single_pixels",
+			Width:    2,
+			Height:   1,
+			Data:     []byte{0x01, 0x02},
+			Expected: [][]uint8{{1, 2}},
+		},
+		{
+			Name:     "short_transparent_run",
+			Width:    4,
+			Height:   1,
+			Data:     []byte{0x00, 0x03, 0x07},
+			Expected: [][]uint8{{0, 0, 0, 7}},
+		},
+		{
+			Name:     "long_transparent_run",
+			Width:    5,
+			Height:   1,
+			Data:     []byte{0x00, 0x40, 0x04, 0x07},
+			Expected: [][]uint8{{0, 0, 0, 0, 7}},
+		},
+		{
+			Name:     "short_color_run",
+			Width:    3,
+			Height:   1,
+			Data:     []byte{0x00, 0x83, 0x09},
+			Expected: [][]uint8{{9, 9, 9}},
+		},
+		{
+			Name:     "long_color_run",
+			Width:    5,
+			Height:   1,
+			Data:     []byte{0x00, 0xC0, 0x05, 0x09},
+			Expected: [][]uint8{{9, 9, 9, 9, 9}},
+		},
+		{
+			Name:     "end_of_line",
+			Width:    2,
+			Height:   2,
+			Data:     []byte{0x01, 0x00, 0x00, 0x02, 0x03},
+			Expected: [][]uint8{{1, 0}, {2, 3}},
+		},
+		{
+			Name:     "run_overflowing_the_line",
+			Width:    2,
+			Height:   1,
+			Data:     []byte{0x00, 0x85, 0x01},
+			Expected: [][]uint8{{1, 1}},
+		},
+		{
+			Name:     "too_many_lines",
+			Width:    1,
+			Height:   1,
+			Data:     []byte{0x01, 0x00, 0x00, 0x02, 0x00, 0x00},
+			Expected: [][]uint8{{1}},
+		},
+		{
+			Name:     "truncated_flags",
+			Width:    2,
+			Height:   1,
+			Data:     []byte{0x01, 0x00},
+			Expected: [][]uint8{{1, 0}},
+		},
+		{
+			Name:     "truncated_length",
+			Width:    2,
+			Height:   1,
+			Data:     []byte{0x01, 0x00, 0x40},
+			Expected: [][]uint8{{1, 0}},
+		},
+		{
+			Name:     "truncated_color",
+			Width:    2,
+			Height:   1,
+			Data:     []byte{0x01, 0x00, 0x81},
+			Expected: [][]uint8{{1, 0}},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			img, err := decodePGSRLE(&pgsObject{
+				Width:  tc.Width,
+				Height: tc.Height,
+				Data:   tc.Data,
+			}, &palette)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for y, line := range tc.Expected {
+				for x, expected := range line {
+					if c := img.RGBAAt(x, y); c != palette[expected] {
+						t.Errorf("(%d, %d): expected %v, got %v", x, y, palette[expected], c)
+					}
+				}
+			}
+		})
+	}
+}
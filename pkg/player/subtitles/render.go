@@ -0,0 +1,199 @@
+package subtitles
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	fontSizeDivider     = 18
+	fontSizeMin         = 12
+	bottomMarginDivider = 20
+)
+
+// Renderer composites cues onto pictures.
+//
+// It is not thread-safe.
+type Renderer struct {
+	font       *opentype.Font
+	face       font.Face
+	faceSize   int
+	rgbaBuffer *image.RGBA
+}
+
+func NewRenderer() (*Renderer, error) {
+	f, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the font: %w", err)
+	}
+	return &Renderer{
+		font: f,
+	}, nil
+}
+
+// Render draws the cues over a copy of the picture and returns the copy;
+// the picture itself is never modified. The copy is an internal RGBA
+// buffer, which is reused by the next Render.
+func (r *Renderer) Render(
+	img image.Image,
+	cues []Cue,
+) (image.Image, error) {
+	if len(cues) == 0 {
+		return img, nil
+	}
+
+	bounds := img.Bounds()
+	if r.rgbaBuffer == nil || r.rgbaBuffer.Bounds() != bounds {
+		r.rgbaBuffer = image.NewRGBA(bounds)
+	}
+	draw.Draw(r.rgbaBuffer, bounds, img, bounds.Min, draw.Src)
+	dst := r.rgbaBuffer
+
+	var lines []string
+	for _, cue := range cues {
+		for _, bitmap := range cue.Bitmaps {
+			r.drawBitmap(dst, bitmap, cue.CanvasSize)
+		}
+		if cue.Text != "" {
+			lines = append(lines, strings.Split(cue.Text, "\n")...)
+		}
+	}
+	if len(lines) > 0 {
+		if err := r.drawText(dst, lines); err != nil {
+			return img, fmt.Errorf("unable to draw the text: %w", err)
+		}
+	}
+	return dst, nil
+}
+
+func (r *Renderer) drawBitmap(
+	dst draw.Image,
+	bitmap Bitmap,
+	canvasSize image.Point,
+) {
+	dstBounds := dst.Bounds()
+	if canvasSize.X <= 0 || canvasSize.Y <= 0 {
+		canvasSize = dstBounds.Size()
+	}
+	scaleX := float64(dstBounds.Dx()) / float64(canvasSize.X)
+	scaleY := float64(dstBounds.Dy()) / float64(canvasSize.Y)
+
+	srcBounds := bitmap.Image.Bounds()
+	scale := func(p image.Point) image.Point {
+		return image.Pt(
+			dstBounds.Min.X+int(float64(p.X)*scaleX),
+			dstBounds.Min.Y+int(float64(p.Y)*scaleY),
+		)
+	}
+	dstRect := image.Rectangle{
+		Min: scale(bitmap.Position),
+		Max: scale(bitmap.Position.Add(srcBounds.Size())),
+	}
+	if dstRect.Size() == srcBounds.Size() {
+		draw.Draw(dst, dstRect, bitmap.Image, srcBounds.Min, draw.Over)
+		return
+	}
+	xdraw.ApproxBiLinear.Scale(dst, dstRect, bitmap.Image, srcBounds, draw.Over, nil)
+}
+
+func (r *Renderer) getFace(height int) (font.Face, error) {
+	size := max(height/fontSizeDivider, fontSizeMin)
+	if r.face != nil && r.faceSize == size {
+		return r.face, nil
+	}
+	face, err := opentype.NewFace(r.font, &opentype.FaceOptions{
+		Size:    float64(size),
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create a font face of size %d: %w", size, err)
+	}
+	if r.face != nil {
+		r.face.Close()
+	}
+	r.face = face
+	r.faceSize = size
+	return face, nil
+}
+
+func (r *Renderer) drawText(
+	dst draw.Image,
+	lines []string,
+) error {
+	bounds := dst.Bounds()
+	face, err := r.getFace(bounds.Dy())
+	if err != nil {
+		return err
+	}
+	drawer := &font.Drawer{
+		Dst:  dst,
+		Face: face,
+	}
+
+	maxWidth := fixed.I(bounds.Dx() * 9 / 10)
+	var wrapped []string
+	for _, line := range lines {
+		wrapped = append(wrapped, wrapLine(drawer, line, maxWidth)...)
+	}
+
+	metrics := face.Metrics()
+	lineHeight := metrics.Height.Ceil()
+	outline := max(r.faceSize/16, 1)
+	baseline := bounds.Max.Y - bounds.Dy()/bottomMarginDivider - metrics.Descent.Ceil()
+	baseline -= (len(wrapped) - 1) * lineHeight
+	for _, line := range wrapped {
+		width := drawer.MeasureString(line)
+		x := fixed.I(bounds.Min.X) + (fixed.I(bounds.Dx())-width)/2
+		y := fixed.I(baseline)
+
+		drawer.Src = image.NewUniform(color.Black)
+		for dy := -outline; dy <= outline; dy += outline {
+			for dx := -outline; dx <= outline; dx += outline {
+				if dx == 0 && dy == 0 {
+					continue
+				}
+				drawer.Dot = fixed.Point26_6{X: x + fixed.I(dx), Y: y + fixed.I(dy)}
+				drawer.DrawString(line)
+			}
+		}
+		drawer.Src = image.NewUniform(color.White)
+		drawer.Dot = fixed.Point26_6{X: x, Y: y}
+		drawer.DrawString(line)
+
+		baseline += lineHeight
+	}
+	return nil
+}
+
+func wrapLine(
+	drawer *font.Drawer,
+	line string,
+	maxWidth fixed.Int26_6,
+) []string {
+	words := strings.Fields(line)
+	if len(words) == 0 {
+		return nil
+	}
+	var result []string
+	cur := words[0]
+	for _, word := range words[1:] {
+		candidate := cur + " " + word
+		if drawer.MeasureString(candidate) > maxWidth {
+			result = append(result, cur)
+			cur = word
+			continue
+		}
+		cur = candidate
+	}
+	return append(result, cur)
+}
@@ -0,0 +1,118 @@
+package subtitles
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Codec int
+
+const (
+	CodecUndefined = Codec(iota)
+	CodecText
+	CodecSubRip
+	CodecASS
+	CodecWebVTT
+	CodecMovText
+	CodecPGS
+	CodecDVB
+)
+
+func (c Codec) String() string {
+	switch c {
+	case CodecUndefined:
+		return "undefined"
+	case CodecText:
+		return "text"
+	case CodecSubRip:
+		return "subrip"
+	case CodecASS:
+		return "ass"
+	case CodecWebVTT:
+		return "webvtt"
+	case CodecMovText:
+		return "mov_text"
+	case CodecPGS:
+		return "hdmv_pgs_subtitle"
+	case CodecDVB:
+		return "dvb_subtitle"
+	default:
+		return fmt.Sprintf("unknown_codec_%d", int(c))
+	}
+}
+
+// IsBitmap returns true if each cue of the codec replaces the whole
+// screen (see Track.Exclusive).
+func (c Codec) IsBitmap() bool {
+	return c == CodecPGS || c == CodecDVB
+}
+
+// StreamDecoder decodes the packets of an embedded subtitles stream.
+type StreamDecoder interface {
+	// Decode decodes a packet; duration is zero if it is unknown.
+	Decode(data []byte, pts time.Duration, duration time.Duration) ([]Cue, error)
+}
+
+func NewStreamDecoder(
+	codec Codec,
+) (StreamDecoder, error) {
+	switch codec {
+	case CodecText, CodecSubRip, CodecASS, CodecWebVTT, CodecMovText:
+		return textDecoder{Codec: codec}, nil
+	case CodecPGS:
+		return newPGSDecoder(), nil
+	case CodecDVB:
+		return newDVBDecoder(), nil
+	default:
+		return nil, fmt.Errorf("subtitles codec %s is not supported", codec)
+	}
+}
+
+type textDecoder struct {
+	Codec Codec
+}
+
+func (d textDecoder) Decode(
+	data []byte,
+	pts time.Duration,
+	duration time.Duration,
+) ([]Cue, error) {
+	var text string
+	switch d.Codec {
+	case CodecText:
+		text = strings.TrimSpace(string(data))
+	case CodecSubRip:
+		text = CleanSRT(string(data))
+	case CodecWebVTT:
+		text = CleanWebVTT(string(data))
+	case CodecASS:
+		// the packets are "ReadOrder,Layer,Style,Name,MarginL,MarginR,MarginV,Effect,Text"
+		fields := strings.SplitN(string(data), ",", 9)
+		if len(fields) != 9 {
+			return nil, fmt.Errorf("invalid ASS packet: expected 9 fields, but got %d", len(fields))
+		}
+		text = CleanASS(fields[8])
+	case CodecMovText:
+		// the packets are a 16-bit length, the text and optional style boxes
+		if len(data) < 2 {
+			return nil, fmt.Errorf("the mov_text packet is too short: %d", len(data))
+		}
+		textLen := int(binary.BigEndian.Uint16(data))
+		if 2+textLen > len(data) {
+			return nil, fmt.Errorf("invalid mov_text packet: the text length %d exceeds the packet size %d", textLen, len(data))
+		}
+		text = strings.TrimSpace(string(data[2 : 2+textLen]))
+	}
+
+	end := CueEndUnknown
+	if duration > 0 {
+		end = pts + duration
+	}
+	return []Cue{{
+		Start: pts,
+		End:   end,
+		Text:  text,
+	}}, nil
+}
@@ -0,0 +1,248 @@
+package subtitles
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Format string
+
+const (
+	FormatUndefined = Format("")
+	FormatSRT       = Format("srt")
+	FormatWebVTT    = Format("webvtt")
+	FormatASS       = Format("ass")
+)
+
+func FormatFromPath(path string) Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".srt":
+		return FormatSRT
+	case ".vtt":
+		return FormatWebVTT
+	case ".ass", ".ssa":
+		return FormatASS
+	}
+	return FormatUndefined
+}
+
+// ParseFile parses a subtitles file; the format is detected by
+// the extension of the file.
+func ParseFile(path string) (*Track, error) {
+	format := FormatFromPath(path)
+	if format == FormatUndefined {
+		return nil, fmt.Errorf("unable to detect the subtitles format of '%s'", path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open '%s': %w", path, err)
+	}
+	defer f.Close()
+	cues, err := Parse(f, format)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse '%s': %w", path, err)
+	}
+	return NewTrack(cues), nil
+}
+
+func Parse(r io.Reader, format Format) ([]Cue, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read: %w", err)
+	}
+	b = bytes.TrimPrefix(b, []byte("\xef\xbb\xbf"))
+	b = bytes.ReplaceAll(b, []byte("\r\n"), []byte("\n"))
+	switch format {
+	case FormatSRT:
+		return parseTimedBlocks(string(b), false)
+	case FormatWebVTT:
+		return parseTimedBlocks(string(b), true)
+	case FormatASS:
+		return parseASS(string(b))
+	default:
+		return nil, fmt.Errorf("unknown subtitles format '%s'", format)
+	}
+}
+
+// parseTimedBlocks parses SubRip and WebVTT files: both are sequences of
+// blank-line-separated blocks with a "start --> end" line.
+func parseTimedBlocks(s string, isWebVTT bool) ([]Cue, error) {
+	var result []Cue
+	for _, block := range strings.Split(s, "\n\n") {
+		lines := strings.Split(strings.Trim(block, "\n"), "\n")
+		timingIdx := -1
+		for idx, line := range lines {
+			if strings.Contains(line, "-->") {
+				timingIdx = idx
+				break
+			}
+		}
+		if timingIdx < 0 {
+			// a header, a NOTE, a STYLE or just a garbage
+			continue
+		}
+		start, end, err := parseTimingLine(lines[timingIdx])
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse line '%s': %w", lines[timingIdx], err)
+		}
+		text := strings.Join(lines[timingIdx+1:], "\n")
+		if isWebVTT {
+			text = CleanWebVTT(text)
+		} else {
+			text = CleanSRT(text)
+		}
+		result = append(result, Cue{
+			Start: start,
+			End:   end,
+			Text:  text,
+		})
+	}
+	return result, nil
+}
+
+func parseTimingLine(line string) (time.Duration, time.Duration, error) {
+	startStr, endStr, _ := strings.Cut(line, "-->")
+	start, err := parseTimestamp(strings.TrimSpace(startStr))
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to parse the start: %w", err)
+	}
+	// WebVTT may have cue settings after the end timestamp
+	endFields := strings.Fields(endStr)
+	if len(endFields) == 0 {
+		return 0, 0, fmt.Errorf("the end is not set")
+	}
+	end, err := parseTimestamp(endFields[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to parse the end: %w", err)
+	}
+	return start, end, nil
+}
+
+// parseTimestamp parses "[hh:]mm:ss[.,]fff" (SubRip, WebVTT) and
+// "h:mm:ss.cc" (ASS).
+func parseTimestamp(s string) (time.Duration, error) {
+	parts := strings.Split(strings.ReplaceAll(s, ",", "."), ":")
+	var hours, minutes uint64
+	var err error
+	switch len(parts) {
+	case 2:
+		minutes, err = strconv.ParseUint(parts[0], 10, 64)
+	case 3:
+		hours, err = strconv.ParseUint(parts[0], 10, 64)
+		if err == nil {
+			minutes, err = strconv.ParseUint(parts[1], 10, 64)
+		}
+	default:
+		return 0, fmt.Errorf("invalid timestamp '%s'", s)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp '%s': %w", s, err)
+	}
+	seconds, err := strconv.ParseFloat(parts[len(parts)-1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp '%s': %w", s, err)
+	}
+	return time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute +
+		time.Duration(seconds*float64(time.Second)), nil
+}
+
+func parseASS(s string) ([]Cue, error) {
+	var result []Cue
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	scanner.Buffer(nil, 1<<20)
+	var section string
+	var format []string
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.ToLower(line)
+			continue
+		}
+		if section != "[events]" {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch key {
+		case "Format":
+			format = strings.Split(value, ",")
+			for idx := range format {
+				format[idx] = strings.TrimSpace(format[idx])
+			}
+		case "Dialogue":
+			if format == nil {
+				return nil, fmt.Errorf("a 'Dialogue' line before the 'Format' line")
+			}
+			fields := strings.SplitN(value, ",", len(format))
+			if len(fields) != len(format) {
+				return nil, fmt.Errorf("invalid line '%s': expected %d fields, but got %d", line, len(format), len(fields))
+			}
+			var cue Cue
+			for idx, name := range format {
+				var err error
+				switch name {
+				case "Start":
+					cue.Start, err = parseTimestamp(strings.TrimSpace(fields[idx]))
+				case "End":
+					cue.End, err = parseTimestamp(strings.TrimSpace(fields[idx]))
+				case "Text":
+					cue.Text = CleanASS(fields[idx])
+				}
+				if err != nil {
+					return nil, fmt.Errorf("invalid line '%s': %w", line, err)
+				}
+			}
+			result = append(result, cue)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+var (
+	assOverrideRegexp = regexp.MustCompile(`\{[^}]*\}`)
+	htmlTagRegexp     = regexp.MustCompile(`</?[a-zA-Z][^>]*>`)
+	htmlEntities      = strings.NewReplacer(
+		"&amp;", "&",
+		"&lt;", "<",
+		"&gt;", ">",
+		"&nbsp;", " ",
+		"&lrm;", "",
+		"&rlm;", "",
+	)
+)
+
+// CleanASS converts the text of an ASS event into a plain text.
+func CleanASS(s string) string {
+	s = assOverrideRegexp.ReplaceAllString(s, "")
+	s = strings.NewReplacer(`\N`, "\n", `\n`, "\n", `\h`, " ").Replace(s)
+	return strings.TrimSpace(s)
+}
+
+// CleanSRT converts the text of a SubRip cue into a plain text.
+func CleanSRT(s string) string {
+	s = htmlTagRegexp.ReplaceAllString(s, "")
+	s = assOverrideRegexp.ReplaceAllString(s, "")
+	return strings.TrimSpace(s)
+}
+
+// CleanWebVTT converts the text of a WebVTT cue into a plain text.
+func CleanWebVTT(s string) string {
+	s = htmlTagRegexp.ReplaceAllString(s, "")
+	s = htmlEntities.Replace(s)
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,234 @@
+package subtitles
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	for _, tc := range []struct {
+		Input    string
+		Expected time.Duration
+		IsError  bool
+	}{
+		{Input: "00:00:01,000", Expected: time.Second},
+		{Input: "01:02:03.250", Expected: time.Hour + 2*time.Minute + 3250*time.Millisecond},
+		{Input: "02:03.5", Expected: 2*time.Minute + 3500*time.Millisecond},
+		{Input: "0:00:01.50", Expected: 1500 * time.Millisecond},
+		{Input: "", IsError: true},
+		{Input: "1", IsError: true},
+		{Input: "a:b", IsError: true},
+		{Input: "00:xx:01", IsError: true},
+		{Input: "00:00:xx", IsError: true},
+		{Input: "1:2:3:4", IsError: true},
+	} {
+		t.Run(tc.Input, func(t *testing.T) {
+			result, err := parseTimestamp(tc.Input)
+			if tc.IsError {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != tc.Expected {
+				t.Fatalf("expected %v, got %v", tc.Expected, result)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Format   Format
+		Input    string
+		Expected []Cue
+		IsError  bool
+	}{
+		{
+			Name:   "srt",
+			Format: FormatSRT,
+			Input: "\xef\xbb\xbf1\r\n00:00:01,000 --> 00:00:02,500\r\n<i>Hello</i>\r\nworld\r\n\r\n" +
+				"2\n00:01:00.000 --> 01:00:00,000\n{\\an8}Top\n",
+			Expected: []Cue{
+				{Start: time.Second, End: 2500 * time.Millisecond, Text: "Hello\nworld"},
+				{Start: time.Minute, End: time.Hour, Text: "Top"},
+			},
+		},
+		{
+			Name:    "srt_invalid_start",
+			Format:  FormatSRT,
+			Input:   "1\n00:00:xx,000 --> 00:00:02,000\nHello\n",
+			IsError: true,
+		},
+		{
+			Name:    "srt_no_end",
+			Format:  FormatSRT,
+			Input:   "1\n00:00:01,000 -->\nHello\n",
+			IsError: true,
+		},
+		{
+			Name:     "srt_garbage",
+			Format:   FormatSRT,
+			Input:    "some\ngarbage\n\nwithout timings",
+			Expected: nil,
+		},
+		{
+			Name:   "webvtt",
+			Format: FormatWebVTT,
+			Input: "WEBVTT\n\nNOTE a comment\n\n" +
+				"intro\n00:01.000 --> 00:02.000 align:start position:10%\n<v Bob>Hi &amp; bye</v>\n",
+			Expected: []Cue{
+				{Start: time.Second, End: 2 * time.Second, Text: "Hi & bye"},
+			},
+		},
+		{
+			Name:   "ass",
+			Format: FormatASS,
+			Input: "[Script Info]\nTitle: x\n\n[Events]\n" +
+				"Format: Layer, Start, End, Style, Name, MarginL, MarginR, MarginV, Effect, Text\n" +
+				"Dialogue: 0,0:00:01.50,0:00:03.00,Default,,0,0,0,,{\\b1}Hello\\Nworld, again\n" +
+				"Comment: 0,0:00:04.00,0:00:05.00,Default,,0,0,0,,ignored\n",
+			Expected: []Cue{
+				{Start: 1500 * time.Millisecond, End: 3 * time.Second, Text: "Hello\nworld, again"},
+			},
+		},
+		{
+			Name:    "ass_dialogue_before_format",
+			Format:  FormatASS,
+			Input:   "[Events]\nDialogue: 0,0:00:01.50,0:00:03.00,Default,,0,0,0,,Hello\n",
+			IsError: true,
+		},
+		{
+			Name:   "ass_too_few_fields",
+			Format: FormatASS,
+			Input: "[Events]\nFormat: Layer, Start, End, Style, Name, MarginL, MarginR, MarginV, Effect, Text\n" +
+				"Dialogue: 0,0:00:01.50\n",
+			IsError: true,
+		},
+		{
+			Name:   "ass_invalid_timestamp",
+			Format: FormatASS,
+			Input: "[Events]\nFormat: Start, End, Text\n" +
+				"Dialogue: 0:00:xx.50,0:00:03.00,Hello\n",
+			IsError: true,
+		},
+		{
+			Name:    "unknown_format",
+			Format:  FormatUndefined,
+			Input:   "",
+			IsError: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			result, err := Parse(strings.NewReader(tc.Input), tc.Format)
+			if tc.IsError {
+				if err == nil {
+					t.Fatalf("expected an error, got %#+v", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, tc.Expected) {
+				t.Fatalf("expected %#+v, got %#+v", tc.Expected, result)
+			}
+		})
+	}
+}
+
+func TestFormatFromPath(t *testing.T) {
+	for path, expected := range map[string]Format{
+		"a.srt":        FormatSRT,
+		"/x/B.VTT":     FormatWebVTT,
+		"c.ass":        FormatASS,
+		"d.ssa":        FormatASS,
+		"e.txt":        FormatUndefined,
+		"no_extension": FormatUndefined,
+	} {
+		if result := FormatFromPath(path); result != expected {
+			t.Errorf("%s: expected '%s', got '%s'", path, expected, result)
+		}
+	}
+}
+
+func TestTextDecoder(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Codec    Codec
+		Data     []byte
+		Duration time.Duration
+		Expected Cue
+		IsError  bool
+	}{
+		{
+			Name:     "text",
+			Codec:    CodecText,
+			Data:     []byte(" Hello \n"),
+			Expected: Cue{Start: time.Second, End: CueEndUnknown, Text: "Hello"},
+		},
+		{
+			Name:     "subrip",
+			Codec:    CodecSubRip,
+			Data:     []byte("<b>Hello</b>"),
+			Duration: time.Second,
+			Expected: Cue{Start: time.Second, End: 2 * time.Second, Text: "Hello"},
+		},
+		{
+			Name:     "ass",
+			Codec:    CodecASS,
+			Data:     []byte("1,0,Default,,0,0,0,,{\\i1}Hello,\\Nworld"),
+			Expected: Cue{Start: time.Second, End: CueEndUnknown, Text: "Hello,\nworld"},
+		},
+		{
+			Name:    "ass_too_few_fields",
+			Codec:   CodecASS,
+			Data:    []byte("1,0,Default"),
+			IsError: true,
+		},
+		{
+			Name:     "mov_text",
+			Codec:    CodecMovText,
+			Data:     []byte("\x00\x05Hello<style box>"),
+			Expected: Cue{Start: time.Second, End: CueEndUnknown, Text: "Hello"},
+		},
+		{
+			Name:    "mov_text_too_short",
+			Codec:   CodecMovText,
+			Data:    []byte("\x00"),
+			IsError: true,
+		},
+		{
+			Name:    "mov_text_truncated",
+			Codec:   CodecMovText,
+			Data:    []byte("\x00\x10Hello"),
+			IsError: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			decoder, err := NewStreamDecoder(tc.Codec)
+			if err != nil {
+				t.Fatalf("unable to initialize the decoder: %v", err)
+			}
+			result, err := decoder.Decode(tc.Data, time.Second, tc.Duration)
+			if tc.IsError {
+				if err == nil {
+					t.Fatalf("expected an error, got %#+v", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, []Cue{tc.Expected}) {
+				t.Fatalf("expected %#+v, got %#+v", []Cue{tc.Expected}, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Chapter is a chapter of the media; End is zero if it is unknown.
+type Chapter struct {
+	Title string
+	Start time.Duration
+	End   time.Duration
+}
+
+type Chapters []Chapter
+
+// IndexAt returns the index of the chapter the given position belongs to,
+// or -1 if the position is before the first chapter.
+func (s Chapters) IndexAt(pos time.Duration) int {
+	result := -1
+	for idx, chapter := range s {
+		if chapter.Start > pos {
+			break
+		}
+		result = idx
+	}
+	return result
+}
+
+// NextChapter switches the player to the chapter following the current one.
+func NextChapter(
+	ctx context.Context,
+	p Player,
+) error {
+	return switchChapter(ctx, p, 1)
+}
+
+// PreviousChapter switches the player to the chapter preceding the current one.
+func PreviousChapter(
+	ctx context.Context,
+	p Player,
+) error {
+	return switchChapter(ctx, p, -1)
+}
+
+func switchChapter(
+	ctx context.Context,
+	p Player,
+	delta int,
+) error {
+	chapters, err := p.GetChapters(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get the chapters: %w", err)
+	}
+	idx, err := p.GetChapter(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get the current chapter: %w", err)
+	}
+	idx += delta
+	if idx < 0 || idx >= len(chapters) {
+		return fmt.Errorf("%w: #%d (total: %d)", ErrNoChapter, idx, len(chapters))
+	}
+	return p.SetChapter(ctx, idx)
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	"errors"
+)
+
+// ErrUnknownLength is returned by GetLength if the media has no defined
+// length (for example, a live stream).
+var ErrUnknownLength = errors.New("the length is unknown (a live stream?)")
+
+// ErrNoChapter is returned if the requested chapter does not exist.
+var ErrNoChapter = errors.New("there is no such chapter")
@@ -0,0 +1,238 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/xsync"
+)
+
+const (
+	// EventsBufferSize is the amount of events buffered for a subscriber
+	// before the events start to be dropped.
+	EventsBufferSize = 100
+
+	// PositionEventInterval is the minimal interval between two
+	// consecutive EventPosition-s.
+	PositionEventInterval = 250 * time.Millisecond
+)
+
+type PlaybackState int
+
+const (
+	PlaybackStateUndefined = PlaybackState(iota)
+	PlaybackStateStopped
+	PlaybackStatePlaying
+	PlaybackStatePaused
+)
+
+func (s PlaybackState) String() string {
+	switch s {
+	case PlaybackStateUndefined:
+		return "undefined"
+	case PlaybackStateStopped:
+		return "stopped"
+	case PlaybackStatePlaying:
+		return "playing"
+	case PlaybackStatePaused:
+		return "paused"
+	default:
+		return fmt.Sprintf("unknown_state_%d", int(s))
+	}
+}
+
+// Event is one of the Event* structures below; not every backend
+// emits every kind of the events.
+type Event interface {
+	event()
+}
+
+type EventStateChange struct {
+	State PlaybackState
+}
+
+// EventPosition is emitted periodically during the playback; Length is
+// zero if it is unknown.
+type EventPosition struct {
+	Position time.Duration
+	Length   time.Duration
+}
+
+// EventTracksChange means the list of the tracks (or the selection of
+// them) has changed, see GetVideoTracks/GetAudioTracks/GetSubtitlesTracks.
+type EventTracksChange struct{}
+
+// EventBuffering reports the progress of the buffering in percents;
+// 100 means the buffering is finished.
+type EventBuffering struct {
+	Percent float64
+}
+
+type EventError struct {
+	Err error
+}
+
+type EventEndOfFile struct{}
+
+type EventMediaChange struct {
+	Link string
+}
+
+func (EventStateChange) event()  {}
+func (EventPosition) event()     {}
+func (EventTracksChange) event() {}
+func (EventBuffering) event()    {}
+func (EventError) event()        {}
+func (EventEndOfFile) event()    {}
+func (EventMediaChange) event()  {}
+
+// EventBroadcaster delivers the events emitted by a player to all the
+// subscribers. The zero value is ready to use.
+type EventBroadcaster struct {
+	locker         xsync.Mutex
+	subscribers    map[*eventSubscriber]struct{}
+	lastPositionAt time.Time
+}
+
+type eventSubscriber struct {
+	ch        chan Event
+	closeChan chan struct{}
+	isClosed  bool
+
+	// pending are the events waiting for a room in ch (see emit);
+	// they are delivered by the drain goroutine.
+	pending    []Event
+	isDraining bool
+}
+
+// isLosslessEvent returns true if the event should be delivered even
+// to a slow subscriber, because missing it would break the subscriber's
+// idea of what is being played.
+func isLosslessEvent(ev Event) bool {
+	switch ev.(type) {
+	case EventEndOfFile, EventMediaChange:
+		return true
+	default:
+		return false
+	}
+}
+
+// Subscribe returns a channel of the events emitted after this call; the
+// channel is closed when the context is cancelled. If the subscriber does
+// not keep up, the events are dropped, except EventEndOfFile and
+// EventMediaChange, which are queued.
+func (b *EventBroadcaster) Subscribe(
+	ctx context.Context,
+) <-chan Event {
+	sub := &eventSubscriber{
+		ch:        make(chan Event, EventsBufferSize),
+		closeChan: make(chan struct{}),
+	}
+	b.locker.Do(ctx, func() {
+		if b.subscribers == nil {
+			b.subscribers = map[*eventSubscriber]struct{}{}
+		}
+		b.subscribers[sub] = struct{}{}
+	})
+	observability.Go(ctx, func(ctx context.Context) {
+		<-ctx.Done()
+		b.locker.Do(context.Background(), func() {
+			delete(b.subscribers, sub)
+			sub.isClosed = true
+			close(sub.closeChan)
+			if !sub.isDraining {
+				// otherwise it is closed by drain
+				close(sub.ch)
+			}
+		})
+	})
+	return sub.ch
+}
+
+func (b *EventBroadcaster) Emit(
+	ctx context.Context,
+	ev Event,
+) {
+	logger.Tracef(ctx, "Emit(ctx, %#+v)", ev)
+	b.locker.Do(ctx, func() {
+		b.emit(ctx, ev)
+	})
+}
+
+// EmitPosition emits an EventPosition unless the previous one was emitted
+// less than PositionEventInterval ago.
+func (b *EventBroadcaster) EmitPosition(
+	ctx context.Context,
+	pos time.Duration,
+	length time.Duration,
+) {
+	b.locker.Do(ctx, func() {
+		now := time.Now()
+		if now.Sub(b.lastPositionAt) < PositionEventInterval {
+			return
+		}
+		b.lastPositionAt = now
+		b.emit(ctx, EventPosition{Position: pos, Length: length})
+	})
+}
+
+func (b *EventBroadcaster) emit(
+	ctx context.Context,
+	ev Event,
+) {
+	for sub := range b.subscribers {
+		if len(sub.pending) == 0 {
+			select {
+			case sub.ch <- ev:
+				continue
+			default:
+			}
+		}
+		if !isLosslessEvent(ev) {
+			logger.Warnf(ctx, "the events subscriber is too slow, dropping %T", ev)
+			continue
+		}
+		sub.pending = append(sub.pending, ev)
+		if !sub.isDraining {
+			sub.isDraining = true
+			observability.Go(ctx, func(ctx context.Context) {
+				b.drain(ctx, sub)
+			})
+		}
+	}
+}
+
+// drain delivers the pending events of the subscriber, until there
+// are none left.
+func (b *EventBroadcaster) drain(
+	ctx context.Context,
+	sub *eventSubscriber,
+) {
+	for {
+		var ev Event
+		isDone := xsync.DoR1(context.Background(), &b.locker, func() bool {
+			if sub.isClosed || len(sub.pending) == 0 {
+				sub.isDraining = false
+				if sub.isClosed {
+					close(sub.ch)
+				}
+				return true
+			}
+			ev = sub.pending[0]
+			return false
+		})
+		if isDone {
+			return
+		}
+		select {
+		case sub.ch <- ev:
+			b.locker.Do(context.Background(), func() {
+				sub.pending = sub.pending[1:]
+			})
+		case <-sub.closeChan:
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestEventBroadcasterSlowSubscriber(t *testing.T) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelFn()
+
+	var b EventBroadcaster
+	ch := b.Subscribe(ctx)
+	for range EventsBufferSize {
+		b.Emit(ctx, EventTracksChange{})
+	}
+	b.Emit(ctx, EventBuffering{Percent: 50})
+	b.Emit(ctx, EventEndOfFile{})
+	b.Emit(ctx, EventTracksChange{})
+	b.Emit(ctx, EventMediaChange{Link: "next"})
+
+	for range EventsBufferSize {
+		if ev := <-ch; ev != (EventTracksChange{}) {
+			t.Fatalf("expected EventTracksChange, got %#+v", ev)
+		}
+	}
+	// the lossy events after the overflow are dropped, but the lossless
+	// ones are delivered in order
+	if ev := <-ch; ev != (EventEndOfFile{}) {
+		t.Fatalf("expected EventEndOfFile, got %#+v", ev)
+	}
+	if ev := <-ch; ev != (EventMediaChange{Link: "next"}) {
+		t.Fatalf("expected EventMediaChange, got %#+v", ev)
+	}
+
+	// nothing is dropped once the subscriber keeps up again
+	b.Emit(ctx, EventBuffering{Percent: 100})
+	if ev := <-ch; ev != (EventBuffering{Percent: 100}) {
+		t.Fatalf("expected EventBuffering, got %#+v", ev)
+	}
+}
+
+func TestEventBroadcasterUnsubscribe(t *testing.T) {
+	var b EventBroadcaster
+	ctx, cancelFn := context.WithCancel(context.Background())
+	ch := b.Subscribe(ctx)
+	for range EventsBufferSize + 10 {
+		b.Emit(ctx, EventEndOfFile{})
+	}
+	cancelFn()
+
+	// the channel is closed even though there are pending events
+	timeout := time.After(time.Minute)
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("the channel is not closed")
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package types
+
+// MediaInfo describes the currently opened media; a field is left empty if
+// the backend does not provide it.
+type MediaInfo struct {
+	FormatName string
+
+	// Bitrate is the overall bitrate in bits per second.
+	Bitrate int64
+
+	StreamCount int
+
+	// Tags are the container-level tags (like "title" or "artist"); the keys
+	// are lower-cased.
+	Tags map[string]string
+
+	Chapters Chapters
+}
@@ -2,9 +2,17 @@ package types
 
 import (
 	"context"
+	"image"
 	"time"
 )
 
+// Player is the common interface of all the player backends.
+//
+// The volume is a linear gain, where 1 means the original loudness and
+// 0 means silence; the mute does not change the volume.
+//
+// The chapters are indexed from 0; GetChapter returns -1 if the position
+// is before the first chapter (or there are no chapters).
 type Player interface {
 	ProcessTitle(ctx context.Context) (string, error)
 	OpenURL(ctx context.Context, link string) error
@@ -18,16 +26,44 @@ type Player interface {
 	SetSpeed(ctx context.Context, speed float64) error
 	GetPause(ctx context.Context) (bool, error)
 	SetPause(ctx context.Context, pause bool) error
+	GetVolume(ctx context.Context) (float64, error)
+	SetVolume(ctx context.Context, volume float64) error
+	GetMute(ctx context.Context) (bool, error)
+	SetMute(ctx context.Context, mute bool) error
 	Seek(ctx context.Context, pos time.Duration, isRelative bool, quick bool) error
+	FrameStep(ctx context.Context, forward bool) error
+
+	// Screenshot returns the current video frame (without the subtitles).
+	Screenshot(ctx context.Context) (image.Image, error)
+
 	GetVideoTracks(ctx context.Context) (VideoTracks, error)
 	GetAudioTracks(ctx context.Context) (AudioTracks, error)
 	GetSubtitlesTracks(ctx context.Context) (SubtitlesTracks, error)
 	SetVideoTrack(ctx context.Context, vid int64) error
 	SetAudioTrack(ctx context.Context, aid int64) error
 	SetSubtitlesTrack(ctx context.Context, sid int64) error
+	GetMediaInfo(ctx context.Context) (*MediaInfo, error)
+	GetChapters(ctx context.Context) (Chapters, error)
+	GetChapter(ctx context.Context) (int, error)
+	SetChapter(ctx context.Context, idx int) error
 	Stop(ctx context.Context) error
 	Close(ctx context.Context) error
 	SetupForStreaming(ctx context.Context) error
+	Events(ctx context.Context) (<-chan Event, error)
+}
+
+// SubtitlesFileLoader is implemented by the players, which could load
+// external subtitles files.
+type SubtitlesFileLoader interface {
+	LoadSubtitlesFile(ctx context.Context, path string) error
+}
+
+// NextURLPreloader is implemented by the players, which could prepare
+// the next media in advance and switch to it without a gap when the
+// current one ends (emitting EventMediaChange instead of EventEndOfFile).
+// An empty link cancels the preloading.
+type NextURLPreloader interface {
+	PreloadNextURL(ctx context.Context, link string) error
 }
 
 type PlayerCommon struct {
@@ -44,9 +80,23 @@ func (p PlayerCommon) ProcessTitle(
 	return p.Title, nil
 }
 
+// TrackInfo is the metadata common to all kinds of tracks; a field is left
+// empty if the backend does not provide it.
+type TrackInfo struct {
+	Codec     string
+	Language  string
+	Title     string
+	IsDefault bool
+	IsForced  bool
+}
+
 type VideoTrack struct {
 	ID       int64
 	IsActive bool
+	TrackInfo
+	Width  int
+	Height int
+	FPS    float64
 }
 
 type VideoTracks []VideoTrack
@@ -54,6 +104,10 @@ type VideoTracks []VideoTrack
 type AudioTrack struct {
 	ID       int64
 	IsActive bool
+	TrackInfo
+	SampleRate    int
+	Channels      int
+	ChannelLayout string
 }
 
 type AudioTracks []AudioTrack
@@ -61,6 +115,7 @@ type AudioTracks []AudioTrack
 type SubtitlesTrack struct {
 	ID       int64
 	IsActive bool
+	TrackInfo
 }
 
 type SubtitlesTracks []SubtitlesTrack
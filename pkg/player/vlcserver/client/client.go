@@ -1,11 +1,12 @@
-//go:build with_libvlc
-// +build with_libvlc
-
 package client
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"image"
+	"image/png"
 	"io"
 	"time"
 
@@ -13,42 +14,140 @@ import (
 	"github.com/xaionaro-go/observability"
 	"github.com/xaionaro-go/player/pkg/player/protobuf/go/player_grpc"
 	"github.com/xaionaro-go/player/pkg/player/types"
+	"github.com/xaionaro-go/xsync"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
 )
 
+const (
+	// DefaultCallTimeout is the deadline of the non-streaming calls, which
+	// are made with a context without a deadline.
+	DefaultCallTimeout = 10 * time.Second
+
+	keepaliveInterval = 10 * time.Second
+	keepaliveTimeout  = 5 * time.Second
+	reconnectMaxDelay = 2 * time.Second
+
+	endChanRetryInterval = 500 * time.Millisecond
+)
+
+var errClientClosed = errors.New("the client is closed")
+
+// Client is a types.Player controlling a player through the gRPC service;
+// all the calls share the same connection, which is re-established
+// automatically if it breaks.
 type Client struct {
-	Title  string
-	Target string
+	Title       string
+	Target      string
+	CallTimeout time.Duration
+
+	connLocker xsync.Mutex
+	conn       *grpc.ClientConn
+	isClosed   bool
 }
 
 var _ types.Player = (*Client)(nil)
 
 func New(title, target string) *Client {
-	return &Client{Title: title, Target: target}
+	return &Client{
+		Title:       title,
+		Target:      target,
+		CallTimeout: DefaultCallTimeout,
+	}
 }
 
-func (c *Client) grpcClient() (player_grpc.PlayerClient, *grpc.ClientConn, error) {
-	conn, err := grpc.NewClient(
-		c.Target,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
-	if err != nil {
-		return nil, nil, fmt.Errorf("unable to initialize a gRPC client: %w", err)
+func (c *Client) grpcClient() (player_grpc.PlayerClient, error) {
+	ctx := context.TODO()
+	return xsync.DoR2(ctx, &c.connLocker, func() (player_grpc.PlayerClient, error) {
+		if c.isClosed {
+			return nil, errClientClosed
+		}
+		if c.conn != nil {
+			return player_grpc.NewPlayerClient(c.conn), nil
+		}
+		conn, err := grpc.NewClient(
+			c.Target,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithKeepaliveParams(keepalive.ClientParameters{
+				Time:                keepaliveInterval,
+				Timeout:             keepaliveTimeout,
+				PermitWithoutStream: true,
+			}),
+			grpc.WithConnectParams(grpc.ConnectParams{
+				Backoff: backoff.Config{
+					BaseDelay:  backoff.DefaultConfig.BaseDelay,
+					Multiplier: backoff.DefaultConfig.Multiplier,
+					Jitter:     backoff.DefaultConfig.Jitter,
+					MaxDelay:   reconnectMaxDelay,
+				},
+			}),
+			grpc.WithUnaryInterceptor(c.callTimeoutInterceptor),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize a gRPC client: %w", err)
+		}
+		c.conn = conn
+		return player_grpc.NewPlayerClient(conn), nil
+	})
+}
+
+// callTimeoutInterceptor sets the CallTimeout deadline on the calls,
+// which do not have a deadline yet.
+func (c *Client) callTimeoutInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply any,
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if _, ok := ctx.Deadline(); !ok && c.CallTimeout > 0 {
+		var cancelFn context.CancelFunc
+		ctx, cancelFn = context.WithTimeout(ctx, c.CallTimeout)
+		defer cancelFn()
 	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
 
-	client := player_grpc.NewPlayerClient(conn)
-	return client, conn, nil
+// SetTarget switches the client to another server (for example, to
+// a restarted one).
+func (c *Client) SetTarget(ctx context.Context, target string) error {
+	return xsync.DoR1(ctx, &c.connLocker, func() error {
+		c.Target = target
+		if c.conn == nil {
+			return nil
+		}
+		err := c.conn.Close()
+		c.conn = nil
+		return err
+	})
+}
+
+// Disconnect closes the connection without closing the remote player;
+// the consequent calls fail.
+func (c *Client) Disconnect(ctx context.Context) error {
+	return xsync.DoR1(ctx, &c.connLocker, func() error {
+		c.isClosed = true
+		if c.conn == nil {
+			return nil
+		}
+		err := c.conn.Close()
+		c.conn = nil
+		return err
+	})
 }
 
 func (c *Client) ProcessTitle(
 	ctx context.Context,
 ) (string, error) {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return "", err
 	}
-	defer conn.Close()
 
 	resp, err := client.ProcessTitle(ctx, &player_grpc.ProcessTitleRequest{})
 	if err != nil {
@@ -81,11 +180,10 @@ func logLevelGo2Protobuf(logLevel logger.Level) player_grpc.LoggingLevel {
 func (c *Client) SetupForStreaming(
 	ctx context.Context,
 ) error {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
 
 	_, err = client.SetupForStreaming(ctx, &player_grpc.SetupForStreamingRequest{})
 	if err != nil {
@@ -98,11 +196,10 @@ func (c *Client) OpenURL(
 	ctx context.Context,
 	link string,
 ) error {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
 
 	_, err = client.Open(ctx, &player_grpc.OpenRequest{
 		Link:         link,
@@ -118,11 +215,10 @@ func (c *Client) OpenURL(
 func (c *Client) GetLink(
 	ctx context.Context,
 ) (string, error) {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return "", err
 	}
-	defer conn.Close()
 
 	resp, err := client.GetLink(ctx, &player_grpc.GetLinkRequest{})
 	if err != nil {
@@ -131,47 +227,91 @@ func (c *Client) GetLink(
 	return resp.GetLink(), nil
 }
 
+// EndChan returns a channel, which is closed when the playback ends
+// (or the player is closed).
+//
+// A broken connection (for example, if the server is restarted) does not
+// mean the end of the playback, so in this case the waiting is resumed
+// on the new connection (to the current Target).
 func (c *Client) EndChan(ctx context.Context) (<-chan struct{}, error) {
-	client, conn, err := c.grpcClient()
+	waiter, err := c.endChanWaiter(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	waiter, err := client.EndChan(ctx, &player_grpc.EndChanRequest{})
-	if err != nil {
-		return nil, fmt.Errorf("query error: %w", err)
-	}
-
 	result := make(chan struct{})
-	waiter.CloseSend()
 	observability.Go(ctx, func(ctx context.Context) {
-		defer conn.Close()
-		defer func() {
-			close(result)
-		}()
-
-		_, err := waiter.Recv()
-		if err == io.EOF {
-			logger.Debugf(ctx, "the receiver is closed: %v", err)
-			return
-		}
-		if err != nil {
-			logger.Errorf(ctx, "unable to read data: %v", err)
-			return
+		for {
+			_, err := waiter.Recv()
+			if ctx.Err() != nil {
+				return
+			}
+			if err == nil || !isConnectionBroken(ctx, err) {
+				logger.Debugf(ctx, "the EndChan stream is finished: %v", err)
+				close(result)
+				return
+			}
+			logger.Debugf(ctx, "the EndChan stream is interrupted, resubscribing: %v", err)
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(endChanRetryInterval):
+				}
+				waiter, err = c.endChanWaiter(ctx)
+				if err == nil {
+					break
+				}
+				if errors.Is(err, errClientClosed) || !isConnectionBroken(ctx, err) {
+					logger.Debugf(ctx, "unable to resubscribe to EndChan: %v", err)
+					close(result)
+					return
+				}
+				logger.Tracef(ctx, "unable to resubscribe to EndChan: %v", err)
+			}
 		}
 	})
 
 	return result, nil
 }
 
+// isConnectionBroken returns true if the call failed because of
+// the connection, rather than because of the server.
+func isConnectionBroken(ctx context.Context, err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable:
+		return true
+	case codes.Canceled:
+		// the connection is closed (for example, by SetTarget)
+		return ctx.Err() == nil
+	default:
+		return false
+	}
+}
+
+func (c *Client) endChanWaiter(
+	ctx context.Context,
+) (player_grpc.Player_EndChanClient, error) {
+	client, err := c.grpcClient()
+	if err != nil {
+		return nil, err
+	}
+
+	waiter, err := client.EndChan(ctx, &player_grpc.EndChanRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	waiter.CloseSend()
+	return waiter, nil
+}
+
 func (c *Client) IsEnded(
 	ctx context.Context,
 ) (bool, error) {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return false, err
 	}
-	defer conn.Close()
 
 	resp, err := client.IsEnded(ctx, &player_grpc.IsEndedRequest{})
 	if err != nil {
@@ -183,11 +323,10 @@ func (c *Client) IsEnded(
 func (c *Client) GetPosition(
 	ctx context.Context,
 ) (time.Duration, error) {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return 0, err
 	}
-	defer conn.Close()
 
 	resp, err := client.GetPosition(ctx, &player_grpc.GetPositionRequest{})
 	if err != nil {
@@ -199,11 +338,10 @@ func (c *Client) GetPosition(
 func (c *Client) GetAudioPosition(
 	ctx context.Context,
 ) (time.Duration, error) {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return 0, err
 	}
-	defer conn.Close()
 
 	resp, err := client.GetAudioPosition(ctx, &player_grpc.GetAudioPositionRequest{})
 	if err != nil {
@@ -215,11 +353,10 @@ func (c *Client) GetAudioPosition(
 func (c *Client) GetLength(
 	ctx context.Context,
 ) (time.Duration, error) {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return 0, err
 	}
-	defer conn.Close()
 
 	resp, err := client.GetLength(ctx, &player_grpc.GetLengthRequest{})
 	if err != nil {
@@ -231,11 +368,10 @@ func (c *Client) GetLength(
 func (c *Client) GetSpeed(
 	ctx context.Context,
 ) (float64, error) {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return 0, err
 	}
-	defer conn.Close()
 
 	resp, err := client.GetSpeed(ctx, &player_grpc.GetSpeedRequest{})
 	if err != nil {
@@ -248,11 +384,10 @@ func (c *Client) SetSpeed(
 	ctx context.Context,
 	speed float64,
 ) error {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
 
 	_, err = client.SetSpeed(ctx, &player_grpc.SetSpeedRequest{Speed: speed})
 	if err != nil {
@@ -264,11 +399,10 @@ func (c *Client) SetSpeed(
 func (c *Client) GetPause(
 	ctx context.Context,
 ) (bool, error) {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return false, err
 	}
-	defer conn.Close()
 
 	resp, err := client.GetPause(ctx, &player_grpc.GetPauseRequest{})
 	if err != nil {
@@ -281,11 +415,10 @@ func (c *Client) SetPause(
 	ctx context.Context,
 	pause bool,
 ) error {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
 
 	_, err = client.SetPause(ctx, &player_grpc.SetPauseRequest{
 		IsPaused: pause,
@@ -296,22 +429,87 @@ func (c *Client) SetPause(
 	return nil
 }
 
+func (c *Client) GetVolume(
+	ctx context.Context,
+) (float64, error) {
+	client, err := c.grpcClient()
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := client.GetVolume(ctx, &player_grpc.GetVolumeRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("query error: %w", err)
+	}
+	return resp.GetVolume(), nil
+}
+
+func (c *Client) SetVolume(
+	ctx context.Context,
+	volume float64,
+) error {
+	client, err := c.grpcClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.SetVolume(ctx, &player_grpc.SetVolumeRequest{
+		Volume: volume,
+	})
+	if err != nil {
+		return fmt.Errorf("query error: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) GetMute(
+	ctx context.Context,
+) (bool, error) {
+	client, err := c.grpcClient()
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := client.GetMute(ctx, &player_grpc.GetMuteRequest{})
+	if err != nil {
+		return false, fmt.Errorf("query error: %w", err)
+	}
+	return resp.GetIsMuted(), nil
+}
+
+func (c *Client) SetMute(
+	ctx context.Context,
+	mute bool,
+) error {
+	client, err := c.grpcClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.SetMute(ctx, &player_grpc.SetMuteRequest{
+		IsMuted: mute,
+	})
+	if err != nil {
+		return fmt.Errorf("query error: %w", err)
+	}
+	return nil
+}
+
 func (c *Client) Seek(
 	ctx context.Context,
 	pos time.Duration,
 	isRelative bool,
 	quick bool,
 ) error {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
 
 	_, err = client.Seek(ctx, &player_grpc.SeekRequest{
-		Pos:        pos.Nanoseconds(),
-		IsRelative: isRelative,
-		IsQuick:    quick,
+		PosNanoseconds: pos.Nanoseconds(),
+		IsRelative:     isRelative,
+		IsQuick:        quick,
 	})
 	if err != nil {
 		return fmt.Errorf("query error: %w", err)
@@ -319,14 +517,50 @@ func (c *Client) Seek(
 	return nil
 }
 
+func (c *Client) FrameStep(
+	ctx context.Context,
+	forward bool,
+) error {
+	client, err := c.grpcClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.FrameStep(ctx, &player_grpc.FrameStepRequest{
+		Forward: forward,
+	})
+	if err != nil {
+		return fmt.Errorf("query error: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) Screenshot(
+	ctx context.Context,
+) (image.Image, error) {
+	client, err := c.grpcClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Screenshot(ctx, &player_grpc.ScreenshotRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	img, err := png.Decode(bytes.NewReader(resp.GetPng()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the screenshot: %w", err)
+	}
+	return img, nil
+}
+
 func (c *Client) GetVideoTracks(
 	ctx context.Context,
 ) (types.VideoTracks, error) {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return nil, err
 	}
-	defer conn.Close()
 
 	resp, err := client.GetVideoTracks(ctx, &player_grpc.GetVideoTracksRequest{})
 	if err != nil {
@@ -335,7 +569,14 @@ func (c *Client) GetVideoTracks(
 
 	var result types.VideoTracks
 	for _, track := range resp.GetVideoTrack() {
-		result = append(result, types.VideoTrack{ID: track.GetId()})
+		result = append(result, types.VideoTrack{
+			ID:        track.GetId(),
+			IsActive:  track.GetIsActive(),
+			TrackInfo: trackInfoFromGRPC(track.GetInfo()),
+			Width:     int(track.GetWidth()),
+			Height:    int(track.GetHeight()),
+			FPS:       track.GetFps(),
+		})
 	}
 	return result, nil
 }
@@ -343,11 +584,10 @@ func (c *Client) GetVideoTracks(
 func (c *Client) GetAudioTracks(
 	ctx context.Context,
 ) (types.AudioTracks, error) {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return nil, err
 	}
-	defer conn.Close()
 
 	resp, err := client.GetAudioTracks(ctx, &player_grpc.GetAudioTracksRequest{})
 	if err != nil {
@@ -356,7 +596,14 @@ func (c *Client) GetAudioTracks(
 
 	var result types.AudioTracks
 	for _, track := range resp.GetAudioTrack() {
-		result = append(result, types.AudioTrack{ID: track.GetId()})
+		result = append(result, types.AudioTrack{
+			ID:            track.GetId(),
+			IsActive:      track.GetIsActive(),
+			TrackInfo:     trackInfoFromGRPC(track.GetInfo()),
+			SampleRate:    int(track.GetSampleRate()),
+			Channels:      int(track.GetChannels()),
+			ChannelLayout: track.GetChannelLayout(),
+		})
 	}
 	return result, nil
 }
@@ -364,11 +611,10 @@ func (c *Client) GetAudioTracks(
 func (c *Client) GetSubtitlesTracks(
 	ctx context.Context,
 ) (types.SubtitlesTracks, error) {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return nil, err
 	}
-	defer conn.Close()
 
 	resp, err := client.GetSubtitlesTracks(ctx, &player_grpc.GetSubtitlesTracksRequest{})
 	if err != nil {
@@ -377,20 +623,33 @@ func (c *Client) GetSubtitlesTracks(
 
 	var result types.SubtitlesTracks
 	for _, track := range resp.GetSubtitlesTrack() {
-		result = append(result, types.SubtitlesTrack{ID: track.GetId()})
+		result = append(result, types.SubtitlesTrack{
+			ID:        track.GetId(),
+			IsActive:  track.GetIsActive(),
+			TrackInfo: trackInfoFromGRPC(track.GetInfo()),
+		})
 	}
 	return result, nil
 }
 
+func trackInfoFromGRPC(info *player_grpc.TrackInfo) types.TrackInfo {
+	return types.TrackInfo{
+		Codec:     info.GetCodec(),
+		Language:  info.GetLanguage(),
+		Title:     info.GetTitle(),
+		IsDefault: info.GetIsDefault(),
+		IsForced:  info.GetIsForced(),
+	}
+}
+
 func (c *Client) SetVideoTrack(
 	ctx context.Context,
 	vid int64,
 ) error {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
 
 	_, err = client.SetVideoTrack(ctx, &player_grpc.SetVideoTrackRequest{
 		VideoTrackID: vid,
@@ -405,11 +664,10 @@ func (c *Client) SetAudioTrack(
 	ctx context.Context,
 	aid int64,
 ) error {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
 
 	_, err = client.SetAudioTrack(ctx, &player_grpc.SetAudioTrackRequest{
 		AudioTrackID: aid,
@@ -424,11 +682,10 @@ func (c *Client) SetSubtitlesTrack(
 	ctx context.Context,
 	sid int64,
 ) error {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
 
 	_, err = client.SetSubtitlesTrack(ctx, &player_grpc.SetSubtitlesTrackRequest{
 		SubtitlesTrackID: sid,
@@ -439,14 +696,98 @@ func (c *Client) SetSubtitlesTrack(
 	return nil
 }
 
+func (c *Client) GetMediaInfo(
+	ctx context.Context,
+) (*types.MediaInfo, error) {
+	client, err := c.grpcClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetMediaInfo(ctx, &player_grpc.GetMediaInfoRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	return mediaInfoFromGRPC(resp.GetMediaInfo()), nil
+}
+
+func mediaInfoFromGRPC(info *player_grpc.MediaInfo) *types.MediaInfo {
+	return &types.MediaInfo{
+		FormatName:  info.GetFormatName(),
+		Bitrate:     info.GetBitrate(),
+		StreamCount: int(info.GetStreamCount()),
+		Tags:        info.GetTags(),
+		Chapters:    chaptersFromGRPC(info.GetChapters()),
+	}
+}
+
+func chaptersFromGRPC(chapters []*player_grpc.Chapter) types.Chapters {
+	result := make(types.Chapters, 0, len(chapters))
+	for _, chapter := range chapters {
+		result = append(result, types.Chapter{
+			Title: chapter.GetTitle(),
+			Start: time.Duration(chapter.GetStartSecs() * float64(time.Second)),
+			End:   time.Duration(chapter.GetEndSecs() * float64(time.Second)),
+		})
+	}
+	return result
+}
+
+func (c *Client) GetChapters(
+	ctx context.Context,
+) (types.Chapters, error) {
+	client, err := c.grpcClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetChapters(ctx, &player_grpc.GetChaptersRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	return chaptersFromGRPC(resp.GetChapters()), nil
+}
+
+func (c *Client) GetChapter(
+	ctx context.Context,
+) (int, error) {
+	client, err := c.grpcClient()
+	if err != nil {
+		return -1, err
+	}
+
+	resp, err := client.GetChapter(ctx, &player_grpc.GetChapterRequest{})
+	if err != nil {
+		return -1, fmt.Errorf("query error: %w", err)
+	}
+	return int(resp.GetChapterIdx()), nil
+}
+
+func (c *Client) SetChapter(
+	ctx context.Context,
+	idx int,
+) error {
+	client, err := c.grpcClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.SetChapter(ctx, &player_grpc.SetChapterRequest{
+		ChapterIdx: int32(idx),
+	})
+	if err != nil {
+		return fmt.Errorf("query error: %w", err)
+	}
+	return nil
+}
+
 func (c *Client) Stop(
 	ctx context.Context,
 ) error {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
 
 	_, err = client.Stop(ctx, &player_grpc.StopRequest{})
 	if err != nil {
@@ -456,15 +797,90 @@ func (c *Client) Stop(
 }
 
 func (c *Client) Close(ctx context.Context) error {
-	client, conn, err := c.grpcClient()
+	client, err := c.grpcClient()
 	if err != nil {
 		return err
 	}
-	defer conn.Close()
 
 	_, err = client.Close(ctx, &player_grpc.CloseRequest{})
 	if err != nil {
+		c.Disconnect(ctx)
 		return fmt.Errorf("query error: %w", err)
 	}
+	return c.Disconnect(ctx)
+}
+
+func (c *Client) Events(
+	ctx context.Context,
+) (<-chan types.Event, error) {
+	client, err := c.grpcClient()
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := client.Events(ctx, &player_grpc.EventsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+
+	result := make(chan types.Event, types.EventsBufferSize)
+	receiver.CloseSend()
+	observability.Go(ctx, func(ctx context.Context) {
+		defer close(result)
+
+		for {
+			ev, err := receiver.Recv()
+			if err == io.EOF {
+				logger.Debugf(ctx, "the receiver is closed: %v", err)
+				return
+			}
+			if err != nil {
+				logger.Errorf(ctx, "unable to read data: %v", err)
+				return
+			}
+			converted := eventFromGRPC(ev)
+			if converted == nil {
+				logger.Warnf(ctx, "unknown event: %v", ev)
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case result <- converted:
+			}
+		}
+	})
+
+	return result, nil
+}
+
+func eventFromGRPC(ev *player_grpc.Event) types.Event {
+	switch ev := ev.GetEvent().(type) {
+	case *player_grpc.Event_StateChange:
+		return types.EventStateChange{
+			State: types.PlaybackState(ev.StateChange.GetState()),
+		}
+	case *player_grpc.Event_Position:
+		return types.EventPosition{
+			Position: time.Duration(ev.Position.GetPositionSecs() * float64(time.Second)),
+			Length:   time.Duration(ev.Position.GetLengthSecs() * float64(time.Second)),
+		}
+	case *player_grpc.Event_TracksChange:
+		return types.EventTracksChange{}
+	case *player_grpc.Event_Buffering:
+		return types.EventBuffering{
+			Percent: ev.Buffering.GetPercent(),
+		}
+	case *player_grpc.Event_Error:
+		return types.EventError{
+			Err: errors.New(ev.Error.GetError()),
+		}
+	case *player_grpc.Event_EndOfFile:
+		return types.EventEndOfFile{}
+	case *player_grpc.Event_MediaChange:
+		return types.EventMediaChange{
+			Link: ev.MediaChange.GetLink(),
+		}
+	}
 	return nil
 }
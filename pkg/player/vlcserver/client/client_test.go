@@ -0,0 +1,264 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/xaionaro-go/player/pkg/player/playerserver"
+	"github.com/xaionaro-go/player/pkg/player/types"
+)
+
+const (
+	envTestServer = "PLAYER_CLIENT_TEST_SERVER"
+
+	// linkEnding is a media, which ends shortly after it is opened
+	// in the test server.
+	linkEnding = "ending"
+)
+
+// fakePlayer is the player of the test server; the methods not needed by
+// the tests are not implemented.
+type fakePlayer struct {
+	types.Player
+
+	title   string
+	endOnce sync.Once
+	endChan chan struct{}
+	events  types.EventBroadcaster
+}
+
+func (p *fakePlayer) ProcessTitle(ctx context.Context) (string, error) {
+	return p.title, nil
+}
+
+func (p *fakePlayer) OpenURL(ctx context.Context, link string) error {
+	if link == linkEnding {
+		time.AfterFunc(100*time.Millisecond, func() {
+			p.endOnce.Do(func() { close(p.endChan) })
+		})
+	}
+	return nil
+}
+
+func (p *fakePlayer) EndChan(ctx context.Context) (<-chan struct{}, error) {
+	return p.endChan, nil
+}
+
+func (p *fakePlayer) Events(ctx context.Context) (<-chan types.Event, error) {
+	return p.events.Subscribe(ctx), nil
+}
+
+func (p *fakePlayer) Close(ctx context.Context) error {
+	return nil
+}
+
+func TestMain(m *testing.M) {
+	if os.Getenv(envTestServer) != "" {
+		runTestServer()
+		return
+	}
+	os.Exit(m.Run())
+}
+
+func newTestServer() *playerserver.GRPCServer {
+	return playerserver.NewServer(func(
+		ctx context.Context,
+		title string,
+	) (types.Player, error) {
+		return &fakePlayer{
+			title:   title,
+			endChan: make(chan struct{}),
+		}, nil
+	})
+}
+
+// runTestServer serves a fakePlayer and prints the address to stdout.
+func runTestServer() {
+	srv := newTestServer()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to listen: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(listener.Addr().String())
+	if err := srv.Serve(listener); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to serve: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// startTestServer runs the test server in a subprocess (to be able to kill
+// it, like a crashed player), and returns its address.
+func startTestServer(t *testing.T) (*exec.Cmd, string) {
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	cmd.Env = append(os.Environ(), envTestServer+"=1")
+	cmd.Stderr = os.Stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatalf("unable to get the stdout: %v", err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("unable to start the test server: %v", err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+	addr, err := bufio.NewReader(stdout).ReadString('\n')
+	if err != nil {
+		t.Fatalf("unable to read the address of the test server: %v", err)
+	}
+	return cmd, strings.TrimSpace(addr)
+}
+
+func TestEndChanSurvivesServerRestart(t *testing.T) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelFn()
+
+	cmd, addr := startTestServer(t)
+	c := New("test", addr)
+	defer c.Disconnect(ctx)
+	if err := c.OpenURL(ctx, "media"); err != nil {
+		t.Fatalf("unable to open: %v", err)
+	}
+	endChan, err := c.EndChan(ctx)
+	if err != nil {
+		t.Fatalf("unable to get EndChan: %v", err)
+	}
+
+	// the crash of the server is not the end of the playback
+	if err := cmd.Process.Kill(); err != nil {
+		t.Fatalf("unable to kill the test server: %v", err)
+	}
+	cmd.Wait()
+	select {
+	case <-endChan:
+		t.Fatalf("EndChan is closed after the server is killed")
+	case <-time.After(2 * endChanRetryInterval):
+	}
+
+	// the same as the restart in vlcserver.VLC
+	_, addr = startTestServer(t)
+	if err := c.SetTarget(ctx, addr); err != nil {
+		t.Fatalf("unable to set the target: %v", err)
+	}
+	if err := c.OpenURL(ctx, "media"); err != nil {
+		t.Fatalf("unable to open: %v", err)
+	}
+	select {
+	case <-endChan:
+		t.Fatalf("EndChan is closed after the server is restarted")
+	case <-time.After(2 * endChanRetryInterval):
+	}
+
+	if err := c.OpenURL(ctx, linkEnding); err != nil {
+		t.Fatalf("unable to open: %v", err)
+	}
+	select {
+	case <-endChan:
+	case <-ctx.Done():
+		t.Fatalf("EndChan is not closed at the end of the playback")
+	}
+}
+
+func TestEndChanContextCancel(t *testing.T) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelFn()
+
+	_, addr := startTestServer(t)
+	c := New("test", addr)
+	defer c.Disconnect(ctx)
+	if err := c.OpenURL(ctx, "media"); err != nil {
+		t.Fatalf("unable to open: %v", err)
+	}
+	waitCtx, waitCancelFn := context.WithCancel(ctx)
+	endChan, err := c.EndChan(waitCtx)
+	if err != nil {
+		t.Fatalf("unable to get EndChan: %v", err)
+	}
+	waitCancelFn()
+	select {
+	case <-endChan:
+		t.Fatalf("EndChan is closed after the context is cancelled")
+	case <-time.After(2 * endChanRetryInterval):
+	}
+}
+
+func TestEndChanClose(t *testing.T) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelFn()
+
+	_, addr := startTestServer(t)
+	c := New("test", addr)
+	if err := c.OpenURL(ctx, "media"); err != nil {
+		t.Fatalf("unable to open: %v", err)
+	}
+	endChan, err := c.EndChan(ctx)
+	if err != nil {
+		t.Fatalf("unable to get EndChan: %v", err)
+	}
+	// the server stops serving on Close, so the reply may be lost
+	c.Close(ctx)
+	select {
+	case <-endChan:
+	case <-ctx.Done():
+		t.Fatalf("EndChan is not closed after the client is closed")
+	}
+}
+
+func TestStreamsFinishOnPlayerReplace(t *testing.T) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelFn()
+
+	srv := newTestServer()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen: %v", err)
+	}
+	go srv.Serve(listener)
+	defer srv.GRPCServer.Stop()
+
+	c := New("test", listener.Addr().String())
+	defer c.Disconnect(ctx)
+	if err := c.OpenURL(ctx, "media"); err != nil {
+		t.Fatalf("unable to open: %v", err)
+	}
+	endChan, err := c.EndChan(ctx)
+	if err != nil {
+		t.Fatalf("unable to get EndChan: %v", err)
+	}
+	events, err := c.Events(ctx)
+	if err != nil {
+		t.Fatalf("unable to subscribe to the events: %v", err)
+	}
+
+	// a different title recreates the player
+	other := New("other", c.Target)
+	defer other.Disconnect(ctx)
+	if err := other.OpenURL(ctx, "media"); err != nil {
+		t.Fatalf("unable to open: %v", err)
+	}
+	select {
+	case <-endChan:
+	case <-ctx.Done():
+		t.Fatalf("EndChan is not closed after the player is replaced")
+	}
+	for {
+		select {
+		case _, ok := <-events:
+			if !ok {
+				return
+			}
+		case <-ctx.Done():
+			t.Fatalf("the events are not finished after the player is replaced")
+		}
+	}
+}
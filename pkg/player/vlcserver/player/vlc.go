@@ -6,8 +6,13 @@ package player
 import (
 	"context"
 	"fmt"
+	"image"
+	"image/png"
+	"math"
 	"net/url"
-	"sync/atomic"
+	"os"
+	"strings"
+	"sync"
 	"time"
 
 	vlc "github.com/adrg/libvlc-go/v3"
@@ -28,18 +33,49 @@ type VLC struct {
 	IsStopped bool
 
 	EndCh chan struct{}
+
+	events types.EventBroadcaster
 }
 
-var vlcPlayerCounter int64 = 0
+// libvlc-go supports only one libvlc instance per process, so it is
+// shared by all the players and released together with the last one.
+var (
+	libvlcLocker sync.Mutex
+	libvlcUsers  int
+)
+
+func acquireLibVLC(title string) error {
+	libvlcLocker.Lock()
+	defer libvlcLocker.Unlock()
+	if libvlcUsers == 0 {
+		args := []string{fmt.Sprintf("--video-title=%s", title)}
+		if err := vlc.Init(args...); err != nil {
+			return fmt.Errorf("unable to initialize VLC with arguments %v: %w", args, err)
+		}
+	}
+	libvlcUsers++
+	return nil
+}
 
-func NewVLC(title string) (*VLC, error) {
-	if atomic.AddInt64(&vlcPlayerCounter, 1) != 1 {
-		return nil, fmt.Errorf("currently we do not support more than one VLC player at once")
+func releaseLibVLC() error {
+	libvlcLocker.Lock()
+	defer libvlcLocker.Unlock()
+	libvlcUsers--
+	if libvlcUsers > 0 {
+		return nil
 	}
-	args := []string{fmt.Sprintf("--video-title=%s", title)}
-	if err := vlc.Init(args...); err != nil {
-		return nil, fmt.Errorf("unable to initialize VLC with arguments: %v", args)
+	return vlc.Release()
+}
+
+func NewVLC(title string) (_ *VLC, _err error) {
+	if err := acquireLibVLC(title); err != nil {
+		return nil, err
 	}
+	defer func() {
+		if _err != nil {
+			releaseLibVLC()
+		}
+	}()
 
 	p := &VLC{
 		Title: title,
@@ -54,22 +90,34 @@ func NewVLC(title string) (*VLC, error) {
 
 	manager, err := p.Player.EventManager()
 	if err != nil {
+		p.Player.Release()
 		return nil, fmt.Errorf("unable to initialize a VLC event manager: %w", err)
 	}
 
-	eventID, err := manager.Attach(vlc.MediaPlayerEndReached, func(e vlc.Event, i interface{}) {
-		p.StatusMutex.Do(context.TODO(), func() {
-			p.IsStopped = true
-			var oldCh chan struct{}
-			oldCh, p.EndCh = p.EndCh, make(chan struct{})
-			close(oldCh)
-		})
-	}, nil)
-	if err != nil {
-		return nil, fmt.Errorf("unable to attach the 'EndReached' event handler: %w", err)
-	}
+	var eventIDs []vlc.EventID
 	p.DetachEventsFunc = func() {
-		manager.Detach(eventID)
+		manager.Detach(eventIDs...)
+	}
+	for event, handler := range map[vlc.Event]func(){
+		vlc.MediaPlayerEndReached:       p.onEndReached,
+		vlc.MediaPlayerPlaying:          func() { p.emitState(types.PlaybackStatePlaying) },
+		vlc.MediaPlayerPaused:           func() { p.emitState(types.PlaybackStatePaused) },
+		vlc.MediaPlayerStopped:          func() { p.emitState(types.PlaybackStateStopped) },
+		vlc.MediaPlayerTimeChanged:      p.emitPosition,
+		vlc.MediaPlayerEncounteredError: p.onError,
+		vlc.MediaPlayerESAdded:          p.emitTracksChange,
+		vlc.MediaPlayerESDeleted:        p.emitTracksChange,
+		vlc.MediaPlayerESSelected:       p.emitTracksChange,
+	} {
+		eventID, err := manager.Attach(event, func(e vlc.Event, i interface{}) {
+			handler()
+		}, nil)
+		if err != nil {
+			p.DetachEventsFunc()
+			p.Player.Release()
+			return nil, fmt.Errorf("unable to attach the handler of event %d: %w", event, err)
+		}
+		eventIDs = append(eventIDs, eventID)
 	}
 
 	return p, nil
@@ -89,24 +137,35 @@ func (p *VLC) OpenURL(
 }
 
 func (p *VLC) openURL(link string) error {
-	if p.Media != nil {
-		return fmt.Errorf("some media is already opened in this player")
-	}
-
 	var (
 		media *vlc.Media
 		err   error
 	)
 	if urlParsed, _err := url.Parse(link); _err == nil && urlParsed.Scheme != "" {
-		media, err = p.Player.LoadMediaFromURL(link)
+		media, err = vlc.NewMediaFromURL(link)
 	} else {
-		media, err = p.Player.LoadMediaFromPath(link)
+		media, err = vlc.NewMediaFromPath(link)
 	}
 	if err != nil {
 		return fmt.Errorf("unable to open '%s': %w", link, err)
 	}
+	// the libvlc instance is shared, so its --video-title could be
+	// of another player
+	if err := media.AddOptions(fmt.Sprintf(":video-title=%s", p.Title)); err != nil {
+		media.Release()
+		return fmt.Errorf("unable to set the title of the video: %w", err)
+	}
+	if err := p.Player.SetMedia(media); err != nil {
+		media.Release()
+		return fmt.Errorf("unable to set the media '%s': %w", link, err)
+	}
+	if p.Media != nil {
+		// the player holds its own reference to the media being played
+		p.Media.Release()
+	}
 	p.Media = media
 	p.LastURL = link
+	p.events.Emit(context.TODO(), types.EventMediaChange{Link: link})
 
 	if err := p.play(); err != nil {
 		return fmt.Errorf("opened, but unable to start playing '%s': %w", link, err)
@@ -206,13 +265,163 @@ func (p *VLC) SetPause(
 	return p.Player.SetPause(pause)
 }
 
+// GetVolume returns the volume, see types.Player (libvlc uses percents).
+func (p *VLC) GetVolume(
+	ctx context.Context,
+) (float64, error) {
+	volume, err := p.Player.Volume()
+	if err != nil {
+		return 0, fmt.Errorf("unable to get the volume: %w", err)
+	}
+	return float64(volume) / 100, nil
+}
+
+func (p *VLC) SetVolume(
+	ctx context.Context,
+	volume float64,
+) error {
+	if volume < 0 || math.IsNaN(volume) {
+		return fmt.Errorf("invalid volume: %v", volume)
+	}
+	return p.Player.SetVolume(int(math.Round(volume * 100)))
+}
+
+func (p *VLC) GetMute(
+	ctx context.Context,
+) (bool, error) {
+	return p.Player.IsMuted()
+}
+
+func (p *VLC) SetMute(
+	ctx context.Context,
+	mute bool,
+) error {
+	return p.Player.SetMute(mute)
+}
+
 func (p *VLC) Seek(
 	ctx context.Context,
 	pos time.Duration,
 	isRelative bool,
 	quick bool,
 ) error {
-	return fmt.Errorf("not implemented, yet")
+	if !p.Player.IsSeekable() {
+		return fmt.Errorf("the media is not seekable")
+	}
+	if isRelative {
+		curPos, err := p.GetPosition(ctx)
+		if err != nil {
+			return err
+		}
+		pos += curPos
+	}
+	pos = max(pos, 0)
+
+	if quick {
+		// libvlc 3 has no per-call fast seek, but the seek by the relative
+		// position is usually done by the byte offset (without decoding to
+		// the exact time), so it is used as the quick seek
+		length, err := p.GetLength(ctx)
+		if err == nil && length > 0 {
+			if err := p.Player.SetMediaPosition(float32(min(float64(pos)/float64(length), 1))); err != nil {
+				return fmt.Errorf("unable to set the position to %v/%v: %w", pos, length, err)
+			}
+			return nil
+		}
+	}
+
+	if err := p.Player.SetMediaTime(int(pos.Milliseconds())); err != nil {
+		return fmt.Errorf("unable to set the time to %v: %w", pos, err)
+	}
+	return nil
+}
+
+// FrameStep shows the next video frame (libvlc pauses the playback on
+// that); libvlc could not step back.
+func (p *VLC) FrameStep(
+	ctx context.Context,
+	forward bool,
+) error {
+	if !forward {
+		return fmt.Errorf("stepping back is not supported by libvlc")
+	}
+	return p.Player.NextFrame()
+}
+
+// Screenshot returns the current video frame (without the subtitles); the
+// frame is passed through a temporary file, because libvlc could only save
+// snapshots to files (synchronously).
+func (p *VLC) Screenshot(
+	ctx context.Context,
+) (image.Image, error) {
+	f, err := os.CreateTemp("", "vlc-screenshot-*.png")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create a temporary file: %w", err)
+	}
+	filePath := f.Name()
+	f.Close()
+	defer os.Remove(filePath)
+
+	if err := p.Player.TakeSnapshot(filePath, 0, 0); err != nil {
+		return nil, fmt.Errorf("unable to take a snapshot: %w", err)
+	}
+
+	f, err = os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open '%s': %w", filePath, err)
+	}
+	defer f.Close()
+	img, err := png.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the snapshot: %w", err)
+	}
+	return img, nil
+}
+
+// getMediaTracks returns the information about the tracks of the current
+// media by the track ID; it is best-effort, because the information is
+// available only after the media is parsed.
+func (p *VLC) getMediaTracks() map[int]*vlc.MediaTrack {
+	result := map[int]*vlc.MediaTrack{}
+	media, err := p.Player.Media()
+	if err != nil || media == nil {
+		return result
+	}
+	tracks, err := media.Tracks()
+	if err != nil {
+		return result
+	}
+	for _, track := range tracks {
+		result[track.ID] = track
+	}
+	return result
+}
+
+func mediaTrackInfo(
+	track *vlc.MediaTrack,
+	description string,
+) types.TrackInfo {
+	info := types.TrackInfo{
+		Title: description,
+	}
+	if track == nil {
+		return info
+	}
+	codec := track.Codec
+	if codec == 0 {
+		codec = track.OriginalCodec
+	}
+	info.Codec = fourCCToString(codec)
+	info.Language = track.Language
+	if track.Description != "" {
+		info.Title = track.Description
+	}
+	return info
+}
+
+func fourCCToString(fourCC uint) string {
+	b := []byte{byte(fourCC), byte(fourCC >> 8), byte(fourCC >> 16), byte(fourCC >> 24)}
+	return strings.TrimRight(string(b), " \x00")
 }
 
 func (p *VLC) GetVideoTracks(
@@ -228,12 +437,22 @@ func (p *VLC) GetVideoTracks(
 		return nil, fmt.Errorf("unable to get video track descriptors: %w", err)
 	}
 
+	mediaTracks := p.getMediaTracks()
 	result := make(types.VideoTracks, 0, len(trackDescrs))
 	for _, track := range trackDescrs {
-		result = append(result, types.VideoTrack{
-			ID:       int64(track.ID),
-			IsActive: track.ID == activeTrackID,
-		})
+		videoTrack := types.VideoTrack{
+			ID:        int64(track.ID),
+			IsActive:  track.ID == activeTrackID,
+			TrackInfo: mediaTrackInfo(mediaTracks[track.ID], track.Description),
+		}
+		if mt := mediaTracks[track.ID]; mt != nil && mt.Video != nil {
+			videoTrack.Width = int(mt.Video.Width)
+			videoTrack.Height = int(mt.Video.Height)
+			if mt.Video.FrameRateDen != 0 {
+				videoTrack.FPS = float64(mt.Video.FrameRateNum) / float64(mt.Video.FrameRateDen)
+			}
+		}
+		result = append(result, videoTrack)
 	}
 	return result, nil
 }
@@ -251,12 +470,19 @@ func (p *VLC) GetAudioTracks(
 		return nil, fmt.Errorf("unable to get video track descriptors: %w", err)
 	}
 
+	mediaTracks := p.getMediaTracks()
 	result := make(types.AudioTracks, 0, len(trackDescrs))
 	for _, track := range trackDescrs {
-		result = append(result, types.AudioTrack{
-			ID:       int64(track.ID),
-			IsActive: track.ID == activeTrackID,
-		})
+		audioTrack := types.AudioTrack{
+			ID:        int64(track.ID),
+			IsActive:  track.ID == activeTrackID,
+			TrackInfo: mediaTrackInfo(mediaTracks[track.ID], track.Description),
+		}
+		if mt := mediaTracks[track.ID]; mt != nil && mt.Audio != nil {
+			audioTrack.SampleRate = int(mt.Audio.Rate)
+			audioTrack.Channels = int(mt.Audio.Channels)
+		}
+		result = append(result, audioTrack)
 	}
 	return result, nil
 }
@@ -274,11 +500,13 @@ func (p *VLC) GetSubtitlesTracks(
 		return nil, fmt.Errorf("unable to get video track descriptors: %w", err)
 	}
 
+	mediaTracks := p.getMediaTracks()
 	result := make(types.SubtitlesTracks, 0, len(trackDescrs))
 	for _, track := range trackDescrs {
 		result = append(result, types.SubtitlesTrack{
-			ID:       int64(track.ID),
-			IsActive: track.ID == activeTrackID,
+			ID:        int64(track.ID),
+			IsActive:  track.ID == activeTrackID,
+			TrackInfo: mediaTrackInfo(mediaTracks[track.ID], track.Description),
 		})
 	}
 	return result, nil
@@ -305,6 +533,110 @@ func (p *VLC) SetSubtitlesTrack(
 	return p.Player.SetSubtitleTrack(int(sid))
 }
 
+var vlcMetaKeys = map[vlc.MediaMetaKey]string{
+	vlc.MediaTitle:       "title",
+	vlc.MediaArtist:      "artist",
+	vlc.MediaGenre:       "genre",
+	vlc.MediaCopyright:   "copyright",
+	vlc.MediaAlbum:       "album",
+	vlc.MediaTrackNumber: "track",
+	vlc.MediaDescription: "description",
+	vlc.MediaRating:      "rating",
+	vlc.MediaDate:        "date",
+	vlc.MediaLanguage:    "language",
+	vlc.MediaPublisher:   "publisher",
+	vlc.MediaEncodedBy:   "encoded_by",
+	vlc.MediaTrackTotal:  "track_total",
+	vlc.MediaDirector:    "director",
+	vlc.MediaSeason:      "season",
+	vlc.MediaEpisode:     "episode",
+	vlc.MediaShowName:    "show",
+	vlc.MediaActors:      "actors",
+	vlc.MediaAlbumArtist: "album_artist",
+	vlc.MediaDiscNumber:  "disc",
+	vlc.MediaDiscTotal:   "disc_total",
+}
+
+// GetMediaInfo returns the information about the current media; libvlc does
+// not report the container format, so FormatName is always empty.
+func (p *VLC) GetMediaInfo(
+	ctx context.Context,
+) (*types.MediaInfo, error) {
+	media, err := p.Player.Media()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the media: %w", err)
+	}
+	if media == nil {
+		return nil, fmt.Errorf("no media is opened")
+	}
+
+	result := &types.MediaInfo{
+		Tags: map[string]string{},
+	}
+	for key, name := range vlcMetaKeys {
+		if value, err := media.Meta(key); err == nil && value != "" {
+			result.Tags[name] = value
+		}
+	}
+	if stats, err := media.Stats(); err == nil {
+		// libvlc reports the bitrate in bytes per microsecond
+		result.Bitrate = int64(stats.DemuxBitRate * 8 * 1000000)
+	}
+	if tracks, err := media.Tracks(); err == nil {
+		result.StreamCount = len(tracks)
+	}
+
+	result.Chapters, err = p.GetChapters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetChapters returns the chapters of the current title of the media.
+func (p *VLC) GetChapters(
+	ctx context.Context,
+) (types.Chapters, error) {
+	// -1 means the current title
+	chapters, err := p.Player.TitleChapters(-1)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the chapters: %w", err)
+	}
+	result := make(types.Chapters, 0, len(chapters))
+	for _, chapter := range chapters {
+		result = append(result, types.Chapter{
+			Title: chapter.Name,
+			Start: chapter.Offset,
+			End:   chapter.Offset + chapter.Duration,
+		})
+	}
+	return result, nil
+}
+
+func (p *VLC) GetChapter(
+	ctx context.Context,
+) (int, error) {
+	idx, err := p.Player.ChapterIndex()
+	if err != nil {
+		return -1, fmt.Errorf("unable to get the chapter index: %w", err)
+	}
+	return max(idx, -1), nil
+}
+
+func (p *VLC) SetChapter(
+	ctx context.Context,
+	idx int,
+) error {
+	count, err := p.Player.ChapterCount()
+	if err != nil {
+		return fmt.Errorf("unable to get the amount of chapters: %w", err)
+	}
+	if idx < 0 || idx >= count {
+		return fmt.Errorf("%w: #%d (total: %d)", types.ErrNoChapter, idx, count)
+	}
+	return p.Player.SetChapter(idx)
+}
+
 func (p *VLC) Stop(
 	ctx context.Context,
 ) error {
@@ -327,6 +659,9 @@ func (p *VLC) Close(ctx context.Context) error {
 func (p *VLC) close(
 	ctx context.Context,
 ) error {
+	if p.Player == nil {
+		return fmt.Errorf("the player is already closed")
+	}
 	if p.DetachEventsFunc != nil {
 		p.DetachEventsFunc()
 		p.DetachEventsFunc = nil
@@ -336,9 +671,9 @@ func (p *VLC) close(
 		p.Player.Stop(),
 		p.Media.Release(),
 		p.Player.Release(),
-		vlc.Release(),
+		releaseLibVLC(),
 	).ErrorOrNil()
-	atomic.AddInt64(&vlcPlayerCounter, -1)
+	p.Player, p.Media = nil, nil
 	return err
 }
 
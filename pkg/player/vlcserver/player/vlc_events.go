@@ -0,0 +1,66 @@
+//go:build with_libvlc
+// +build with_libvlc
+
+package player
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/xaionaro-go/player/pkg/player/types"
+)
+
+// The handlers below are called from the threads of libvlc, so they
+// must neither block nor call back into libvlc functions which
+// wait for the events (like Stop).
+//
+// The buffering is not reported, because libvlc-go does not expose
+// the percentage of the MediaPlayerBuffering event.
+
+func (p *VLC) Events(
+	ctx context.Context,
+) (<-chan types.Event, error) {
+	return p.events.Subscribe(ctx), nil
+}
+
+func (p *VLC) onEndReached() {
+	p.StatusMutex.Do(context.TODO(), func() {
+		p.IsStopped = true
+		var oldCh chan struct{}
+		oldCh, p.EndCh = p.EndCh, make(chan struct{})
+		close(oldCh)
+	})
+	p.events.Emit(context.TODO(), types.EventEndOfFile{})
+	p.emitState(types.PlaybackStateStopped)
+}
+
+func (p *VLC) onError() {
+	p.events.Emit(context.TODO(), types.EventError{
+		Err: fmt.Errorf("libvlc encountered an error"),
+	})
+}
+
+func (p *VLC) emitState(state types.PlaybackState) {
+	p.events.Emit(context.TODO(), types.EventStateChange{State: state})
+}
+
+func (p *VLC) emitPosition() {
+	ts, err := p.Player.MediaTime()
+	if err != nil {
+		return
+	}
+	length, err := p.Player.MediaLength()
+	if err != nil || length < 0 {
+		length = 0
+	}
+	p.events.EmitPosition(
+		context.TODO(),
+		time.Duration(ts)*time.Millisecond,
+		time.Duration(length)*time.Millisecond,
+	)
+}
+
+func (p *VLC) emitTracksChange() {
+	p.events.Emit(context.TODO(), types.EventTracksChange{})
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"image"
 	"net"
 	"os"
 	"os/exec"
@@ -20,15 +21,30 @@ import (
 	"github.com/xaionaro-go/player/pkg/player/types"
 	"github.com/xaionaro-go/player/pkg/player/vlcserver/client"
 	"github.com/xaionaro-go/xpath"
+	"github.com/xaionaro-go/xsync"
 )
 
 const (
 	debugRunVLCInTheSameProcess = false
+	restartVLC                  = true
 )
 
 type VLC struct {
 	Client *client.Client
-	Cmd    *exec.Cmd
+
+	// ProcessLocker guards Cmd, ExitChan and ExitError, which are replaced
+	// when the subprocess is restarted.
+	ProcessLocker xsync.Mutex
+	Cmd           *exec.Cmd
+	ExitChan      chan struct{} // closed when the subprocess exits (with ExitError)
+	ExitError     error
+	isClosed      bool
+
+	stateLocker xsync.Mutex
+	state       playbackState // restored after a restart of the subprocess
+
+	events       types.EventBroadcaster
+	cancelEvents context.CancelFunc
 }
 
 func Run(
@@ -45,15 +61,33 @@ func run(
 	ctx context.Context,
 	title string,
 ) (*VLC, error) {
+	cmd, listenAddr, err := spawn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vlc := newVLC(ctx, client.New(title, listenAddr))
+	vlc.setProcess(ctx, cmd)
+	if restartVLC {
+		observability.Go(ctx, vlc.supervise)
+	}
+	return vlc, nil
+}
+
+// spawn starts a subprocess running a VLC server and returns the address
+// it listens at.
+func spawn(
+	ctx context.Context,
+) (*exec.Cmd, string, error) {
 	execPath, err := xpath.GetExecPath(os.Args[0])
 	if err != nil {
-		return nil, fmt.Errorf("unable to get self-path: %w", err)
+		return nil, "", fmt.Errorf("unable to get self-path: %w", err)
 	}
 	cmd := exec.Command(execPath)
 	cmd.Stderr = os.Stderr
 	stdout, err := cmd.StdoutPipe()
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize an stdout pipe: %w", err)
+		return nil, "", fmt.Errorf("unable to initialize an stdout pipe: %w", err)
 	}
 	cmd.Env = append(os.Environ(), EnvKeyIsVLCServer+"=1")
 	cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", EnvKeyLoggingLevel, logger.FromCtx(ctx).Level().String()))
@@ -61,7 +95,7 @@ func run(
 	errmon.ObserveErrorCtx(ctx, err)
 	err = cmd.Start()
 	if err != nil {
-		return nil, fmt.Errorf("unable to start a subprocess to isolate VLC: %w", err)
+		return nil, "", fmt.Errorf("unable to start a subprocess to isolate VLC: %w", err)
 	}
 	err = child_process_manager.AddChildProcess(cmd.Process)
 	if err != nil {
@@ -78,13 +112,10 @@ func run(
 	err = decoder.Decode(&d)
 	logger.Debugf(ctx, "got data: %#+v", d)
 	if err != nil {
-		return nil, fmt.Errorf("unable to un-JSON-ize the process output: %w", err)
+		cmd.Process.Kill()
+		return nil, "", fmt.Errorf("unable to un-JSON-ize the process output: %w", err)
 	}
-
-	return &VLC{
-		Client: client.New(title, d.ListenAddr),
-		Cmd:    cmd,
-	}, nil
+	return cmd, d.ListenAddr, nil
 }
 
 func runInTheSameProcess(
@@ -101,9 +132,7 @@ func runInTheSameProcess(
 	})
 	select {
 	case addr := <-addrCh:
-		return &VLC{
-			Client: client.New(title, addr.String()),
-		}, nil
+		return newVLC(ctx, client.New(title, addr.String())), nil
 	case err := <-errCh:
 		return nil, err
 	}
@@ -137,7 +166,13 @@ func (vlc *VLC) OpenURL(
 	if vlc.Client == nil {
 		return fmt.Errorf("vlc.Client == nil")
 	}
-	return vlc.Client.OpenURL(ctx, link)
+	if err := vlc.Client.OpenURL(ctx, link); err != nil {
+		return err
+	}
+	vlc.stateLocker.Do(ctx, func() {
+		vlc.state = playbackState{Link: link}
+	})
+	return nil
 }
 
 func (vlc *VLC) GetLink(
@@ -259,7 +294,63 @@ func (vlc *VLC) SetPause(
 	if vlc.Client == nil {
 		return fmt.Errorf("vlc.Client == nil")
 	}
-	return vlc.Client.SetPause(ctx, pause)
+	if err := vlc.Client.SetPause(ctx, pause); err != nil {
+		return err
+	}
+	vlc.stateLocker.Do(ctx, func() {
+		vlc.state.IsPaused = pause
+	})
+	return nil
+}
+
+func (vlc *VLC) GetVolume(
+	ctx context.Context,
+) (float64, error) {
+	if vlc == nil {
+		return 0, fmt.Errorf("vlc == nil")
+	}
+	if vlc.Client == nil {
+		return 0, fmt.Errorf("vlc.Client == nil")
+	}
+	return vlc.Client.GetVolume(ctx)
+}
+
+func (vlc *VLC) SetVolume(
+	ctx context.Context,
+	volume float64,
+) error {
+	if vlc == nil {
+		return fmt.Errorf("vlc == nil")
+	}
+	if vlc.Client == nil {
+		return fmt.Errorf("vlc.Client == nil")
+	}
+	return vlc.Client.SetVolume(ctx, volume)
+}
+
+func (vlc *VLC) GetMute(
+	ctx context.Context,
+) (bool, error) {
+	if vlc == nil {
+		return false, fmt.Errorf("vlc == nil")
+	}
+	if vlc.Client == nil {
+		return false, fmt.Errorf("vlc.Client == nil")
+	}
+	return vlc.Client.GetMute(ctx)
+}
+
+func (vlc *VLC) SetMute(
+	ctx context.Context,
+	mute bool,
+) error {
+	if vlc == nil {
+		return fmt.Errorf("vlc == nil")
+	}
+	if vlc.Client == nil {
+		return fmt.Errorf("vlc.Client == nil")
+	}
+	return vlc.Client.SetMute(ctx, mute)
 }
 
 func (vlc *VLC) Seek(
@@ -277,6 +368,31 @@ func (vlc *VLC) Seek(
 	return vlc.Client.Seek(ctx, pos, isRelative, quick)
 }
 
+func (vlc *VLC) FrameStep(
+	ctx context.Context,
+	forward bool,
+) error {
+	if vlc == nil {
+		return fmt.Errorf("vlc == nil")
+	}
+	if vlc.Client == nil {
+		return fmt.Errorf("vlc.Client == nil")
+	}
+	return vlc.Client.FrameStep(ctx, forward)
+}
+
+func (vlc *VLC) Screenshot(
+	ctx context.Context,
+) (image.Image, error) {
+	if vlc == nil {
+		return nil, fmt.Errorf("vlc == nil")
+	}
+	if vlc.Client == nil {
+		return nil, fmt.Errorf("vlc.Client == nil")
+	}
+	return vlc.Client.Screenshot(ctx)
+}
+
 func (vlc *VLC) GetVideoTracks(
 	ctx context.Context,
 ) (types.VideoTracks, error) {
@@ -323,7 +439,13 @@ func (vlc *VLC) SetVideoTrack(
 	if vlc.Client == nil {
 		return fmt.Errorf("vlc.Client == nil")
 	}
-	return vlc.Client.SetVideoTrack(ctx, vid)
+	if err := vlc.Client.SetVideoTrack(ctx, vid); err != nil {
+		return err
+	}
+	vlc.stateLocker.Do(ctx, func() {
+		vlc.state.VideoTrackID = &vid
+	})
+	return nil
 }
 
 func (vlc *VLC) SetAudioTrack(
@@ -336,7 +458,13 @@ func (vlc *VLC) SetAudioTrack(
 	if vlc.Client == nil {
 		return fmt.Errorf("vlc.Client == nil")
 	}
-	return vlc.Client.SetAudioTrack(ctx, aid)
+	if err := vlc.Client.SetAudioTrack(ctx, aid); err != nil {
+		return err
+	}
+	vlc.stateLocker.Do(ctx, func() {
+		vlc.state.AudioTrackID = &aid
+	})
+	return nil
 }
 
 func (vlc *VLC) SetSubtitlesTrack(
@@ -349,7 +477,62 @@ func (vlc *VLC) SetSubtitlesTrack(
 	if vlc.Client == nil {
 		return fmt.Errorf("vlc.Client == nil")
 	}
-	return vlc.Client.SetSubtitlesTrack(ctx, sid)
+	if err := vlc.Client.SetSubtitlesTrack(ctx, sid); err != nil {
+		return err
+	}
+	vlc.stateLocker.Do(ctx, func() {
+		vlc.state.SubtitlesTrackID = &sid
+	})
+	return nil
+}
+
+func (vlc *VLC) GetMediaInfo(
+	ctx context.Context,
+) (*types.MediaInfo, error) {
+	if vlc == nil {
+		return nil, fmt.Errorf("vlc == nil")
+	}
+	if vlc.Client == nil {
+		return nil, fmt.Errorf("vlc.Client == nil")
+	}
+	return vlc.Client.GetMediaInfo(ctx)
+}
+
+func (vlc *VLC) GetChapters(
+	ctx context.Context,
+) (types.Chapters, error) {
+	if vlc == nil {
+		return nil, fmt.Errorf("vlc == nil")
+	}
+	if vlc.Client == nil {
+		return nil, fmt.Errorf("vlc.Client == nil")
+	}
+	return vlc.Client.GetChapters(ctx)
+}
+
+func (vlc *VLC) GetChapter(
+	ctx context.Context,
+) (int, error) {
+	if vlc == nil {
+		return -1, fmt.Errorf("vlc == nil")
+	}
+	if vlc.Client == nil {
+		return -1, fmt.Errorf("vlc.Client == nil")
+	}
+	return vlc.Client.GetChapter(ctx)
+}
+
+func (vlc *VLC) SetChapter(
+	ctx context.Context,
+	idx int,
+) error {
+	if vlc == nil {
+		return fmt.Errorf("vlc == nil")
+	}
+	if vlc.Client == nil {
+		return fmt.Errorf("vlc.Client == nil")
+	}
+	return vlc.Client.SetChapter(ctx, idx)
 }
 
 func (vlc *VLC) Stop(
@@ -361,7 +544,13 @@ func (vlc *VLC) Stop(
 	if vlc.Client == nil {
 		return fmt.Errorf("vlc.Client == nil")
 	}
-	return vlc.Client.Stop(ctx)
+	if err := vlc.Client.Stop(ctx); err != nil {
+		return err
+	}
+	vlc.stateLocker.Do(ctx, func() {
+		vlc.state = playbackState{}
+	})
+	return nil
 }
 
 func (vlc *VLC) Close(
@@ -370,11 +559,41 @@ func (vlc *VLC) Close(
 	if vlc == nil {
 		return fmt.Errorf("vlc == nil")
 	}
-	if vlc.Cmd != nil {
-		defer vlc.Cmd.Process.Kill()
+	var (
+		cmd      *exec.Cmd
+		exitChan chan struct{}
+	)
+	vlc.ProcessLocker.Do(ctx, func() {
+		// stops the supervision, so the subprocess is not restarted
+		vlc.isClosed = true
+		cmd, exitChan = vlc.Cmd, vlc.ExitChan
+	})
+	if vlc.cancelEvents != nil {
+		vlc.cancelEvents()
+	}
+	if cmd != nil {
+		defer cmd.Process.Kill()
 	}
 	if vlc.Client == nil {
 		return fmt.Errorf("vlc.Client == nil")
 	}
+	select {
+	case <-exitChan:
+		vlc.Client.Disconnect(ctx)
+		exitError := xsync.DoR1(ctx, &vlc.ProcessLocker, func() error {
+			return vlc.ExitError
+		})
+		return fmt.Errorf("the VLC subprocess has already exited: %v", exitError)
+	default:
+	}
 	return vlc.Client.Close(ctx)
 }
+
+func (vlc *VLC) Events(
+	ctx context.Context,
+) (<-chan types.Event, error) {
+	if vlc == nil {
+		return nil, fmt.Errorf("vlc == nil")
+	}
+	return vlc.events.Subscribe(ctx), nil
+}
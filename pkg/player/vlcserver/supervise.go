@@ -0,0 +1,254 @@
+//go:build with_libvlc
+// +build with_libvlc
+
+package vlcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/facebookincubator/go-belt/tool/logger"
+	"github.com/xaionaro-go/observability"
+	"github.com/xaionaro-go/player/pkg/player/types"
+	"github.com/xaionaro-go/player/pkg/player/vlcserver/client"
+	"github.com/xaionaro-go/xsync"
+)
+
+const (
+	restartRetryInterval = time.Second
+	eventsRetryInterval  = 500 * time.Millisecond
+	restoreWaitTimeout   = 10 * time.Second
+	restoreWaitInterval  = 100 * time.Millisecond
+)
+
+// playbackState is the state of the player, which is restored after
+// a restart of the subprocess.
+type playbackState struct {
+	Link             string
+	Position         time.Duration
+	IsPaused         bool
+	VideoTrackID     *int64
+	AudioTrackID     *int64
+	SubtitlesTrackID *int64
+}
+
+func newVLC(
+	ctx context.Context,
+	client *client.Client,
+) *VLC {
+	ctx, cancelFn := context.WithCancel(ctx)
+	vlc := &VLC{
+		Client:       client,
+		cancelEvents: cancelFn,
+	}
+	observability.Go(ctx, vlc.forwardEvents)
+	return vlc
+}
+
+// setProcess makes the VLC to use the subprocess; it returns false if
+// the VLC is already closed.
+func (vlc *VLC) setProcess(
+	ctx context.Context,
+	cmd *exec.Cmd,
+) bool {
+	exitChan := make(chan struct{})
+	isSet := xsync.DoR1(ctx, &vlc.ProcessLocker, func() bool {
+		if vlc.isClosed {
+			return false
+		}
+		vlc.Cmd = cmd
+		vlc.ExitChan = exitChan
+		vlc.ExitError = nil
+		return true
+	})
+	if !isSet {
+		return false
+	}
+	observability.Go(ctx, func(ctx context.Context) {
+		// the stdout is not read anymore, so it is safe to Wait
+		err := cmd.Wait()
+		logger.Debugf(ctx, "the VLC subprocess exited: %v", err)
+		vlc.ProcessLocker.Do(ctx, func() {
+			vlc.ExitError = err
+		})
+		close(exitChan)
+	})
+	return true
+}
+
+// supervise restarts the subprocess if it exits unexpectedly (for example,
+// if libvlc crashes), and restores the playback.
+func (vlc *VLC) supervise(ctx context.Context) {
+	logger.Debugf(ctx, "supervise")
+	defer logger.Debugf(ctx, "/supervise")
+	for {
+		exitChan := xsync.DoR1(ctx, &vlc.ProcessLocker, func() chan struct{} {
+			return vlc.ExitChan
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-exitChan:
+		}
+
+		var (
+			isClosed  bool
+			exitError error
+		)
+		vlc.ProcessLocker.Do(ctx, func() {
+			isClosed, exitError = vlc.isClosed, vlc.ExitError
+		})
+		if isClosed {
+			logger.Debugf(ctx, "the player is closed, not restarting the subprocess")
+			return
+		}
+
+		err := fmt.Errorf("the VLC subprocess unexpectedly exited: %v", exitError)
+		logger.Errorf(ctx, "%v; restarting it", err)
+		vlc.events.Emit(ctx, types.EventError{Err: err})
+		if err := vlc.restart(ctx); err != nil {
+			logger.Errorf(ctx, "unable to restart the VLC subprocess: %v", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(restartRetryInterval):
+			}
+		}
+	}
+}
+
+func (vlc *VLC) restart(
+	ctx context.Context,
+) (_err error) {
+	logger.Debugf(ctx, "restart")
+	defer func() { logger.Debugf(ctx, "/restart: %v", _err) }()
+
+	cmd, listenAddr, err := spawn(ctx)
+	if err != nil {
+		return err
+	}
+	if err := vlc.Client.SetTarget(ctx, listenAddr); err != nil {
+		logger.Debugf(ctx, "unable to close the previous connection: %v", err)
+	}
+	if !vlc.setProcess(ctx, cmd) {
+		logger.Debugf(ctx, "the player was closed during the restart")
+		cmd.Process.Kill()
+		return nil
+	}
+
+	state := xsync.DoR1(ctx, &vlc.stateLocker, func() playbackState {
+		return vlc.state
+	})
+	if err := vlc.restoreState(ctx, state); err != nil {
+		logger.Errorf(ctx, "unable to restore the playback state %#+v: %v", state, err)
+	}
+	return nil
+}
+
+func (vlc *VLC) restoreState(
+	ctx context.Context,
+	state playbackState,
+) error {
+	if state.Link == "" {
+		return nil
+	}
+	logger.Debugf(ctx, "reopen link '%s'", state.Link)
+	if err := vlc.Client.OpenURL(ctx, state.Link); err != nil {
+		return fmt.Errorf("unable to reopen link '%s': %w", state.Link, err)
+	}
+	vlc.waitForStart(ctx)
+
+	var errs []error
+	if state.VideoTrackID != nil {
+		if err := vlc.Client.SetVideoTrack(ctx, *state.VideoTrackID); err != nil {
+			errs = append(errs, fmt.Errorf("unable to set the video track: %w", err))
+		}
+	}
+	if state.AudioTrackID != nil {
+		if err := vlc.Client.SetAudioTrack(ctx, *state.AudioTrackID); err != nil {
+			errs = append(errs, fmt.Errorf("unable to set the audio track: %w", err))
+		}
+	}
+	if state.SubtitlesTrackID != nil {
+		if err := vlc.Client.SetSubtitlesTrack(ctx, *state.SubtitlesTrackID); err != nil {
+			errs = append(errs, fmt.Errorf("unable to set the subtitles track: %w", err))
+		}
+	}
+	if state.Position > 0 {
+		if err := vlc.Client.Seek(ctx, state.Position, false, false); err != nil {
+			errs = append(errs, fmt.Errorf("unable to seek to %v: %w", state.Position, err))
+		}
+	}
+	if err := vlc.Client.SetPause(ctx, state.IsPaused); err != nil {
+		errs = append(errs, fmt.Errorf("unable to set pause to %t: %w", state.IsPaused, err))
+	}
+	return errors.Join(errs...)
+}
+
+// waitForStart waits until the media is started (the length is known), so
+// that it could be seeked; it gives up after restoreWaitTimeout, because
+// the length of a live stream is never known.
+func (vlc *VLC) waitForStart(ctx context.Context) {
+	ctx, cancelFn := context.WithTimeout(ctx, restoreWaitTimeout)
+	defer cancelFn()
+	t := time.NewTicker(restoreWaitInterval)
+	defer t.Stop()
+	for {
+		if length, err := vlc.Client.GetLength(ctx); err == nil && length > 0 {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			logger.Debugf(ctx, "the length is still unknown: %v", ctx.Err())
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// forwardEvents re-emits the events of the current subprocess (so that
+// the subscriptions survive the restarts), and tracks the playback state.
+func (vlc *VLC) forwardEvents(ctx context.Context) {
+	logger.Debugf(ctx, "forwardEvents")
+	defer logger.Debugf(ctx, "/forwardEvents")
+	for {
+		ch, err := vlc.Client.Events(ctx)
+		if err != nil {
+			logger.Tracef(ctx, "unable to subscribe to the events: %v", err)
+		} else {
+			for ev := range ch {
+				vlc.onEvent(ctx, ev)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(eventsRetryInterval):
+		}
+	}
+}
+
+func (vlc *VLC) onEvent(
+	ctx context.Context,
+	ev types.Event,
+) {
+	switch ev := ev.(type) {
+	case types.EventPosition:
+		vlc.stateLocker.Do(ctx, func() {
+			vlc.state.Position = ev.Position
+		})
+	case types.EventStateChange:
+		vlc.stateLocker.Do(ctx, func() {
+			switch ev.State {
+			case types.PlaybackStatePlaying:
+				vlc.state.IsPaused = false
+			case types.PlaybackStatePaused:
+				vlc.state.IsPaused = true
+			}
+		})
+	}
+	vlc.events.Emit(ctx, ev)
+}